@@ -29,10 +29,13 @@ import (
 	"github.com/m3db/m3db/encoding"
 	"github.com/m3db/m3db/encoding/m3tsz"
 	"github.com/m3db/m3db/environment"
+	"github.com/m3db/m3db/storage/namespace"
 	"github.com/m3db/m3db/topology"
 	"github.com/m3db/m3db/x/tchannel"
 	"github.com/m3db/m3x/instrument"
 	"github.com/m3db/m3x/retry"
+
+	"google.golang.org/grpc/credentials"
 )
 
 var (
@@ -79,6 +82,58 @@ type Configuration struct {
 
 	// HashingConfiguration is the configuration for hashing of IDs to shards.
 	HashingConfiguration HashingConfiguration `yaml:"hashing"`
+
+	// HintedHandoff is the hinted handoff configuration, when set writes that
+	// fail against a replica are kept on disk and replayed once it is healthy.
+	HintedHandoff *HintedHandoffConfiguration `yaml:"hintedHandoff"`
+
+	// ReadRepair is the read repair configuration, when set datapoints missing
+	// from lagging replicas on a fetch are written back to them.
+	ReadRepair *ReadRepairConfiguration `yaml:"readRepair"`
+
+	// Transport is the network transport used to communicate with nodes, when
+	// set to grpc the topology must use the gRPC listen addresses of the nodes.
+	Transport *Transport `yaml:"transport"`
+
+	// GRPC is the gRPC transport configuration, only used when the transport
+	// is set to grpc.
+	GRPC *GRPCConfiguration `yaml:"grpc"`
+}
+
+// GRPCConfiguration is the configuration for the gRPC transport.
+type GRPCConfiguration struct {
+	// TLS is the TLS configuration, when not set connections are not encrypted.
+	TLS *GRPCTLSConfiguration `yaml:"tls"`
+}
+
+// GRPCTLSConfiguration is the configuration for gRPC transport TLS.
+type GRPCTLSConfiguration struct {
+	// CAFile is the path to the PEM encoded certificate authority used to
+	// verify the certificates of nodes.
+	CAFile string `yaml:"caFile" validate:"nonzero"`
+
+	// ServerNameOverride overrides the server name used to verify the
+	// certificates of nodes, by default the host of the node address is used.
+	ServerNameOverride string `yaml:"serverNameOverride"`
+}
+
+// ReadRepairConfiguration is the configuration for read repair.
+type ReadRepairConfiguration struct {
+	// SampleRate is the rate between 0 and 1 of series fetched that are
+	// checked for read repair, all series are checked when not set.
+	SampleRate float64 `yaml:"sampleRate" validate:"min=0,max=1"`
+}
+
+// HintedHandoffConfiguration is the configuration for hinted handoff.
+type HintedHandoffConfiguration struct {
+	// Directory is the directory hints are persisted to.
+	Directory string `yaml:"directory" validate:"nonzero"`
+
+	// MaxBytesPerHost is the max bytes of hints kept per host.
+	MaxBytesPerHost int64 `yaml:"maxBytesPerHost" validate:"min=0"`
+
+	// ReplayInterval is the interval at which hints are replayed.
+	ReplayInterval time.Duration `yaml:"replayInterval" validate:"min=0"`
 }
 
 // HashingConfiguration is the configuration for hashing
@@ -99,6 +154,11 @@ type ConfigurationParameters struct {
 	// constructing a client from configuration.
 	TopologyInitializer topology.Initializer
 
+	// NamespaceInitializer is an optional argument when constructing a
+	// client from configuration, it is used to resolve the retention of
+	// namespaces written to when a topology initializer is supplied.
+	NamespaceInitializer namespace.Initializer
+
 	// EncodingOptions is an optional argument when
 	// constructing a client from configuration.
 	EncodingOptions encoding.Options
@@ -148,7 +208,8 @@ func (c Configuration) NewAdminClient(
 	fetchRequestScope := iopts.MetricsScope().SubScope("fetch-req")
 
 	envCfg := environment.ConfigureResults{
-		TopologyInitializer: params.TopologyInitializer,
+		TopologyInitializer:  params.TopologyInitializer,
+		NamespaceInitializer: params.NamespaceInitializer,
 	}
 
 	var err error
@@ -180,6 +241,7 @@ func (c Configuration) NewAdminClient(
 
 	v := NewAdminOptions().
 		SetTopologyInitializer(envCfg.TopologyInitializer).
+		SetNamespaceInitializer(envCfg.NamespaceInitializer).
 		SetWriteConsistencyLevel(c.WriteConsistencyLevel).
 		SetReadConsistencyLevel(c.ReadConsistencyLevel).
 		SetClusterConnectConsistencyLevel(c.ConnectConsistencyLevel).
@@ -203,6 +265,37 @@ func (c Configuration) NewAdminClient(
 		return m3tsz.NewReaderIterator(r, intOptimized, encodingOpts)
 	})
 
+	if hh := c.HintedHandoff; hh != nil {
+		v = v.SetHintedHandoffEnabled(true).
+			SetHintedHandoffDirectory(hh.Directory)
+		if hh.MaxBytesPerHost > 0 {
+			v = v.SetHintedHandoffMaxBytesPerHost(hh.MaxBytesPerHost)
+		}
+		if hh.ReplayInterval > 0 {
+			v = v.SetHintedHandoffReplayInterval(hh.ReplayInterval)
+		}
+	}
+
+	if rr := c.ReadRepair; rr != nil {
+		v = v.SetReadRepairEnabled(true)
+		if rr.SampleRate > 0 {
+			v = v.SetReadRepairSampleRate(rr.SampleRate)
+		}
+	}
+
+	if c.Transport != nil {
+		v = v.SetTransport(*c.Transport)
+	}
+
+	if c.GRPC != nil && c.GRPC.TLS != nil {
+		tls := c.GRPC.TLS
+		creds, err := credentials.NewClientTLSFromFile(tls.CAFile, tls.ServerNameOverride)
+		if err != nil {
+			return nil, err
+		}
+		v = v.SetGRPCTransportCredentials(creds)
+	}
+
 	// Apply programtic custom options last
 	opts := v.(AdminOptions)
 	for _, opt := range custom {
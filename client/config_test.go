@@ -56,6 +56,17 @@ backgroundHealthCheckFailLimit: 4
 backgroundHealthCheckFailThrottleFactor: 0.5
 hashing:
   seed: 42
+hintedHandoff:
+  directory: /var/lib/m3db/hints
+  maxBytesPerHost: 1048576
+  replayInterval: 30s
+readRepair:
+  sampleRate: 0.1
+transport: grpc
+grpc:
+  tls:
+    caFile: /etc/m3db/ca.pem
+    serverNameOverride: m3db
 `
 
 	fd, err := ioutil.TempFile("", "config.yaml")
@@ -73,6 +84,7 @@ hashing:
 	require.NoError(t, err)
 
 	boolTrue := true
+	transport := GRPCTransport
 	expected := Configuration{
 		WriteConsistencyLevel:   topology.ConsistencyLevelMajority,
 		ReadConsistencyLevel:    ReadConsistencyLevelUnstrictMajority,
@@ -97,6 +109,21 @@ hashing:
 		HashingConfiguration: HashingConfiguration{
 			Seed: 42,
 		},
+		HintedHandoff: &HintedHandoffConfiguration{
+			Directory:       "/var/lib/m3db/hints",
+			MaxBytesPerHost: 1048576,
+			ReplayInterval:  30 * time.Second,
+		},
+		ReadRepair: &ReadRepairConfiguration{
+			SampleRate: 0.1,
+		},
+		Transport: &transport,
+		GRPC: &GRPCConfiguration{
+			TLS: &GRPCTLSConfiguration{
+				CAFile:             "/etc/m3db/ca.pem",
+				ServerNameOverride: "m3db",
+			},
+		},
 	}
 
 	assert.Equal(t, expected, cfg)
@@ -84,6 +84,11 @@ var globalNewConn = newConn
 func newConnectionPool(host topology.Host, opts Options) connectionPool {
 	seed := int64(murmur3.Sum32([]byte(host.Address())))
 
+	newConn := globalNewConn
+	if opts.Transport() == GRPCTransport {
+		newConn = newGRPCConn
+	}
+
 	p := &connPool{
 		opts:               opts,
 		host:               host,
@@ -91,7 +96,7 @@ func newConnectionPool(host topology.Host, opts Options) connectionPool {
 		poolLen:            0,
 		connectRand:        rand.NewSource(seed),
 		healthCheckRand:    rand.NewSource(seed + 1),
-		newConn:            globalNewConn,
+		newConn:            newConn,
 		healthCheckNewConn: healthCheck,
 		healthCheck:        healthCheck,
 		sleepConnect:       time.Sleep,
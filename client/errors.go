@@ -50,6 +50,18 @@ func IsBadRequestError(err error) bool {
 	return false
 }
 
+// IsQuotaExceededError determines if the error is the rejection of a request
+// that exceeds a namespace quota
+func IsQuotaExceededError(err error) bool {
+	for err != nil {
+		if e, ok := err.(*rpc.Error); ok && tterrors.IsQuotaExceededError(e) {
+			return true
+		}
+		err = xerrors.InnerError(err)
+	}
+	return false
+}
+
 // NumResponded returns how many nodes responded for a given error
 func NumResponded(err error) int {
 	for err != nil {
@@ -53,3 +53,23 @@ func TestConsistencyResultError(t *testing.T) {
 	assert.Equal(t, 1, NumSuccess(err))
 	assert.Equal(t, 2, NumError(err))
 }
+
+func TestIsQuotaExceededError(t *testing.T) {
+	quotaErr := &rpc.Error{
+		Type: rpc.ErrorType_QUOTA_EXCEEDED,
+	}
+	assert.True(t, IsQuotaExceededError(quotaErr))
+	assert.False(t, IsBadRequestError(quotaErr))
+	assert.False(t, IsInternalServerError(quotaErr))
+
+	err := consistencyResultErr{
+		level:       ReadConsistencyLevelMajority,
+		success:     1,
+		enqueued:    3,
+		responded:   3,
+		topLevelErr: quotaErr,
+		errs:        []error{quotaErr, quotaErr},
+	}
+	assert.True(t, IsQuotaExceededError(err))
+	assert.False(t, IsQuotaExceededError(fmt.Errorf("another error")))
+}
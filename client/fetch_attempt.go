@@ -59,8 +59,8 @@ func (f *fetchAttempt) perform() error {
 		f.args.ids, f.args.start, f.args.end)
 	f.result = result
 
-	if IsBadRequestError(err) {
-		// Do not retry bad request errors
+	if IsBadRequestError(err) || IsQuotaExceededError(err) {
+		// Do not retry bad request or quota exceeded errors
 		err = xerrors.NewNonRetryableError(err)
 	}
 
@@ -0,0 +1,302 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/m3db/m3db/encoding"
+	"github.com/m3db/m3db/generated/thrift/rpc"
+	"github.com/m3db/m3db/network/server/tchannelthrift/convert"
+	"github.com/m3db/m3db/topology"
+	"github.com/m3db/m3x/checked"
+	xerrors "github.com/m3db/m3x/errors"
+	"github.com/m3db/m3x/ident"
+)
+
+var (
+	errSeriesPageIterClosed = errors.New("series page iterator is closed")
+)
+
+type fetchBatchRawPagedOp struct {
+	request      rpc.FetchBatchRawPagedRequest
+	completionFn completionFn
+}
+
+func (f *fetchBatchRawPagedOp) Size() int {
+	// A page is always requested as a single op
+	return 1
+}
+
+func (f *fetchBatchRawPagedOp) CompletionFn() completionFn {
+	return f.completionFn
+}
+
+// seriesPageGroup is a set of IDs that belong to the same shard and so can be
+// fetched from the same replicas with a single page token.
+type seriesPageGroup struct {
+	shard uint32
+	ids   [][]byte
+}
+
+type seriesPageIter struct {
+	session    *session
+	namespace  []byte
+	start      time.Time
+	end        time.Time
+	rangeStart int64
+	rangeEnd   int64
+
+	groups    []seriesPageGroup
+	groupIdx  int
+	pageToken []byte
+
+	page    []encoding.SeriesIterator
+	pageIdx int
+	curr    encoding.SeriesIterator
+	err     error
+	closed  bool
+}
+
+func newSeriesPageIter(
+	s *session,
+	namespace []byte,
+	groups []seriesPageGroup,
+	start, end time.Time,
+	rangeStart, rangeEnd int64,
+) *seriesPageIter {
+	return &seriesPageIter{
+		session:    s,
+		namespace:  namespace,
+		start:      start,
+		end:        end,
+		rangeStart: rangeStart,
+		rangeEnd:   rangeEnd,
+		groups:     groups,
+	}
+}
+
+func (it *seriesPageIter) Next() bool {
+	if it.closed || it.err != nil {
+		return false
+	}
+	if it.curr != nil {
+		it.curr.Close()
+		it.curr = nil
+	}
+	for it.pageIdx >= len(it.page) {
+		if it.groupIdx >= len(it.groups) {
+			return false
+		}
+		if err := it.fetchNextPage(); err != nil {
+			it.err = err
+			return false
+		}
+	}
+	it.curr = it.page[it.pageIdx]
+	it.page[it.pageIdx] = nil
+	it.pageIdx++
+	return true
+}
+
+func (it *seriesPageIter) fetchNextPage() error {
+	group := it.groups[it.groupIdx]
+	req := rpc.FetchBatchRawPagedRequest{
+		RangeStart:    it.rangeStart,
+		RangeEnd:      it.rangeEnd,
+		RangeTimeType: rpc.TimeType_UNIX_NANOSECONDS,
+		NameSpace:     it.namespace,
+		Ids:           group.ids,
+		Limit:         int64(it.session.opts.FetchPagedBlockLimit()),
+		PageToken:     it.pageToken,
+	}
+	page, nextPageToken, err := it.session.fetchPage(group.shard, req, it.start, it.end)
+	if err != nil {
+		return err
+	}
+
+	it.page, it.pageIdx = page, 0
+	it.pageToken = nextPageToken
+	if nextPageToken == nil {
+		it.groupIdx++
+	}
+	return nil
+}
+
+func (it *seriesPageIter) Current() encoding.SeriesIterator {
+	return it.curr
+}
+
+func (it *seriesPageIter) Err() error {
+	if it.closed && it.err == nil {
+		return errSeriesPageIterClosed
+	}
+	return it.err
+}
+
+func (it *seriesPageIter) Close() {
+	if it.closed {
+		return
+	}
+	it.closed = true
+	if it.curr != nil {
+		it.curr.Close()
+		it.curr = nil
+	}
+	for i := it.pageIdx; i < len(it.page); i++ {
+		it.page[i].Close()
+	}
+	it.page = nil
+}
+
+// fetchPage requests a single page from every replica of a shard and merges
+// the segments each replica returned for every series into series iterators.
+func (s *session) fetchPage(
+	shard uint32,
+	req rpc.FetchBatchRawPagedRequest,
+	startInclusive, endExclusive time.Time,
+) ([]encoding.SeriesIterator, []byte, error) {
+	var (
+		wg         sync.WaitGroup
+		resultLock sync.Mutex
+		results    []*rpc.FetchBatchRawPagedResult_
+		resultErrs []error
+		enqueued   int32
+		enqueueErr error
+		majority   = atomic.LoadInt32(&s.majority)
+	)
+
+	f := &fetchBatchRawPagedOp{request: req}
+	f.completionFn = func(r interface{}, err error) {
+		resultLock.Lock()
+		if err != nil {
+			resultErrs = append(resultErrs, err)
+		} else {
+			results = append(results, r.(*rpc.FetchBatchRawPagedResult_))
+		}
+		resultLock.Unlock()
+		wg.Done()
+	}
+
+	s.RLock()
+	if s.state != stateOpen {
+		s.RUnlock()
+		return nil, nil, errSessionStateNotOpen
+	}
+	routeErr := s.topoMap.RouteShardForEach(shard, func(idx int, host topology.Host) {
+		if enqueueErr != nil {
+			return
+		}
+		wg.Add(1)
+		if err := s.queues[idx].Enqueue(f); err != nil {
+			wg.Done()
+			enqueueErr = err
+			return
+		}
+		enqueued++
+	})
+	s.RUnlock()
+
+	// Wait for all enqueued requests even on error as they reference the op
+	wg.Wait()
+
+	if err := xerrors.FirstError(routeErr, enqueueErr); err != nil {
+		s.log.Errorf("failed to enqueue request: %v", err)
+		return nil, nil, err
+	}
+
+	if len(results) == 0 {
+		errsLen := int32(len(resultErrs))
+		err := s.readConsistencyResult(majority, enqueued, enqueued, errsLen, resultErrs)
+		s.incFetchMetrics(err, errsLen)
+		return nil, nil, err
+	}
+
+	var (
+		nextPageToken   []byte
+		indexes         []int64
+		errsByIndex     = make(map[int64][]error)
+		segmentsByIndex = make(map[int64][][]*rpc.Segments)
+		seenIndexes     = make(map[int64]struct{})
+	)
+	for _, result := range results {
+		// Every replica visits the same blocks for the same request so
+		// they all return the same next page token.
+		nextPageToken = result.NextPageToken
+		for _, elem := range result.Elements {
+			if _, ok := seenIndexes[elem.Index]; !ok {
+				seenIndexes[elem.Index] = struct{}{}
+				indexes = append(indexes, elem.Index)
+			}
+			if elem.Err != nil {
+				errsByIndex[elem.Index] = append(errsByIndex[elem.Index], elem.Err)
+				continue
+			}
+			segmentsByIndex[elem.Index] = append(segmentsByIndex[elem.Index], elem.Segments)
+		}
+	}
+	sort.Sort(int64Asc(indexes))
+
+	page := make([]encoding.SeriesIterator, 0, len(indexes))
+	for _, idx := range indexes {
+		if idx < 0 || idx >= int64(len(req.Ids)) {
+			continue
+		}
+
+		errs := append(resultErrs[:len(resultErrs):len(resultErrs)], errsByIndex[idx]...)
+		errsLen := int32(len(errs))
+		err := s.readConsistencyResult(majority, enqueued, enqueued, errsLen, errs)
+		s.incFetchMetrics(err, errsLen)
+		if err != nil {
+			for _, iter := range page {
+				iter.Close()
+			}
+			return nil, nil, err
+		}
+
+		replicas := make([]encoding.Iterator, 0, len(segmentsByIndex[idx]))
+		for _, segments := range segmentsByIndex[idx] {
+			slicesIter := s.readerSliceOfSlicesIteratorPool.Get()
+			slicesIter.Reset(segments)
+			multiIter := s.multiReaderIteratorPool.Get()
+			multiIter.ResetSliceOfSlices(slicesIter)
+			replicas = append(replicas, multiIter)
+		}
+
+		iter := s.seriesIteratorPool.Get()
+		iter.Reset(ident.BinaryID(checked.NewBytes(req.Ids[idx], nil)),
+			ident.BinaryID(checked.NewBytes(req.NameSpace, nil)),
+			startInclusive, endExclusive, replicas)
+		page = append(page, iter)
+	}
+
+	return page, nextPageToken, nil
+}
+
+type int64Asc []int64
+
+func (s int64Asc) Len() int           { return len(s) }
+func (s int64Asc) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s int64Asc) Less(i, j int) bool { return s[i] < s[j] }
@@ -0,0 +1,165 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3db/generated/thrift/rpc"
+	"github.com/m3db/m3x/ident"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchIDsPaged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions().SetFetchPagedBlockLimit(2)
+	s, err := newSession(opts)
+	assert.NoError(t, err)
+	session := s.(*session)
+
+	var (
+		start     = time.Now().Truncate(time.Hour)
+		end       = start.Add(time.Hour)
+		pageToken = []byte("token")
+		fooValues = []readRepairTestValue{{start.Add(time.Second), 1}}
+		barValues = []readRepairTestValue{{start.Add(2 * time.Second), 2}}
+	)
+	mockHostQueues(ctrl, session, sessionTestReplicas, []testEnqueueFn{
+		func(idx int, op op) {
+			fetch, ok := op.(*fetchBatchRawPagedOp)
+			require.True(t, ok)
+			assert.Equal(t, []byte("metrics"), fetch.request.NameSpace)
+			assert.Equal(t, [][]byte{[]byte("foo"), []byte("bar")}, fetch.request.Ids)
+			assert.Equal(t, start.UnixNano(), fetch.request.RangeStart)
+			assert.Equal(t, end.UnixNano(), fetch.request.RangeEnd)
+			assert.Equal(t, int64(2), fetch.request.Limit)
+			assert.Nil(t, fetch.request.PageToken)
+
+			if idx == 0 {
+				fetch.completionFn(nil, errors.New("an error"))
+				return
+			}
+			fetch.completionFn(&rpc.FetchBatchRawPagedResult_{
+				Elements: []*rpc.FetchRawPagedResult_{
+					{Index: 0, Segments: newReadRepairTestSegments(fooValues)},
+				},
+				NextPageToken: pageToken,
+			}, nil)
+		},
+		func(idx int, op op) {
+			fetch, ok := op.(*fetchBatchRawPagedOp)
+			require.True(t, ok)
+			assert.Equal(t, pageToken, fetch.request.PageToken)
+
+			fetch.completionFn(&rpc.FetchBatchRawPagedResult_{
+				Elements: []*rpc.FetchRawPagedResult_{
+					{Index: 1, Segments: newReadRepairTestSegments(barValues)},
+				},
+			}, nil)
+		},
+	})
+
+	assert.NoError(t, session.Open())
+
+	iter, err := s.FetchIDsPaged(ident.StringID("metrics"),
+		ident.NewStringIDsSliceIterator([]string{"foo", "bar"}), start, end)
+	require.NoError(t, err)
+
+	expected := []struct {
+		id     string
+		values []readRepairTestValue
+	}{
+		{"foo", fooValues},
+		{"bar", barValues},
+	}
+	for _, e := range expected {
+		require.True(t, iter.Next())
+		series := iter.Current()
+		assert.Equal(t, e.id, series.ID().String())
+
+		var values []readRepairTestValue
+		for series.Next() {
+			dp, _, _ := series.Current()
+			values = append(values, readRepairTestValue{dp.Timestamp, dp.Value})
+		}
+		require.NoError(t, series.Err())
+		require.Equal(t, len(e.values), len(values))
+		for i := range values {
+			assert.True(t, e.values[i].t.Equal(values[i].t))
+			assert.Equal(t, e.values[i].value, values[i].value)
+		}
+	}
+	require.False(t, iter.Next())
+	require.NoError(t, iter.Err())
+	iter.Close()
+
+	assert.NoError(t, session.Close())
+}
+
+func TestFetchIDsPagedConsistencyError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions()
+	s, err := newSession(opts)
+	assert.NoError(t, err)
+	session := s.(*session)
+
+	start := time.Now().Truncate(time.Hour)
+	mockHostQueues(ctrl, session, sessionTestReplicas, []testEnqueueFn{
+		func(idx int, op op) {
+			fetch, ok := op.(*fetchBatchRawPagedOp)
+			require.True(t, ok)
+			if idx == 0 {
+				fetch.completionFn(&rpc.FetchBatchRawPagedResult_{
+					Elements: []*rpc.FetchRawPagedResult_{
+						{Index: 0, Segments: []*rpc.Segments{}},
+					},
+				}, nil)
+				return
+			}
+			fetch.completionFn(&rpc.FetchBatchRawPagedResult_{
+				Elements: []*rpc.FetchRawPagedResult_{
+					{Index: 0, Err: &rpc.Error{Message: "an error"}},
+				},
+			}, nil)
+		},
+	})
+
+	assert.NoError(t, session.Open())
+
+	iter, err := s.FetchIDsPaged(ident.StringID("metrics"),
+		ident.NewStringIDsSliceIterator([]string{"foo"}), start, start.Add(time.Hour))
+	require.NoError(t, err)
+
+	require.False(t, iter.Next())
+	require.Error(t, iter.Err())
+	iter.Close()
+
+	assert.NoError(t, session.Close())
+}
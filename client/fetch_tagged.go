@@ -0,0 +1,106 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"time"
+
+	"github.com/m3db/m3db/encoding"
+	"github.com/m3db/m3db/generated/thrift/rpc"
+	"github.com/m3db/m3db/ts"
+	"github.com/m3db/m3x/ident"
+	xtime "github.com/m3db/m3x/time"
+)
+
+type fetchTaggedOp struct {
+	request      rpc.FetchTaggedRequest
+	completionFn completionFn
+}
+
+func (f *fetchTaggedOp) Size() int {
+	// Fetch tagged is always a single op
+	return 1
+}
+
+func (f *fetchTaggedOp) CompletionFn() completionFn {
+	return f.completionFn
+}
+
+type fetchTaggedSeriesKey struct {
+	namespace string
+	id        string
+}
+
+// fetchTaggedSeries is a series fetched by a tagged fetch along with the
+// datapoints returned by each replica that returned the series successfully.
+type fetchTaggedSeries struct {
+	namespace string
+	id        string
+	tags      ident.Tags
+	replicas  [][]*rpc.Datapoint
+}
+
+// datapointsIterator iterates over the datapoints returned by a replica for
+// a tagged fetch, with timestamps in nanoseconds.
+type datapointsIterator struct {
+	datapoints []*rpc.Datapoint
+	idx        int
+}
+
+func newDatapointsIterator(datapoints []*rpc.Datapoint) encoding.Iterator {
+	return &datapointsIterator{datapoints: datapoints, idx: -1}
+}
+
+func (it *datapointsIterator) Next() bool {
+	if it.idx >= len(it.datapoints) {
+		return false
+	}
+	it.idx++
+	return it.idx < len(it.datapoints)
+}
+
+func (it *datapointsIterator) Current() (ts.Datapoint, xtime.Unit, ts.Annotation) {
+	dp := it.datapoints[it.idx]
+	return ts.Datapoint{
+		Timestamp: xtime.FromNormalizedTime(dp.Timestamp, time.Nanosecond),
+		Value:     dp.Value,
+	}, xtime.Nanosecond, dp.Annotation
+}
+
+func (it *datapointsIterator) Err() error {
+	return nil
+}
+
+func (it *datapointsIterator) Close() {
+	it.datapoints = nil
+}
+
+// taggedSeriesIterator is a series iterator that returns the tags
+// the series was fetched with.
+type taggedSeriesIterator struct {
+	encoding.SeriesIterator
+
+	tags ident.Tags
+}
+
+func (it *taggedSeriesIterator) Tags() ident.TagIterator {
+	return ident.NewTagSliceIterator(it.tags)
+}
@@ -0,0 +1,243 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package client
+
+import (
+	"github.com/m3db/m3db/generated/proto/rpcpb"
+	"github.com/m3db/m3db/generated/thrift/rpc"
+	"github.com/m3db/m3db/network/server/grpc/convert"
+	xclose "github.com/m3db/m3x/close"
+
+	"github.com/uber/tchannel-go/thrift"
+	"google.golang.org/grpc"
+)
+
+// grpcNodeClient is a node client that talks to the gRPC node service while
+// exposing the same interface as the TChannel Thrift node client, so that host
+// queues and connection pools are agnostic of the transport in use
+type grpcNodeClient struct {
+	conn   *grpc.ClientConn
+	client rpcpb.NodeClient
+}
+
+func newGRPCConn(channelName string, address string, opts Options) (xclose.SimpleCloser, rpc.TChanNode, error) {
+	// NB: the vendored gRPC version does not limit the size of messages
+	// received by clients, large results are bounded by the server only.
+	dialOpts := []grpc.DialOption{grpc.WithUserAgent(channelName)}
+	if creds := opts.GRPCTransportCredentials(); creds != nil {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(creds))
+	} else {
+		dialOpts = append(dialOpts, grpc.WithInsecure())
+	}
+	conn, err := grpc.Dial(address, dialOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	client := &grpcNodeClient{
+		conn:   conn,
+		client: rpcpb.NewNodeClient(conn),
+	}
+	return client, client, nil
+}
+
+func (c *grpcNodeClient) Close() {
+	c.conn.Close()
+}
+
+func (c *grpcNodeClient) Fetch(ctx thrift.Context, req *rpc.FetchRequest) (*rpc.FetchResult_, error) {
+	result, err := c.client.Fetch(ctx, convert.ToProtoFetchRequest(req))
+	if err != nil {
+		return nil, convert.FromGRPCError(err)
+	}
+	return convert.ToRPCFetchResult(result), nil
+}
+
+func (c *grpcNodeClient) FetchTagged(ctx thrift.Context, req *rpc.FetchTaggedRequest) (*rpc.FetchTaggedResult_, error) {
+	result, err := c.client.FetchTagged(ctx, convert.ToProtoFetchTaggedRequest(req))
+	if err != nil {
+		return nil, convert.FromGRPCError(err)
+	}
+	return convert.ToRPCFetchTaggedResult(result), nil
+}
+
+func (c *grpcNodeClient) Write(ctx thrift.Context, req *rpc.WriteRequest) error {
+	_, err := c.client.Write(ctx, convert.ToProtoWriteRequest(req))
+	return convert.FromGRPCError(err)
+}
+
+func (c *grpcNodeClient) WriteTagged(ctx thrift.Context, req *rpc.WriteTaggedRequest) error {
+	_, err := c.client.WriteTagged(ctx, convert.ToProtoWriteTaggedRequest(req))
+	return convert.FromGRPCError(err)
+}
+
+func (c *grpcNodeClient) FetchBatchRaw(ctx thrift.Context, req *rpc.FetchBatchRawRequest) (*rpc.FetchBatchRawResult_, error) {
+	result, err := c.client.FetchBatchRaw(ctx, convert.ToProtoFetchBatchRawRequest(req))
+	if err != nil {
+		return nil, convert.FromGRPCError(err)
+	}
+	return convert.ToRPCFetchBatchRawResult(result), nil
+}
+
+func (c *grpcNodeClient) FetchBatchRawPaged(ctx thrift.Context, req *rpc.FetchBatchRawPagedRequest) (*rpc.FetchBatchRawPagedResult_, error) {
+	result, err := c.client.FetchBatchRawPaged(ctx, convert.ToProtoFetchBatchRawPagedRequest(req))
+	if err != nil {
+		return nil, convert.FromGRPCError(err)
+	}
+	return convert.ToRPCFetchBatchRawPagedResult(result), nil
+}
+
+func (c *grpcNodeClient) FetchBlocksRaw(ctx thrift.Context, req *rpc.FetchBlocksRawRequest) (*rpc.FetchBlocksRawResult_, error) {
+	result, err := c.client.FetchBlocksRaw(ctx, convert.ToProtoFetchBlocksRawRequest(req))
+	if err != nil {
+		return nil, convert.FromGRPCError(err)
+	}
+	return convert.ToRPCFetchBlocksRawResult(result), nil
+}
+
+func (c *grpcNodeClient) FetchBlocksMetadataRaw(ctx thrift.Context, req *rpc.FetchBlocksMetadataRawRequest) (*rpc.FetchBlocksMetadataRawResult_, error) {
+	result, err := c.client.FetchBlocksMetadataRaw(ctx, convert.ToProtoFetchBlocksMetadataRawRequest(req))
+	if err != nil {
+		return nil, convert.FromGRPCError(err)
+	}
+	return convert.ToRPCFetchBlocksMetadataRawResult(result), nil
+}
+
+func (c *grpcNodeClient) FetchBlocksMetadataRawV2(ctx thrift.Context, req *rpc.FetchBlocksMetadataRawV2Request) (*rpc.FetchBlocksMetadataRawV2Result_, error) {
+	result, err := c.client.FetchBlocksMetadataRawV2(ctx, convert.ToProtoFetchBlocksMetadataRawV2Request(req))
+	if err != nil {
+		return nil, convert.FromGRPCError(err)
+	}
+	return convert.ToRPCFetchBlocksMetadataRawV2Result(result), nil
+}
+
+func (c *grpcNodeClient) WriteBatchRaw(ctx thrift.Context, req *rpc.WriteBatchRawRequest) error {
+	result, err := c.client.WriteBatchRaw(ctx, convert.ToProtoWriteBatchRawRequest(req))
+	if err != nil {
+		return convert.FromGRPCError(err)
+	}
+	if len(result.Errors) > 0 {
+		return convert.ToRPCWriteBatchRawErrors(result)
+	}
+	return nil
+}
+
+func (c *grpcNodeClient) WriteTaggedBatchRaw(ctx thrift.Context, req *rpc.WriteTaggedBatchRawRequest) error {
+	result, err := c.client.WriteTaggedBatchRaw(ctx, convert.ToProtoWriteTaggedBatchRawRequest(req))
+	if err != nil {
+		return convert.FromGRPCError(err)
+	}
+	if len(result.Errors) > 0 {
+		return convert.ToRPCWriteBatchRawErrors(result)
+	}
+	return nil
+}
+
+func (c *grpcNodeClient) Repair(ctx thrift.Context) error {
+	_, err := c.client.Repair(ctx, &rpcpb.Empty{})
+	return convert.FromGRPCError(err)
+}
+
+func (c *grpcNodeClient) Truncate(ctx thrift.Context, req *rpc.TruncateRequest) (*rpc.TruncateResult_, error) {
+	result, err := c.client.Truncate(ctx, convert.ToProtoTruncateRequest(req))
+	if err != nil {
+		return nil, convert.FromGRPCError(err)
+	}
+	return convert.ToRPCTruncateResult(result), nil
+}
+
+func (c *grpcNodeClient) Health(ctx thrift.Context) (*rpc.NodeHealthResult_, error) {
+	result, err := c.client.Health(ctx, &rpcpb.Empty{})
+	if err != nil {
+		return nil, convert.FromGRPCError(err)
+	}
+	return convert.ToRPCNodeHealthResult(result), nil
+}
+
+func (c *grpcNodeClient) GetPersistRateLimit(ctx thrift.Context) (*rpc.NodePersistRateLimitResult_, error) {
+	result, err := c.client.GetPersistRateLimit(ctx, &rpcpb.Empty{})
+	if err != nil {
+		return nil, convert.FromGRPCError(err)
+	}
+	return convert.ToRPCNodePersistRateLimitResult(result), nil
+}
+
+func (c *grpcNodeClient) SetPersistRateLimit(ctx thrift.Context, req *rpc.NodeSetPersistRateLimitRequest) (*rpc.NodePersistRateLimitResult_, error) {
+	result, err := c.client.SetPersistRateLimit(ctx, convert.ToProtoNodeSetPersistRateLimitRequest(req))
+	if err != nil {
+		return nil, convert.FromGRPCError(err)
+	}
+	return convert.ToRPCNodePersistRateLimitResult(result), nil
+}
+
+func (c *grpcNodeClient) GetWriteNewSeriesAsync(ctx thrift.Context) (*rpc.NodeWriteNewSeriesAsyncResult_, error) {
+	result, err := c.client.GetWriteNewSeriesAsync(ctx, &rpcpb.Empty{})
+	if err != nil {
+		return nil, convert.FromGRPCError(err)
+	}
+	return convert.ToRPCNodeWriteNewSeriesAsyncResult(result), nil
+}
+
+func (c *grpcNodeClient) SetWriteNewSeriesAsync(ctx thrift.Context, req *rpc.NodeSetWriteNewSeriesAsyncRequest) (*rpc.NodeWriteNewSeriesAsyncResult_, error) {
+	result, err := c.client.SetWriteNewSeriesAsync(ctx, convert.ToProtoNodeSetWriteNewSeriesAsyncRequest(req))
+	if err != nil {
+		return nil, convert.FromGRPCError(err)
+	}
+	return convert.ToRPCNodeWriteNewSeriesAsyncResult(result), nil
+}
+
+func (c *grpcNodeClient) GetWriteNewSeriesBackoffDuration(ctx thrift.Context) (*rpc.NodeWriteNewSeriesBackoffDurationResult_, error) {
+	result, err := c.client.GetWriteNewSeriesBackoffDuration(ctx, &rpcpb.Empty{})
+	if err != nil {
+		return nil, convert.FromGRPCError(err)
+	}
+	return convert.ToRPCNodeWriteNewSeriesBackoffDurationResult(result), nil
+}
+
+func (c *grpcNodeClient) SetWriteNewSeriesBackoffDuration(ctx thrift.Context, req *rpc.NodeSetWriteNewSeriesBackoffDurationRequest) (*rpc.NodeWriteNewSeriesBackoffDurationResult_, error) {
+	result, err := c.client.SetWriteNewSeriesBackoffDuration(ctx, convert.ToProtoNodeSetWriteNewSeriesBackoffDurationRequest(req))
+	if err != nil {
+		return nil, convert.FromGRPCError(err)
+	}
+	return convert.ToRPCNodeWriteNewSeriesBackoffDurationResult(result), nil
+}
+
+func (c *grpcNodeClient) GetWriteNewSeriesLimitPerShardPerSecond(ctx thrift.Context) (*rpc.NodeWriteNewSeriesLimitPerShardPerSecondResult_, error) {
+	result, err := c.client.GetWriteNewSeriesLimitPerShardPerSecond(ctx, &rpcpb.Empty{})
+	if err != nil {
+		return nil, convert.FromGRPCError(err)
+	}
+	return convert.ToRPCNodeWriteNewSeriesLimitPerShardPerSecondResult(result), nil
+}
+
+func (c *grpcNodeClient) SetWriteNewSeriesLimitPerShardPerSecond(ctx thrift.Context, req *rpc.NodeSetWriteNewSeriesLimitPerShardPerSecondRequest) (*rpc.NodeWriteNewSeriesLimitPerShardPerSecondResult_, error) {
+	result, err := c.client.SetWriteNewSeriesLimitPerShardPerSecond(ctx, convert.ToProtoNodeSetWriteNewSeriesLimitPerShardPerSecondRequest(req))
+	if err != nil {
+		return nil, convert.FromGRPCError(err)
+	}
+	return convert.ToRPCNodeWriteNewSeriesLimitPerShardPerSecondResult(result), nil
+}
+
+func (c *grpcNodeClient) GetBootstrapStatus(ctx thrift.Context) (*rpc.NodeBootstrapStatusResult_, error) {
+	result, err := c.client.GetBootstrapStatus(ctx, &rpcpb.Empty{})
+	if err != nil {
+		return nil, convert.FromGRPCError(err)
+	}
+	return convert.ToRPCNodeBootstrapStatusResult(result), nil
+}
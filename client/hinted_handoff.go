@@ -0,0 +1,584 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"encoding/binary"
+	"errors"
+	"io/ioutil"
+	"math"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3db/clock"
+	"github.com/m3db/m3db/digest"
+	"github.com/m3db/m3db/generated/thrift/rpc"
+	"github.com/m3db/m3db/network/server/tchannelthrift/convert"
+	"github.com/m3db/m3x/checked"
+	"github.com/m3db/m3x/ident"
+	xlog "github.com/m3db/m3x/log"
+
+	"github.com/uber-go/tally"
+)
+
+const (
+	hintsFileSuffix    = ".hints"
+	hintsTmpFileSuffix = ".hints.tmp"
+
+	// hintHeaderLen is the length of the record header, the payload
+	// length followed by the adler32 checksum of the payload
+	hintHeaderLen = 8
+
+	// hintFixedPayloadLen is the length of the fixed size fields of a hint,
+	// timestamp, time type, value and the three lengths of the namespace,
+	// ID and annotation
+	hintFixedPayloadLen = 8 + 4 + 8 + 4 + 4 + 4
+
+	hintsFilePerm = 0666
+	hintsDirPerm  = 0755
+)
+
+var (
+	errHintCorrupt = errors.New("hint record is corrupt")
+)
+
+// hostQueueLookupFn returns the current host queue for a host, if any
+type hostQueueLookupFn func(hostID string) (hostQueue, bool)
+
+// hint is a write that failed against a single replica and is
+// kept to be replayed once the replica is healthy again
+type hint struct {
+	namespace  []byte
+	id         []byte
+	timestamp  int64
+	timeType   rpc.TimeType
+	value      float64
+	annotation []byte
+}
+
+func (h hint) encodedLen() int {
+	return hintHeaderLen + hintFixedPayloadLen +
+		len(h.namespace) + len(h.id) + len(h.annotation)
+}
+
+func (h hint) encode(buf []byte) []byte {
+	var (
+		total = h.encodedLen()
+		b     []byte
+	)
+	if cap(buf) >= total {
+		b = buf[:total]
+	} else {
+		b = make([]byte, total)
+	}
+
+	payload := b[hintHeaderLen:]
+	idx := 0
+	binary.BigEndian.PutUint64(payload[idx:], uint64(h.timestamp))
+	idx += 8
+	binary.BigEndian.PutUint32(payload[idx:], uint32(h.timeType))
+	idx += 4
+	binary.BigEndian.PutUint64(payload[idx:], math.Float64bits(h.value))
+	idx += 8
+	for _, v := range [][]byte{h.namespace, h.id, h.annotation} {
+		binary.BigEndian.PutUint32(payload[idx:], uint32(len(v)))
+		idx += 4
+		idx += copy(payload[idx:], v)
+	}
+
+	binary.BigEndian.PutUint32(b, uint32(len(payload)))
+	binary.BigEndian.PutUint32(b[4:], digest.Checksum(payload))
+	return b
+}
+
+// decodeHints decodes all hints from the buffer, it stops at the first
+// corrupt or truncated record and returns the hints decoded before it
+func decodeHints(b []byte) ([]hint, error) {
+	var hints []hint
+	for len(b) > 0 {
+		if len(b) < hintHeaderLen {
+			return hints, errHintCorrupt
+		}
+		size := int(binary.BigEndian.Uint32(b))
+		checksum := binary.BigEndian.Uint32(b[4:])
+		b = b[hintHeaderLen:]
+		if size < hintFixedPayloadLen || size > len(b) {
+			return hints, errHintCorrupt
+		}
+		payload := b[:size]
+		b = b[size:]
+		if digest.Checksum(payload) != checksum {
+			return hints, errHintCorrupt
+		}
+
+		var h hint
+		idx := 0
+		h.timestamp = int64(binary.BigEndian.Uint64(payload[idx:]))
+		idx += 8
+		h.timeType = rpc.TimeType(binary.BigEndian.Uint32(payload[idx:]))
+		idx += 4
+		h.value = math.Float64frombits(binary.BigEndian.Uint64(payload[idx:]))
+		idx += 8
+		fields := []*[]byte{&h.namespace, &h.id, &h.annotation}
+		for _, field := range fields {
+			if idx+4 > len(payload) {
+				return hints, errHintCorrupt
+			}
+			n := int(binary.BigEndian.Uint32(payload[idx:]))
+			idx += 4
+			if idx+n > len(payload) {
+				return hints, errHintCorrupt
+			}
+			if n > 0 {
+				*field = append([]byte(nil), payload[idx:idx+n]...)
+			}
+			idx += n
+		}
+		hints = append(hints, h)
+	}
+	return hints, nil
+}
+
+type hintedHandoffMetrics struct {
+	hints             tally.Counter
+	droppedMaxBytes   tally.Counter
+	droppedWriteError tally.Counter
+	expired           tally.Counter
+	corrupt           tally.Counter
+	replaySuccess     tally.Counter
+	replayErrors      tally.Counter
+	pendingBytes      tally.Gauge
+}
+
+func newHintedHandoffMetrics(scope tally.Scope) hintedHandoffMetrics {
+	return hintedHandoffMetrics{
+		hints: scope.Counter("hints"),
+		droppedMaxBytes: scope.Tagged(map[string]string{
+			"reason": "max-bytes",
+		}).Counter("dropped"),
+		droppedWriteError: scope.Tagged(map[string]string{
+			"reason": "write-error",
+		}).Counter("dropped"),
+		expired:       scope.Counter("expired"),
+		corrupt:       scope.Counter("corrupt"),
+		replaySuccess: scope.Counter("replay-success"),
+		replayErrors:  scope.Counter("replay-errors"),
+		pendingBytes:  scope.Gauge("pending-bytes"),
+	}
+}
+
+// hintedHandoff keeps bounded, disk backed hints of writes that failed
+// per host and replays them through the host queue once the host is
+// healthy again
+type hintedHandoff struct {
+	sync.Mutex
+
+	dir             string
+	maxBytesPerHost int64
+	replayInterval  time.Duration
+	nowFn           clock.NowFn
+	log             xlog.Logger
+	lookupFn        hostQueueLookupFn
+	nsLookupFn      namespaceLookupFn
+	hosts           map[string]*hostHints
+	closed          bool
+	closeCh         chan struct{}
+	doneCh          chan struct{}
+	metrics         hintedHandoffMetrics
+}
+
+type hostHints struct {
+	sync.Mutex
+
+	hostID string
+	path   string
+	fd     *os.File
+	bytes  int64
+	buf    []byte
+}
+
+func newHintedHandoff(
+	opts Options,
+	lookupFn hostQueueLookupFn,
+	nsLookupFn namespaceLookupFn,
+) (*hintedHandoff, error) {
+	dir := opts.HintedHandoffDirectory()
+	if err := os.MkdirAll(dir, hintsDirPerm); err != nil {
+		return nil, err
+	}
+
+	scope := opts.InstrumentOptions().MetricsScope().SubScope("hinted-handoff")
+	h := &hintedHandoff{
+		dir:             dir,
+		maxBytesPerHost: opts.HintedHandoffMaxBytesPerHost(),
+		replayInterval:  opts.HintedHandoffReplayInterval(),
+		nowFn:           opts.ClockOptions().NowFn(),
+		log:             opts.InstrumentOptions().Logger(),
+		lookupFn:        lookupFn,
+		nsLookupFn:      nsLookupFn,
+		hosts:           make(map[string]*hostHints),
+		closeCh:         make(chan struct{}),
+		doneCh:          make(chan struct{}),
+		metrics:         newHintedHandoffMetrics(scope),
+	}
+
+	// Pick up hints persisted before a restart
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		name := f.Name()
+		if f.IsDir() || !strings.HasSuffix(name, hintsFileSuffix) {
+			continue
+		}
+		hostID, err := url.PathUnescape(strings.TrimSuffix(name, hintsFileSuffix))
+		if err != nil {
+			continue
+		}
+		hh, err := h.newHostHints(hostID)
+		if err != nil {
+			h.closeHosts()
+			return nil, err
+		}
+		h.hosts[hostID] = hh
+	}
+
+	return h, nil
+}
+
+func (h *hintedHandoff) newHostHints(hostID string) (*hostHints, error) {
+	path := filepath.Join(h.dir, url.PathEscape(hostID)+hintsFileSuffix)
+	fd, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, hintsFilePerm)
+	if err != nil {
+		return nil, err
+	}
+	stat, err := fd.Stat()
+	if err != nil {
+		fd.Close()
+		return nil, err
+	}
+	return &hostHints{
+		hostID: hostID,
+		path:   path,
+		fd:     fd,
+		bytes:  stat.Size(),
+	}, nil
+}
+
+func (h *hintedHandoff) start() {
+	go h.run()
+}
+
+func (h *hintedHandoff) run() {
+	defer close(h.doneCh)
+
+	ticker := time.NewTicker(h.replayInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.closeCh:
+			return
+		case <-ticker.C:
+			h.replay()
+		}
+	}
+}
+
+// add records a hint for a write operation that failed against a host
+func (h *hintedHandoff) add(hostID string, op *writeOperation) {
+	dp := op.request.Datapoint
+	h.addHint(hostID, hint{
+		namespace:  op.namespace.Data().Get(),
+		id:         op.request.ID,
+		timestamp:  dp.Timestamp,
+		timeType:   dp.TimestampTimeType,
+		value:      dp.Value,
+		annotation: dp.Annotation,
+	})
+}
+
+func (h *hintedHandoff) addHint(hostID string, value hint) {
+	h.Lock()
+	if h.closed {
+		h.Unlock()
+		return
+	}
+	hh, ok := h.hosts[hostID]
+	if !ok {
+		var err error
+		hh, err = h.newHostHints(hostID)
+		if err != nil {
+			h.Unlock()
+			h.metrics.droppedWriteError.Inc(1)
+			h.log.Errorf("could not open hints file for host %s: %v", hostID, err)
+			return
+		}
+		h.hosts[hostID] = hh
+	}
+	h.Unlock()
+
+	hh.Lock()
+	defer hh.Unlock()
+
+	if hh.fd == nil {
+		// Closed concurrently
+		return
+	}
+	size := int64(value.encodedLen())
+	if hh.bytes+size > h.maxBytesPerHost {
+		h.metrics.droppedMaxBytes.Inc(1)
+		return
+	}
+	hh.buf = value.encode(hh.buf)
+	if _, err := hh.fd.Write(hh.buf); err != nil {
+		h.metrics.droppedWriteError.Inc(1)
+		h.log.Errorf("could not write hint for host %s: %v", hostID, err)
+		return
+	}
+	hh.bytes += size
+	h.metrics.hints.Inc(1)
+}
+
+// drain returns the hints kept for the host along with the length of the
+// hints file they were read from, the hints are kept on disk until the
+// result of replaying them is committed
+func (hh *hostHints) drain() ([]hint, int64, error) {
+	hh.Lock()
+	defer hh.Unlock()
+
+	if hh.fd == nil || hh.bytes == 0 {
+		return nil, 0, nil
+	}
+
+	data, err := ioutil.ReadFile(hh.path)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	hints, err := decodeHints(data)
+	return hints, int64(len(data)), err
+}
+
+// commit removes the drained bytes from the hints file once the hints read
+// from them have been replayed, keeping the hints that failed to be replayed
+// and any hints added while replaying
+func (hh *hostHints) commit(drained int64, failed []hint) error {
+	hh.Lock()
+	defer hh.Unlock()
+
+	if hh.fd == nil {
+		// Closed concurrently, hints are replayed again after a restart
+		return nil
+	}
+
+	data, err := ioutil.ReadFile(hh.path)
+	if err != nil {
+		return err
+	}
+	if drained > int64(len(data)) {
+		drained = int64(len(data))
+	}
+
+	var remaining []byte
+	for _, value := range failed {
+		hh.buf = value.encode(hh.buf)
+		remaining = append(remaining, hh.buf...)
+	}
+	remaining = append(remaining, data[drained:]...)
+
+	// Rewrite the file and rename it over the current one so that a crash
+	// never loses hints that were not replayed
+	tmpPath := strings.TrimSuffix(hh.path, hintsFileSuffix) + hintsTmpFileSuffix
+	fd, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_RDWR|os.O_APPEND, hintsFilePerm)
+	if err != nil {
+		return err
+	}
+	if _, err := fd.Write(remaining); err != nil {
+		fd.Close()
+		return err
+	}
+	if err := fd.Sync(); err != nil {
+		fd.Close()
+		return err
+	}
+	if err := os.Rename(tmpPath, hh.path); err != nil {
+		fd.Close()
+		return err
+	}
+
+	prevFd := hh.fd
+	hh.fd = fd
+	hh.bytes = int64(len(remaining))
+
+	// Sync the directory so the rename is durable, the new file is already
+	// in use as the rename has taken place either way
+	if err := syncDir(filepath.Dir(hh.path)); err != nil {
+		prevFd.Close()
+		return err
+	}
+	return prevFd.Close()
+}
+
+func (h *hintedHandoff) replay() {
+	h.Lock()
+	hosts := make([]*hostHints, 0, len(h.hosts))
+	for _, hh := range h.hosts {
+		hosts = append(hosts, hh)
+	}
+	h.Unlock()
+
+	for _, hh := range hosts {
+		queue, ok := h.lookupFn(hh.hostID)
+		if !ok || queue.ConnectionCount() == 0 {
+			// Host is not part of the topology or not healthy yet
+			continue
+		}
+
+		hints, drained, err := hh.drain()
+		if err == errHintCorrupt {
+			h.metrics.corrupt.Inc(1)
+			h.log.Errorf("corrupt hints for host %s, replaying %d readable hints",
+				hh.hostID, len(hints))
+		} else if err != nil {
+			h.log.Errorf("could not read hints for host %s: %v", hh.hostID, err)
+			continue
+		}
+
+		failed := h.replayHints(queue, hints)
+		if err := hh.commit(drained, failed); err != nil {
+			h.log.Errorf("could not remove replayed hints for host %s: %v", hh.hostID, err)
+		}
+	}
+
+	var pending int64
+	for _, hh := range hosts {
+		hh.Lock()
+		pending += hh.bytes
+		hh.Unlock()
+	}
+	h.metrics.pendingBytes.Update(float64(pending))
+}
+
+// replayHints replays the hints through the host queue and returns the
+// hints that failed to be replayed and should be kept, hints for datapoints
+// that the namespace would no longer accept a write for are expired
+func (h *hintedHandoff) replayHints(queue hostQueue, hints []hint) []hint {
+	var (
+		wg       sync.WaitGroup
+		failedMu sync.Mutex
+		failed   []hint
+		now      = h.nowFn()
+	)
+	for i := range hints {
+		value := hints[i]
+		timestamp, err := convert.ToTime(value.timestamp, value.timeType)
+		if err != nil || !timestampWritable(h.nsLookupFn, value.namespace, now, timestamp) {
+			h.metrics.expired.Inc(1)
+			continue
+		}
+
+		op := &writeOperation{}
+		op.reset()
+		op.namespace = ident.BinaryID(checked.NewBytes(value.namespace, nil))
+		op.request.ID = value.id
+		op.request.Datapoint.Value = value.value
+		op.request.Datapoint.Timestamp = value.timestamp
+		op.request.Datapoint.TimestampTimeType = value.timeType
+		op.request.Datapoint.Annotation = value.annotation
+		op.completionFn = func(result interface{}, err error) {
+			if err != nil {
+				h.metrics.replayErrors.Inc(1)
+				if !IsBadRequestError(err) && !IsQuotaExceededError(err) {
+					failedMu.Lock()
+					failed = append(failed, value)
+					failedMu.Unlock()
+				}
+			} else {
+				h.metrics.replaySuccess.Inc(1)
+			}
+			wg.Done()
+		}
+
+		wg.Add(1)
+		if err := queue.Enqueue(op); err != nil {
+			wg.Done()
+			h.metrics.replayErrors.Inc(1)
+			failedMu.Lock()
+			failed = append(failed, value)
+			failedMu.Unlock()
+		}
+	}
+
+	// Wait for the replayed writes to complete before moving on so that
+	// replays for a single host are not piled up on its queue and the
+	// hints are only removed once acknowledged
+	wg.Wait()
+	return failed
+}
+
+func (h *hintedHandoff) closeHosts() {
+	for _, hh := range h.hosts {
+		hh.Lock()
+		if hh.fd != nil {
+			if err := hh.fd.Close(); err != nil {
+				h.log.Errorf("could not close hints file for host %s: %v", hh.hostID, err)
+			}
+			hh.fd = nil
+		}
+		hh.Unlock()
+	}
+}
+
+func (h *hintedHandoff) close() {
+	h.Lock()
+	if h.closed {
+		h.Unlock()
+		return
+	}
+	h.closed = true
+	h.Unlock()
+
+	close(h.closeCh)
+	<-h.doneCh
+
+	h.Lock()
+	h.closeHosts()
+	h.Unlock()
+}
+
+// syncDir fsyncs the directory so that a rename within it is durable
+func syncDir(dir string) error {
+	fd, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	if err := fd.Sync(); err != nil {
+		fd.Close()
+		return err
+	}
+	return fd.Close()
+}
@@ -0,0 +1,421 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3db/generated/thrift/rpc"
+	tterrors "github.com/m3db/m3db/network/server/tchannelthrift/errors"
+	"github.com/m3db/m3db/retention"
+	"github.com/m3db/m3db/storage/namespace"
+	"github.com/m3db/m3db/topology"
+	"github.com/m3db/m3x/ident"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testHintsHostID = "testhost"
+
+func newHintedHandoffTestOptions(dir string) Options {
+	return NewOptions().
+		SetHintedHandoffEnabled(true).
+		SetHintedHandoffDirectory(dir).
+		SetHintedHandoffReplayInterval(time.Hour)
+}
+
+func newTestHintedHandoff(
+	opts Options,
+	lookupFn hostQueueLookupFn,
+) (*hintedHandoff, error) {
+	return newHintedHandoff(opts, lookupFn, func(ns []byte) (namespace.Metadata, bool) {
+		return nil, false
+	})
+}
+
+func newHintedHandoffTestDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "hints")
+	require.NoError(t, err)
+	return dir
+}
+
+func newHintTestWriteOp(id string, value float64) *writeOperation {
+	op := &writeOperation{}
+	op.reset()
+	op.namespace = ident.StringID("testns")
+	op.request.ID = []byte(id)
+	op.request.Datapoint.Value = value
+	op.request.Datapoint.Timestamp = time.Now().Unix()
+	op.request.Datapoint.TimestampTimeType = rpc.TimeType_UNIX_SECONDS
+	op.request.Datapoint.Annotation = []byte("annotation")
+	return op
+}
+
+func newHintTestQueue(
+	ctrl *gomock.Controller,
+	connections int,
+	result error,
+	enqueued *[]*writeOperation,
+) *MockhostQueue {
+	queue := NewMockhostQueue(ctrl)
+	queue.EXPECT().ConnectionCount().Return(connections).AnyTimes()
+	queue.EXPECT().Enqueue(gomock.Any()).Do(func(o op) {
+		w := o.(*writeOperation)
+		*enqueued = append(*enqueued, w)
+		w.CompletionFn()(topology.NewHost(testHintsHostID, "testhost:9000"), result)
+	}).Return(nil).AnyTimes()
+	return queue
+}
+
+func TestHintEncodeDecodeRoundTrip(t *testing.T) {
+	hints := []hint{
+		{
+			namespace:  []byte("testns"),
+			id:         []byte("foo"),
+			timestamp:  1000,
+			timeType:   rpc.TimeType_UNIX_MILLISECONDS,
+			value:      3.14,
+			annotation: []byte("annotation"),
+		},
+		{
+			namespace: []byte("testns"),
+			id:        []byte("bar"),
+			timestamp: 2000,
+			timeType:  rpc.TimeType_UNIX_SECONDS,
+			value:     -1,
+		},
+	}
+
+	var data []byte
+	for _, h := range hints {
+		data = append(data, h.encode(nil)...)
+	}
+
+	decoded, err := decodeHints(data)
+	require.NoError(t, err)
+	assert.Equal(t, hints, decoded)
+
+	// Truncated trailing record returns the hints before it
+	decoded, err = decodeHints(data[:len(data)-1])
+	assert.Equal(t, errHintCorrupt, err)
+	assert.Equal(t, hints[:1], decoded)
+
+	// Corrupt checksum
+	corrupt := append([]byte(nil), data...)
+	corrupt[hintHeaderLen] ^= 0xff
+	decoded, err = decodeHints(corrupt)
+	assert.Equal(t, errHintCorrupt, err)
+	assert.Equal(t, 0, len(decoded))
+}
+
+func TestHintedHandoffReplaysToHealthyHost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := newHintedHandoffTestDir(t)
+	defer os.RemoveAll(dir)
+
+	var (
+		healthy  = false
+		enqueued []*writeOperation
+		queue    = newHintTestQueue(ctrl, 1, nil, &enqueued)
+	)
+	handoff, err := newTestHintedHandoff(newHintedHandoffTestOptions(dir),
+		func(hostID string) (hostQueue, bool) {
+			assert.Equal(t, testHintsHostID, hostID)
+			return queue, healthy
+		})
+	require.NoError(t, err)
+	defer handoff.close()
+
+	handoff.add(testHintsHostID, newHintTestWriteOp("foo", 1))
+	handoff.add(testHintsHostID, newHintTestWriteOp("bar", 2))
+
+	// Host not available, nothing replayed
+	handoff.replay()
+	require.Equal(t, 0, len(enqueued))
+
+	healthy = true
+	handoff.replay()
+	require.Equal(t, 2, len(enqueued))
+	assert.Equal(t, "testns", enqueued[0].namespace.String())
+	assert.Equal(t, []byte("foo"), enqueued[0].request.ID)
+	assert.Equal(t, 1.0, enqueued[0].request.Datapoint.Value)
+	assert.Equal(t, int64(1000), enqueued[0].request.Datapoint.Timestamp)
+	assert.Equal(t, rpc.TimeType_UNIX_SECONDS, enqueued[0].request.Datapoint.TimestampTimeType)
+	assert.Equal(t, []byte("annotation"), enqueued[0].request.Datapoint.Annotation)
+	assert.Equal(t, []byte("bar"), enqueued[1].request.ID)
+
+	// Hints are drained once replayed successfully
+	handoff.replay()
+	assert.Equal(t, 2, len(enqueued))
+}
+
+func TestHintedHandoffReplayErrorKeepsHint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := newHintedHandoffTestDir(t)
+	defer os.RemoveAll(dir)
+
+	var (
+		enqueued []*writeOperation
+		queue    = newHintTestQueue(ctrl, 1, errors.New("an error"), &enqueued)
+	)
+	handoff, err := newTestHintedHandoff(newHintedHandoffTestOptions(dir),
+		func(hostID string) (hostQueue, bool) {
+			return queue, true
+		})
+	require.NoError(t, err)
+	defer handoff.close()
+
+	handoff.add(testHintsHostID, newHintTestWriteOp("foo", 1))
+
+	handoff.replay()
+	handoff.replay()
+	assert.Equal(t, 2, len(enqueued))
+}
+
+func TestHintedHandoffKeepsHintsUntilReplayed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := newHintedHandoffTestDir(t)
+	defer os.RemoveAll(dir)
+
+	var (
+		handoff  *hintedHandoff
+		enqueued []*writeOperation
+		queue    = NewMockhostQueue(ctrl)
+		path     = filepath.Join(dir, testHintsHostID+hintsFileSuffix)
+	)
+	queue.EXPECT().ConnectionCount().Return(1).AnyTimes()
+	queue.EXPECT().Enqueue(gomock.Any()).Do(func(o op) {
+		w := o.(*writeOperation)
+		enqueued = append(enqueued, w)
+
+		// The hint being replayed is still on disk until acknowledged
+		data, err := ioutil.ReadFile(path)
+		require.NoError(t, err)
+		hints, err := decodeHints(data)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(hints))
+
+		// A write failing while replaying is hinted again
+		handoff.add(testHintsHostID, newHintTestWriteOp("bar", 2))
+		w.CompletionFn()(topology.NewHost(testHintsHostID, "testhost:9000"), nil)
+	}).Return(nil).Times(2)
+
+	var err error
+	handoff, err = newTestHintedHandoff(newHintedHandoffTestOptions(dir),
+		func(hostID string) (hostQueue, bool) {
+			return queue, true
+		})
+	require.NoError(t, err)
+	defer handoff.close()
+
+	handoff.add(testHintsHostID, newHintTestWriteOp("foo", 1))
+
+	// Only the acknowledged hint is removed from disk
+	handoff.replay()
+	require.Equal(t, 1, len(enqueued))
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	hints, err := decodeHints(data)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(hints))
+	assert.Equal(t, []byte("bar"), hints[0].id)
+
+	handoff.replay()
+	require.Equal(t, 2, len(enqueued))
+	assert.Equal(t, []byte("bar"), enqueued[1].request.ID)
+}
+
+func TestHintedHandoffDropsExpiredHints(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := newHintedHandoffTestDir(t)
+	defer os.RemoveAll(dir)
+
+	var (
+		now        = time.Now().Truncate(time.Second)
+		bufferPast = retention.NewOptions().BufferPast()
+		enqueued   []*writeOperation
+		queue      = newHintTestQueue(ctrl, 1, nil, &enqueued)
+		opts       = newHintedHandoffTestOptions(dir)
+		coldNs     = ident.StringID("coldns")
+	)
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return now
+	}))
+	md, err := namespace.NewMetadata(coldNs,
+		namespace.NewOptions().SetColdWritesEnabled(true))
+	require.NoError(t, err)
+	handoff, err := newHintedHandoff(opts, func(hostID string) (hostQueue, bool) {
+		return queue, true
+	}, func(ns []byte) (namespace.Metadata, bool) {
+		return md, string(ns) == coldNs.String()
+	})
+	require.NoError(t, err)
+	defer handoff.close()
+
+	newOp := func(id string, ns ident.ID, timestamp time.Time) *writeOperation {
+		op := newHintTestWriteOp(id, 1)
+		op.namespace = ns
+		op.request.Datapoint.Timestamp = timestamp.Unix()
+		return op
+	}
+
+	// Hints expire by the timestamp of the datapoint rather than when they
+	// were added, once the datapoint is older than the buffer past
+	handoff.add(testHintsHostID, newOp("foo", ident.StringID("testns"), now.Add(-time.Minute)))
+	handoff.add(testHintsHostID, newOp("bar", ident.StringID("testns"), now.Add(-bufferPast)))
+	handoff.add(testHintsHostID, newOp("baz", coldNs, now.Add(-bufferPast)))
+
+	handoff.replay()
+	require.Equal(t, 2, len(enqueued))
+	assert.Equal(t, []byte("foo"), enqueued[0].request.ID)
+
+	// Namespaces with cold writes enabled accept writes older than the
+	// buffer past so the hint is still replayed
+	assert.Equal(t, []byte("baz"), enqueued[1].request.ID)
+
+	// Expired hints are dropped rather than kept for a later replay
+	hh := handoff.hosts[testHintsHostID]
+	hh.Lock()
+	assert.Equal(t, int64(0), hh.bytes)
+	hh.Unlock()
+}
+
+func TestHintedHandoffMaxBytesPerHost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := newHintedHandoffTestDir(t)
+	defer os.RemoveAll(dir)
+
+	var (
+		enqueued []*writeOperation
+		queue    = newHintTestQueue(ctrl, 1, nil, &enqueued)
+		op       = newHintTestWriteOp("foo", 1)
+		size     = hint{
+			namespace:  op.namespace.Data().Get(),
+			id:         op.request.ID,
+			annotation: op.request.Datapoint.Annotation,
+		}.encodedLen()
+		opts = newHintedHandoffTestOptions(dir).
+			SetHintedHandoffMaxBytesPerHost(int64(2*size + 1))
+	)
+	handoff, err := newTestHintedHandoff(opts, func(hostID string) (hostQueue, bool) {
+		return queue, true
+	})
+	require.NoError(t, err)
+	defer handoff.close()
+
+	for i := 0; i < 3; i++ {
+		handoff.add(testHintsHostID, newHintTestWriteOp("foo", float64(i)))
+	}
+
+	handoff.replay()
+	require.Equal(t, 2, len(enqueued))
+	assert.Equal(t, 0.0, enqueued[0].request.Datapoint.Value)
+	assert.Equal(t, 1.0, enqueued[1].request.Datapoint.Value)
+}
+
+func TestHintedHandoffHintsSurviveRestart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := newHintedHandoffTestDir(t)
+	defer os.RemoveAll(dir)
+
+	var (
+		enqueued []*writeOperation
+		queue    = newHintTestQueue(ctrl, 1, nil, &enqueued)
+		opts     = newHintedHandoffTestOptions(dir)
+		lookupFn = func(hostID string) (hostQueue, bool) {
+			assert.Equal(t, "host/with:chars", hostID)
+			return queue, true
+		}
+	)
+	handoff, err := newTestHintedHandoff(opts, lookupFn)
+	require.NoError(t, err)
+	handoff.start()
+	handoff.add("host/with:chars", newHintTestWriteOp("foo", 1))
+	handoff.close()
+
+	handoff, err = newTestHintedHandoff(opts, lookupFn)
+	require.NoError(t, err)
+	defer handoff.close()
+
+	handoff.replay()
+	require.Equal(t, 1, len(enqueued))
+	assert.Equal(t, []byte("foo"), enqueued[0].request.ID)
+}
+
+func TestWriteStateCompletionFnAddsHint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := newHintedHandoffTestDir(t)
+	defer os.RemoveAll(dir)
+
+	var (
+		enqueued []*writeOperation
+		queue    = newHintTestQueue(ctrl, 1, nil, &enqueued)
+		host     = topology.NewHost(testHintsHostID, "testhost:9000")
+	)
+	handoff, err := newTestHintedHandoff(newHintedHandoffTestOptions(dir),
+		func(hostID string) (hostQueue, bool) {
+			return queue, true
+		})
+	require.NoError(t, err)
+	defer handoff.close()
+
+	for _, writeErr := range []error{
+		errors.New("host unavailable"),
+		tterrors.NewBadRequestError(errors.New("bad request")),
+		tterrors.NewQuotaExceededError(errors.New("quota exceeded")),
+	} {
+		state := &writeState{consistencyLevel: topology.ConsistencyLevelOne}
+		state.reset()
+		state.op = newHintTestWriteOp("foo", 1)
+		state.nsID, state.tsID = ident.StringID("testns"), ident.StringID("foo")
+		state.hints = handoff
+		state.pending = 1
+		state.incRef()
+		state.completionFn(host, writeErr)
+	}
+
+	// Only the write that failed for a reason other than the
+	// request being rejected by the host is hinted
+	handoff.replay()
+	require.Equal(t, 1, len(enqueued))
+	assert.Equal(t, []byte("foo"), enqueued[0].request.ID)
+}
@@ -215,6 +215,12 @@ func (q *queue) drain() {
 				q.asyncFetch(v)
 			case *truncateOp:
 				q.asyncTruncate(v)
+			case *fetchAggregatedOp:
+				q.asyncFetchAggregated(v)
+			case *fetchBatchRawPagedOp:
+				q.asyncFetchBatchRawPaged(v)
+			case *fetchTaggedOp:
+				q.asyncFetchTagged(v)
 			default:
 				completionFn := ops[i].CompletionFn()
 				completionFn(nil, errQueueUnknownOperation(q.host.ID()))
@@ -377,6 +383,81 @@ func (q *queue) asyncTruncate(op *truncateOp) {
 	}()
 }
 
+func (q *queue) asyncFetchAggregated(op *fetchAggregatedOp) {
+	q.Add(1)
+
+	go func() {
+		cleanup := q.Done
+
+		client, err := q.connPool.NextClient()
+		if err != nil {
+			// No client available
+			op.completionFn(nil, err)
+			cleanup()
+			return
+		}
+
+		ctx, _ := thrift.NewContext(q.opts.FetchRequestTimeout())
+		if res, err := client.Fetch(ctx, &op.request); err != nil {
+			op.completionFn(nil, err)
+		} else {
+			op.completionFn(res, nil)
+		}
+
+		cleanup()
+	}()
+}
+
+func (q *queue) asyncFetchBatchRawPaged(op *fetchBatchRawPagedOp) {
+	q.Add(1)
+
+	go func() {
+		cleanup := q.Done
+
+		client, err := q.connPool.NextClient()
+		if err != nil {
+			// No client available
+			op.completionFn(nil, err)
+			cleanup()
+			return
+		}
+
+		ctx, _ := thrift.NewContext(q.opts.FetchRequestTimeout())
+		if res, err := client.FetchBatchRawPaged(ctx, &op.request); err != nil {
+			op.completionFn(nil, err)
+		} else {
+			op.completionFn(res, nil)
+		}
+
+		cleanup()
+	}()
+}
+
+func (q *queue) asyncFetchTagged(op *fetchTaggedOp) {
+	q.Add(1)
+
+	go func() {
+		cleanup := q.Done
+
+		client, err := q.connPool.NextClient()
+		if err != nil {
+			// No client available
+			op.completionFn(nil, err)
+			cleanup()
+			return
+		}
+
+		ctx, _ := thrift.NewContext(q.opts.FetchRequestTimeout())
+		if res, err := client.FetchTagged(ctx, &op.request); err != nil {
+			op.completionFn(nil, err)
+		} else {
+			op.completionFn(res, nil)
+		}
+
+		cleanup()
+	}()
+}
+
 func (q *queue) Len() int {
 	q.RLock()
 	v := q.opsSumSize
@@ -30,6 +30,7 @@ import (
 	"github.com/m3db/m3db/clock"
 	"github.com/m3db/m3db/encoding"
 	"github.com/m3db/m3db/encoding/m3tsz"
+	"github.com/m3db/m3db/storage/namespace"
 	"github.com/m3db/m3db/topology"
 	"github.com/m3db/m3x/context"
 	"github.com/m3db/m3x/ident"
@@ -38,6 +39,7 @@ import (
 	xretry "github.com/m3db/m3x/retry"
 
 	"github.com/uber/tchannel-go"
+	"google.golang.org/grpc/credentials"
 )
 
 const (
@@ -86,6 +88,10 @@ const (
 	// defaultFetchBatchSize is the default fetch batch size
 	defaultFetchBatchSize = 128
 
+	// defaultFetchPagedBlockLimit is the default number of series blocks
+	// requested per page for a paged fetch
+	defaultFetchPagedBlockLimit = 1024
+
 	// defaultHostQueueOpsFlushSize is the default host queue ops flush size
 	defaultHostQueueOpsFlushSize = 128
 
@@ -132,6 +138,21 @@ const (
 
 	// defaultFetchSeriesBlocksMetadataBatchTimeout is the default series blocks contents fetch timeout
 	defaultFetchSeriesBlocksBatchTimeout = 60 * time.Second
+
+	// defaultHintedHandoffEnabled is the default hinted handoff enabled value
+	defaultHintedHandoffEnabled = false
+
+	// defaultHintedHandoffMaxBytesPerHost is the default max bytes of hints kept per host
+	defaultHintedHandoffMaxBytesPerHost = 64 * 1024 * 1024
+
+	// defaultHintedHandoffReplayInterval is the default hinted handoff replay interval
+	defaultHintedHandoffReplayInterval = 10 * time.Second
+
+	// defaultReadRepairEnabled is the default read repair enabled value
+	defaultReadRepairEnabled = false
+
+	// defaultReadRepairSampleRate is the default read repair sample rate
+	defaultReadRepairSampleRate = 1.0
 )
 
 var (
@@ -161,17 +182,25 @@ var (
 			SetJitter(true),
 	)
 
-	errNoTopologyInitializerSet    = errors.New("no topology initializer set")
-	errNoReaderIteratorAllocateSet = errors.New("no reader iterator allocator set, encoding not set")
+	errNoTopologyInitializerSet           = errors.New("no topology initializer set")
+	errNoReaderIteratorAllocateSet        = errors.New("no reader iterator allocator set, encoding not set")
+	errNoHintedHandoffDirectorySet        = errors.New("hinted handoff enabled but no directory set")
+	errHintedHandoffMaxBytesInvalid       = errors.New("hinted handoff max bytes per host must be positive")
+	errHintedHandoffReplayIntervalInvalid = errors.New("hinted handoff replay interval must be positive")
+	errReadRepairSampleRateInvalid        = errors.New("read repair sample rate must be between 0 and 1")
+	errFetchPagedBlockLimitInvalid        = errors.New("fetch paged block limit must be positive")
 )
 
 type options struct {
 	clockOpts                               clock.Options
 	instrumentOpts                          instrument.Options
 	topologyInitializer                     topology.Initializer
+	namespaceInitializer                    namespace.Initializer
 	writeConsistencyLevel                   topology.ConsistencyLevel
 	readConsistencyLevel                    ReadConsistencyLevel
 	channelOptions                          *tchannel.ChannelOptions
+	transport                               Transport
+	grpcTransportCredentials                credentials.TransportCredentials
 	maxConnectionCount                      int
 	minConnectionCount                      int
 	hostConnectTimeout                      time.Duration
@@ -194,6 +223,7 @@ type options struct {
 	fetchBatchOpPoolSize                    int
 	writeBatchSize                          int
 	fetchBatchSize                          int
+	fetchPagedBlockLimit                    int
 	identifierPool                          ident.Pool
 	hostQueueOpsFlushSize                   int
 	hostQueueOpsFlushInterval               time.Duration
@@ -207,6 +237,12 @@ type options struct {
 	fetchSeriesBlocksMetadataBatchTimeout   time.Duration
 	fetchSeriesBlocksBatchTimeout           time.Duration
 	fetchSeriesBlocksBatchConcurrency       int
+	hintedHandoffEnabled                    bool
+	hintedHandoffDirectory                  string
+	hintedHandoffMaxBytesPerHost            int64
+	hintedHandoffReplayInterval             time.Duration
+	readRepairEnabled                       bool
+	readRepairSampleRate                    float64
 }
 
 // NewOptions creates a new set of client options with defaults
@@ -262,6 +298,7 @@ func newOptions() *options {
 		fetchBatchOpPoolSize:                    defaultFetchBatchOpPoolSize,
 		writeBatchSize:                          defaultWriteBatchSize,
 		fetchBatchSize:                          defaultFetchBatchSize,
+		fetchPagedBlockLimit:                    defaultFetchPagedBlockLimit,
 		identifierPool:                          idPool,
 		hostQueueOpsFlushSize:                   defaultHostQueueOpsFlushSize,
 		hostQueueOpsFlushInterval:               defaultHostQueueOpsFlushInterval,
@@ -274,6 +311,11 @@ func newOptions() *options {
 		fetchSeriesBlocksMetadataBatchTimeout:   defaultFetchSeriesBlocksMetadataBatchTimeout,
 		fetchSeriesBlocksBatchTimeout:           defaultFetchSeriesBlocksBatchTimeout,
 		fetchSeriesBlocksBatchConcurrency:       defaultFetchSeriesBlocksBatchConcurrency,
+		hintedHandoffEnabled:                    defaultHintedHandoffEnabled,
+		hintedHandoffMaxBytesPerHost:            defaultHintedHandoffMaxBytesPerHost,
+		hintedHandoffReplayInterval:             defaultHintedHandoffReplayInterval,
+		readRepairEnabled:                       defaultReadRepairEnabled,
+		readRepairSampleRate:                    defaultReadRepairSampleRate,
 	}
 	return opts.SetEncodingM3TSZ().(*options)
 }
@@ -285,6 +327,24 @@ func (o *options) Validate() error {
 	if o.readerIteratorAllocate == nil {
 		return errNoReaderIteratorAllocateSet
 	}
+	if o.fetchPagedBlockLimit <= 0 {
+		return errFetchPagedBlockLimitInvalid
+	}
+	if o.readRepairSampleRate < 0 || o.readRepairSampleRate > 1 {
+		return errReadRepairSampleRateInvalid
+	}
+	if !o.hintedHandoffEnabled {
+		return nil
+	}
+	if o.hintedHandoffDirectory == "" {
+		return errNoHintedHandoffDirectorySet
+	}
+	if o.hintedHandoffMaxBytesPerHost <= 0 {
+		return errHintedHandoffMaxBytesInvalid
+	}
+	if o.hintedHandoffReplayInterval <= 0 {
+		return errHintedHandoffReplayIntervalInvalid
+	}
 	return nil
 }
 
@@ -326,6 +386,16 @@ func (o *options) TopologyInitializer() topology.Initializer {
 	return o.topologyInitializer
 }
 
+func (o *options) SetNamespaceInitializer(value namespace.Initializer) Options {
+	opts := *o
+	opts.namespaceInitializer = value
+	return &opts
+}
+
+func (o *options) NamespaceInitializer() namespace.Initializer {
+	return o.namespaceInitializer
+}
+
 func (o *options) SetWriteConsistencyLevel(value topology.ConsistencyLevel) Options {
 	opts := *o
 	opts.writeConsistencyLevel = value
@@ -356,6 +426,26 @@ func (o *options) ChannelOptions() *tchannel.ChannelOptions {
 	return o.channelOptions
 }
 
+func (o *options) SetTransport(value Transport) Options {
+	opts := *o
+	opts.transport = value
+	return &opts
+}
+
+func (o *options) Transport() Transport {
+	return o.transport
+}
+
+func (o *options) SetGRPCTransportCredentials(value credentials.TransportCredentials) Options {
+	opts := *o
+	opts.grpcTransportCredentials = value
+	return &opts
+}
+
+func (o *options) GRPCTransportCredentials() credentials.TransportCredentials {
+	return o.grpcTransportCredentials
+}
+
 func (o *options) SetMaxConnectionCount(value int) Options {
 	opts := *o
 	opts.maxConnectionCount = value
@@ -576,6 +666,16 @@ func (o *options) FetchBatchSize() int {
 	return o.fetchBatchSize
 }
 
+func (o *options) SetFetchPagedBlockLimit(value int) Options {
+	opts := *o
+	opts.fetchPagedBlockLimit = value
+	return &opts
+}
+
+func (o *options) FetchPagedBlockLimit() int {
+	return o.fetchPagedBlockLimit
+}
+
 func (o *options) SetIdentifierPool(value ident.Pool) Options {
 	opts := *o
 	opts.identifierPool = value
@@ -646,6 +746,66 @@ func (o *options) ReaderIteratorAllocate() encoding.ReaderIteratorAllocate {
 	return o.readerIteratorAllocate
 }
 
+func (o *options) SetHintedHandoffEnabled(value bool) Options {
+	opts := *o
+	opts.hintedHandoffEnabled = value
+	return &opts
+}
+
+func (o *options) HintedHandoffEnabled() bool {
+	return o.hintedHandoffEnabled
+}
+
+func (o *options) SetHintedHandoffDirectory(value string) Options {
+	opts := *o
+	opts.hintedHandoffDirectory = value
+	return &opts
+}
+
+func (o *options) HintedHandoffDirectory() string {
+	return o.hintedHandoffDirectory
+}
+
+func (o *options) SetHintedHandoffMaxBytesPerHost(value int64) Options {
+	opts := *o
+	opts.hintedHandoffMaxBytesPerHost = value
+	return &opts
+}
+
+func (o *options) HintedHandoffMaxBytesPerHost() int64 {
+	return o.hintedHandoffMaxBytesPerHost
+}
+
+func (o *options) SetHintedHandoffReplayInterval(value time.Duration) Options {
+	opts := *o
+	opts.hintedHandoffReplayInterval = value
+	return &opts
+}
+
+func (o *options) HintedHandoffReplayInterval() time.Duration {
+	return o.hintedHandoffReplayInterval
+}
+
+func (o *options) SetReadRepairEnabled(value bool) Options {
+	opts := *o
+	opts.readRepairEnabled = value
+	return &opts
+}
+
+func (o *options) ReadRepairEnabled() bool {
+	return o.readRepairEnabled
+}
+
+func (o *options) SetReadRepairSampleRate(value float64) Options {
+	opts := *o
+	opts.readRepairSampleRate = value
+	return &opts
+}
+
+func (o *options) ReadRepairSampleRate() float64 {
+	return o.readRepairSampleRate
+}
+
 func (o *options) SetOrigin(value topology.Host) AdminOptions {
 	opts := *o
 	opts.origin = value
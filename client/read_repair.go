@@ -0,0 +1,316 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3db/clock"
+	"github.com/m3db/m3db/encoding"
+	"github.com/m3db/m3db/generated/thrift/rpc"
+	"github.com/m3db/m3db/network/server/tchannelthrift/convert"
+	"github.com/m3db/m3db/topology"
+	"github.com/m3db/m3x/checked"
+	"github.com/m3db/m3x/ident"
+	xtime "github.com/m3db/m3x/time"
+
+	"github.com/uber-go/tally"
+)
+
+type readRepairMetrics struct {
+	sampled      tally.Counter
+	skipped      tally.Counter
+	divergent    tally.Counter
+	conflicts    tally.Counter
+	unwritable   tally.Counter
+	decodeErrors tally.Counter
+	repaired     tally.Counter
+	writeSuccess tally.Counter
+	writeErrors  tally.Counter
+}
+
+func newReadRepairMetrics(scope tally.Scope) readRepairMetrics {
+	return readRepairMetrics{
+		sampled:      scope.Counter("sampled"),
+		skipped:      scope.Counter("skipped"),
+		divergent:    scope.Counter("divergent"),
+		conflicts:    scope.Counter("conflicts"),
+		unwritable:   scope.Counter("unwritable-datapoints"),
+		decodeErrors: scope.Counter("decode-errors"),
+		repaired:     scope.Counter("repaired-datapoints"),
+		writeSuccess: scope.Counter("write-success"),
+		writeErrors:  scope.Counter("write-errors"),
+	}
+}
+
+// readRepairer compares the replica responses of sampled fetches and
+// writes datapoints missing from lagging replicas back to them. Missing
+// datapoints older than the buffer past of the namespace are only repaired
+// when the namespace has cold writes enabled, otherwise the replicas would
+// reject them and they are counted as unwritable instead.
+type readRepairer struct {
+	sampleRate             float64
+	randFn                 func() float64
+	nowFn                  clock.NowFn
+	lookupFn               hostQueueLookupFn
+	nsLookupFn             namespaceLookupFn
+	readerIteratorAllocate encoding.ReaderIteratorAllocate
+	metrics                readRepairMetrics
+}
+
+func newReadRepairer(
+	opts Options,
+	lookupFn hostQueueLookupFn,
+	nsLookupFn namespaceLookupFn,
+) *readRepairer {
+	scope := opts.InstrumentOptions().MetricsScope().SubScope("read-repair")
+	return &readRepairer{
+		sampleRate:             opts.ReadRepairSampleRate(),
+		randFn:                 rand.Float64,
+		nowFn:                  opts.ClockOptions().NowFn(),
+		lookupFn:               lookupFn,
+		nsLookupFn:             nsLookupFn,
+		readerIteratorAllocate: opts.ReaderIteratorAllocate(),
+		metrics:                newReadRepairMetrics(scope),
+	}
+}
+
+// sample returns whether the fetch of a series should be read repaired
+func (r *readRepairer) sample() bool {
+	if r.sampleRate <= 0 {
+		return false
+	}
+	if r.sampleRate < 1 && r.randFn() >= r.sampleRate {
+		return false
+	}
+	r.metrics.sampled.Inc(1)
+	return true
+}
+
+// newState returns the state used to collect the replica responses
+// of a sampled series fetch
+func (r *readRepairer) newState(
+	namespace, id []byte,
+	start, end time.Time,
+) *readRepairState {
+	return &readRepairState{
+		repairer:  r,
+		namespace: append([]byte(nil), namespace...),
+		id:        append([]byte(nil), id...),
+		start:     start,
+		end:       end,
+	}
+}
+
+type readRepairReplica struct {
+	host     topology.Host
+	segments []*rpc.Segments
+}
+
+type readRepairDatapoint struct {
+	value      float64
+	unit       xtime.Unit
+	annotation []byte
+}
+
+type readRepairState struct {
+	sync.Mutex
+
+	repairer   *readRepairer
+	namespace  []byte
+	id         []byte
+	start, end time.Time
+	pending    int
+	failed     bool
+	replicas   []readRepairReplica
+}
+
+// completionFn wraps the completion function of a fetch from a replica,
+// once all replicas have responded the responses are compared and
+// repaired asynchronously
+func (s *readRepairState) completionFn(host topology.Host, fn completionFn) completionFn {
+	// NB: Replicas are all registered before any fetch is enqueued
+	s.pending++
+	return func(result interface{}, err error) {
+		s.Lock()
+		if err != nil {
+			s.failed = true
+		} else {
+			s.replicas = append(s.replicas, readRepairReplica{
+				host:     host,
+				segments: result.([]*rpc.Segments),
+			})
+		}
+		s.pending--
+		done := s.pending == 0
+		s.Unlock()
+
+		fn(result, err)
+
+		if done {
+			go s.repairer.repair(s)
+		}
+	}
+}
+
+func (r *readRepairer) repair(state *readRepairState) {
+	if state.failed || len(state.replicas) < 2 {
+		// Can only tell which replicas are lagging if all replicas responded
+		r.metrics.skipped.Inc(1)
+		return
+	}
+
+	var (
+		datapoints = make([]map[int64]readRepairDatapoint, 0, len(state.replicas))
+		union      = make(map[int64]readRepairDatapoint)
+		conflicts  = make(map[int64]struct{})
+	)
+	for _, replica := range state.replicas {
+		values, err := r.decode(replica.segments, state.start, state.end)
+		if err != nil {
+			r.metrics.decodeErrors.Inc(1)
+			return
+		}
+		datapoints = append(datapoints, values)
+		for t, v := range values {
+			existing, ok := union[t]
+			if !ok {
+				union[t] = v
+				continue
+			}
+			if math.Float64bits(existing.value) != math.Float64bits(v.value) {
+				conflicts[t] = struct{}{}
+			}
+		}
+	}
+
+	// NB: Values that differ between replicas at the same timestamp are
+	// not repaired as there is no way to tell which one is correct
+	if len(conflicts) > 0 {
+		r.metrics.conflicts.Inc(int64(len(conflicts)))
+	}
+
+	var (
+		now        = r.nowFn()
+		divergent  = false
+		unwritable = int64(0)
+	)
+	for i, replica := range state.replicas {
+		var queue hostQueue
+		for t, v := range union {
+			if _, ok := datapoints[i][t]; ok {
+				continue
+			}
+			divergent = true
+			if _, ok := conflicts[t]; ok {
+				continue
+			}
+			if !timestampWritable(r.nsLookupFn, state.namespace, now, time.Unix(0, t)) {
+				unwritable++
+				continue
+			}
+			if queue == nil {
+				var ok bool
+				queue, ok = r.lookupFn(replica.host.ID())
+				if !ok {
+					r.metrics.writeErrors.Inc(1)
+					break
+				}
+			}
+			r.write(queue, state, t, v)
+		}
+	}
+	if divergent {
+		r.metrics.divergent.Inc(1)
+	}
+	if unwritable > 0 {
+		r.metrics.unwritable.Inc(unwritable)
+	}
+}
+
+func (r *readRepairer) decode(
+	segments []*rpc.Segments,
+	start, end time.Time,
+) (map[int64]readRepairDatapoint, error) {
+	slicesIter := newReaderSliceOfSlicesIterator(segments, nil)
+	iter := encoding.NewMultiReaderIterator(r.readerIteratorAllocate, nil)
+	iter.ResetSliceOfSlices(slicesIter)
+	defer iter.Close()
+
+	values := make(map[int64]readRepairDatapoint)
+	for iter.Next() {
+		dp, unit, annotation := iter.Current()
+		if dp.Timestamp.Before(start) || !dp.Timestamp.Before(end) {
+			continue
+		}
+		values[dp.Timestamp.UnixNano()] = readRepairDatapoint{
+			value:      dp.Value,
+			unit:       unit,
+			annotation: append([]byte(nil), annotation...),
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func (r *readRepairer) write(
+	queue hostQueue,
+	state *readRepairState,
+	t int64,
+	value readRepairDatapoint,
+) {
+	timeType, err := convert.ToTimeType(value.unit)
+	if err != nil {
+		r.metrics.writeErrors.Inc(1)
+		return
+	}
+	timestamp, err := convert.ToValue(time.Unix(0, t), timeType)
+	if err != nil {
+		r.metrics.writeErrors.Inc(1)
+		return
+	}
+
+	op := &writeOperation{}
+	op.reset()
+	op.namespace = ident.BinaryID(checked.NewBytes(state.namespace, nil))
+	op.request.ID = state.id
+	op.request.Datapoint.Value = value.value
+	op.request.Datapoint.Timestamp = timestamp
+	op.request.Datapoint.TimestampTimeType = timeType
+	op.request.Datapoint.Annotation = value.annotation
+	op.completionFn = func(result interface{}, err error) {
+		if err != nil {
+			r.metrics.writeErrors.Inc(1)
+			return
+		}
+		r.metrics.writeSuccess.Inc(1)
+		r.metrics.repaired.Inc(1)
+	}
+
+	if err := queue.Enqueue(op); err != nil {
+		r.metrics.writeErrors.Inc(1)
+	}
+}
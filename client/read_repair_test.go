@@ -0,0 +1,339 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3db/encoding/m3tsz"
+	"github.com/m3db/m3db/generated/thrift/rpc"
+	"github.com/m3db/m3db/storage/namespace"
+	"github.com/m3db/m3db/topology"
+	"github.com/m3db/m3db/ts"
+	"github.com/m3db/m3x/ident"
+	"github.com/m3db/m3x/instrument"
+	xtime "github.com/m3db/m3x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+type readRepairTestValue struct {
+	t     time.Time
+	value float64
+}
+
+func newReadRepairTestSegments(values []readRepairTestValue) []*rpc.Segments {
+	encoder := m3tsz.NewEncoder(values[0].t, nil, true, nil)
+	for _, v := range values {
+		encoder.Encode(ts.Datapoint{Timestamp: v.t, Value: v.value}, xtime.Second, nil)
+	}
+	seg := encoder.Discard()
+	return []*rpc.Segments{&rpc.Segments{
+		Merged: &rpc.Segment{Head: seg.Head.Get(), Tail: seg.Tail.Get()},
+	}}
+}
+
+func newReadRepairTestRepairer(
+	scope tally.Scope,
+	queues map[string]hostQueue,
+) *readRepairer {
+	opts := NewOptions().
+		SetReadRepairEnabled(true).
+		SetInstrumentOptions(instrument.NewOptions().SetMetricsScope(scope))
+	return newReadRepairer(opts, func(hostID string) (hostQueue, bool) {
+		queue, ok := queues[hostID]
+		return queue, ok
+	}, func(ns []byte) (namespace.Metadata, bool) {
+		return nil, false
+	})
+}
+
+// readRepairTestStart returns a start time recent enough that datapoints
+// written shortly after it are within the default buffer past
+func readRepairTestStart() time.Time {
+	return time.Now().Truncate(time.Minute).Add(-5 * time.Minute)
+}
+
+func TestReadRepairSample(t *testing.T) {
+	repairer := newReadRepairTestRepairer(tally.NoopScope, nil)
+	assert.True(t, repairer.sample())
+
+	repairer.sampleRate = 0
+	assert.False(t, repairer.sample())
+
+	repairer.sampleRate = 0.5
+	repairer.randFn = func() float64 { return 0.4 }
+	assert.True(t, repairer.sample())
+	repairer.randFn = func() float64 { return 0.6 }
+	assert.False(t, repairer.sample())
+}
+
+func TestReadRepairWritesMissingDatapoints(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		start  = readRepairTestStart()
+		end    = start.Add(time.Hour)
+		hostA  = topology.NewHost("a", "a:9000")
+		hostB  = topology.NewHost("b", "b:9000")
+		queueA = NewMockhostQueue(ctrl)
+		queueB = NewMockhostQueue(ctrl)
+		writes []*writeOperation
+	)
+	queueB.EXPECT().Enqueue(gomock.Any()).Do(func(o op) {
+		w := o.(*writeOperation)
+		writes = append(writes, w)
+		w.CompletionFn()(hostB, nil)
+	}).Return(nil)
+
+	repairer := newReadRepairTestRepairer(tally.NoopScope, map[string]hostQueue{
+		"a": queueA,
+		"b": queueB,
+	})
+	state := repairer.newState([]byte("testns"), []byte("foo"), start, end)
+	state.replicas = []readRepairReplica{
+		{host: hostA, segments: newReadRepairTestSegments([]readRepairTestValue{
+			{start, 1},
+			{start.Add(time.Minute), 2},
+			{start.Add(2 * time.Minute), 3},
+		})},
+		{host: hostB, segments: newReadRepairTestSegments([]readRepairTestValue{
+			{start, 1},
+			{start.Add(2 * time.Minute), 3},
+		})},
+	}
+
+	repairer.repair(state)
+
+	require.Equal(t, 1, len(writes))
+	assert.Equal(t, "testns", writes[0].namespace.String())
+	assert.Equal(t, []byte("foo"), writes[0].request.ID)
+	assert.Equal(t, 2.0, writes[0].request.Datapoint.Value)
+	assert.Equal(t, rpc.TimeType_UNIX_SECONDS, writes[0].request.Datapoint.TimestampTimeType)
+	assert.Equal(t, start.Add(time.Minute).Unix(), writes[0].request.Datapoint.Timestamp)
+}
+
+func TestReadRepairSkipsConflictingDatapoints(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		start = readRepairTestStart()
+		end   = start.Add(time.Hour)
+		scope = tally.NewTestScope("", nil)
+	)
+	repairer := newReadRepairTestRepairer(scope, map[string]hostQueue{
+		"a": NewMockhostQueue(ctrl),
+		"b": NewMockhostQueue(ctrl),
+	})
+	state := repairer.newState([]byte("testns"), []byte("foo"), start, end)
+	state.replicas = []readRepairReplica{
+		{host: topology.NewHost("a", "a:9000"), segments: newReadRepairTestSegments(
+			[]readRepairTestValue{{start, 1}, {start.Add(time.Minute), 2}})},
+		{host: topology.NewHost("b", "b:9000"), segments: newReadRepairTestSegments(
+			[]readRepairTestValue{{start, 1}, {start.Add(time.Minute), 4}})},
+	}
+
+	// No writes expected as the replicas only differ in value
+	repairer.repair(state)
+
+	counters := scope.Snapshot().Counters()
+	conflicts, ok := counters[tally.KeyForPrefixedStringMap("read-repair.conflicts", nil)]
+	require.True(t, ok)
+	assert.Equal(t, int64(1), conflicts.Value())
+}
+
+func TestReadRepairCompletionFnRepairsOnceAllReplicasResponded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		start    = readRepairTestStart()
+		end      = start.Add(time.Hour)
+		hostA    = topology.NewHost("a", "a:9000")
+		hostB    = topology.NewHost("b", "b:9000")
+		queueA   = NewMockhostQueue(ctrl)
+		repaired = make(chan *writeOperation, 1)
+		called   int
+	)
+	queueA.EXPECT().Enqueue(gomock.Any()).Do(func(o op) {
+		repaired <- o.(*writeOperation)
+	}).Return(nil)
+
+	repairer := newReadRepairTestRepairer(tally.NoopScope, map[string]hostQueue{
+		"a": queueA,
+	})
+	state := repairer.newState([]byte("testns"), []byte("foo"), start, end)
+	fetchFn := func(result interface{}, err error) {
+		called++
+	}
+	fnA := state.completionFn(hostA, fetchFn)
+	fnB := state.completionFn(hostB, fetchFn)
+
+	fnA(newReadRepairTestSegments([]readRepairTestValue{{start, 1}}), nil)
+	fnB(newReadRepairTestSegments([]readRepairTestValue{{start, 1}, {start.Add(time.Second), 2}}), nil)
+	assert.Equal(t, 2, called)
+
+	select {
+	case w := <-repaired:
+		assert.Equal(t, 2.0, w.request.Datapoint.Value)
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "timed out waiting for read repair")
+	}
+}
+
+func TestReadRepairSkippedWhenReplicaFails(t *testing.T) {
+	var (
+		start = readRepairTestStart()
+		end   = start.Add(time.Hour)
+		scope = tally.NewTestScope("", nil)
+	)
+	repairer := newReadRepairTestRepairer(scope, nil)
+	state := repairer.newState([]byte("testns"), []byte("foo"), start, end)
+	noop := func(result interface{}, err error) {}
+	fnA := state.completionFn(topology.NewHost("a", "a:9000"), noop)
+	fnB := state.completionFn(topology.NewHost("b", "b:9000"), noop)
+
+	fnA(newReadRepairTestSegments([]readRepairTestValue{{start, 1}}), nil)
+	fnB(nil, errors.New("an error"))
+
+	key := tally.KeyForPrefixedStringMap("read-repair.skipped", nil)
+	for i := 0; i < 1000; i++ {
+		if c, ok := scope.Snapshot().Counters()[key]; ok && c.Value() == 1 {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.FailNow(t, "timed out waiting for read repair to be skipped")
+}
+
+func TestReadRepairSkipsUnwritableDatapoints(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		now    = time.Now().Truncate(time.Hour)
+		start  = now.Add(-time.Hour)
+		end    = now
+		hostA  = topology.NewHost("a", "a:9000")
+		hostB  = topology.NewHost("b", "b:9000")
+		queueB = NewMockhostQueue(ctrl)
+		scope  = tally.NewTestScope("", nil)
+		writes []*writeOperation
+	)
+	queueB.EXPECT().Enqueue(gomock.Any()).Do(func(o op) {
+		w := o.(*writeOperation)
+		writes = append(writes, w)
+		w.CompletionFn()(hostB, nil)
+	}).Return(nil)
+
+	repairer := newReadRepairTestRepairer(scope, map[string]hostQueue{
+		"b": queueB,
+	})
+	repairer.nowFn = func() time.Time { return now }
+	newState := func() *readRepairState {
+		state := repairer.newState([]byte("testns"), []byte("foo"), start, end)
+		state.replicas = []readRepairReplica{
+			{host: hostA, segments: newReadRepairTestSegments([]readRepairTestValue{
+				{start, 1},
+				{now.Add(-time.Minute), 2},
+			})},
+			{host: hostB, segments: newReadRepairTestSegments([]readRepairTestValue{
+				{now.Add(-2 * time.Minute), 3},
+			})},
+		}
+		return state
+	}
+
+	// The datapoint older than the buffer past is not written back
+	repairer.repair(newState())
+	require.Equal(t, 1, len(writes))
+	assert.Equal(t, 2.0, writes[0].request.Datapoint.Value)
+
+	counters := scope.Snapshot().Counters()
+	unwritable := counters[tally.KeyForPrefixedStringMap("read-repair.unwritable-datapoints", nil)]
+	require.NotNil(t, unwritable)
+	assert.Equal(t, int64(1), unwritable.Value())
+
+	// Once the namespace has cold writes enabled it is written back
+	md, err := namespace.NewMetadata(ident.StringID("testns"),
+		namespace.NewOptions().SetColdWritesEnabled(true))
+	require.NoError(t, err)
+	repairer.nsLookupFn = func(ns []byte) (namespace.Metadata, bool) {
+		return md, string(ns) == "testns"
+	}
+	queueB.EXPECT().Enqueue(gomock.Any()).Do(func(o op) {
+		w := o.(*writeOperation)
+		writes = append(writes, w)
+		w.CompletionFn()(hostB, nil)
+	}).Return(nil).Times(2)
+
+	writes = nil
+	repairer.repair(newState())
+	require.Equal(t, 2, len(writes))
+}
+
+func TestReadRepairCountsRepairedOnlyOnWriteSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		start  = readRepairTestStart()
+		end    = start.Add(time.Hour)
+		hostA  = topology.NewHost("a", "a:9000")
+		hostB  = topology.NewHost("b", "b:9000")
+		queueB = NewMockhostQueue(ctrl)
+		scope  = tally.NewTestScope("", nil)
+	)
+	queueB.EXPECT().Enqueue(gomock.Any()).Do(func(o op) {
+		o.(*writeOperation).CompletionFn()(hostB, errors.New("an error"))
+	}).Return(nil)
+
+	repairer := newReadRepairTestRepairer(scope, map[string]hostQueue{
+		"b": queueB,
+	})
+	state := repairer.newState([]byte("testns"), []byte("foo"), start, end)
+	state.replicas = []readRepairReplica{
+		{host: hostA, segments: newReadRepairTestSegments([]readRepairTestValue{
+			{start, 1},
+			{start.Add(time.Minute), 2},
+		})},
+		{host: hostB, segments: newReadRepairTestSegments([]readRepairTestValue{
+			{start, 1},
+		})},
+	}
+
+	repairer.repair(state)
+
+	counters := scope.Snapshot().Counters()
+	writeErrors := counters[tally.KeyForPrefixedStringMap("read-repair.write-errors", nil)]
+	require.NotNil(t, writeErrors)
+	assert.Equal(t, int64(1), writeErrors.Value())
+	repaired := counters[tally.KeyForPrefixedStringMap("read-repair.repaired-datapoints", nil)]
+	require.NotNil(t, repaired)
+	assert.Equal(t, int64(0), repaired.Value())
+}
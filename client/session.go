@@ -37,12 +37,14 @@ import (
 	"github.com/m3db/m3db/encoding"
 	"github.com/m3db/m3db/generated/thrift/rpc"
 	"github.com/m3db/m3db/network/server/tchannelthrift/convert"
+	"github.com/m3db/m3db/retention"
 	"github.com/m3db/m3db/storage/block"
 	"github.com/m3db/m3db/storage/bootstrap/result"
 	"github.com/m3db/m3db/storage/index"
 	"github.com/m3db/m3db/storage/namespace"
 	"github.com/m3db/m3db/topology"
 	"github.com/m3db/m3db/ts"
+	"github.com/m3db/m3db/x/serialize"
 	"github.com/m3db/m3db/x/xio"
 	"github.com/m3db/m3x/checked"
 	"github.com/m3db/m3x/context"
@@ -95,6 +97,10 @@ var (
 	errFetchBlocksMetadataEndpointVersionUnspecified = errors.New(
 		"fetch blocks metadata endpoint version unspecified")
 	errNotImplemented = errors.New("not implemented")
+
+	// defaultNamespaceRetentionOptions are the retention options assumed
+	// for namespaces the session cannot resolve the metadata of
+	defaultNamespaceRetentionOptions = retention.NewOptions()
 )
 
 var (
@@ -118,6 +124,9 @@ var (
 	// errInvalidFetchBlocksMetadataVersion is raised when an invalid fetch blocks
 	// metadata endpoint version is provided
 	errInvalidFetchBlocksMetadataVersion = errors.New("invalid fetch blocks metadata endpoint version")
+	// errFetchAggregatedStepNotPositive is raised when fetching aggregated
+	// values with a step that is not positive
+	errFetchAggregatedStepNotPositive = errors.New("fetch aggregated step must be positive")
 )
 
 type session struct {
@@ -133,6 +142,7 @@ type session struct {
 	topo                             topology.Topology
 	topoMap                          topology.Map
 	topoWatch                        topology.MapWatch
+	nsWatch                          namespace.Watch
 	replicas                         int32
 	majority                         int32
 	queues                           []hostQueue
@@ -163,6 +173,8 @@ type session struct {
 	streamBlocksBatchSize            int
 	streamBlocksMetadataBatchTimeout time.Duration
 	streamBlocksBatchTimeout         time.Duration
+	hints                            *hintedHandoff
+	readRepair                       *readRepairer
 	metrics                          sessionMetrics
 }
 
@@ -260,6 +272,9 @@ func newSession(opts Options) (clientSession, error) {
 		))
 	s.fetchAttemptPool = newFetchAttemptPool(s, fetchAttemptPoolOpts)
 	s.fetchAttemptPool.Init()
+	if opts.ReadRepairEnabled() {
+		s.readRepair = newReadRepairer(opts, s.hostQueueByID, s.namespaceMetadata)
+	}
 
 	if opts, ok := opts.(AdminOptions); ok {
 		s.origin = opts.Origin()
@@ -390,8 +405,24 @@ func (s *session) Open() error {
 
 	topoMap := watch.Get()
 
+	if nsInit := s.opts.NamespaceInitializer(); nsInit != nil {
+		// NB: The registry may be shared with the owner of the initializer
+		// so only the watch is closed by the session
+		nsRegistry, err := nsInit.Init()
+		if err == nil {
+			s.nsWatch, err = nsRegistry.Watch()
+		}
+		if err != nil {
+			watch.Close()
+			s.Unlock()
+			return err
+		}
+	}
+
 	queues, replicas, majority, err := s.hostQueues(topoMap, nil)
 	if err != nil {
+		s.closeNamespaceWatchWithLock()
+		watch.Close()
 		s.Unlock()
 		return err
 	}
@@ -431,6 +462,20 @@ func (s *session) Open() error {
 	s.seriesIteratorPool.Init()
 	s.seriesIteratorsPool = encoding.NewMutableSeriesIteratorsPool(s.opts.SeriesIteratorArrayPoolBuckets())
 	s.seriesIteratorsPool.Init()
+	if s.opts.HintedHandoffEnabled() {
+		hints, err := newHintedHandoff(s.opts, s.hostQueueByID, s.namespaceMetadata)
+		if err != nil {
+			for _, q := range queues {
+				q.Close()
+			}
+			s.closeNamespaceWatchWithLock()
+			s.topoWatch.Close()
+			s.Unlock()
+			return err
+		}
+		s.hints = hints
+		s.hints.start()
+	}
 	s.state = stateOpen
 	s.Unlock()
 
@@ -459,6 +504,16 @@ func (s *session) Open() error {
 	return nil
 }
 
+func (s *session) hostQueueByID(hostID string) (hostQueue, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.state != stateOpen {
+		return nil, false
+	}
+	queue, ok := s.queuesByHostID[hostID]
+	return queue, ok
+}
+
 func (s *session) BorrowConnection(hostID string, fn withConnectionFn) error {
 	s.RLock()
 	unlocked := false
@@ -783,6 +838,7 @@ func (s *session) writeAttempt(
 
 	state := s.writeStatePool.Get()
 	state.topoMap = s.topoMap
+	state.hints = s.hints
 	state.incRef()
 
 	op := s.writeOperationPool.Get()
@@ -860,6 +916,111 @@ func (s *session) Fetch(
 	return iter, nil
 }
 
+// FetchAggregated fetches the values consolidated by each replica for the ID,
+// returning the values of the first replica to respond successfully once the
+// read consistency level is met.
+func (s *session) FetchAggregated(
+	namespace ident.ID,
+	id ident.ID,
+	startInclusive, endExclusive time.Time,
+	opts FetchAggregatedOptions,
+) ([]ts.Datapoint, error) {
+	if opts.Step <= 0 {
+		return nil, xerrors.NewInvalidParamsError(errFetchAggregatedStepNotPositive)
+	}
+	aggregation, err := convert.ToRPCAggregationType(opts.Aggregation)
+	if err != nil {
+		return nil, xerrors.NewInvalidParamsError(err)
+	}
+
+	rangeStart, tsErr := convert.ToValue(startInclusive, rpc.TimeType_UNIX_NANOSECONDS)
+	if tsErr != nil {
+		return nil, tsErr
+	}
+
+	rangeEnd, tsErr := convert.ToValue(endExclusive, rpc.TimeType_UNIX_NANOSECONDS)
+	if tsErr != nil {
+		return nil, tsErr
+	}
+
+	var (
+		wg         sync.WaitGroup
+		resultLock sync.Mutex
+		result     *rpc.FetchResult_
+		resultErrs []error
+		enqueued   int32
+		enqueueErr error
+		majority   = atomic.LoadInt32(&s.majority)
+		step       = int64(opts.Step)
+	)
+
+	f := &fetchAggregatedOp{}
+	f.request.RangeStart = rangeStart
+	f.request.RangeEnd = rangeEnd
+	f.request.RangeType = rpc.TimeType_UNIX_NANOSECONDS
+	f.request.ResultTimeType = rpc.TimeType_UNIX_NANOSECONDS
+	f.request.NameSpace = namespace.String()
+	f.request.ID = id.String()
+	f.request.Step = &step
+	f.request.Aggregation = aggregation
+	f.completionFn = func(r interface{}, err error) {
+		resultLock.Lock()
+		if err != nil {
+			resultErrs = append(resultErrs, err)
+		} else if result == nil {
+			result = r.(*rpc.FetchResult_)
+		}
+		resultLock.Unlock()
+		wg.Done()
+	}
+
+	s.RLock()
+	if s.state != stateOpen {
+		s.RUnlock()
+		return nil, errSessionStateNotOpen
+	}
+	routeErr := s.topoMap.RouteForEach(id, func(idx int, host topology.Host) {
+		if enqueueErr != nil {
+			return
+		}
+		wg.Add(1)
+		if err := s.queues[idx].Enqueue(f); err != nil {
+			wg.Done()
+			enqueueErr = err
+			return
+		}
+		enqueued++
+	})
+	s.RUnlock()
+
+	// Wait for all enqueued requests even on error as they reference the op
+	wg.Wait()
+
+	if err := xerrors.FirstError(routeErr, enqueueErr); err != nil {
+		s.log.Errorf("failed to enqueue request: %v", err)
+		return nil, err
+	}
+
+	errsLen := int32(len(resultErrs))
+	err = s.readConsistencyResult(majority, enqueued, enqueued, errsLen, resultErrs)
+	s.incFetchMetrics(err, errsLen)
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, nil
+	}
+	datapoints := make([]ts.Datapoint, 0, len(result.Datapoints))
+	for _, dp := range result.Datapoints {
+		datapoints = append(datapoints, ts.Datapoint{
+			Timestamp: xtime.FromNormalizedTime(dp.Timestamp, time.Nanosecond),
+			Value:     dp.Value,
+		})
+	}
+	return datapoints, nil
+}
+
 func (s *session) FetchIDs(
 	namespace ident.ID,
 	ids ident.Iterator,
@@ -874,16 +1035,259 @@ func (s *session) FetchIDs(
 	return result, err
 }
 
+func (s *session) FetchIDsPaged(
+	namespace ident.ID,
+	ids ident.Iterator,
+	startInclusive, endExclusive time.Time,
+) (SeriesPageIterator, error) {
+	rangeStart, tsErr := convert.ToValue(startInclusive, rpc.TimeType_UNIX_NANOSECONDS)
+	if tsErr != nil {
+		return nil, tsErr
+	}
+
+	rangeEnd, tsErr := convert.ToValue(endExclusive, rpc.TimeType_UNIX_NANOSECONDS)
+	if tsErr != nil {
+		return nil, tsErr
+	}
+
+	s.RLock()
+	if s.state != stateOpen {
+		s.RUnlock()
+		return nil, errSessionStateNotOpen
+	}
+	shardSet := s.topoMap.ShardSet()
+	s.RUnlock()
+
+	// Group the IDs by shard so each page can be requested from a single
+	// set of replicas, the order of the groups follows the order of the IDs.
+	var (
+		groups       []seriesPageGroup
+		groupByShard = make(map[uint32]int)
+		iter         = ids.Duplicate()
+	)
+	for iter.Next() {
+		id := iter.Current()
+		shard := shardSet.Lookup(id)
+		idx, ok := groupByShard[shard]
+		if !ok {
+			idx = len(groups)
+			groupByShard[shard] = idx
+			groups = append(groups, seriesPageGroup{shard: shard})
+		}
+		groups[idx].ids = append(groups[idx].ids, append([]byte(nil), id.Data().Get()...))
+	}
+	err := iter.Err()
+	iter.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return newSeriesPageIter(s, append([]byte(nil), namespace.Data().Get()...),
+		groups, startInclusive, endExclusive, rangeStart, rangeEnd), nil
+}
+
 func (s *session) FetchTagged(
 	q index.Query, opts index.QueryOptions,
 ) (encoding.SeriesIterators, bool, error) {
-	return nil, false, errNotImplemented
+	series, exhaustive, err := s.fetchTaggedAttempt(q, opts, true)
+	if err != nil {
+		return nil, false, err
+	}
+
+	iters := s.seriesIteratorsPool.Get(len(series))
+	iters.Reset(len(series))
+	for i, elem := range series {
+		replicas := make([]encoding.Iterator, 0, len(elem.replicas))
+		for _, datapoints := range elem.replicas {
+			replicas = append(replicas, newDatapointsIterator(datapoints))
+		}
+		iter := s.seriesIteratorPool.Get()
+		iter.Reset(ident.StringID(elem.id), ident.StringID(elem.namespace),
+			opts.StartInclusive, opts.EndExclusive, replicas)
+		iters.SetAt(i, &taggedSeriesIterator{SeriesIterator: iter, tags: elem.tags})
+	}
+	return iters, exhaustive, nil
 }
 
 func (s *session) FetchTaggedIDs(
 	q index.Query, opts index.QueryOptions,
 ) (index.QueryResults, error) {
-	return index.QueryResults{}, errNotImplemented
+	series, exhaustive, err := s.fetchTaggedAttempt(q, opts, false)
+	if err != nil {
+		return index.QueryResults{}, err
+	}
+
+	var (
+		resultsByNamespace = make(map[string]index.Results)
+		results            []index.Results
+	)
+	for _, elem := range series {
+		nsResults, ok := resultsByNamespace[elem.namespace]
+		if !ok {
+			nsResults = index.NewResults(ident.StringID(elem.namespace))
+			resultsByNamespace[elem.namespace] = nsResults
+			results = append(results, nsResults)
+		}
+		nsResults.Add(ident.StringID(elem.id), elem.tags)
+	}
+	return index.QueryResults{
+		Iter:       index.NewMultiTaggedIDsIter(results...),
+		Exhaustive: exhaustive,
+	}, nil
+}
+
+// fetchTaggedAttempt resolves the query on every host, returning the series
+// matched by the hosts once the read consistency level is met for each shard.
+func (s *session) fetchTaggedAttempt(
+	q index.Query,
+	opts index.QueryOptions,
+	fetchData bool,
+) ([]*fetchTaggedSeries, bool, error) {
+	query, err := convert.ToRPCIndexQuery(q)
+	if err != nil {
+		return nil, false, xerrors.NewInvalidParamsError(err)
+	}
+
+	rangeStart, tsErr := convert.ToValue(opts.StartInclusive, rpc.TimeType_UNIX_NANOSECONDS)
+	if tsErr != nil {
+		return nil, false, tsErr
+	}
+
+	rangeEnd, tsErr := convert.ToValue(opts.EndExclusive, rpc.TimeType_UNIX_NANOSECONDS)
+	if tsErr != nil {
+		return nil, false, tsErr
+	}
+
+	s.RLock()
+	if s.state != stateOpen {
+		s.RUnlock()
+		return nil, false, errSessionStateNotOpen
+	}
+
+	var (
+		wg         sync.WaitGroup
+		topoMap    = s.topoMap
+		majority   = atomic.LoadInt32(&s.majority)
+		results    = make([]*rpc.FetchTaggedResult_, len(s.queues))
+		hostErrs   = make([]error, len(s.queues))
+		enqueueErr xerrors.MultiError
+	)
+	for idx := range s.queues {
+		idx := idx // capture loop variable
+		f := &fetchTaggedOp{}
+		f.request.Query = query
+		f.request.RangeStart = rangeStart
+		f.request.RangeEnd = rangeEnd
+		f.request.RangeTimeType = rpc.TimeType_UNIX_NANOSECONDS
+		f.request.FetchData = fetchData
+		if opts.Limit > 0 {
+			limit := int64(opts.Limit)
+			f.request.Limit = &limit
+		}
+		f.completionFn = func(result interface{}, err error) {
+			if err != nil {
+				hostErrs[idx] = err
+			} else {
+				results[idx] = result.(*rpc.FetchTaggedResult_)
+			}
+			wg.Done()
+		}
+
+		wg.Add(1)
+		if err := s.queues[idx].Enqueue(f); err != nil {
+			wg.Done()
+			hostErrs[idx] = err
+			enqueueErr = enqueueErr.Add(err)
+		}
+	}
+	s.RUnlock()
+
+	if err := enqueueErr.FinalError(); err != nil {
+		s.log.Errorf("failed to enqueue request: %v", err)
+	}
+
+	// Wait for the query to be resolved by all hosts
+	wg.Wait()
+
+	var (
+		consistencyErr error
+		respErrs       int32
+	)
+	for _, err := range hostErrs {
+		if err != nil {
+			respErrs++
+		}
+	}
+	for _, shard := range topoMap.ShardSet().AllIDs() {
+		var (
+			enqueued  int32
+			shardErrs []error
+		)
+		if err := topoMap.RouteShardForEach(shard, func(idx int, _ topology.Host) {
+			enqueued++
+			if hostErrs[idx] != nil {
+				shardErrs = append(shardErrs, hostErrs[idx])
+			}
+		}); err != nil {
+			return nil, false, err
+		}
+		errsLen := int32(len(shardErrs))
+		consistencyErr = s.readConsistencyResult(majority, enqueued, enqueued, errsLen, shardErrs)
+		if consistencyErr != nil {
+			break
+		}
+	}
+	s.incFetchMetrics(consistencyErr, respErrs)
+	if consistencyErr != nil {
+		return nil, false, consistencyErr
+	}
+
+	var (
+		exhaustive = true
+		seriesByID = make(map[fetchTaggedSeriesKey]*fetchTaggedSeries)
+		series     []*fetchTaggedSeries
+	)
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		exhaustive = exhaustive && result.Exhaustive
+		for _, elem := range result.Elements {
+			if elem.Err != nil {
+				continue
+			}
+			key := fetchTaggedSeriesKey{namespace: elem.NameSpace, id: elem.ID}
+			curr, ok := seriesByID[key]
+			if !ok {
+				curr = &fetchTaggedSeries{
+					namespace: elem.NameSpace,
+					id:        elem.ID,
+					tags:      make(ident.Tags, 0, len(elem.Tags)),
+				}
+				for _, tag := range elem.Tags {
+					curr.tags = append(curr.tags, ident.Tag{
+						Name:  ident.StringID(tag.Name),
+						Value: ident.StringID(tag.Value),
+					})
+				}
+				seriesByID[key] = curr
+				series = append(series, curr)
+			}
+			if fetchData {
+				curr.replicas = append(curr.replicas, elem.Datapoints)
+			}
+		}
+	}
+
+	// NB: Each host applies the limit to the series it owns so the merged
+	// result can exceed the limit, if so truncate it and since some matched
+	// series are dropped mark it as not exhaustive
+	if opts.Limit > 0 && len(series) > opts.Limit {
+		series = series[:opts.Limit]
+		exhaustive = false
+	}
+
+	return series, exhaustive, nil
 }
 
 func (s *session) fetchIDsAttempt(
@@ -976,8 +1380,14 @@ func (s *session) fetchIDsAttempt(
 			success          int32
 			errors           []error
 			errs             int32
+			repair           *readRepairState
 		)
 
+		if s.readRepair != nil && s.readRepair.sample() {
+			repair = s.readRepair.newState(namespace.Data().Get(),
+				tsID.Data().Get(), startInclusive, endExclusive)
+		}
+
 		// increment namespaceAccesors by 1 to indicate it still needs to be handled by the
 		// allCompletionFn for tsID.
 		atomic.AddInt32(&namespaceAccessors, 1)
@@ -1114,7 +1524,12 @@ func (s *session) fetchIDsAttempt(
 			}
 
 			// Append IDWithNamespace to this request
-			f.append(namespace.Data().Get(), tsID.Data().Get(), completionFn)
+			fn := completionFn
+			if repair != nil {
+				// Compare the responses of all replicas once they have responded
+				fn = repair.completionFn(host, completionFn)
+			}
+			f.append(namespace.Data().Get(), tsID.Data().Get(), fn)
 		}); err != nil {
 			routeErr = err
 			break
@@ -1231,15 +1646,79 @@ func (s *session) Close() error {
 	s.state = stateClosed
 	s.Unlock()
 
+	if s.hints != nil {
+		s.hints.close()
+	}
+
 	for _, q := range s.queues {
 		q.Close()
 	}
 
+	s.Lock()
+	s.closeNamespaceWatchWithLock()
+	s.Unlock()
+
 	s.topoWatch.Close()
 	s.topo.Close()
 	return nil
 }
 
+func (s *session) closeNamespaceWatchWithLock() {
+	if s.nsWatch != nil {
+		s.nsWatch.Close()
+		s.nsWatch = nil
+	}
+}
+
+// namespaceLookupFn returns the metadata of a namespace, if known
+type namespaceLookupFn func(ns []byte) (namespace.Metadata, bool)
+
+// timestampWritable returns whether a write at the timestamp would currently
+// be accepted by the namespace, the default retention options are assumed
+// for namespaces that are not known
+func timestampWritable(
+	lookupFn namespaceLookupFn,
+	ns []byte,
+	now, timestamp time.Time,
+) bool {
+	var (
+		ropts      = defaultNamespaceRetentionOptions
+		coldWrites = false
+	)
+	if md, ok := lookupFn(ns); ok {
+		ropts = md.Options().RetentionOptions()
+		coldWrites = md.Options().ColdWritesEnabled()
+	}
+	if timestamp.After(now.Add(-ropts.BufferPast())) {
+		return true
+	}
+	if !coldWrites {
+		return false
+	}
+	blockStart := timestamp.Truncate(ropts.BlockSize())
+	return !blockStart.Before(retention.FlushTimeStart(ropts, now))
+}
+
+// namespaceMetadata returns the metadata of a namespace if the session was
+// given a namespace initializer and the namespace is registered
+func (s *session) namespaceMetadata(ns []byte) (namespace.Metadata, bool) {
+	s.RLock()
+	nsWatch := s.nsWatch
+	s.RUnlock()
+	if nsWatch == nil {
+		return nil, false
+	}
+	nsMap := nsWatch.Get()
+	if nsMap == nil {
+		return nil, false
+	}
+	md, err := nsMap.Get(ident.BinaryID(checked.NewBytes(ns, nil)))
+	if err != nil {
+		return nil, false
+	}
+	return md, true
+}
+
 func (s *session) Origin() topology.Host {
 	return s.origin
 }
@@ -1819,9 +2298,23 @@ func (s *session) streamBlocksMetadataFromPeerV2(
 				}
 			}
 
+			// NB: Peers that do not store tags do not send them, the series
+			// are then bootstrapped without tags.
+			tags, err := serialize.DecodeTags(elem.EncodedTags)
+			if err != nil {
+				progress.metadataFetchBatchBlockErr.Inc(1)
+				s.log.WithFields(
+					xlog.NewField("shard", shard),
+					xlog.NewField("peer", peerStr),
+					xlog.NewField("block", blockStart),
+					xlog.NewField("error", err),
+				).Error("error occurred decoding block metadata tags")
+			}
+
 			metadataCh <- blocksMetadata{
 				peer: peer,
 				id:   clonedID,
+				tags: tags,
 				blocks: []blockMetadata{
 					{start: blockStart,
 						size:     size,
@@ -2305,6 +2798,7 @@ func (s *session) selectBlocksForSeriesFromPeerBlocksMetadata(
 
 					// Set the reattempt metadata
 					currEligible[i].blocks[idx].reattempt.id = currID
+					currEligible[i].blocks[idx].reattempt.tags = currEligible[i].tags
 					currEligible[i].blocks[idx].reattempt.attempt++
 					currEligible[i].blocks[idx].reattempt.attempted =
 						append(currEligible[i].blocks[idx].reattempt.attempted, peer)
@@ -2325,6 +2819,7 @@ func (s *session) selectBlocksForSeriesFromPeerBlocksMetadata(
 				peer := currEligible[i].peer
 				block := currEligible[i].blocks[idx]
 				currEligible[i].blocks[idx].reattempt.id = currID
+				currEligible[i].blocks[idx].reattempt.tags = currEligible[i].tags
 				currEligible[i].blocks[idx].reattempt.attempt++
 				currEligible[i].blocks[idx].reattempt.attempted =
 					append(currEligible[i].blocks[idx].reattempt.attempted, peer)
@@ -2474,7 +2969,7 @@ func (s *session) streamBlocksBatchFromPeer(
 			// Verify and if verify succeeds add the block from the peer
 			err := s.verifyFetchedBlock(block)
 			if err == nil {
-				err = blocksResult.addBlockFromPeer(id, peer.Host(), block)
+				err = blocksResult.addBlockFromPeer(id, batch[i].tags, peer.Host(), block)
 			}
 
 			if err != nil {
@@ -2585,6 +3080,7 @@ func (s *session) streamBlocksReattemptFromPeersEnqueue(
 			reattemptBlocksMetadata[j] = &blocksMetadata{
 				peer: reattempt.peersMetadata[j].peer,
 				id:   reattempt.id,
+				tags: reattempt.tags,
 				blocks: []blockMetadata{blockMetadata{
 					start:     reattempt.peersMetadata[j].start,
 					size:      reattempt.peersMetadata[j].size,
@@ -2600,7 +3096,7 @@ func (s *session) streamBlocksReattemptFromPeersEnqueue(
 }
 
 type blocksResult interface {
-	addBlockFromPeer(id ident.ID, peer topology.Host, block *rpc.Block) error
+	addBlockFromPeer(id ident.ID, tags ident.Tags, peer topology.Host, block *rpc.Block) error
 }
 
 type baseBlocksResult struct {
@@ -2741,7 +3237,12 @@ type peerBlocksDatapoint struct {
 	block block.DatabaseBlock
 }
 
-func (s *streamBlocksResult) addBlockFromPeer(id ident.ID, peer topology.Host, block *rpc.Block) error {
+func (s *streamBlocksResult) addBlockFromPeer(
+	id ident.ID,
+	_ ident.Tags,
+	peer topology.Host,
+	block *rpc.Block,
+) error {
 	result, err := s.newDatabaseBlock(block)
 	if err != nil {
 		return err
@@ -2812,7 +3313,12 @@ func newBulkBlocksResult(
 	}
 }
 
-func (r *bulkBlocksResult) addBlockFromPeer(id ident.ID, peer topology.Host, block *rpc.Block) error {
+func (r *bulkBlocksResult) addBlockFromPeer(
+	id ident.ID,
+	tags ident.Tags,
+	peer topology.Host,
+	block *rpc.Block,
+) error {
 	start := time.Unix(0, block.Start)
 	result, err := r.newDatabaseBlock(block)
 	if err != nil {
@@ -2823,7 +3329,7 @@ func (r *bulkBlocksResult) addBlockFromPeer(id ident.ID, peer topology.Host, blo
 		r.Lock()
 		currBlock, exists := r.result.BlockAt(id, start)
 		if !exists {
-			r.result.AddBlock(id, result)
+			r.result.AddBlock(id, tags, result)
 			r.Unlock()
 			break
 		}
@@ -3092,6 +3598,7 @@ func (qs peerBlocksQueues) closeAll() {
 type blocksMetadata struct {
 	peer peer
 	id   ident.ID
+	tags ident.Tags
 	// TODO(rartoul): Make this not a slice once we delete the V1 code path
 	blocks []blockMetadata
 	idx    int
@@ -3180,6 +3687,7 @@ type blockMetadataReattempt struct {
 	attempt       int
 	failAllowed   *int32
 	id            ident.ID
+	tags          ident.Tags
 	attempted     []peer
 	errs          []error
 	peersMetadata []blockMetadataReattemptPeerMetadata
@@ -0,0 +1,104 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3db/generated/thrift/rpc"
+	"github.com/m3db/m3db/storage/namespace"
+	xerrors "github.com/m3db/m3x/errors"
+	"github.com/m3db/m3x/ident"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchAggregated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions()
+	s, err := newSession(opts)
+	assert.NoError(t, err)
+	session := s.(*session)
+
+	var (
+		start = time.Now().Truncate(time.Hour)
+		end   = start.Add(time.Hour)
+		step  = 10 * time.Minute
+	)
+	mockHostQueues(ctrl, session, sessionTestReplicas, []testEnqueueFn{
+		func(idx int, op op) {
+			fetch, ok := op.(*fetchAggregatedOp)
+			assert.True(t, ok)
+			assert.Equal(t, "metrics", fetch.request.NameSpace)
+			assert.Equal(t, "foo", fetch.request.ID)
+			assert.Equal(t, start.UnixNano(), fetch.request.RangeStart)
+			assert.Equal(t, end.UnixNano(), fetch.request.RangeEnd)
+			assert.Equal(t, rpc.TimeType_UNIX_NANOSECONDS, fetch.request.RangeType)
+			assert.Equal(t, int64(step), fetch.request.GetStep())
+			assert.Equal(t, rpc.AggregationType_MAX, fetch.request.Aggregation)
+
+			if idx == 0 {
+				fetch.completionFn(nil, errors.New("an error"))
+				return
+			}
+			fetch.completionFn(&rpc.FetchResult_{
+				Datapoints: []*rpc.Datapoint{
+					{Timestamp: start.UnixNano(), Value: 1},
+					{Timestamp: start.Add(step).UnixNano(), Value: 2},
+				},
+			}, nil)
+		},
+	})
+
+	assert.NoError(t, session.Open())
+
+	datapoints, err := s.FetchAggregated(ident.StringID("metrics"),
+		ident.StringID("foo"), start, end, FetchAggregatedOptions{
+			Step:        step,
+			Aggregation: namespace.AggregationMax,
+		})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(datapoints))
+	assert.True(t, start.Equal(datapoints[0].Timestamp))
+	assert.Equal(t, float64(1), datapoints[0].Value)
+	assert.True(t, start.Add(step).Equal(datapoints[1].Timestamp))
+	assert.Equal(t, float64(2), datapoints[1].Value)
+
+	assert.NoError(t, session.Close())
+}
+
+func TestFetchAggregatedInvalidStep(t *testing.T) {
+	opts := newSessionTestOptions()
+	s, err := newSession(opts)
+	assert.NoError(t, err)
+
+	now := time.Now()
+	_, err = s.FetchAggregated(ident.StringID("metrics"),
+		ident.StringID("foo"), now, now.Add(time.Hour), FetchAggregatedOptions{})
+	require.Error(t, err)
+	assert.True(t, xerrors.IsInvalidParams(err))
+}
@@ -1706,14 +1706,17 @@ func TestBlocksResultAddBlockFromPeerReadMerged(t *testing.T) {
 		}},
 	}
 
+	fooTags := ident.Tags{{Name: ident.StringID("city"), Value: ident.StringID("nyc")}}
+
 	r := newBulkBlocksResult(opts, bopts)
-	r.addBlockFromPeer(fooID, testHost, bl)
+	r.addBlockFromPeer(fooID, fooTags, testHost, bl)
 
 	series := r.result.AllSeries()
 	assert.Equal(t, 1, len(series))
 
 	sl, ok := series[fooID.Hash()]
 	assert.True(t, ok)
+	assert.Equal(t, fooTags, sl.Tags)
 	blocks := sl.Blocks
 	assert.Equal(t, 1, blocks.Len())
 	result, ok := blocks.BlockAt(start)
@@ -1788,7 +1791,7 @@ func TestBlocksResultAddBlockFromPeerReadUnmerged(t *testing.T) {
 	}
 
 	r := newBulkBlocksResult(opts, bopts)
-	r.addBlockFromPeer(fooID, testHost, bl)
+	r.addBlockFromPeer(fooID, nil, testHost, bl)
 
 	series := r.result.AllSeries()
 	assert.Equal(t, 1, len(series))
@@ -1835,7 +1838,7 @@ func TestBlocksResultAddBlockFromPeerErrorOnNoSegments(t *testing.T) {
 	r := newBulkBlocksResult(opts, bopts)
 
 	bl := &rpc.Block{Start: time.Now().UnixNano()}
-	err := r.addBlockFromPeer(fooID, testHost, bl)
+	err := r.addBlockFromPeer(fooID, nil, testHost, bl)
 	assert.Error(t, err)
 	assert.Equal(t, errSessionBadBlockResultFromPeer, err)
 }
@@ -1846,7 +1849,7 @@ func TestBlocksResultAddBlockFromPeerErrorOnNoSegmentsData(t *testing.T) {
 	r := newBulkBlocksResult(opts, bopts)
 
 	bl := &rpc.Block{Start: time.Now().UnixNano(), Segments: &rpc.Segments{}}
-	err := r.addBlockFromPeer(fooID, testHost, bl)
+	err := r.addBlockFromPeer(fooID, nil, testHost, bl)
 	assert.Error(t, err)
 	assert.Equal(t, errSessionBadBlockResultFromPeer, err)
 }
@@ -0,0 +1,276 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3db/generated/thrift/rpc"
+	"github.com/m3db/m3db/storage/index"
+	"github.com/m3db/m3ninx/index/segment"
+	"github.com/m3db/m3x/ident"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testFetchTaggedQuery() index.Query {
+	return index.Query{segment.Query{
+		Conjunction: segment.AndConjunction,
+		Filters: []segment.Filter{
+			{FieldName: []byte("city"), FieldValueFilter: []byte("nyc")},
+		},
+	}}
+}
+
+func TestFetchTagged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions()
+	s, err := newSession(opts)
+	assert.NoError(t, err)
+	session := s.(*session)
+
+	var (
+		start = time.Now().Truncate(time.Hour)
+		end   = start.Add(time.Hour)
+	)
+	replicaDatapoints := [][]*rpc.Datapoint{
+		{
+			{Timestamp: start.UnixNano(), Value: 1},
+			{Timestamp: start.Add(time.Minute).UnixNano(), Value: 2},
+		},
+		{
+			{Timestamp: start.Add(time.Minute).UnixNano(), Value: 2},
+			{Timestamp: start.Add(2 * time.Minute).UnixNano(), Value: 3},
+		},
+	}
+	mockHostQueues(ctrl, session, sessionTestReplicas, []testEnqueueFn{
+		func(idx int, op op) {
+			fetch, ok := op.(*fetchTaggedOp)
+			assert.True(t, ok)
+			assert.True(t, fetch.request.FetchData)
+			assert.Equal(t, start.UnixNano(), fetch.request.RangeStart)
+			assert.Equal(t, end.UnixNano(), fetch.request.RangeEnd)
+			assert.Equal(t, int64(10), fetch.request.GetLimit())
+			assert.Equal(t, &rpc.IdxQuery{
+				Operator: rpc.BooleanOperator_AND_OPERATOR,
+				Filters: []*rpc.IdxTagFilter{
+					{TagName: "city", TagValueFilter: "nyc"},
+				},
+			}, fetch.request.Query)
+
+			if idx >= len(replicaDatapoints) {
+				fetch.completionFn(nil, errors.New("an error"))
+				return
+			}
+			fetch.completionFn(&rpc.FetchTaggedResult_{
+				Elements: []*rpc.FetchTaggedIDResult_{
+					{
+						ID:         "foo",
+						NameSpace:  "metrics",
+						Tags:       []*rpc.TagString{{Name: "city", Value: "nyc"}},
+						Datapoints: replicaDatapoints[idx],
+					},
+				},
+				Exhaustive: true,
+			}, nil)
+		},
+	})
+
+	assert.NoError(t, session.Open())
+
+	iters, exhaustive, err := s.FetchTagged(testFetchTaggedQuery(), index.QueryOptions{
+		StartInclusive: start,
+		EndExclusive:   end,
+		Limit:          10,
+	})
+	require.NoError(t, err)
+	assert.True(t, exhaustive)
+	require.Equal(t, 1, iters.Len())
+
+	iter := iters.Iters()[0]
+	assert.Equal(t, "foo", iter.ID().String())
+	assert.Equal(t, "metrics", iter.Namespace().String())
+
+	tags := iter.Tags()
+	require.True(t, tags.Next())
+	assert.Equal(t, "city", tags.Current().Name.String())
+	assert.Equal(t, "nyc", tags.Current().Value.String())
+	require.False(t, tags.Next())
+
+	var values []float64
+	for iter.Next() {
+		dp, _, _ := iter.Current()
+		values = append(values, dp.Value)
+	}
+	require.NoError(t, iter.Err())
+	assert.Equal(t, []float64{1, 2, 3}, values)
+	iters.Close()
+
+	assert.NoError(t, session.Close())
+}
+
+func TestFetchTaggedIDsConsistencyError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions()
+	s, err := newSession(opts)
+	assert.NoError(t, err)
+	session := s.(*session)
+
+	mockHostQueues(ctrl, session, sessionTestReplicas, []testEnqueueFn{
+		func(idx int, op op) {
+			fetch, ok := op.(*fetchTaggedOp)
+			assert.True(t, ok)
+			assert.False(t, fetch.request.FetchData)
+
+			if idx > 0 {
+				fetch.completionFn(nil, errors.New("an error"))
+				return
+			}
+			fetch.completionFn(&rpc.FetchTaggedResult_{
+				Elements: []*rpc.FetchTaggedIDResult_{
+					{ID: "foo", NameSpace: "metrics"},
+				},
+				Exhaustive: true,
+			}, nil)
+		},
+	})
+
+	assert.NoError(t, session.Open())
+
+	now := time.Now()
+	_, err = s.FetchTaggedIDs(testFetchTaggedQuery(), index.QueryOptions{
+		StartInclusive: now.Add(-time.Hour),
+		EndExclusive:   now,
+	})
+	require.Error(t, err)
+	assert.Equal(t, 2, NumError(err))
+
+	assert.NoError(t, session.Close())
+}
+
+func TestFetchTaggedIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions()
+	s, err := newSession(opts)
+	assert.NoError(t, err)
+	session := s.(*session)
+
+	mockHostQueues(ctrl, session, sessionTestReplicas, []testEnqueueFn{
+		func(idx int, op op) {
+			fetch, ok := op.(*fetchTaggedOp)
+			assert.True(t, ok)
+			fetch.completionFn(&rpc.FetchTaggedResult_{
+				Elements: []*rpc.FetchTaggedIDResult_{
+					{
+						ID:        "foo",
+						NameSpace: "metrics",
+						Tags:      []*rpc.TagString{{Name: "city", Value: "nyc"}},
+					},
+				},
+				Exhaustive: idx > 0,
+			}, nil)
+		},
+	})
+
+	assert.NoError(t, session.Open())
+
+	now := time.Now()
+	results, err := s.FetchTaggedIDs(testFetchTaggedQuery(), index.QueryOptions{
+		StartInclusive: now.Add(-time.Hour),
+		EndExclusive:   now,
+	})
+	require.NoError(t, err)
+	assert.False(t, results.Exhaustive)
+
+	iter := results.Iter
+	require.True(t, iter.Next())
+	nsID, id, tags := iter.Current()
+	assert.Equal(t, "metrics", nsID.String())
+	assert.Equal(t, "foo", id.String())
+	require.True(t, tags.Next())
+	assert.Equal(t, "city", tags.Current().Name.String())
+	require.False(t, iter.Next())
+	require.NoError(t, iter.Err())
+
+	assert.NoError(t, session.Close())
+}
+
+func TestFetchTaggedIDsTruncatesToLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions()
+	s, err := newSession(opts)
+	assert.NoError(t, err)
+	session := s.(*session)
+
+	mockHostQueues(ctrl, session, sessionTestReplicas, []testEnqueueFn{
+		func(idx int, op op) {
+			fetch, ok := op.(*fetchTaggedOp)
+			assert.True(t, ok)
+			assert.Equal(t, int64(2), fetch.request.GetLimit())
+
+			// Each host returns up to the limit but the hosts differ in
+			// the series matched so the merged result exceeds the limit
+			fetch.completionFn(&rpc.FetchTaggedResult_{
+				Elements: []*rpc.FetchTaggedIDResult_{
+					{ID: "foo", NameSpace: "metrics"},
+					{ID: fmt.Sprintf("bar%d", idx), NameSpace: "metrics"},
+				},
+				Exhaustive: true,
+			}, nil)
+		},
+	})
+
+	assert.NoError(t, session.Open())
+
+	now := time.Now()
+	results, err := s.FetchTaggedIDs(testFetchTaggedQuery(), index.QueryOptions{
+		StartInclusive: now.Add(-time.Hour),
+		EndExclusive:   now,
+		Limit:          2,
+	})
+	require.NoError(t, err)
+	assert.False(t, results.Exhaustive)
+
+	var ids []string
+	iter := results.Iter
+	for iter.Next() {
+		_, id, _ := iter.Current()
+		ids = append(ids, id.String())
+	}
+	require.NoError(t, iter.Err())
+	require.Equal(t, 2, len(ids))
+	assert.Equal(t, "foo", ids[0])
+
+	assert.NoError(t, session.Close())
+}
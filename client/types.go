@@ -34,6 +34,7 @@ import (
 	"github.com/m3db/m3db/storage/index"
 	"github.com/m3db/m3db/storage/namespace"
 	"github.com/m3db/m3db/topology"
+	"github.com/m3db/m3db/ts"
 	"github.com/m3db/m3x/context"
 	"github.com/m3db/m3x/ident"
 	"github.com/m3db/m3x/instrument"
@@ -42,6 +43,7 @@ import (
 	xtime "github.com/m3db/m3x/time"
 
 	tchannel "github.com/uber/tchannel-go"
+	"google.golang.org/grpc/credentials"
 )
 
 // unknown string constant, required to fix lint complaining about
@@ -181,6 +183,57 @@ func (l *ReadConsistencyLevel) UnmarshalYAML(unmarshal func(interface{}) error)
 		str, strings.Join(strs, ", "))
 }
 
+// Transport is the network transport used to communicate with nodes
+type Transport int
+
+const (
+	// TChannelTransport corresponds to communicating with nodes using TChannel Thrift
+	TChannelTransport Transport = iota
+
+	// GRPCTransport corresponds to communicating with nodes using gRPC, in which case
+	// host addresses in the topology must be the gRPC listen addresses of the nodes
+	GRPCTransport
+)
+
+// String returns the transport as a string
+func (t Transport) String() string {
+	switch t {
+	case TChannelTransport:
+		return "tchannel"
+	case GRPCTransport:
+		return "grpc"
+	}
+	return unknown
+}
+
+var validTransports = []Transport{
+	TChannelTransport,
+	GRPCTransport,
+}
+
+var errTransportUnspecified = errors.New("transport not specified")
+
+// UnmarshalYAML unmarshals a Transport into a valid type from string.
+func (t *Transport) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	if str == "" {
+		return errTransportUnspecified
+	}
+	strs := make([]string, 0, len(validTransports))
+	for _, valid := range validTransports {
+		if str == valid.String() {
+			*t = valid
+			return nil
+		}
+		strs = append(strs, "'"+valid.String()+"'")
+	}
+	return fmt.Errorf("invalid Transport '%s' valid types are: %s",
+		str, strings.Join(strs, ", "))
+}
+
 // Client can create sessions to write and read to a cluster
 type Client interface {
 	// Options returns the Client Options.
@@ -196,6 +249,15 @@ type Client interface {
 	DefaultSessionActive() bool
 }
 
+// FetchAggregatedOptions describes how fetched values are consolidated.
+type FetchAggregatedOptions struct {
+	// Step is the duration covered by each consolidated value.
+	Step time.Duration
+
+	// Aggregation is the function used to consolidate the values of a step.
+	Aggregation namespace.AggregationType
+}
+
 // Session can write and read to a cluster
 type Session interface {
 	// Write value to the database for an ID
@@ -207,9 +269,17 @@ type Session interface {
 	// Fetch values from the database for an ID
 	Fetch(namespace ident.ID, id ident.ID, startInclusive, endExclusive time.Time) (encoding.SeriesIterator, error)
 
+	// FetchAggregated values from the database for an ID, consolidated by the
+	// database nodes into a single value per step
+	FetchAggregated(namespace ident.ID, id ident.ID, startInclusive, endExclusive time.Time, opts FetchAggregatedOptions) ([]ts.Datapoint, error)
+
 	// FetchIDs values from the database for a set of IDs
 	FetchIDs(namespace ident.ID, ids ident.Iterator, startInclusive, endExclusive time.Time) (encoding.SeriesIterators, error)
 
+	// FetchIDsPaged values from the database for a set of IDs, requesting a
+	// bounded number of series blocks at a time as the results are iterated
+	FetchIDsPaged(namespace ident.ID, ids ident.Iterator, startInclusive, endExclusive time.Time) (SeriesPageIterator, error)
+
 	// FetchTagged resolves the provided query to known IDs, and fetches the data for them.
 	FetchTagged(index.Query, index.QueryOptions) (results encoding.SeriesIterators, exhaustive bool, err error)
 
@@ -225,6 +295,25 @@ type Session interface {
 	Close() error
 }
 
+// SeriesPageIterator iterates over series fetched a page at a time, a series
+// with more blocks than fit in a single page is returned once for each page
+// that it spans with each iterator covering only the blocks in that page
+type SeriesPageIterator interface {
+	// Next returns whether there are more series, fetching the next
+	// page if the current one has been exhausted
+	Next() bool
+
+	// Current returns the current series iterator, which remains
+	// valid until Next() or Close() is called
+	Current() encoding.SeriesIterator
+
+	// Err returns any error encountered
+	Err() error
+
+	// Close closes the iterator and any remaining series iterators
+	Close()
+}
+
 // AdminClient can create administration sessions
 type AdminClient interface {
 	Client
@@ -410,6 +499,15 @@ type Options interface {
 	// TopologyInitializer returns the TopologyInitializer
 	TopologyInitializer() topology.Initializer
 
+	// SetNamespaceInitializer sets the NamespaceInitializer used to resolve
+	// the retention of namespaces written to, this is optional and the
+	// default retention options are assumed when not set
+	SetNamespaceInitializer(value namespace.Initializer) Options
+
+	// NamespaceInitializer returns the NamespaceInitializer used to resolve
+	// the retention of namespaces written to
+	NamespaceInitializer() namespace.Initializer
+
 	// SetWriteConsistencyLevel sets the write consistency level
 	SetWriteConsistencyLevel(value topology.ConsistencyLevel) Options
 
@@ -428,6 +526,20 @@ type Options interface {
 	// ChannelOptions returns the channelOptions
 	ChannelOptions() *tchannel.ChannelOptions
 
+	// SetTransport sets the network transport used to communicate with nodes
+	SetTransport(value Transport) Options
+
+	// Transport returns the network transport used to communicate with nodes
+	Transport() Transport
+
+	// SetGRPCTransportCredentials sets the credentials used to secure gRPC
+	// connections, when nil connections are not encrypted
+	SetGRPCTransportCredentials(value credentials.TransportCredentials) Options
+
+	// GRPCTransportCredentials returns the credentials used to secure gRPC
+	// connections
+	GRPCTransportCredentials() credentials.TransportCredentials
+
 	// SetMaxConnectionCount sets the maxConnectionCount
 	SetMaxConnectionCount(value int) Options
 
@@ -554,6 +666,14 @@ type Options interface {
 	// FetchBatchSize returns the fetchBatchSize
 	FetchBatchSize() int
 
+	// SetFetchPagedBlockLimit sets the number of series blocks requested
+	// from each replica per page when fetching with FetchIDsPaged
+	SetFetchPagedBlockLimit(value int) Options
+
+	// FetchPagedBlockLimit returns the number of series blocks requested
+	// from each replica per page when fetching with FetchIDsPaged
+	FetchPagedBlockLimit() int
+
 	// SetWriteOpPoolSize sets the writeOperationPoolSize
 	SetWriteOpPoolSize(value int) Options
 
@@ -613,6 +733,55 @@ type Options interface {
 
 	// ReaderIteratorAllocate returns the readerIteratorAllocate
 	ReaderIteratorAllocate() encoding.ReaderIteratorAllocate
+
+	// SetHintedHandoffEnabled sets whether writes that fail against a replica
+	// are kept as hints on disk and replayed once the replica is healthy again,
+	// hints are dropped once the namespace would no longer accept the write
+	SetHintedHandoffEnabled(value bool) Options
+
+	// HintedHandoffEnabled returns whether writes that fail against a replica
+	// are kept as hints on disk and replayed once the replica is healthy again
+	HintedHandoffEnabled() bool
+
+	// SetHintedHandoffDirectory sets the directory hints are persisted to
+	SetHintedHandoffDirectory(value string) Options
+
+	// HintedHandoffDirectory returns the directory hints are persisted to
+	HintedHandoffDirectory() string
+
+	// SetHintedHandoffMaxBytesPerHost sets the max bytes of hints kept per host,
+	// hints for a host beyond this bound are dropped
+	SetHintedHandoffMaxBytesPerHost(value int64) Options
+
+	// HintedHandoffMaxBytesPerHost returns the max bytes of hints kept per host,
+	// hints for a host beyond this bound are dropped
+	HintedHandoffMaxBytesPerHost() int64
+
+	// SetHintedHandoffReplayInterval sets the interval at which hints are
+	// replayed to hosts that are healthy
+	SetHintedHandoffReplayInterval(value time.Duration) Options
+
+	// HintedHandoffReplayInterval returns the interval at which hints are
+	// replayed to hosts that are healthy
+	HintedHandoffReplayInterval() time.Duration
+
+	// SetReadRepairEnabled sets whether datapoints missing from replicas that
+	// lag behind the others on a fetch are written back to them, datapoints
+	// older than the buffer past are only written back to namespaces with
+	// cold writes enabled
+	SetReadRepairEnabled(value bool) Options
+
+	// ReadRepairEnabled returns whether datapoints missing from replicas that
+	// lag behind the others on a fetch are written back to them
+	ReadRepairEnabled() bool
+
+	// SetReadRepairSampleRate sets the rate between 0 and 1 of series
+	// fetched that are checked for read repair
+	SetReadRepairSampleRate(value float64) Options
+
+	// ReadRepairSampleRate returns the rate between 0 and 1 of series
+	// fetched that are checked for read repair
+	ReadRepairSampleRate() float64
 }
 
 // AdminOptions is a set of administration client options
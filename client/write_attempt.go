@@ -57,8 +57,8 @@ func (w *writeAttempt) perform() error {
 	err := w.session.writeAttempt(w.args.namespace, w.args.id,
 		w.args.t, w.args.value, w.args.unit, w.args.annotation)
 
-	if IsBadRequestError(err) {
-		// Do not retry bad request errors
+	if IsBadRequestError(err) || IsQuotaExceededError(err) {
+		// Do not retry bad request or quota exceeded errors
 		err = xerrors.NewNonRetryableError(err)
 	}
 
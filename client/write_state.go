@@ -57,6 +57,7 @@ type writeState struct {
 	errors            []error
 
 	queues []hostQueue
+	hints  *hintedHandoff
 	pool   *writeStatePool
 }
 
@@ -75,6 +76,7 @@ func (w *writeState) close() {
 
 	w.op, w.majority, w.pending, w.success = nil, 0, 0, 0
 	w.nsID, w.tsID = nil, nil
+	w.hints = nil
 
 	for i := range w.errors {
 		w.errors[i] = nil
@@ -103,6 +105,7 @@ func (w *writeState) completionFn(result interface{}, err error) {
 
 	if err != nil {
 		wErr = xerrors.NewRenamedError(err, fmt.Errorf("error writing to host %s: %v", hostID, err))
+		w.addHint(hostID, err)
 	} else if hostShardSet, ok := w.topoMap.LookupHostShardSet(hostID); !ok {
 		errStr := "missing host shard in writeState completionFn: %s"
 		wErr = xerrors.NewRetryableError(fmt.Errorf(errStr, hostID))
@@ -148,6 +151,16 @@ func (w *writeState) completionFn(result interface{}, err error) {
 	w.decRef()
 }
 
+func (w *writeState) addHint(hostID string, err error) {
+	if w.hints == nil || IsBadRequestError(err) || IsQuotaExceededError(err) {
+		// NB: Replaying writes the host rejected would only fail again
+		return
+	}
+	if op, ok := w.op.(*writeOperation); ok {
+		w.hints.add(hostID, op)
+	}
+}
+
 type writeStatePool struct {
 	pool             pool.ObjectPool
 	consistencyLevel topology.ConsistencyLevel
@@ -62,9 +62,10 @@ type StaticConfiguration struct {
 
 // StaticNamespaceConfiguration sets the static namespace
 type StaticNamespaceConfiguration struct {
-	Name      string                    `yaml:"name"`
-	Options   *StaticNamespaceOptions   `yaml:"options"`
-	Retention *StaticNamespaceRetention `yaml:"retention"`
+	Name      string                         `yaml:"name"`
+	Options   *StaticNamespaceOptions        `yaml:"options"`
+	Retention *StaticNamespaceRetention      `yaml:"retention"`
+	Rollup    *namespace.RollupConfiguration `yaml:"rollup"`
 }
 
 // StaticNamespaceOptions sets namespace options- if nil, default is used
@@ -74,6 +75,8 @@ type StaticNamespaceOptions struct {
 	WritesToCommitLog   bool `yaml:"writesToCommitLog"`
 	NeedsFilesetCleanup bool `yaml:"needsFilesetCleanup"`
 	NeedsRepair         bool `yaml:"needsRepair"`
+	ColdWritesEnabled   bool `yaml:"coldWritesEnabled"`
+	SnapshotEnabled     bool `yaml:"snapshotEnabled"`
 }
 
 // StaticNamespaceRetention sets the retention per namespace (required)
@@ -243,22 +246,26 @@ func newNamespaceMetadata(cfg StaticNamespaceConfiguration) (namespace.Metadata,
 			WritesToCommitLog:   true,
 		}
 	}
-	md, err := namespace.NewMetadata(
-		ident.StringID(cfg.Name),
-		namespace.NewOptions().
-			SetNeedsBootstrap(cfg.Options.NeedsBootstrap).
-			SetNeedsFilesetCleanup(cfg.Options.NeedsFilesetCleanup).
-			SetNeedsFlush(cfg.Options.NeedsFlush).
-			SetNeedsRepair(cfg.Options.NeedsRepair).
-			SetWritesToCommitLog(cfg.Options.WritesToCommitLog).
-			SetRetentionOptions(
-				retention.NewOptions().
-					SetBlockSize(cfg.Retention.BlockSize).
-					SetRetentionPeriod(cfg.Retention.RetentionPeriod).
-					SetBufferFuture(cfg.Retention.BufferFuture).
-					SetBufferPast(cfg.Retention.BufferPast).
-					SetBlockDataExpiry(cfg.Retention.BlockDataExpiry).
-					SetBlockDataExpiryAfterNotAccessedPeriod(cfg.Retention.BlockDataExpiryAfterNotAccessPeriod)))
+	opts := namespace.NewOptions().
+		SetNeedsBootstrap(cfg.Options.NeedsBootstrap).
+		SetNeedsFilesetCleanup(cfg.Options.NeedsFilesetCleanup).
+		SetNeedsFlush(cfg.Options.NeedsFlush).
+		SetNeedsRepair(cfg.Options.NeedsRepair).
+		SetWritesToCommitLog(cfg.Options.WritesToCommitLog).
+		SetColdWritesEnabled(cfg.Options.ColdWritesEnabled).
+		SetSnapshotEnabled(cfg.Options.SnapshotEnabled).
+		SetRetentionOptions(
+			retention.NewOptions().
+				SetBlockSize(cfg.Retention.BlockSize).
+				SetRetentionPeriod(cfg.Retention.RetentionPeriod).
+				SetBufferFuture(cfg.Retention.BufferFuture).
+				SetBufferPast(cfg.Retention.BufferPast).
+				SetBlockDataExpiry(cfg.Retention.BlockDataExpiry).
+				SetBlockDataExpiryAfterNotAccessedPeriod(cfg.Retention.BlockDataExpiryAfterNotAccessPeriod))
+	if cfg.Rollup != nil {
+		opts = opts.SetRollupOptions(cfg.Rollup.Options())
+	}
+	md, err := namespace.NewMetadata(ident.StringID(cfg.Name), opts)
 	if err != nil {
 		return nil, err
 	}
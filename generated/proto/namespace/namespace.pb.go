@@ -32,6 +32,8 @@ It has these top-level messages:
 	RetentionOptions
 	NamespaceOptions
 	Registry
+	RollupOptions
+	QuotaOptions
 */
 package namespace
 
@@ -71,6 +73,11 @@ type NamespaceOptions struct {
 	NeedsFilesetCleanup bool              `protobuf:"varint,4,opt,name=needsFilesetCleanup" json:"needsFilesetCleanup,omitempty"`
 	NeedsRepair         bool              `protobuf:"varint,5,opt,name=needsRepair" json:"needsRepair,omitempty"`
 	RetentionOptions    *RetentionOptions `protobuf:"bytes,6,opt,name=retentionOptions" json:"retentionOptions,omitempty"`
+	RepairFetchesBlocks bool              `protobuf:"varint,7,opt,name=repairFetchesBlocks" json:"repairFetchesBlocks,omitempty"`
+	ColdWritesEnabled   bool              `protobuf:"varint,8,opt,name=coldWritesEnabled" json:"coldWritesEnabled,omitempty"`
+	SnapshotEnabled     bool              `protobuf:"varint,9,opt,name=snapshotEnabled" json:"snapshotEnabled,omitempty"`
+	RollupOptions       *RollupOptions    `protobuf:"bytes,10,opt,name=rollupOptions" json:"rollupOptions,omitempty"`
+	QuotaOptions        *QuotaOptions     `protobuf:"bytes,11,opt,name=quotaOptions" json:"quotaOptions,omitempty"`
 }
 
 func (m *NamespaceOptions) Reset()                    { *m = NamespaceOptions{} }
@@ -85,6 +92,20 @@ func (m *NamespaceOptions) GetRetentionOptions() *RetentionOptions {
 	return nil
 }
 
+func (m *NamespaceOptions) GetRollupOptions() *RollupOptions {
+	if m != nil {
+		return m.RollupOptions
+	}
+	return nil
+}
+
+func (m *NamespaceOptions) GetQuotaOptions() *QuotaOptions {
+	if m != nil {
+		return m.QuotaOptions
+	}
+	return nil
+}
+
 type Registry struct {
 	Namespaces map[string]*NamespaceOptions `protobuf:"bytes,1,rep,name=namespaces" json:"namespaces,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
@@ -101,40 +122,76 @@ func (m *Registry) GetNamespaces() map[string]*NamespaceOptions {
 	return nil
 }
 
+type RollupOptions struct {
+	SourceNamespace string `protobuf:"bytes,1,opt,name=sourceNamespace" json:"sourceNamespace,omitempty"`
+	ResolutionNanos int64  `protobuf:"varint,2,opt,name=resolutionNanos" json:"resolutionNanos,omitempty"`
+	Aggregation     string `protobuf:"bytes,3,opt,name=aggregation" json:"aggregation,omitempty"`
+}
+
+func (m *RollupOptions) Reset()                    { *m = RollupOptions{} }
+func (m *RollupOptions) String() string            { return proto.CompactTextString(m) }
+func (*RollupOptions) ProtoMessage()               {}
+func (*RollupOptions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+type QuotaOptions struct {
+	WriteDatapointsPerSecond int64 `protobuf:"varint,1,opt,name=writeDatapointsPerSecond" json:"writeDatapointsPerSecond,omitempty"`
+	NewSeriesPerSecond       int64 `protobuf:"varint,2,opt,name=newSeriesPerSecond" json:"newSeriesPerSecond,omitempty"`
+	FetchedBytesPerSecond    int64 `protobuf:"varint,3,opt,name=fetchedBytesPerSecond" json:"fetchedBytesPerSecond,omitempty"`
+}
+
+func (m *QuotaOptions) Reset()                    { *m = QuotaOptions{} }
+func (m *QuotaOptions) String() string            { return proto.CompactTextString(m) }
+func (*QuotaOptions) ProtoMessage()               {}
+func (*QuotaOptions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
 func init() {
 	proto.RegisterType((*RetentionOptions)(nil), "namespace.RetentionOptions")
 	proto.RegisterType((*NamespaceOptions)(nil), "namespace.NamespaceOptions")
 	proto.RegisterType((*Registry)(nil), "namespace.Registry")
+	proto.RegisterType((*RollupOptions)(nil), "namespace.RollupOptions")
+	proto.RegisterType((*QuotaOptions)(nil), "namespace.QuotaOptions")
 }
 
 func init() { proto.RegisterFile("namespace.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6f, 0xda, 0x30,
-	0x14, 0xc7, 0x95, 0x64, 0xa0, 0xf0, 0x90, 0x20, 0xf3, 0x76, 0x40, 0x9b, 0x34, 0x21, 0x26, 0xa1,
-	0x1c, 0x26, 0xb4, 0xb1, 0xcb, 0xb4, 0x1b, 0x63, 0xb0, 0xcb, 0xc4, 0x90, 0xd7, 0x13, 0x37, 0x13,
-	0x1e, 0xd4, 0x22, 0xc4, 0x91, 0xed, 0xb4, 0x4d, 0xbf, 0x4f, 0x4f, 0xfd, 0x2a, 0xfd, 0x50, 0x55,
-	0x1c, 0xa0, 0xc1, 0x70, 0xe8, 0x05, 0xa1, 0xdf, 0xff, 0xff, 0xe2, 0xe4, 0x97, 0x17, 0x68, 0x27,
-	0x6c, 0x87, 0x2a, 0x65, 0x11, 0x0e, 0x52, 0x29, 0xb4, 0x20, 0x8d, 0x23, 0xe8, 0x3d, 0xb9, 0x10,
-	0x50, 0xd4, 0x98, 0x68, 0x2e, 0x92, 0x7f, 0x69, 0xf1, 0xab, 0xc8, 0x10, 0xde, 0xcb, 0x03, 0x9b,
-	0xa3, 0xe4, 0x62, 0x35, 0x63, 0x89, 0x50, 0x1d, 0xa7, 0xeb, 0x84, 0x1e, 0xbd, 0x98, 0x91, 0x3e,
-	0xb4, 0x96, 0xb1, 0x88, 0xb6, 0xff, 0xf9, 0x3d, 0x96, 0x6d, 0xd7, 0xb4, 0x2d, 0x4a, 0xbe, 0xc0,
-	0xdb, 0x65, 0xb6, 0x5e, 0xa3, 0x9c, 0x66, 0x3a, 0x93, 0xfb, 0xaa, 0x67, 0xaa, 0xe7, 0x01, 0x09,
-	0xa1, 0x5d, 0xc2, 0x39, 0x53, 0xba, 0xec, 0xbe, 0x31, 0x5d, 0x1b, 0x9b, 0x66, 0x71, 0xd2, 0x6f,
-	0xa6, 0xd9, 0xe4, 0x2e, 0xe5, 0x32, 0xef, 0xd4, 0xba, 0x4e, 0xe8, 0x53, 0x1b, 0x93, 0x05, 0x84,
-	0x16, 0x1a, 0xad, 0x35, 0xca, 0x99, 0xd0, 0xa3, 0x28, 0x42, 0xa5, 0xaa, 0x4f, 0x5c, 0x37, 0x87,
-	0xbd, 0xba, 0xdf, 0x7b, 0x70, 0x21, 0x98, 0x1d, 0xe4, 0x1e, 0x74, 0xf6, 0xa1, 0x95, 0x20, 0xae,
-	0xd4, 0x2f, 0x21, 0xb4, 0xd2, 0x92, 0xa5, 0x46, 0xa4, 0x4f, 0x2d, 0x4a, 0x3e, 0x01, 0x18, 0x32,
-	0x8d, 0x33, 0x75, 0x6d, 0xf4, 0xf9, 0xb4, 0x42, 0x0a, 0x75, 0xb7, 0x92, 0x6b, 0x54, 0x57, 0x62,
-	0x2c, 0x76, 0x3b, 0xae, 0xff, 0x8a, 0x8d, 0x51, 0xe7, 0xd3, 0xf3, 0x80, 0x7c, 0x85, 0x77, 0xe5,
-	0x2c, 0x8f, 0x51, 0xa1, 0x1e, 0xc7, 0xc8, 0x92, 0x2c, 0x35, 0xfa, 0x7c, 0x7a, 0x29, 0x22, 0x5d,
-	0x68, 0x1a, 0x4c, 0x31, 0x65, 0x5c, 0xee, 0xf5, 0x55, 0x11, 0xf9, 0x03, 0x81, 0xb4, 0x96, 0xc5,
-	0x28, 0x6a, 0x0e, 0x3f, 0x0e, 0x5e, 0x96, 0xcc, 0xde, 0x27, 0x7a, 0x36, 0xd4, 0x7b, 0x74, 0xc0,
-	0xa7, 0xb8, 0xe1, 0x4a, 0xcb, 0x9c, 0x8c, 0x01, 0x8e, 0xc3, 0xc5, 0x92, 0x79, 0x61, 0x73, 0xf8,
-	0xf9, 0xe4, 0x7a, 0x65, 0x71, 0x70, 0x34, 0xab, 0x26, 0x89, 0x96, 0x39, 0xad, 0x8c, 0x7d, 0x58,
-	0x40, 0xdb, 0x8a, 0x49, 0x00, 0xde, 0x16, 0x73, 0x23, 0xbb, 0x41, 0x8b, 0xbf, 0xe4, 0x1b, 0xd4,
-	0x6e, 0x58, 0x9c, 0xa1, 0x91, 0x7b, 0x7a, 0xd3, 0xf6, 0x5b, 0xa3, 0x65, 0xf3, 0xa7, 0xfb, 0xc3,
-	0x59, 0xd6, 0xcd, 0x67, 0xf3, 0xfd, 0x39, 0x00, 0x00, 0xff, 0xff, 0xac, 0x0c, 0x58, 0x12, 0x49,
-	0x03, 0x00, 0x00,
+	// 595 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0x8d, 0x54, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0x56, 0xda, 0x6d, 0xa4, 0x6f, 0x37, 0x5a, 0x0c, 0x88, 0x08, 0x24, 0x34, 0x15, 0x09, 0xf5,
+	0x80, 0x2a, 0x18, 0x1c, 0xd0, 0x90, 0x90, 0xd6, 0xb2, 0x72, 0x41, 0x65, 0xb8, 0x48, 0x48, 0xbb,
+	0xb9, 0xc9, 0xdb, 0x36, 0x5a, 0x1a, 0x07, 0xdb, 0x61, 0x94, 0x3b, 0xbf, 0x06, 0x0e, 0xfc, 0x10,
+	0x7e, 0x14, 0xb1, 0xd3, 0x14, 0xd7, 0x2d, 0x12, 0x97, 0x2a, 0x7d, 0x3e, 0x62, 0xbf, 0x4f, 0x1e,
+	0x1b, 0x5a, 0x29, 0x5b, 0xa0, 0xcc, 0x58, 0x88, 0xbd, 0x4c, 0x70, 0xc5, 0x49, 0x63, 0x0d, 0x74,
+	0x7e, 0xd7, 0xa0, 0x4d, 0x51, 0x61, 0xaa, 0x62, 0x9e, 0xbe, 0xcf, 0xf4, 0xaf, 0x24, 0x27, 0x70,
+	0x47, 0x54, 0xd8, 0x05, 0x8a, 0x98, 0x47, 0x23, 0x96, 0x72, 0x19, 0x78, 0xc7, 0x5e, 0xb7, 0x4e,
+	0x77, 0x72, 0xe4, 0x31, 0xdc, 0x9c, 0x24, 0x3c, 0xbc, 0x1a, 0xc7, 0xdf, 0xb0, 0x54, 0xd7, 0x8c,
+	0xda, 0x41, 0xc9, 0x13, 0xb8, 0x35, 0xc9, 0xa7, 0x53, 0x14, 0xc3, 0x5c, 0xe5, 0x62, 0x25, 0xad,
+	0x1b, 0xe9, 0x36, 0x41, 0xba, 0xd0, 0x2a, 0xc1, 0x0b, 0x26, 0x55, 0xa9, 0xdd, 0x33, 0x5a, 0x17,
+	0x36, 0x4a, 0xbd, 0xd2, 0x1b, 0xa6, 0xd8, 0xf9, 0xd7, 0x2c, 0x16, 0xcb, 0x60, 0xbf, 0x50, 0xfa,
+	0xd4, 0x85, 0xc9, 0x25, 0x74, 0x1d, 0xe8, 0x6c, 0xaa, 0x50, 0x8c, 0xb8, 0x3a, 0x0b, 0x43, 0x94,
+	0xd2, 0x9e, 0xf8, 0xc0, 0x2c, 0xf6, 0xdf, 0xfa, 0xce, 0xcf, 0x3d, 0x68, 0x8f, 0xaa, 0x70, 0xab,
+	0x38, 0x8b, 0x68, 0x52, 0xc4, 0x48, 0xf6, 0x39, 0x57, 0x52, 0x09, 0x96, 0x99, 0x20, 0x7d, 0xea,
+	0xa0, 0xe4, 0x21, 0x80, 0x41, 0x86, 0x49, 0x2e, 0xe7, 0x26, 0x3e, 0x9f, 0x5a, 0x88, 0x8e, 0xee,
+	0x5a, 0xc4, 0x0a, 0xe5, 0x47, 0x3e, 0xe0, 0x8b, 0x45, 0xac, 0xde, 0xf1, 0x99, 0x89, 0xce, 0xa7,
+	0xdb, 0x04, 0x79, 0x0a, 0xb7, 0x4b, 0x6f, 0x9c, 0xa0, 0x44, 0x35, 0x48, 0x90, 0xa5, 0x79, 0x66,
+	0xe2, 0xf3, 0xe9, 0x2e, 0x8a, 0x1c, 0x43, 0xd3, 0xc0, 0x14, 0x33, 0x16, 0x8b, 0x55, 0x7c, 0x36,
+	0x44, 0xde, 0x42, 0x5b, 0x38, 0x65, 0x31, 0x11, 0x35, 0x4f, 0x1e, 0xf4, 0xfe, 0x96, 0xcc, 0xed,
+	0x13, 0xdd, 0x32, 0xe9, 0xcd, 0x09, 0xf3, 0xca, 0x21, 0xaa, 0x70, 0x8e, 0xb2, 0xaf, 0x03, 0x96,
+	0xc1, 0x8d, 0x72, 0x73, 0x3b, 0x28, 0x3d, 0x7c, 0xc8, 0x93, 0xe8, 0x93, 0x99, 0xf3, 0x3c, 0x65,
+	0x93, 0x04, 0xa3, 0xc0, 0x2f, 0x87, 0xdf, 0x22, 0x74, 0x1b, 0x64, 0xca, 0x32, 0x39, 0xe7, 0xaa,
+	0xd2, 0x36, 0xca, 0x36, 0x38, 0x30, 0x79, 0x0d, 0x47, 0x82, 0x27, 0x49, 0x9e, 0x55, 0xf3, 0x80,
+	0x99, 0x27, 0xb0, 0xe7, 0xb1, 0x79, 0xba, 0x29, 0x27, 0xaf, 0xe0, 0xf0, 0x73, 0xce, 0x15, 0xab,
+	0xec, 0x4d, 0x63, 0xbf, 0x67, 0xd9, 0x3f, 0x58, 0x34, 0xdd, 0x10, 0x77, 0x7e, 0x78, 0xe0, 0x53,
+	0x9c, 0xc5, 0xc5, 0xf7, 0x5f, 0x92, 0x41, 0xf1, 0xf9, 0x2b, 0x93, 0x3e, 0x6b, 0xf5, 0xe2, 0x3d,
+	0x8f, 0x36, 0x62, 0x2d, 0x85, 0xbd, 0x75, 0xc1, 0x8a, 0x71, 0x8b, 0xff, 0xd4, 0xb2, 0xdd, 0xbf,
+	0x84, 0x96, 0x43, 0x93, 0x36, 0xd4, 0xaf, 0x70, 0x69, 0x3a, 0xd7, 0xa0, 0xfa, 0x91, 0x3c, 0x83,
+	0xfd, 0x2f, 0x2c, 0xc9, 0xd1, 0x74, 0x6c, 0xf3, 0xdb, 0xb9, 0xe5, 0xa5, 0xa5, 0xf2, 0xb4, 0xf6,
+	0xd2, 0xeb, 0x7c, 0xf7, 0xe0, 0x68, 0x23, 0x0b, 0x13, 0x33, 0xcf, 0x45, 0x88, 0x6b, 0xdb, 0x6a,
+	0x19, 0x17, 0xd6, 0x4a, 0x81, 0x92, 0x27, 0xb9, 0x36, 0xda, 0xf7, 0x83, 0x0b, 0xeb, 0x16, 0xb2,
+	0xd9, 0x4c, 0xe0, 0x8c, 0x69, 0xcc, 0xf4, 0xbb, 0x41, 0x6d, 0xa8, 0xf3, 0xcb, 0x83, 0x43, 0x3b,
+	0x54, 0x72, 0x0a, 0x81, 0xe9, 0xbf, 0x3e, 0xa1, 0x19, 0x8f, 0x53, 0xa5, 0xcf, 0xe4, 0x18, 0x43,
+	0x9e, 0x46, 0xab, 0x3b, 0xeb, 0x9f, 0x3c, 0xe9, 0x01, 0x49, 0xf1, 0x7a, 0x5c, 0x9c, 0x61, 0xb4,
+	0x5c, 0xe5, 0xde, 0x76, 0x30, 0xe4, 0x05, 0xdc, 0x9d, 0x9a, 0x62, 0x46, 0xfd, 0xa5, 0xb2, 0x2d,
+	0xe5, 0x1d, 0xb6, 0x9b, 0x9c, 0x1c, 0x98, 0x8b, 0xf7, 0xf9, 0x1f, 0xea, 0x7f, 0x8f, 0x10, 0x8b,
+	0x05, 0x00, 0x00,
 }
@@ -30,6 +30,7 @@ It is generated from these files:
 
 It has these top-level messages:
 	PageToken
+	FetchBatchRawPageToken
 */
 package pagetoken
 
@@ -91,29 +92,42 @@ func (m *PageToken_FlushedSeriesPhase) String() string            { return proto
 func (*PageToken_FlushedSeriesPhase) ProtoMessage()               {}
 func (*PageToken_FlushedSeriesPhase) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 1} }
 
+type FetchBatchRawPageToken struct {
+	IdIndex             int64 `protobuf:"varint,1,opt,name=idIndex" json:"idIndex,omitempty"`
+	BlockStartUnixNanos int64 `protobuf:"varint,2,opt,name=blockStartUnixNanos" json:"blockStartUnixNanos,omitempty"`
+}
+
+func (m *FetchBatchRawPageToken) Reset()                    { *m = FetchBatchRawPageToken{} }
+func (m *FetchBatchRawPageToken) String() string            { return proto.CompactTextString(m) }
+func (*FetchBatchRawPageToken) ProtoMessage()               {}
+func (*FetchBatchRawPageToken) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
 func init() {
 	proto.RegisterType((*PageToken)(nil), "pagetoken.PageToken")
 	proto.RegisterType((*PageToken_ActiveSeriesPhase)(nil), "pagetoken.PageToken.ActiveSeriesPhase")
 	proto.RegisterType((*PageToken_FlushedSeriesPhase)(nil), "pagetoken.PageToken.FlushedSeriesPhase")
+	proto.RegisterType((*FetchBatchRawPageToken)(nil), "pagetoken.FetchBatchRawPageToken")
 }
 
 func init() { proto.RegisterFile("pagetoken.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 228 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2f, 0x48, 0x4c, 0x4f,
+	// 264 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0xe3, 0xe2, 0x2f, 0x48, 0x4c, 0x4f,
 	0x2d, 0xc9, 0xcf, 0x4e, 0xcd, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x84, 0x0b, 0x28,
-	0x7d, 0x66, 0xe2, 0xe2, 0x0c, 0x48, 0x4c, 0x4f, 0x0d, 0x01, 0xf1, 0x84, 0xc2, 0xb8, 0x84, 0x13,
-	0x93, 0x4b, 0x32, 0xcb, 0x52, 0xe3, 0x8b, 0x53, 0x8b, 0x32, 0x53, 0x8b, 0xe3, 0x0b, 0x32, 0x12,
-	0x8b, 0x53, 0x25, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0xd4, 0xf4, 0x10, 0xe6, 0xc0, 0xb5, 0xe8,
-	0x39, 0x82, 0xd5, 0x07, 0x83, 0x95, 0x07, 0x80, 0x54, 0x07, 0x09, 0x26, 0xa2, 0x0b, 0x09, 0x45,
-	0x72, 0x89, 0xa4, 0xe5, 0x94, 0x16, 0x67, 0xa4, 0xa6, 0xa0, 0x1a, 0xcc, 0x04, 0x36, 0x58, 0x1d,
-	0xab, 0xc1, 0x6e, 0x10, 0x0d, 0xc8, 0x26, 0x0b, 0xa5, 0x61, 0x88, 0x49, 0x99, 0x72, 0x09, 0x62,
-	0x38, 0x41, 0x48, 0x81, 0x8b, 0x3b, 0x33, 0x2f, 0x25, 0xb5, 0xc2, 0xb9, 0xb4, 0xa8, 0x38, 0xbf,
-	0x08, 0xec, 0x7e, 0xe6, 0x20, 0x64, 0x21, 0xa9, 0x1a, 0x2e, 0x21, 0x4c, 0x0b, 0x84, 0x2c, 0xb8,
-	0xc4, 0x93, 0x4b, 0x8b, 0x8a, 0x9c, 0x72, 0xf2, 0x93, 0xb3, 0x83, 0x4b, 0x12, 0x8b, 0x4a, 0x42,
-	0xf3, 0x32, 0x2b, 0xfc, 0x12, 0xf3, 0xf2, 0x8b, 0xa1, 0x66, 0xe0, 0x92, 0x16, 0xd2, 0xe1, 0x12,
-	0x84, 0x4b, 0xb9, 0xe6, 0x95, 0x14, 0x55, 0x7a, 0xa6, 0x54, 0x80, 0xbd, 0xc7, 0x1c, 0x84, 0x29,
-	0x91, 0xc4, 0x06, 0x8e, 0x07, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe4, 0xf3, 0x20, 0xd9,
-	0x9a, 0x01, 0x00, 0x00,
+	0x7d, 0x66, 0xe2, 0xe2, 0x0c, 0x00, 0xf2, 0x42, 0x40, 0x3c, 0xa1, 0x30, 0x2e, 0xe1, 0xc4, 0xe4,
+	0x92, 0xcc, 0xb2, 0xd4, 0xf8, 0xe2, 0xd4, 0xa2, 0xcc, 0xd4, 0xe2, 0xf8, 0x82, 0x8c, 0xc4, 0xe2,
+	0x54, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x35, 0x3d, 0x84, 0x39, 0x70, 0x2d, 0x7a, 0x8e,
+	0x60, 0xf5, 0xc1, 0x60, 0xe5, 0x01, 0x20, 0xd5, 0x41, 0x82, 0x89, 0xe8, 0x42, 0x42, 0x91, 0x5c,
+	0x22, 0x69, 0x39, 0xa5, 0xc5, 0x19, 0xa9, 0x29, 0xa8, 0x06, 0x33, 0x81, 0x0d, 0x56, 0xc7, 0x6a,
+	0xb0, 0x1b, 0x44, 0x03, 0xb2, 0xc9, 0x42, 0x69, 0x18, 0x62, 0x52, 0xa6, 0x5c, 0x82, 0x18, 0x4e,
+	0x10, 0x52, 0xe0, 0xe2, 0xce, 0xcc, 0x4b, 0x49, 0xad, 0x70, 0x2e, 0x2d, 0x2a, 0xce, 0x2f, 0x02,
+	0xbb, 0x9f, 0x39, 0x08, 0x59, 0x48, 0xaa, 0x86, 0x4b, 0x08, 0xd3, 0x02, 0x21, 0x0b, 0x2e, 0xf1,
+	0xe4, 0xd2, 0xa2, 0x22, 0xa7, 0x9c, 0xfc, 0xe4, 0xec, 0xe0, 0x92, 0xc4, 0xa2, 0x92, 0xd0, 0xbc,
+	0xcc, 0x0a, 0xbf, 0xc4, 0xbc, 0xfc, 0x62, 0xa8, 0x19, 0xb8, 0xa4, 0x85, 0x74, 0xb8, 0x04, 0xe1,
+	0x52, 0xae, 0x79, 0x25, 0x45, 0x95, 0x9e, 0x29, 0x15, 0x60, 0xef, 0x31, 0x07, 0x61, 0x4a, 0x28,
+	0xa5, 0x70, 0x89, 0xb9, 0xa5, 0x96, 0x24, 0x67, 0x38, 0x25, 0x02, 0x89, 0xa0, 0xc4, 0x72, 0x44,
+	0x0c, 0x48, 0x70, 0xb1, 0x67, 0xa6, 0x78, 0x82, 0x1c, 0x0a, 0xb5, 0x11, 0xc6, 0x15, 0x32, 0xe0,
+	0x12, 0x4e, 0xc2, 0xe2, 0x2e, 0x88, 0x1d, 0xd8, 0xa4, 0x92, 0xd8, 0xc0, 0xb1, 0x6d, 0x0c, 0x00,
+	0x1f, 0x02, 0x96, 0x47, 0x00, 0x02, 0x00, 0x00,
 }
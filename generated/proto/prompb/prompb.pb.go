@@ -0,0 +1,264 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-go.
+// source: prompb.proto
+// DO NOT EDIT!
+
+/*
+Package prompb is a generated protocol buffer package.
+
+It is generated from these files:
+	prompb.proto
+
+It has these top-level messages:
+	WriteRequest
+	ReadRequest
+	ReadResponse
+	Query
+	QueryResult
+	Sample
+	TimeSeries
+	Label
+	LabelMatcher
+*/
+package prompb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type LabelMatcher_Type int32
+
+const (
+	LabelMatcher_EQ  LabelMatcher_Type = 0
+	LabelMatcher_NEQ LabelMatcher_Type = 1
+	LabelMatcher_RE  LabelMatcher_Type = 2
+	LabelMatcher_NRE LabelMatcher_Type = 3
+)
+
+var LabelMatcher_Type_name = map[int32]string{
+	0: "EQ",
+	1: "NEQ",
+	2: "RE",
+	3: "NRE",
+}
+var LabelMatcher_Type_value = map[string]int32{
+	"EQ":  0,
+	"NEQ": 1,
+	"RE":  2,
+	"NRE": 3,
+}
+
+func (x LabelMatcher_Type) String() string {
+	return proto.EnumName(LabelMatcher_Type_name, int32(x))
+}
+func (LabelMatcher_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{8, 0} }
+
+type WriteRequest struct {
+	Timeseries []*TimeSeries `protobuf:"bytes,1,rep,name=timeseries" json:"timeseries,omitempty"`
+}
+
+func (m *WriteRequest) Reset()                    { *m = WriteRequest{} }
+func (m *WriteRequest) String() string            { return proto.CompactTextString(m) }
+func (*WriteRequest) ProtoMessage()               {}
+func (*WriteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *WriteRequest) GetTimeseries() []*TimeSeries {
+	if m != nil {
+		return m.Timeseries
+	}
+	return nil
+}
+
+type ReadRequest struct {
+	Queries []*Query `protobuf:"bytes,1,rep,name=queries" json:"queries,omitempty"`
+}
+
+func (m *ReadRequest) Reset()                    { *m = ReadRequest{} }
+func (m *ReadRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReadRequest) ProtoMessage()               {}
+func (*ReadRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *ReadRequest) GetQueries() []*Query {
+	if m != nil {
+		return m.Queries
+	}
+	return nil
+}
+
+type ReadResponse struct {
+	Results []*QueryResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+}
+
+func (m *ReadResponse) Reset()                    { *m = ReadResponse{} }
+func (m *ReadResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReadResponse) ProtoMessage()               {}
+func (*ReadResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *ReadResponse) GetResults() []*QueryResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type Query struct {
+	StartTimestampMs int64           `protobuf:"varint,1,opt,name=start_timestamp_ms,json=startTimestampMs" json:"start_timestamp_ms,omitempty"`
+	EndTimestampMs   int64           `protobuf:"varint,2,opt,name=end_timestamp_ms,json=endTimestampMs" json:"end_timestamp_ms,omitempty"`
+	Matchers         []*LabelMatcher `protobuf:"bytes,3,rep,name=matchers" json:"matchers,omitempty"`
+}
+
+func (m *Query) Reset()                    { *m = Query{} }
+func (m *Query) String() string            { return proto.CompactTextString(m) }
+func (*Query) ProtoMessage()               {}
+func (*Query) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *Query) GetMatchers() []*LabelMatcher {
+	if m != nil {
+		return m.Matchers
+	}
+	return nil
+}
+
+type QueryResult struct {
+	Timeseries []*TimeSeries `protobuf:"bytes,1,rep,name=timeseries" json:"timeseries,omitempty"`
+}
+
+func (m *QueryResult) Reset()                    { *m = QueryResult{} }
+func (m *QueryResult) String() string            { return proto.CompactTextString(m) }
+func (*QueryResult) ProtoMessage()               {}
+func (*QueryResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *QueryResult) GetTimeseries() []*TimeSeries {
+	if m != nil {
+		return m.Timeseries
+	}
+	return nil
+}
+
+type Sample struct {
+	Value     float64 `protobuf:"fixed64,1,opt,name=value" json:"value,omitempty"`
+	Timestamp int64   `protobuf:"varint,2,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *Sample) Reset()                    { *m = Sample{} }
+func (m *Sample) String() string            { return proto.CompactTextString(m) }
+func (*Sample) ProtoMessage()               {}
+func (*Sample) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+type TimeSeries struct {
+	Labels  []*Label  `protobuf:"bytes,1,rep,name=labels" json:"labels,omitempty"`
+	Samples []*Sample `protobuf:"bytes,2,rep,name=samples" json:"samples,omitempty"`
+}
+
+func (m *TimeSeries) Reset()                    { *m = TimeSeries{} }
+func (m *TimeSeries) String() string            { return proto.CompactTextString(m) }
+func (*TimeSeries) ProtoMessage()               {}
+func (*TimeSeries) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *TimeSeries) GetLabels() []*Label {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *TimeSeries) GetSamples() []*Sample {
+	if m != nil {
+		return m.Samples
+	}
+	return nil
+}
+
+type Label struct {
+	Name  string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *Label) Reset()                    { *m = Label{} }
+func (m *Label) String() string            { return proto.CompactTextString(m) }
+func (*Label) ProtoMessage()               {}
+func (*Label) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+type LabelMatcher struct {
+	Type  LabelMatcher_Type `protobuf:"varint,1,opt,name=type,enum=prompb.LabelMatcher_Type" json:"type,omitempty"`
+	Name  string            `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Value string            `protobuf:"bytes,3,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *LabelMatcher) Reset()                    { *m = LabelMatcher{} }
+func (m *LabelMatcher) String() string            { return proto.CompactTextString(m) }
+func (*LabelMatcher) ProtoMessage()               {}
+func (*LabelMatcher) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func init() {
+	proto.RegisterType((*WriteRequest)(nil), "prompb.WriteRequest")
+	proto.RegisterType((*ReadRequest)(nil), "prompb.ReadRequest")
+	proto.RegisterType((*ReadResponse)(nil), "prompb.ReadResponse")
+	proto.RegisterType((*Query)(nil), "prompb.Query")
+	proto.RegisterType((*QueryResult)(nil), "prompb.QueryResult")
+	proto.RegisterType((*Sample)(nil), "prompb.Sample")
+	proto.RegisterType((*TimeSeries)(nil), "prompb.TimeSeries")
+	proto.RegisterType((*Label)(nil), "prompb.Label")
+	proto.RegisterType((*LabelMatcher)(nil), "prompb.LabelMatcher")
+	proto.RegisterEnum("prompb.LabelMatcher_Type", LabelMatcher_Type_name, LabelMatcher_Type_value)
+}
+
+func init() { proto.RegisterFile("prompb.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0x9d, 0x53, 0xc1, 0x4a, 0xc3, 0x40,
+	0x10, 0x35, 0x49, 0x9b, 0xd8, 0x69, 0x2c, 0x61, 0xed, 0x41, 0xc1, 0x83, 0x2c, 0x88, 0x39, 0x68,
+	0xd1, 0x0a, 0x9e, 0xf4, 0xa0, 0xd0, 0x9b, 0x15, 0xba, 0x2d, 0x78, 0x92, 0xb2, 0xb5, 0x0b, 0x16,
+	0x92, 0x36, 0x66, 0xb7, 0x42, 0x3f, 0xc3, 0x3f, 0x76, 0x77, 0xb6, 0xdb, 0xa6, 0xd8, 0x93, 0xa7,
+	0xcc, 0xcc, 0x7b, 0x6f, 0xe6, 0xed, 0x0c, 0x81, 0xb8, 0x28, 0x17, 0x79, 0x31, 0xe9, 0xe8, 0x8f,
+	0x5a, 0x90, 0xd0, 0x66, 0xf4, 0x19, 0xe2, 0xb7, 0x72, 0xa6, 0x04, 0x13, 0x5f, 0x4b, 0x21, 0x15,
+	0xe9, 0x02, 0xa8, 0x59, 0x2e, 0xa4, 0x28, 0x67, 0x42, 0x9e, 0x78, 0xe7, 0x41, 0xda, 0xec, 0x92,
+	0xce, 0x5a, 0x3a, 0xd2, 0xc8, 0x10, 0x11, 0x56, 0x61, 0xd1, 0x7b, 0x68, 0x32, 0xc1, 0xa7, 0xae,
+	0xc5, 0x25, 0x44, 0x3a, 0xa8, 0xe8, 0x8f, 0x9c, 0x7e, 0xa0, 0xcb, 0x2b, 0xe6, 0x50, 0xfa, 0x08,
+	0xb1, 0xd5, 0xc9, 0x62, 0x31, 0x97, 0x82, 0x5c, 0x43, 0x54, 0x0a, 0xb9, 0xcc, 0x94, 0x13, 0x1e,
+	0xef, 0x0a, 0x11, 0x63, 0x8e, 0x43, 0x7f, 0x3c, 0xa8, 0x23, 0x40, 0xae, 0x80, 0x48, 0xc5, 0x4b,
+	0x35, 0x46, 0x53, 0x8a, 0xe7, 0xc5, 0x38, 0x37, 0x3d, 0xbc, 0x34, 0x60, 0x09, 0x22, 0x23, 0x07,
+	0xf4, 0x25, 0x49, 0x21, 0x11, 0xf3, 0xe9, 0x2e, 0xd7, 0x47, 0x6e, 0x4b, 0xd7, 0xab, 0xcc, 0x1b,
+	0x38, 0xcc, 0xb9, 0xfa, 0xf8, 0x14, 0xa5, 0x3c, 0x09, 0xd0, 0x51, 0xdb, 0x39, 0x7a, 0xe1, 0x13,
+	0x91, 0xf5, 0x2d, 0xc8, 0x36, 0x2c, 0xfa, 0x04, 0xcd, 0x8a, 0xd7, 0x7f, 0x6d, 0xf3, 0x01, 0xc2,
+	0xa1, 0x1e, 0x9f, 0x09, 0xd2, 0x86, 0xfa, 0x37, 0xcf, 0x96, 0x02, 0x5f, 0xe2, 0x31, 0x9b, 0x90,
+	0x33, 0x68, 0x6c, 0xac, 0xaf, 0x7d, 0x6f, 0x0b, 0xf4, 0x1d, 0x60, 0xdb, 0x97, 0x5c, 0x40, 0x98,
+	0x19, 0xa3, 0x7f, 0x2e, 0x81, 0xf6, 0xd9, 0x1a, 0xd4, 0x1b, 0x89, 0x24, 0x8e, 0x34, 0x8b, 0x30,
+	0xbc, 0x96, 0xe3, 0x59, 0x27, 0xcc, 0xc1, 0xf4, 0x16, 0xea, 0x28, 0x25, 0x04, 0x6a, 0x73, 0x9e,
+	0x5b, 0x6b, 0x0d, 0x86, 0xf1, 0xd6, 0xaf, 0x8f, 0x45, 0x9b, 0x98, 0x33, 0xc5, 0xd5, 0x6d, 0xe9,
+	0x33, 0xd7, 0xd4, 0xaa, 0xb0, 0xd2, 0x56, 0xf7, 0x74, 0xdf, 0x46, 0x3b, 0x23, 0x4d, 0x60, 0x48,
+	0xdb, 0x4c, 0xf2, 0xf7, 0x4d, 0x0a, 0xaa, 0x93, 0x52, 0xa8, 0x19, 0x1d, 0x09, 0xc1, 0xef, 0x0d,
+	0x92, 0x03, 0x12, 0x41, 0xf0, 0xaa, 0x03, 0xcf, 0x14, 0x58, 0x2f, 0xf1, 0xb1, 0xa0, 0x83, 0x60,
+	0x12, 0xe2, 0x4f, 0x70, 0xf7, 0x0b, 0x0f, 0x71, 0x96, 0xba, 0x14, 0x03, 0x00, 0x00,
+}
@@ -0,0 +1,2580 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-go.
+// source: rpc.proto
+// DO NOT EDIT!
+
+/*
+Package rpcpb is a generated protocol buffer package.
+
+It is generated from these files:
+	rpc.proto
+
+It has these top-level messages:
+	Error
+	WriteBatchRawErrors
+	FetchRequest
+	FetchResult
+	Datapoint
+	WriteRequest
+	WriteTaggedRequest
+	FetchBatchRawRequest
+	FetchBatchRawResult
+	FetchRawResult
+	FetchBatchRawPagedRequest
+	FetchBatchRawPagedResult
+	FetchRawPagedResult
+	Segments
+	Segment
+	FetchTaggedRequest
+	IdxQuery
+	IdxTagFilter
+	FetchTaggedResult
+	FetchTaggedIDResult
+	FetchBlocksRawRequest
+	FetchBlocksRawRequestElement
+	FetchBlocksRawResult
+	Blocks
+	Block
+	TagString
+	TagRaw
+	FetchBlocksMetadataRawRequest
+	FetchBlocksMetadataRawResult
+	BlocksMetadata
+	BlockMetadata
+	FetchBlocksMetadataRawV2Request
+	FetchBlocksMetadataRawV2Result
+	BlockMetadataV2
+	WriteBatchRawRequest
+	WriteBatchRawRequestElement
+	WriteTaggedBatchRawRequest
+	WriteTaggedBatchRawRequestElement
+	WriteBatchRawError
+	TruncateRequest
+	TruncateResult
+	NodeHealthResult
+	NodePersistRateLimitResult
+	NodeSetPersistRateLimitRequest
+	NodeWriteNewSeriesAsyncResult
+	NodeSetWriteNewSeriesAsyncRequest
+	NodeWriteNewSeriesBackoffDurationResult
+	NodeSetWriteNewSeriesBackoffDurationRequest
+	NodeWriteNewSeriesLimitPerShardPerSecondResult
+	NodeSetWriteNewSeriesLimitPerShardPerSecondRequest
+	NodeBootstrapStatusResult
+	NamespaceBootstrapStatus
+	ShardBootstrapStatus
+	HealthResult
+	Int64Value
+	BoolValue
+	DoubleValue
+	Empty
+*/
+package rpcpb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type TimeType int32
+
+const (
+	TimeType_UNIX_SECONDS      TimeType = 0
+	TimeType_UNIX_MICROSECONDS TimeType = 1
+	TimeType_UNIX_MILLISECONDS TimeType = 2
+	TimeType_UNIX_NANOSECONDS  TimeType = 3
+)
+
+var TimeType_name = map[int32]string{
+	0: "UNIX_SECONDS",
+	1: "UNIX_MICROSECONDS",
+	2: "UNIX_MILLISECONDS",
+	3: "UNIX_NANOSECONDS",
+}
+var TimeType_value = map[string]int32{
+	"UNIX_SECONDS":      0,
+	"UNIX_MICROSECONDS": 1,
+	"UNIX_MILLISECONDS": 2,
+	"UNIX_NANOSECONDS":  3,
+}
+
+func (x TimeType) String() string {
+	return proto.EnumName(TimeType_name, int32(x))
+}
+func (TimeType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type ErrorType int32
+
+const (
+	ErrorType_INTERNAL_ERROR ErrorType = 0
+	ErrorType_BAD_REQUEST    ErrorType = 1
+	ErrorType_QUOTA_EXCEEDED ErrorType = 2
+)
+
+var ErrorType_name = map[int32]string{
+	0: "INTERNAL_ERROR",
+	1: "BAD_REQUEST",
+	2: "QUOTA_EXCEEDED",
+}
+var ErrorType_value = map[string]int32{
+	"INTERNAL_ERROR": 0,
+	"BAD_REQUEST":    1,
+	"QUOTA_EXCEEDED": 2,
+}
+
+func (x ErrorType) String() string {
+	return proto.EnumName(ErrorType_name, int32(x))
+}
+func (ErrorType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+type AggregationType int32
+
+const (
+	AggregationType_MEAN  AggregationType = 0
+	AggregationType_MIN   AggregationType = 1
+	AggregationType_MAX   AggregationType = 2
+	AggregationType_SUM   AggregationType = 3
+	AggregationType_COUNT AggregationType = 4
+	AggregationType_LAST  AggregationType = 5
+)
+
+var AggregationType_name = map[int32]string{
+	0: "MEAN",
+	1: "MIN",
+	2: "MAX",
+	3: "SUM",
+	4: "COUNT",
+	5: "LAST",
+}
+var AggregationType_value = map[string]int32{
+	"MEAN":  0,
+	"MIN":   1,
+	"MAX":   2,
+	"SUM":   3,
+	"COUNT": 4,
+	"LAST":  5,
+}
+
+func (x AggregationType) String() string {
+	return proto.EnumName(AggregationType_name, int32(x))
+}
+func (AggregationType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+type BooleanOperator int32
+
+const (
+	BooleanOperator_AND_OPERATOR BooleanOperator = 0
+)
+
+var BooleanOperator_name = map[int32]string{
+	0: "AND_OPERATOR",
+}
+var BooleanOperator_value = map[string]int32{
+	"AND_OPERATOR": 0,
+}
+
+func (x BooleanOperator) String() string {
+	return proto.EnumName(BooleanOperator_name, int32(x))
+}
+func (BooleanOperator) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+type ShardBootstrapState int32
+
+const (
+	ShardBootstrapState_PENDING       ShardBootstrapState = 0
+	ShardBootstrapState_BOOTSTRAPPING ShardBootstrapState = 1
+	ShardBootstrapState_BOOTSTRAPPED  ShardBootstrapState = 2
+	ShardBootstrapState_FAILED        ShardBootstrapState = 3
+)
+
+var ShardBootstrapState_name = map[int32]string{
+	0: "PENDING",
+	1: "BOOTSTRAPPING",
+	2: "BOOTSTRAPPED",
+	3: "FAILED",
+}
+var ShardBootstrapState_value = map[string]int32{
+	"PENDING":       0,
+	"BOOTSTRAPPING": 1,
+	"BOOTSTRAPPED":  2,
+	"FAILED":        3,
+}
+
+func (x ShardBootstrapState) String() string {
+	return proto.EnumName(ShardBootstrapState_name, int32(x))
+}
+func (ShardBootstrapState) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+type Error struct {
+	Type    ErrorType `protobuf:"varint,1,opt,name=type,enum=rpcpb.ErrorType" json:"type,omitempty"`
+	Message string    `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *Error) Reset()                    { *m = Error{} }
+func (m *Error) String() string            { return proto.CompactTextString(m) }
+func (*Error) ProtoMessage()               {}
+func (*Error) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type WriteBatchRawErrors struct {
+	Errors []*WriteBatchRawError `protobuf:"bytes,1,rep,name=errors" json:"errors,omitempty"`
+}
+
+func (m *WriteBatchRawErrors) Reset()                    { *m = WriteBatchRawErrors{} }
+func (m *WriteBatchRawErrors) String() string            { return proto.CompactTextString(m) }
+func (*WriteBatchRawErrors) ProtoMessage()               {}
+func (*WriteBatchRawErrors) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *WriteBatchRawErrors) GetErrors() []*WriteBatchRawError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type FetchRequest struct {
+	RangeStart     int64           `protobuf:"varint,1,opt,name=rangeStart" json:"rangeStart,omitempty"`
+	RangeEnd       int64           `protobuf:"varint,2,opt,name=rangeEnd" json:"rangeEnd,omitempty"`
+	NameSpace      string          `protobuf:"bytes,3,opt,name=nameSpace" json:"nameSpace,omitempty"`
+	Id             string          `protobuf:"bytes,4,opt,name=id" json:"id,omitempty"`
+	RangeType      TimeType        `protobuf:"varint,5,opt,name=rangeType,enum=rpcpb.TimeType" json:"rangeType,omitempty"`
+	ResultTimeType TimeType        `protobuf:"varint,6,opt,name=resultTimeType,enum=rpcpb.TimeType" json:"resultTimeType,omitempty"`
+	Step           *Int64Value     `protobuf:"bytes,7,opt,name=step" json:"step,omitempty"`
+	Aggregation    AggregationType `protobuf:"varint,8,opt,name=aggregation,enum=rpcpb.AggregationType" json:"aggregation,omitempty"`
+}
+
+func (m *FetchRequest) Reset()                    { *m = FetchRequest{} }
+func (m *FetchRequest) String() string            { return proto.CompactTextString(m) }
+func (*FetchRequest) ProtoMessage()               {}
+func (*FetchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *FetchRequest) GetStep() *Int64Value {
+	if m != nil {
+		return m.Step
+	}
+	return nil
+}
+
+type FetchResult struct {
+	Datapoints []*Datapoint `protobuf:"bytes,1,rep,name=datapoints" json:"datapoints,omitempty"`
+}
+
+func (m *FetchResult) Reset()                    { *m = FetchResult{} }
+func (m *FetchResult) String() string            { return proto.CompactTextString(m) }
+func (*FetchResult) ProtoMessage()               {}
+func (*FetchResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *FetchResult) GetDatapoints() []*Datapoint {
+	if m != nil {
+		return m.Datapoints
+	}
+	return nil
+}
+
+type Datapoint struct {
+	Timestamp         int64    `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	Value             float64  `protobuf:"fixed64,2,opt,name=value" json:"value,omitempty"`
+	Annotation        []byte   `protobuf:"bytes,3,opt,name=annotation,proto3" json:"annotation,omitempty"`
+	TimestampTimeType TimeType `protobuf:"varint,4,opt,name=timestampTimeType,enum=rpcpb.TimeType" json:"timestampTimeType,omitempty"`
+}
+
+func (m *Datapoint) Reset()                    { *m = Datapoint{} }
+func (m *Datapoint) String() string            { return proto.CompactTextString(m) }
+func (*Datapoint) ProtoMessage()               {}
+func (*Datapoint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+type WriteRequest struct {
+	NameSpace string     `protobuf:"bytes,1,opt,name=nameSpace" json:"nameSpace,omitempty"`
+	Id        string     `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+	Datapoint *Datapoint `protobuf:"bytes,3,opt,name=datapoint" json:"datapoint,omitempty"`
+}
+
+func (m *WriteRequest) Reset()                    { *m = WriteRequest{} }
+func (m *WriteRequest) String() string            { return proto.CompactTextString(m) }
+func (*WriteRequest) ProtoMessage()               {}
+func (*WriteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *WriteRequest) GetDatapoint() *Datapoint {
+	if m != nil {
+		return m.Datapoint
+	}
+	return nil
+}
+
+type WriteTaggedRequest struct {
+	NameSpace string       `protobuf:"bytes,1,opt,name=nameSpace" json:"nameSpace,omitempty"`
+	Id        string       `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+	Tags      []*TagString `protobuf:"bytes,3,rep,name=tags" json:"tags,omitempty"`
+	Datapoint *Datapoint   `protobuf:"bytes,4,opt,name=datapoint" json:"datapoint,omitempty"`
+}
+
+func (m *WriteTaggedRequest) Reset()                    { *m = WriteTaggedRequest{} }
+func (m *WriteTaggedRequest) String() string            { return proto.CompactTextString(m) }
+func (*WriteTaggedRequest) ProtoMessage()               {}
+func (*WriteTaggedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *WriteTaggedRequest) GetTags() []*TagString {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *WriteTaggedRequest) GetDatapoint() *Datapoint {
+	if m != nil {
+		return m.Datapoint
+	}
+	return nil
+}
+
+type FetchBatchRawRequest struct {
+	RangeStart    int64    `protobuf:"varint,1,opt,name=rangeStart" json:"rangeStart,omitempty"`
+	RangeEnd      int64    `protobuf:"varint,2,opt,name=rangeEnd" json:"rangeEnd,omitempty"`
+	NameSpace     []byte   `protobuf:"bytes,3,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+	Ids           [][]byte `protobuf:"bytes,4,rep,name=ids,proto3" json:"ids,omitempty"`
+	RangeTimeType TimeType `protobuf:"varint,5,opt,name=rangeTimeType,enum=rpcpb.TimeType" json:"rangeTimeType,omitempty"`
+}
+
+func (m *FetchBatchRawRequest) Reset()                    { *m = FetchBatchRawRequest{} }
+func (m *FetchBatchRawRequest) String() string            { return proto.CompactTextString(m) }
+func (*FetchBatchRawRequest) ProtoMessage()               {}
+func (*FetchBatchRawRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+type FetchBatchRawResult struct {
+	Elements []*FetchRawResult `protobuf:"bytes,1,rep,name=elements" json:"elements,omitempty"`
+}
+
+func (m *FetchBatchRawResult) Reset()                    { *m = FetchBatchRawResult{} }
+func (m *FetchBatchRawResult) String() string            { return proto.CompactTextString(m) }
+func (*FetchBatchRawResult) ProtoMessage()               {}
+func (*FetchBatchRawResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *FetchBatchRawResult) GetElements() []*FetchRawResult {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+type FetchRawResult struct {
+	Segments []*Segments `protobuf:"bytes,1,rep,name=segments" json:"segments,omitempty"`
+	Err      *Error      `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
+}
+
+func (m *FetchRawResult) Reset()                    { *m = FetchRawResult{} }
+func (m *FetchRawResult) String() string            { return proto.CompactTextString(m) }
+func (*FetchRawResult) ProtoMessage()               {}
+func (*FetchRawResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *FetchRawResult) GetSegments() []*Segments {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *FetchRawResult) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+type FetchBatchRawPagedRequest struct {
+	RangeStart    int64    `protobuf:"varint,1,opt,name=rangeStart" json:"rangeStart,omitempty"`
+	RangeEnd      int64    `protobuf:"varint,2,opt,name=rangeEnd" json:"rangeEnd,omitempty"`
+	NameSpace     []byte   `protobuf:"bytes,3,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+	Ids           [][]byte `protobuf:"bytes,4,rep,name=ids,proto3" json:"ids,omitempty"`
+	Limit         int64    `protobuf:"varint,5,opt,name=limit" json:"limit,omitempty"`
+	PageToken     []byte   `protobuf:"bytes,6,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	RangeTimeType TimeType `protobuf:"varint,7,opt,name=rangeTimeType,enum=rpcpb.TimeType" json:"rangeTimeType,omitempty"`
+}
+
+func (m *FetchBatchRawPagedRequest) Reset()                    { *m = FetchBatchRawPagedRequest{} }
+func (m *FetchBatchRawPagedRequest) String() string            { return proto.CompactTextString(m) }
+func (*FetchBatchRawPagedRequest) ProtoMessage()               {}
+func (*FetchBatchRawPagedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+type FetchBatchRawPagedResult struct {
+	Elements      []*FetchRawPagedResult `protobuf:"bytes,1,rep,name=elements" json:"elements,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+}
+
+func (m *FetchBatchRawPagedResult) Reset()                    { *m = FetchBatchRawPagedResult{} }
+func (m *FetchBatchRawPagedResult) String() string            { return proto.CompactTextString(m) }
+func (*FetchBatchRawPagedResult) ProtoMessage()               {}
+func (*FetchBatchRawPagedResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *FetchBatchRawPagedResult) GetElements() []*FetchRawPagedResult {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+type FetchRawPagedResult struct {
+	Index    int64       `protobuf:"varint,1,opt,name=index" json:"index,omitempty"`
+	Segments []*Segments `protobuf:"bytes,2,rep,name=segments" json:"segments,omitempty"`
+	Err      *Error      `protobuf:"bytes,3,opt,name=err" json:"err,omitempty"`
+}
+
+func (m *FetchRawPagedResult) Reset()                    { *m = FetchRawPagedResult{} }
+func (m *FetchRawPagedResult) String() string            { return proto.CompactTextString(m) }
+func (*FetchRawPagedResult) ProtoMessage()               {}
+func (*FetchRawPagedResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *FetchRawPagedResult) GetSegments() []*Segments {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *FetchRawPagedResult) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+type Segments struct {
+	Merged   *Segment   `protobuf:"bytes,1,opt,name=merged" json:"merged,omitempty"`
+	Unmerged []*Segment `protobuf:"bytes,2,rep,name=unmerged" json:"unmerged,omitempty"`
+}
+
+func (m *Segments) Reset()                    { *m = Segments{} }
+func (m *Segments) String() string            { return proto.CompactTextString(m) }
+func (*Segments) ProtoMessage()               {}
+func (*Segments) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *Segments) GetMerged() *Segment {
+	if m != nil {
+		return m.Merged
+	}
+	return nil
+}
+
+func (m *Segments) GetUnmerged() []*Segment {
+	if m != nil {
+		return m.Unmerged
+	}
+	return nil
+}
+
+type Segment struct {
+	Head []byte `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	Tail []byte `protobuf:"bytes,2,opt,name=tail,proto3" json:"tail,omitempty"`
+}
+
+func (m *Segment) Reset()                    { *m = Segment{} }
+func (m *Segment) String() string            { return proto.CompactTextString(m) }
+func (*Segment) ProtoMessage()               {}
+func (*Segment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+type FetchTaggedRequest struct {
+	Query         *IdxQuery       `protobuf:"bytes,1,opt,name=query" json:"query,omitempty"`
+	RangeStart    int64           `protobuf:"varint,2,opt,name=rangeStart" json:"rangeStart,omitempty"`
+	RangeEnd      int64           `protobuf:"varint,3,opt,name=rangeEnd" json:"rangeEnd,omitempty"`
+	FetchData     bool            `protobuf:"varint,4,opt,name=fetchData" json:"fetchData,omitempty"`
+	Limit         *Int64Value     `protobuf:"bytes,5,opt,name=limit" json:"limit,omitempty"`
+	RangeTimeType TimeType        `protobuf:"varint,6,opt,name=rangeTimeType,enum=rpcpb.TimeType" json:"rangeTimeType,omitempty"`
+	Step          *Int64Value     `protobuf:"bytes,7,opt,name=step" json:"step,omitempty"`
+	Aggregation   AggregationType `protobuf:"varint,8,opt,name=aggregation,enum=rpcpb.AggregationType" json:"aggregation,omitempty"`
+}
+
+func (m *FetchTaggedRequest) Reset()                    { *m = FetchTaggedRequest{} }
+func (m *FetchTaggedRequest) String() string            { return proto.CompactTextString(m) }
+func (*FetchTaggedRequest) ProtoMessage()               {}
+func (*FetchTaggedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *FetchTaggedRequest) GetQuery() *IdxQuery {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *FetchTaggedRequest) GetLimit() *Int64Value {
+	if m != nil {
+		return m.Limit
+	}
+	return nil
+}
+
+func (m *FetchTaggedRequest) GetStep() *Int64Value {
+	if m != nil {
+		return m.Step
+	}
+	return nil
+}
+
+type IdxQuery struct {
+	Operator   BooleanOperator `protobuf:"varint,1,opt,name=operator,enum=rpcpb.BooleanOperator" json:"operator,omitempty"`
+	Filters    []*IdxTagFilter `protobuf:"bytes,2,rep,name=filters" json:"filters,omitempty"`
+	SubQueries []*IdxQuery     `protobuf:"bytes,3,rep,name=subQueries" json:"subQueries,omitempty"`
+}
+
+func (m *IdxQuery) Reset()                    { *m = IdxQuery{} }
+func (m *IdxQuery) String() string            { return proto.CompactTextString(m) }
+func (*IdxQuery) ProtoMessage()               {}
+func (*IdxQuery) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+func (m *IdxQuery) GetFilters() []*IdxTagFilter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+func (m *IdxQuery) GetSubQueries() []*IdxQuery {
+	if m != nil {
+		return m.SubQueries
+	}
+	return nil
+}
+
+type IdxTagFilter struct {
+	TagName        string `protobuf:"bytes,1,opt,name=tagName" json:"tagName,omitempty"`
+	TagValueFilter string `protobuf:"bytes,2,opt,name=tagValueFilter" json:"tagValueFilter,omitempty"`
+	Negate         bool   `protobuf:"varint,3,opt,name=negate" json:"negate,omitempty"`
+	Regexp         bool   `protobuf:"varint,4,opt,name=regexp" json:"regexp,omitempty"`
+}
+
+func (m *IdxTagFilter) Reset()                    { *m = IdxTagFilter{} }
+func (m *IdxTagFilter) String() string            { return proto.CompactTextString(m) }
+func (*IdxTagFilter) ProtoMessage()               {}
+func (*IdxTagFilter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+type FetchTaggedResult struct {
+	Elements   []*FetchTaggedIDResult `protobuf:"bytes,1,rep,name=elements" json:"elements,omitempty"`
+	Exhaustive bool                   `protobuf:"varint,2,opt,name=exhaustive" json:"exhaustive,omitempty"`
+}
+
+func (m *FetchTaggedResult) Reset()                    { *m = FetchTaggedResult{} }
+func (m *FetchTaggedResult) String() string            { return proto.CompactTextString(m) }
+func (*FetchTaggedResult) ProtoMessage()               {}
+func (*FetchTaggedResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *FetchTaggedResult) GetElements() []*FetchTaggedIDResult {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+type FetchTaggedIDResult struct {
+	Id         string       `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	NameSpace  string       `protobuf:"bytes,2,opt,name=nameSpace" json:"nameSpace,omitempty"`
+	Tags       []*TagString `protobuf:"bytes,3,rep,name=tags" json:"tags,omitempty"`
+	Datapoints []*Datapoint `protobuf:"bytes,5,rep,name=datapoints" json:"datapoints,omitempty"`
+	Err        *Error       `protobuf:"bytes,6,opt,name=err" json:"err,omitempty"`
+}
+
+func (m *FetchTaggedIDResult) Reset()                    { *m = FetchTaggedIDResult{} }
+func (m *FetchTaggedIDResult) String() string            { return proto.CompactTextString(m) }
+func (*FetchTaggedIDResult) ProtoMessage()               {}
+func (*FetchTaggedIDResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *FetchTaggedIDResult) GetTags() []*TagString {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *FetchTaggedIDResult) GetDatapoints() []*Datapoint {
+	if m != nil {
+		return m.Datapoints
+	}
+	return nil
+}
+
+func (m *FetchTaggedIDResult) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+type FetchBlocksRawRequest struct {
+	NameSpace []byte                          `protobuf:"bytes,1,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+	Shard     int32                           `protobuf:"varint,2,opt,name=shard" json:"shard,omitempty"`
+	Elements  []*FetchBlocksRawRequestElement `protobuf:"bytes,3,rep,name=elements" json:"elements,omitempty"`
+}
+
+func (m *FetchBlocksRawRequest) Reset()                    { *m = FetchBlocksRawRequest{} }
+func (m *FetchBlocksRawRequest) String() string            { return proto.CompactTextString(m) }
+func (*FetchBlocksRawRequest) ProtoMessage()               {}
+func (*FetchBlocksRawRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *FetchBlocksRawRequest) GetElements() []*FetchBlocksRawRequestElement {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+type FetchBlocksRawRequestElement struct {
+	Id     []byte  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Starts []int64 `protobuf:"varint,2,rep,packed,name=starts" json:"starts,omitempty"`
+}
+
+func (m *FetchBlocksRawRequestElement) Reset()                    { *m = FetchBlocksRawRequestElement{} }
+func (m *FetchBlocksRawRequestElement) String() string            { return proto.CompactTextString(m) }
+func (*FetchBlocksRawRequestElement) ProtoMessage()               {}
+func (*FetchBlocksRawRequestElement) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+type FetchBlocksRawResult struct {
+	Elements []*Blocks `protobuf:"bytes,1,rep,name=elements" json:"elements,omitempty"`
+}
+
+func (m *FetchBlocksRawResult) Reset()                    { *m = FetchBlocksRawResult{} }
+func (m *FetchBlocksRawResult) String() string            { return proto.CompactTextString(m) }
+func (*FetchBlocksRawResult) ProtoMessage()               {}
+func (*FetchBlocksRawResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+func (m *FetchBlocksRawResult) GetElements() []*Blocks {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+type Blocks struct {
+	Id     []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Blocks []*Block `protobuf:"bytes,2,rep,name=blocks" json:"blocks,omitempty"`
+}
+
+func (m *Blocks) Reset()                    { *m = Blocks{} }
+func (m *Blocks) String() string            { return proto.CompactTextString(m) }
+func (*Blocks) ProtoMessage()               {}
+func (*Blocks) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+func (m *Blocks) GetBlocks() []*Block {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+type Block struct {
+	Start    int64       `protobuf:"varint,1,opt,name=start" json:"start,omitempty"`
+	Segments *Segments   `protobuf:"bytes,2,opt,name=segments" json:"segments,omitempty"`
+	Err      *Error      `protobuf:"bytes,3,opt,name=err" json:"err,omitempty"`
+	Checksum *Int64Value `protobuf:"bytes,4,opt,name=checksum" json:"checksum,omitempty"`
+}
+
+func (m *Block) Reset()                    { *m = Block{} }
+func (m *Block) String() string            { return proto.CompactTextString(m) }
+func (*Block) ProtoMessage()               {}
+func (*Block) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+func (m *Block) GetSegments() *Segments {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *Block) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+func (m *Block) GetChecksum() *Int64Value {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+type TagString struct {
+	Name  string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *TagString) Reset()                    { *m = TagString{} }
+func (m *TagString) String() string            { return proto.CompactTextString(m) }
+func (*TagString) ProtoMessage()               {}
+func (*TagString) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+
+type TagRaw struct {
+	Name  []byte `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *TagRaw) Reset()                    { *m = TagRaw{} }
+func (m *TagRaw) String() string            { return proto.CompactTextString(m) }
+func (*TagRaw) ProtoMessage()               {}
+func (*TagRaw) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+type FetchBlocksMetadataRawRequest struct {
+	NameSpace        []byte      `protobuf:"bytes,1,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+	Shard            int32       `protobuf:"varint,2,opt,name=shard" json:"shard,omitempty"`
+	RangeStart       int64       `protobuf:"varint,3,opt,name=rangeStart" json:"rangeStart,omitempty"`
+	RangeEnd         int64       `protobuf:"varint,4,opt,name=rangeEnd" json:"rangeEnd,omitempty"`
+	Limit            int64       `protobuf:"varint,5,opt,name=limit" json:"limit,omitempty"`
+	PageToken        *Int64Value `protobuf:"bytes,6,opt,name=pageToken" json:"pageToken,omitempty"`
+	IncludeSizes     *BoolValue  `protobuf:"bytes,7,opt,name=includeSizes" json:"includeSizes,omitempty"`
+	IncludeChecksums *BoolValue  `protobuf:"bytes,8,opt,name=includeChecksums" json:"includeChecksums,omitempty"`
+	IncludeLastRead  *BoolValue  `protobuf:"bytes,9,opt,name=includeLastRead" json:"includeLastRead,omitempty"`
+}
+
+func (m *FetchBlocksMetadataRawRequest) Reset()                    { *m = FetchBlocksMetadataRawRequest{} }
+func (m *FetchBlocksMetadataRawRequest) String() string            { return proto.CompactTextString(m) }
+func (*FetchBlocksMetadataRawRequest) ProtoMessage()               {}
+func (*FetchBlocksMetadataRawRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *FetchBlocksMetadataRawRequest) GetPageToken() *Int64Value {
+	if m != nil {
+		return m.PageToken
+	}
+	return nil
+}
+
+func (m *FetchBlocksMetadataRawRequest) GetIncludeSizes() *BoolValue {
+	if m != nil {
+		return m.IncludeSizes
+	}
+	return nil
+}
+
+func (m *FetchBlocksMetadataRawRequest) GetIncludeChecksums() *BoolValue {
+	if m != nil {
+		return m.IncludeChecksums
+	}
+	return nil
+}
+
+func (m *FetchBlocksMetadataRawRequest) GetIncludeLastRead() *BoolValue {
+	if m != nil {
+		return m.IncludeLastRead
+	}
+	return nil
+}
+
+type FetchBlocksMetadataRawResult struct {
+	Elements      []*BlocksMetadata `protobuf:"bytes,1,rep,name=elements" json:"elements,omitempty"`
+	NextPageToken *Int64Value       `protobuf:"bytes,2,opt,name=nextPageToken" json:"nextPageToken,omitempty"`
+}
+
+func (m *FetchBlocksMetadataRawResult) Reset()                    { *m = FetchBlocksMetadataRawResult{} }
+func (m *FetchBlocksMetadataRawResult) String() string            { return proto.CompactTextString(m) }
+func (*FetchBlocksMetadataRawResult) ProtoMessage()               {}
+func (*FetchBlocksMetadataRawResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *FetchBlocksMetadataRawResult) GetElements() []*BlocksMetadata {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+func (m *FetchBlocksMetadataRawResult) GetNextPageToken() *Int64Value {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
+type BlocksMetadata struct {
+	Id     []byte           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Blocks []*BlockMetadata `protobuf:"bytes,2,rep,name=blocks" json:"blocks,omitempty"`
+}
+
+func (m *BlocksMetadata) Reset()                    { *m = BlocksMetadata{} }
+func (m *BlocksMetadata) String() string            { return proto.CompactTextString(m) }
+func (*BlocksMetadata) ProtoMessage()               {}
+func (*BlocksMetadata) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *BlocksMetadata) GetBlocks() []*BlockMetadata {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+type BlockMetadata struct {
+	Err              *Error      `protobuf:"bytes,1,opt,name=err" json:"err,omitempty"`
+	Start            int64       `protobuf:"varint,2,opt,name=start" json:"start,omitempty"`
+	Size             *Int64Value `protobuf:"bytes,3,opt,name=size" json:"size,omitempty"`
+	Checksum         *Int64Value `protobuf:"bytes,4,opt,name=checksum" json:"checksum,omitempty"`
+	LastRead         *Int64Value `protobuf:"bytes,5,opt,name=lastRead" json:"lastRead,omitempty"`
+	LastReadTimeType TimeType    `protobuf:"varint,6,opt,name=lastReadTimeType,enum=rpcpb.TimeType" json:"lastReadTimeType,omitempty"`
+}
+
+func (m *BlockMetadata) Reset()                    { *m = BlockMetadata{} }
+func (m *BlockMetadata) String() string            { return proto.CompactTextString(m) }
+func (*BlockMetadata) ProtoMessage()               {}
+func (*BlockMetadata) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *BlockMetadata) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+func (m *BlockMetadata) GetSize() *Int64Value {
+	if m != nil {
+		return m.Size
+	}
+	return nil
+}
+
+func (m *BlockMetadata) GetChecksum() *Int64Value {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+func (m *BlockMetadata) GetLastRead() *Int64Value {
+	if m != nil {
+		return m.LastRead
+	}
+	return nil
+}
+
+type FetchBlocksMetadataRawV2Request struct {
+	NameSpace        []byte     `protobuf:"bytes,1,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+	Shard            int32      `protobuf:"varint,2,opt,name=shard" json:"shard,omitempty"`
+	RangeStart       int64      `protobuf:"varint,3,opt,name=rangeStart" json:"rangeStart,omitempty"`
+	RangeEnd         int64      `protobuf:"varint,4,opt,name=rangeEnd" json:"rangeEnd,omitempty"`
+	Limit            int64      `protobuf:"varint,5,opt,name=limit" json:"limit,omitempty"`
+	PageToken        []byte     `protobuf:"bytes,6,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	IncludeSizes     *BoolValue `protobuf:"bytes,7,opt,name=includeSizes" json:"includeSizes,omitempty"`
+	IncludeChecksums *BoolValue `protobuf:"bytes,8,opt,name=includeChecksums" json:"includeChecksums,omitempty"`
+	IncludeLastRead  *BoolValue `protobuf:"bytes,9,opt,name=includeLastRead" json:"includeLastRead,omitempty"`
+}
+
+func (m *FetchBlocksMetadataRawV2Request) Reset()         { *m = FetchBlocksMetadataRawV2Request{} }
+func (m *FetchBlocksMetadataRawV2Request) String() string { return proto.CompactTextString(m) }
+func (*FetchBlocksMetadataRawV2Request) ProtoMessage()    {}
+func (*FetchBlocksMetadataRawV2Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{31}
+}
+
+func (m *FetchBlocksMetadataRawV2Request) GetIncludeSizes() *BoolValue {
+	if m != nil {
+		return m.IncludeSizes
+	}
+	return nil
+}
+
+func (m *FetchBlocksMetadataRawV2Request) GetIncludeChecksums() *BoolValue {
+	if m != nil {
+		return m.IncludeChecksums
+	}
+	return nil
+}
+
+func (m *FetchBlocksMetadataRawV2Request) GetIncludeLastRead() *BoolValue {
+	if m != nil {
+		return m.IncludeLastRead
+	}
+	return nil
+}
+
+type FetchBlocksMetadataRawV2Result struct {
+	Elements      []*BlockMetadataV2 `protobuf:"bytes,1,rep,name=elements" json:"elements,omitempty"`
+	NextPageToken []byte             `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+}
+
+func (m *FetchBlocksMetadataRawV2Result) Reset()         { *m = FetchBlocksMetadataRawV2Result{} }
+func (m *FetchBlocksMetadataRawV2Result) String() string { return proto.CompactTextString(m) }
+func (*FetchBlocksMetadataRawV2Result) ProtoMessage()    {}
+func (*FetchBlocksMetadataRawV2Result) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{32}
+}
+
+func (m *FetchBlocksMetadataRawV2Result) GetElements() []*BlockMetadataV2 {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+type BlockMetadataV2 struct {
+	Id               []byte      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Start            int64       `protobuf:"varint,2,opt,name=start" json:"start,omitempty"`
+	Err              *Error      `protobuf:"bytes,3,opt,name=err" json:"err,omitempty"`
+	Size             *Int64Value `protobuf:"bytes,4,opt,name=size" json:"size,omitempty"`
+	Checksum         *Int64Value `protobuf:"bytes,5,opt,name=checksum" json:"checksum,omitempty"`
+	LastRead         *Int64Value `protobuf:"bytes,6,opt,name=lastRead" json:"lastRead,omitempty"`
+	LastReadTimeType TimeType    `protobuf:"varint,7,opt,name=lastReadTimeType,enum=rpcpb.TimeType" json:"lastReadTimeType,omitempty"`
+	EncodedTags      []byte      `protobuf:"bytes,8,opt,name=encodedTags,proto3" json:"encodedTags,omitempty"`
+}
+
+func (m *BlockMetadataV2) Reset()                    { *m = BlockMetadataV2{} }
+func (m *BlockMetadataV2) String() string            { return proto.CompactTextString(m) }
+func (*BlockMetadataV2) ProtoMessage()               {}
+func (*BlockMetadataV2) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *BlockMetadataV2) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+func (m *BlockMetadataV2) GetSize() *Int64Value {
+	if m != nil {
+		return m.Size
+	}
+	return nil
+}
+
+func (m *BlockMetadataV2) GetChecksum() *Int64Value {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+func (m *BlockMetadataV2) GetLastRead() *Int64Value {
+	if m != nil {
+		return m.LastRead
+	}
+	return nil
+}
+
+type WriteBatchRawRequest struct {
+	NameSpace []byte                         `protobuf:"bytes,1,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+	Elements  []*WriteBatchRawRequestElement `protobuf:"bytes,2,rep,name=elements" json:"elements,omitempty"`
+}
+
+func (m *WriteBatchRawRequest) Reset()                    { *m = WriteBatchRawRequest{} }
+func (m *WriteBatchRawRequest) String() string            { return proto.CompactTextString(m) }
+func (*WriteBatchRawRequest) ProtoMessage()               {}
+func (*WriteBatchRawRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *WriteBatchRawRequest) GetElements() []*WriteBatchRawRequestElement {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+type WriteBatchRawRequestElement struct {
+	Id        []byte     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Datapoint *Datapoint `protobuf:"bytes,2,opt,name=datapoint" json:"datapoint,omitempty"`
+}
+
+func (m *WriteBatchRawRequestElement) Reset()                    { *m = WriteBatchRawRequestElement{} }
+func (m *WriteBatchRawRequestElement) String() string            { return proto.CompactTextString(m) }
+func (*WriteBatchRawRequestElement) ProtoMessage()               {}
+func (*WriteBatchRawRequestElement) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *WriteBatchRawRequestElement) GetDatapoint() *Datapoint {
+	if m != nil {
+		return m.Datapoint
+	}
+	return nil
+}
+
+type WriteTaggedBatchRawRequest struct {
+	NameSpace []byte                               `protobuf:"bytes,1,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+	Elements  []*WriteTaggedBatchRawRequestElement `protobuf:"bytes,2,rep,name=elements" json:"elements,omitempty"`
+}
+
+func (m *WriteTaggedBatchRawRequest) Reset()                    { *m = WriteTaggedBatchRawRequest{} }
+func (m *WriteTaggedBatchRawRequest) String() string            { return proto.CompactTextString(m) }
+func (*WriteTaggedBatchRawRequest) ProtoMessage()               {}
+func (*WriteTaggedBatchRawRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *WriteTaggedBatchRawRequest) GetElements() []*WriteTaggedBatchRawRequestElement {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+type WriteTaggedBatchRawRequestElement struct {
+	Id        []byte     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tags      []*TagRaw  `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty"`
+	Datapoint *Datapoint `protobuf:"bytes,3,opt,name=datapoint" json:"datapoint,omitempty"`
+}
+
+func (m *WriteTaggedBatchRawRequestElement) Reset()         { *m = WriteTaggedBatchRawRequestElement{} }
+func (m *WriteTaggedBatchRawRequestElement) String() string { return proto.CompactTextString(m) }
+func (*WriteTaggedBatchRawRequestElement) ProtoMessage()    {}
+func (*WriteTaggedBatchRawRequestElement) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{37}
+}
+
+func (m *WriteTaggedBatchRawRequestElement) GetTags() []*TagRaw {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *WriteTaggedBatchRawRequestElement) GetDatapoint() *Datapoint {
+	if m != nil {
+		return m.Datapoint
+	}
+	return nil
+}
+
+type WriteBatchRawError struct {
+	Index int64  `protobuf:"varint,1,opt,name=index" json:"index,omitempty"`
+	Err   *Error `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
+}
+
+func (m *WriteBatchRawError) Reset()                    { *m = WriteBatchRawError{} }
+func (m *WriteBatchRawError) String() string            { return proto.CompactTextString(m) }
+func (*WriteBatchRawError) ProtoMessage()               {}
+func (*WriteBatchRawError) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *WriteBatchRawError) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+type TruncateRequest struct {
+	NameSpace []byte `protobuf:"bytes,1,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+}
+
+func (m *TruncateRequest) Reset()                    { *m = TruncateRequest{} }
+func (m *TruncateRequest) String() string            { return proto.CompactTextString(m) }
+func (*TruncateRequest) ProtoMessage()               {}
+func (*TruncateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+type TruncateResult struct {
+	NumSeries int64 `protobuf:"varint,1,opt,name=numSeries" json:"numSeries,omitempty"`
+}
+
+func (m *TruncateResult) Reset()                    { *m = TruncateResult{} }
+func (m *TruncateResult) String() string            { return proto.CompactTextString(m) }
+func (*TruncateResult) ProtoMessage()               {}
+func (*TruncateResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+type NodeHealthResult struct {
+	Ok           bool   `protobuf:"varint,1,opt,name=ok" json:"ok,omitempty"`
+	Status       string `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+	Bootstrapped bool   `protobuf:"varint,3,opt,name=bootstrapped" json:"bootstrapped,omitempty"`
+}
+
+func (m *NodeHealthResult) Reset()                    { *m = NodeHealthResult{} }
+func (m *NodeHealthResult) String() string            { return proto.CompactTextString(m) }
+func (*NodeHealthResult) ProtoMessage()               {}
+func (*NodeHealthResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+type NodePersistRateLimitResult struct {
+	LimitEnabled    bool    `protobuf:"varint,1,opt,name=limitEnabled" json:"limitEnabled,omitempty"`
+	LimitMbps       float64 `protobuf:"fixed64,2,opt,name=limitMbps" json:"limitMbps,omitempty"`
+	LimitCheckEvery int64   `protobuf:"varint,3,opt,name=limitCheckEvery" json:"limitCheckEvery,omitempty"`
+}
+
+func (m *NodePersistRateLimitResult) Reset()                    { *m = NodePersistRateLimitResult{} }
+func (m *NodePersistRateLimitResult) String() string            { return proto.CompactTextString(m) }
+func (*NodePersistRateLimitResult) ProtoMessage()               {}
+func (*NodePersistRateLimitResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+type NodeSetPersistRateLimitRequest struct {
+	LimitEnabled    *BoolValue   `protobuf:"bytes,1,opt,name=limitEnabled" json:"limitEnabled,omitempty"`
+	LimitMbps       *DoubleValue `protobuf:"bytes,2,opt,name=limitMbps" json:"limitMbps,omitempty"`
+	LimitCheckEvery *Int64Value  `protobuf:"bytes,3,opt,name=limitCheckEvery" json:"limitCheckEvery,omitempty"`
+}
+
+func (m *NodeSetPersistRateLimitRequest) Reset()         { *m = NodeSetPersistRateLimitRequest{} }
+func (m *NodeSetPersistRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeSetPersistRateLimitRequest) ProtoMessage()    {}
+func (*NodeSetPersistRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{43}
+}
+
+func (m *NodeSetPersistRateLimitRequest) GetLimitEnabled() *BoolValue {
+	if m != nil {
+		return m.LimitEnabled
+	}
+	return nil
+}
+
+func (m *NodeSetPersistRateLimitRequest) GetLimitMbps() *DoubleValue {
+	if m != nil {
+		return m.LimitMbps
+	}
+	return nil
+}
+
+func (m *NodeSetPersistRateLimitRequest) GetLimitCheckEvery() *Int64Value {
+	if m != nil {
+		return m.LimitCheckEvery
+	}
+	return nil
+}
+
+type NodeWriteNewSeriesAsyncResult struct {
+	WriteNewSeriesAsync bool `protobuf:"varint,1,opt,name=writeNewSeriesAsync" json:"writeNewSeriesAsync,omitempty"`
+}
+
+func (m *NodeWriteNewSeriesAsyncResult) Reset()                    { *m = NodeWriteNewSeriesAsyncResult{} }
+func (m *NodeWriteNewSeriesAsyncResult) String() string            { return proto.CompactTextString(m) }
+func (*NodeWriteNewSeriesAsyncResult) ProtoMessage()               {}
+func (*NodeWriteNewSeriesAsyncResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+type NodeSetWriteNewSeriesAsyncRequest struct {
+	WriteNewSeriesAsync bool `protobuf:"varint,1,opt,name=writeNewSeriesAsync" json:"writeNewSeriesAsync,omitempty"`
+}
+
+func (m *NodeSetWriteNewSeriesAsyncRequest) Reset()         { *m = NodeSetWriteNewSeriesAsyncRequest{} }
+func (m *NodeSetWriteNewSeriesAsyncRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeSetWriteNewSeriesAsyncRequest) ProtoMessage()    {}
+func (*NodeSetWriteNewSeriesAsyncRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{45}
+}
+
+type NodeWriteNewSeriesBackoffDurationResult struct {
+	WriteNewSeriesBackoffDuration int64    `protobuf:"varint,1,opt,name=writeNewSeriesBackoffDuration" json:"writeNewSeriesBackoffDuration,omitempty"`
+	DurationType                  TimeType `protobuf:"varint,2,opt,name=durationType,enum=rpcpb.TimeType" json:"durationType,omitempty"`
+}
+
+func (m *NodeWriteNewSeriesBackoffDurationResult) Reset() {
+	*m = NodeWriteNewSeriesBackoffDurationResult{}
+}
+func (m *NodeWriteNewSeriesBackoffDurationResult) String() string { return proto.CompactTextString(m) }
+func (*NodeWriteNewSeriesBackoffDurationResult) ProtoMessage()    {}
+func (*NodeWriteNewSeriesBackoffDurationResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{46}
+}
+
+type NodeSetWriteNewSeriesBackoffDurationRequest struct {
+	WriteNewSeriesBackoffDuration int64    `protobuf:"varint,1,opt,name=writeNewSeriesBackoffDuration" json:"writeNewSeriesBackoffDuration,omitempty"`
+	DurationType                  TimeType `protobuf:"varint,2,opt,name=durationType,enum=rpcpb.TimeType" json:"durationType,omitempty"`
+}
+
+func (m *NodeSetWriteNewSeriesBackoffDurationRequest) Reset() {
+	*m = NodeSetWriteNewSeriesBackoffDurationRequest{}
+}
+func (m *NodeSetWriteNewSeriesBackoffDurationRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*NodeSetWriteNewSeriesBackoffDurationRequest) ProtoMessage() {}
+func (*NodeSetWriteNewSeriesBackoffDurationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{47}
+}
+
+type NodeWriteNewSeriesLimitPerShardPerSecondResult struct {
+	WriteNewSeriesLimitPerShardPerSecond int64 `protobuf:"varint,1,opt,name=writeNewSeriesLimitPerShardPerSecond" json:"writeNewSeriesLimitPerShardPerSecond,omitempty"`
+}
+
+func (m *NodeWriteNewSeriesLimitPerShardPerSecondResult) Reset() {
+	*m = NodeWriteNewSeriesLimitPerShardPerSecondResult{}
+}
+func (m *NodeWriteNewSeriesLimitPerShardPerSecondResult) String() string {
+	return proto.CompactTextString(m)
+}
+func (*NodeWriteNewSeriesLimitPerShardPerSecondResult) ProtoMessage() {}
+func (*NodeWriteNewSeriesLimitPerShardPerSecondResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{48}
+}
+
+type NodeSetWriteNewSeriesLimitPerShardPerSecondRequest struct {
+	WriteNewSeriesLimitPerShardPerSecond int64 `protobuf:"varint,1,opt,name=writeNewSeriesLimitPerShardPerSecond" json:"writeNewSeriesLimitPerShardPerSecond,omitempty"`
+}
+
+func (m *NodeSetWriteNewSeriesLimitPerShardPerSecondRequest) Reset() {
+	*m = NodeSetWriteNewSeriesLimitPerShardPerSecondRequest{}
+}
+func (m *NodeSetWriteNewSeriesLimitPerShardPerSecondRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*NodeSetWriteNewSeriesLimitPerShardPerSecondRequest) ProtoMessage() {}
+func (*NodeSetWriteNewSeriesLimitPerShardPerSecondRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{49}
+}
+
+type NodeBootstrapStatusResult struct {
+	Namespaces []*NamespaceBootstrapStatus `protobuf:"bytes,1,rep,name=namespaces" json:"namespaces,omitempty"`
+}
+
+func (m *NodeBootstrapStatusResult) Reset()                    { *m = NodeBootstrapStatusResult{} }
+func (m *NodeBootstrapStatusResult) String() string            { return proto.CompactTextString(m) }
+func (*NodeBootstrapStatusResult) ProtoMessage()               {}
+func (*NodeBootstrapStatusResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+
+func (m *NodeBootstrapStatusResult) GetNamespaces() []*NamespaceBootstrapStatus {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+type NamespaceBootstrapStatus struct {
+	NameSpace string                  `protobuf:"bytes,1,opt,name=nameSpace" json:"nameSpace,omitempty"`
+	Shards    []*ShardBootstrapStatus `protobuf:"bytes,2,rep,name=shards" json:"shards,omitempty"`
+}
+
+func (m *NamespaceBootstrapStatus) Reset()                    { *m = NamespaceBootstrapStatus{} }
+func (m *NamespaceBootstrapStatus) String() string            { return proto.CompactTextString(m) }
+func (*NamespaceBootstrapStatus) ProtoMessage()               {}
+func (*NamespaceBootstrapStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+
+func (m *NamespaceBootstrapStatus) GetShards() []*ShardBootstrapStatus {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+type ShardBootstrapStatus struct {
+	Shard         int32               `protobuf:"varint,1,opt,name=shard" json:"shard,omitempty"`
+	State         ShardBootstrapState `protobuf:"varint,2,opt,name=state,enum=rpcpb.ShardBootstrapState" json:"state,omitempty"`
+	Source        string              `protobuf:"bytes,3,opt,name=source" json:"source,omitempty"`
+	SeriesFetched int64               `protobuf:"varint,4,opt,name=seriesFetched" json:"seriesFetched,omitempty"`
+	BytesFetched  int64               `protobuf:"varint,5,opt,name=bytesFetched" json:"bytesFetched,omitempty"`
+	Error         string              `protobuf:"bytes,6,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *ShardBootstrapStatus) Reset()                    { *m = ShardBootstrapStatus{} }
+func (m *ShardBootstrapStatus) String() string            { return proto.CompactTextString(m) }
+func (*ShardBootstrapStatus) ProtoMessage()               {}
+func (*ShardBootstrapStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+
+type HealthResult struct {
+	Ok     bool   `protobuf:"varint,1,opt,name=ok" json:"ok,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+}
+
+func (m *HealthResult) Reset()                    { *m = HealthResult{} }
+func (m *HealthResult) String() string            { return proto.CompactTextString(m) }
+func (*HealthResult) ProtoMessage()               {}
+func (*HealthResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+
+type Int64Value struct {
+	Value int64 `protobuf:"varint,1,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *Int64Value) Reset()                    { *m = Int64Value{} }
+func (m *Int64Value) String() string            { return proto.CompactTextString(m) }
+func (*Int64Value) ProtoMessage()               {}
+func (*Int64Value) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+
+type BoolValue struct {
+	Value bool `protobuf:"varint,1,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *BoolValue) Reset()                    { *m = BoolValue{} }
+func (m *BoolValue) String() string            { return proto.CompactTextString(m) }
+func (*BoolValue) ProtoMessage()               {}
+func (*BoolValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+
+type DoubleValue struct {
+	Value float64 `protobuf:"fixed64,1,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *DoubleValue) Reset()                    { *m = DoubleValue{} }
+func (m *DoubleValue) String() string            { return proto.CompactTextString(m) }
+func (*DoubleValue) ProtoMessage()               {}
+func (*DoubleValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+type Empty struct {
+}
+
+func (m *Empty) Reset()                    { *m = Empty{} }
+func (m *Empty) String() string            { return proto.CompactTextString(m) }
+func (*Empty) ProtoMessage()               {}
+func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+func init() {
+	proto.RegisterType((*Error)(nil), "rpcpb.Error")
+	proto.RegisterType((*WriteBatchRawErrors)(nil), "rpcpb.WriteBatchRawErrors")
+	proto.RegisterType((*FetchRequest)(nil), "rpcpb.FetchRequest")
+	proto.RegisterType((*FetchResult)(nil), "rpcpb.FetchResult")
+	proto.RegisterType((*Datapoint)(nil), "rpcpb.Datapoint")
+	proto.RegisterType((*WriteRequest)(nil), "rpcpb.WriteRequest")
+	proto.RegisterType((*WriteTaggedRequest)(nil), "rpcpb.WriteTaggedRequest")
+	proto.RegisterType((*FetchBatchRawRequest)(nil), "rpcpb.FetchBatchRawRequest")
+	proto.RegisterType((*FetchBatchRawResult)(nil), "rpcpb.FetchBatchRawResult")
+	proto.RegisterType((*FetchRawResult)(nil), "rpcpb.FetchRawResult")
+	proto.RegisterType((*FetchBatchRawPagedRequest)(nil), "rpcpb.FetchBatchRawPagedRequest")
+	proto.RegisterType((*FetchBatchRawPagedResult)(nil), "rpcpb.FetchBatchRawPagedResult")
+	proto.RegisterType((*FetchRawPagedResult)(nil), "rpcpb.FetchRawPagedResult")
+	proto.RegisterType((*Segments)(nil), "rpcpb.Segments")
+	proto.RegisterType((*Segment)(nil), "rpcpb.Segment")
+	proto.RegisterType((*FetchTaggedRequest)(nil), "rpcpb.FetchTaggedRequest")
+	proto.RegisterType((*IdxQuery)(nil), "rpcpb.IdxQuery")
+	proto.RegisterType((*IdxTagFilter)(nil), "rpcpb.IdxTagFilter")
+	proto.RegisterType((*FetchTaggedResult)(nil), "rpcpb.FetchTaggedResult")
+	proto.RegisterType((*FetchTaggedIDResult)(nil), "rpcpb.FetchTaggedIDResult")
+	proto.RegisterType((*FetchBlocksRawRequest)(nil), "rpcpb.FetchBlocksRawRequest")
+	proto.RegisterType((*FetchBlocksRawRequestElement)(nil), "rpcpb.FetchBlocksRawRequestElement")
+	proto.RegisterType((*FetchBlocksRawResult)(nil), "rpcpb.FetchBlocksRawResult")
+	proto.RegisterType((*Blocks)(nil), "rpcpb.Blocks")
+	proto.RegisterType((*Block)(nil), "rpcpb.Block")
+	proto.RegisterType((*TagString)(nil), "rpcpb.TagString")
+	proto.RegisterType((*TagRaw)(nil), "rpcpb.TagRaw")
+	proto.RegisterType((*FetchBlocksMetadataRawRequest)(nil), "rpcpb.FetchBlocksMetadataRawRequest")
+	proto.RegisterType((*FetchBlocksMetadataRawResult)(nil), "rpcpb.FetchBlocksMetadataRawResult")
+	proto.RegisterType((*BlocksMetadata)(nil), "rpcpb.BlocksMetadata")
+	proto.RegisterType((*BlockMetadata)(nil), "rpcpb.BlockMetadata")
+	proto.RegisterType((*FetchBlocksMetadataRawV2Request)(nil), "rpcpb.FetchBlocksMetadataRawV2Request")
+	proto.RegisterType((*FetchBlocksMetadataRawV2Result)(nil), "rpcpb.FetchBlocksMetadataRawV2Result")
+	proto.RegisterType((*BlockMetadataV2)(nil), "rpcpb.BlockMetadataV2")
+	proto.RegisterType((*WriteBatchRawRequest)(nil), "rpcpb.WriteBatchRawRequest")
+	proto.RegisterType((*WriteBatchRawRequestElement)(nil), "rpcpb.WriteBatchRawRequestElement")
+	proto.RegisterType((*WriteTaggedBatchRawRequest)(nil), "rpcpb.WriteTaggedBatchRawRequest")
+	proto.RegisterType((*WriteTaggedBatchRawRequestElement)(nil), "rpcpb.WriteTaggedBatchRawRequestElement")
+	proto.RegisterType((*WriteBatchRawError)(nil), "rpcpb.WriteBatchRawError")
+	proto.RegisterType((*TruncateRequest)(nil), "rpcpb.TruncateRequest")
+	proto.RegisterType((*TruncateResult)(nil), "rpcpb.TruncateResult")
+	proto.RegisterType((*NodeHealthResult)(nil), "rpcpb.NodeHealthResult")
+	proto.RegisterType((*NodePersistRateLimitResult)(nil), "rpcpb.NodePersistRateLimitResult")
+	proto.RegisterType((*NodeSetPersistRateLimitRequest)(nil), "rpcpb.NodeSetPersistRateLimitRequest")
+	proto.RegisterType((*NodeWriteNewSeriesAsyncResult)(nil), "rpcpb.NodeWriteNewSeriesAsyncResult")
+	proto.RegisterType((*NodeSetWriteNewSeriesAsyncRequest)(nil), "rpcpb.NodeSetWriteNewSeriesAsyncRequest")
+	proto.RegisterType((*NodeWriteNewSeriesBackoffDurationResult)(nil), "rpcpb.NodeWriteNewSeriesBackoffDurationResult")
+	proto.RegisterType((*NodeSetWriteNewSeriesBackoffDurationRequest)(nil), "rpcpb.NodeSetWriteNewSeriesBackoffDurationRequest")
+	proto.RegisterType((*NodeWriteNewSeriesLimitPerShardPerSecondResult)(nil), "rpcpb.NodeWriteNewSeriesLimitPerShardPerSecondResult")
+	proto.RegisterType((*NodeSetWriteNewSeriesLimitPerShardPerSecondRequest)(nil), "rpcpb.NodeSetWriteNewSeriesLimitPerShardPerSecondRequest")
+	proto.RegisterType((*NodeBootstrapStatusResult)(nil), "rpcpb.NodeBootstrapStatusResult")
+	proto.RegisterType((*NamespaceBootstrapStatus)(nil), "rpcpb.NamespaceBootstrapStatus")
+	proto.RegisterType((*ShardBootstrapStatus)(nil), "rpcpb.ShardBootstrapStatus")
+	proto.RegisterType((*HealthResult)(nil), "rpcpb.HealthResult")
+	proto.RegisterType((*Int64Value)(nil), "rpcpb.Int64Value")
+	proto.RegisterType((*BoolValue)(nil), "rpcpb.BoolValue")
+	proto.RegisterType((*DoubleValue)(nil), "rpcpb.DoubleValue")
+	proto.RegisterType((*Empty)(nil), "rpcpb.Empty")
+	proto.RegisterEnum("rpcpb.TimeType", TimeType_name, TimeType_value)
+	proto.RegisterEnum("rpcpb.ErrorType", ErrorType_name, ErrorType_value)
+	proto.RegisterEnum("rpcpb.AggregationType", AggregationType_name, AggregationType_value)
+	proto.RegisterEnum("rpcpb.BooleanOperator", BooleanOperator_name, BooleanOperator_value)
+	proto.RegisterEnum("rpcpb.ShardBootstrapState", ShardBootstrapState_name, ShardBootstrapState_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Node service
+
+type NodeClient interface {
+	Fetch(ctx context.Context, in *FetchRequest, opts ...grpc.CallOption) (*FetchResult, error)
+	FetchTagged(ctx context.Context, in *FetchTaggedRequest, opts ...grpc.CallOption) (*FetchTaggedResult, error)
+	Write(ctx context.Context, in *WriteRequest, opts ...grpc.CallOption) (*Empty, error)
+	WriteTagged(ctx context.Context, in *WriteTaggedRequest, opts ...grpc.CallOption) (*Empty, error)
+	FetchBatchRaw(ctx context.Context, in *FetchBatchRawRequest, opts ...grpc.CallOption) (*FetchBatchRawResult, error)
+	FetchBatchRawPaged(ctx context.Context, in *FetchBatchRawPagedRequest, opts ...grpc.CallOption) (*FetchBatchRawPagedResult, error)
+	FetchBlocksRaw(ctx context.Context, in *FetchBlocksRawRequest, opts ...grpc.CallOption) (*FetchBlocksRawResult, error)
+	FetchBlocksMetadataRaw(ctx context.Context, in *FetchBlocksMetadataRawRequest, opts ...grpc.CallOption) (*FetchBlocksMetadataRawResult, error)
+	FetchBlocksMetadataRawV2(ctx context.Context, in *FetchBlocksMetadataRawV2Request, opts ...grpc.CallOption) (*FetchBlocksMetadataRawV2Result, error)
+	WriteBatchRaw(ctx context.Context, in *WriteBatchRawRequest, opts ...grpc.CallOption) (*WriteBatchRawErrors, error)
+	WriteTaggedBatchRaw(ctx context.Context, in *WriteTaggedBatchRawRequest, opts ...grpc.CallOption) (*WriteBatchRawErrors, error)
+	Repair(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	Truncate(ctx context.Context, in *TruncateRequest, opts ...grpc.CallOption) (*TruncateResult, error)
+	Health(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NodeHealthResult, error)
+	GetPersistRateLimit(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NodePersistRateLimitResult, error)
+	SetPersistRateLimit(ctx context.Context, in *NodeSetPersistRateLimitRequest, opts ...grpc.CallOption) (*NodePersistRateLimitResult, error)
+	GetWriteNewSeriesAsync(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NodeWriteNewSeriesAsyncResult, error)
+	SetWriteNewSeriesAsync(ctx context.Context, in *NodeSetWriteNewSeriesAsyncRequest, opts ...grpc.CallOption) (*NodeWriteNewSeriesAsyncResult, error)
+	GetWriteNewSeriesBackoffDuration(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NodeWriteNewSeriesBackoffDurationResult, error)
+	SetWriteNewSeriesBackoffDuration(ctx context.Context, in *NodeSetWriteNewSeriesBackoffDurationRequest, opts ...grpc.CallOption) (*NodeWriteNewSeriesBackoffDurationResult, error)
+	GetWriteNewSeriesLimitPerShardPerSecond(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NodeWriteNewSeriesLimitPerShardPerSecondResult, error)
+	SetWriteNewSeriesLimitPerShardPerSecond(ctx context.Context, in *NodeSetWriteNewSeriesLimitPerShardPerSecondRequest, opts ...grpc.CallOption) (*NodeWriteNewSeriesLimitPerShardPerSecondResult, error)
+	GetBootstrapStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NodeBootstrapStatusResult, error)
+}
+
+type nodeClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNodeClient(cc *grpc.ClientConn) NodeClient {
+	return &nodeClient{cc}
+}
+
+func (c *nodeClient) Fetch(ctx context.Context, in *FetchRequest, opts ...grpc.CallOption) (*FetchResult, error) {
+	out := new(FetchResult)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/Fetch", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) FetchTagged(ctx context.Context, in *FetchTaggedRequest, opts ...grpc.CallOption) (*FetchTaggedResult, error) {
+	out := new(FetchTaggedResult)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/FetchTagged", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Write(ctx context.Context, in *WriteRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/Write", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) WriteTagged(ctx context.Context, in *WriteTaggedRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/WriteTagged", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) FetchBatchRaw(ctx context.Context, in *FetchBatchRawRequest, opts ...grpc.CallOption) (*FetchBatchRawResult, error) {
+	out := new(FetchBatchRawResult)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/FetchBatchRaw", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) FetchBatchRawPaged(ctx context.Context, in *FetchBatchRawPagedRequest, opts ...grpc.CallOption) (*FetchBatchRawPagedResult, error) {
+	out := new(FetchBatchRawPagedResult)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/FetchBatchRawPaged", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) FetchBlocksRaw(ctx context.Context, in *FetchBlocksRawRequest, opts ...grpc.CallOption) (*FetchBlocksRawResult, error) {
+	out := new(FetchBlocksRawResult)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/FetchBlocksRaw", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) FetchBlocksMetadataRaw(ctx context.Context, in *FetchBlocksMetadataRawRequest, opts ...grpc.CallOption) (*FetchBlocksMetadataRawResult, error) {
+	out := new(FetchBlocksMetadataRawResult)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/FetchBlocksMetadataRaw", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) FetchBlocksMetadataRawV2(ctx context.Context, in *FetchBlocksMetadataRawV2Request, opts ...grpc.CallOption) (*FetchBlocksMetadataRawV2Result, error) {
+	out := new(FetchBlocksMetadataRawV2Result)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/FetchBlocksMetadataRawV2", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) WriteBatchRaw(ctx context.Context, in *WriteBatchRawRequest, opts ...grpc.CallOption) (*WriteBatchRawErrors, error) {
+	out := new(WriteBatchRawErrors)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/WriteBatchRaw", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) WriteTaggedBatchRaw(ctx context.Context, in *WriteTaggedBatchRawRequest, opts ...grpc.CallOption) (*WriteBatchRawErrors, error) {
+	out := new(WriteBatchRawErrors)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/WriteTaggedBatchRaw", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Repair(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/Repair", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Truncate(ctx context.Context, in *TruncateRequest, opts ...grpc.CallOption) (*TruncateResult, error) {
+	out := new(TruncateResult)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/Truncate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Health(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NodeHealthResult, error) {
+	out := new(NodeHealthResult)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/Health", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) GetPersistRateLimit(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NodePersistRateLimitResult, error) {
+	out := new(NodePersistRateLimitResult)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/GetPersistRateLimit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) SetPersistRateLimit(ctx context.Context, in *NodeSetPersistRateLimitRequest, opts ...grpc.CallOption) (*NodePersistRateLimitResult, error) {
+	out := new(NodePersistRateLimitResult)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/SetPersistRateLimit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) GetWriteNewSeriesAsync(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NodeWriteNewSeriesAsyncResult, error) {
+	out := new(NodeWriteNewSeriesAsyncResult)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/GetWriteNewSeriesAsync", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) SetWriteNewSeriesAsync(ctx context.Context, in *NodeSetWriteNewSeriesAsyncRequest, opts ...grpc.CallOption) (*NodeWriteNewSeriesAsyncResult, error) {
+	out := new(NodeWriteNewSeriesAsyncResult)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/SetWriteNewSeriesAsync", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) GetWriteNewSeriesBackoffDuration(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NodeWriteNewSeriesBackoffDurationResult, error) {
+	out := new(NodeWriteNewSeriesBackoffDurationResult)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/GetWriteNewSeriesBackoffDuration", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) SetWriteNewSeriesBackoffDuration(ctx context.Context, in *NodeSetWriteNewSeriesBackoffDurationRequest, opts ...grpc.CallOption) (*NodeWriteNewSeriesBackoffDurationResult, error) {
+	out := new(NodeWriteNewSeriesBackoffDurationResult)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/SetWriteNewSeriesBackoffDuration", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) GetWriteNewSeriesLimitPerShardPerSecond(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NodeWriteNewSeriesLimitPerShardPerSecondResult, error) {
+	out := new(NodeWriteNewSeriesLimitPerShardPerSecondResult)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/GetWriteNewSeriesLimitPerShardPerSecond", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) SetWriteNewSeriesLimitPerShardPerSecond(ctx context.Context, in *NodeSetWriteNewSeriesLimitPerShardPerSecondRequest, opts ...grpc.CallOption) (*NodeWriteNewSeriesLimitPerShardPerSecondResult, error) {
+	out := new(NodeWriteNewSeriesLimitPerShardPerSecondResult)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/SetWriteNewSeriesLimitPerShardPerSecond", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) GetBootstrapStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NodeBootstrapStatusResult, error) {
+	out := new(NodeBootstrapStatusResult)
+	err := grpc.Invoke(ctx, "/rpcpb.Node/GetBootstrapStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Node service
+
+type NodeServer interface {
+	Fetch(context.Context, *FetchRequest) (*FetchResult, error)
+	FetchTagged(context.Context, *FetchTaggedRequest) (*FetchTaggedResult, error)
+	Write(context.Context, *WriteRequest) (*Empty, error)
+	WriteTagged(context.Context, *WriteTaggedRequest) (*Empty, error)
+	FetchBatchRaw(context.Context, *FetchBatchRawRequest) (*FetchBatchRawResult, error)
+	FetchBatchRawPaged(context.Context, *FetchBatchRawPagedRequest) (*FetchBatchRawPagedResult, error)
+	FetchBlocksRaw(context.Context, *FetchBlocksRawRequest) (*FetchBlocksRawResult, error)
+	FetchBlocksMetadataRaw(context.Context, *FetchBlocksMetadataRawRequest) (*FetchBlocksMetadataRawResult, error)
+	FetchBlocksMetadataRawV2(context.Context, *FetchBlocksMetadataRawV2Request) (*FetchBlocksMetadataRawV2Result, error)
+	WriteBatchRaw(context.Context, *WriteBatchRawRequest) (*WriteBatchRawErrors, error)
+	WriteTaggedBatchRaw(context.Context, *WriteTaggedBatchRawRequest) (*WriteBatchRawErrors, error)
+	Repair(context.Context, *Empty) (*Empty, error)
+	Truncate(context.Context, *TruncateRequest) (*TruncateResult, error)
+	Health(context.Context, *Empty) (*NodeHealthResult, error)
+	GetPersistRateLimit(context.Context, *Empty) (*NodePersistRateLimitResult, error)
+	SetPersistRateLimit(context.Context, *NodeSetPersistRateLimitRequest) (*NodePersistRateLimitResult, error)
+	GetWriteNewSeriesAsync(context.Context, *Empty) (*NodeWriteNewSeriesAsyncResult, error)
+	SetWriteNewSeriesAsync(context.Context, *NodeSetWriteNewSeriesAsyncRequest) (*NodeWriteNewSeriesAsyncResult, error)
+	GetWriteNewSeriesBackoffDuration(context.Context, *Empty) (*NodeWriteNewSeriesBackoffDurationResult, error)
+	SetWriteNewSeriesBackoffDuration(context.Context, *NodeSetWriteNewSeriesBackoffDurationRequest) (*NodeWriteNewSeriesBackoffDurationResult, error)
+	GetWriteNewSeriesLimitPerShardPerSecond(context.Context, *Empty) (*NodeWriteNewSeriesLimitPerShardPerSecondResult, error)
+	SetWriteNewSeriesLimitPerShardPerSecond(context.Context, *NodeSetWriteNewSeriesLimitPerShardPerSecondRequest) (*NodeWriteNewSeriesLimitPerShardPerSecondResult, error)
+	GetBootstrapStatus(context.Context, *Empty) (*NodeBootstrapStatusResult, error)
+}
+
+func RegisterNodeServer(s *grpc.Server, srv NodeServer) {
+	s.RegisterService(&_Node_serviceDesc, srv)
+}
+
+func _Node_Fetch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Fetch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/Fetch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Fetch(ctx, req.(*FetchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_FetchTagged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchTaggedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).FetchTagged(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/FetchTagged",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).FetchTagged(ctx, req.(*FetchTaggedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Write_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Write(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/Write",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Write(ctx, req.(*WriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_WriteTagged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteTaggedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).WriteTagged(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/WriteTagged",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).WriteTagged(ctx, req.(*WriteTaggedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_FetchBatchRaw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchBatchRawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).FetchBatchRaw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/FetchBatchRaw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).FetchBatchRaw(ctx, req.(*FetchBatchRawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_FetchBatchRawPaged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchBatchRawPagedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).FetchBatchRawPaged(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/FetchBatchRawPaged",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).FetchBatchRawPaged(ctx, req.(*FetchBatchRawPagedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_FetchBlocksRaw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchBlocksRawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).FetchBlocksRaw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/FetchBlocksRaw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).FetchBlocksRaw(ctx, req.(*FetchBlocksRawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_FetchBlocksMetadataRaw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchBlocksMetadataRawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).FetchBlocksMetadataRaw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/FetchBlocksMetadataRaw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).FetchBlocksMetadataRaw(ctx, req.(*FetchBlocksMetadataRawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_FetchBlocksMetadataRawV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchBlocksMetadataRawV2Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).FetchBlocksMetadataRawV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/FetchBlocksMetadataRawV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).FetchBlocksMetadataRawV2(ctx, req.(*FetchBlocksMetadataRawV2Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_WriteBatchRaw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteBatchRawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).WriteBatchRaw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/WriteBatchRaw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).WriteBatchRaw(ctx, req.(*WriteBatchRawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_WriteTaggedBatchRaw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteTaggedBatchRawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).WriteTaggedBatchRaw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/WriteTaggedBatchRaw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).WriteTaggedBatchRaw(ctx, req.(*WriteTaggedBatchRawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Repair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Repair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/Repair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Repair(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Truncate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TruncateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Truncate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/Truncate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Truncate(ctx, req.(*TruncateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Health(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_GetPersistRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetPersistRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/GetPersistRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetPersistRateLimit(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_SetPersistRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeSetPersistRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).SetPersistRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/SetPersistRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).SetPersistRateLimit(ctx, req.(*NodeSetPersistRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_GetWriteNewSeriesAsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetWriteNewSeriesAsync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/GetWriteNewSeriesAsync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetWriteNewSeriesAsync(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_SetWriteNewSeriesAsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeSetWriteNewSeriesAsyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).SetWriteNewSeriesAsync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/SetWriteNewSeriesAsync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).SetWriteNewSeriesAsync(ctx, req.(*NodeSetWriteNewSeriesAsyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_GetWriteNewSeriesBackoffDuration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetWriteNewSeriesBackoffDuration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/GetWriteNewSeriesBackoffDuration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetWriteNewSeriesBackoffDuration(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_SetWriteNewSeriesBackoffDuration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeSetWriteNewSeriesBackoffDurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).SetWriteNewSeriesBackoffDuration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/SetWriteNewSeriesBackoffDuration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).SetWriteNewSeriesBackoffDuration(ctx, req.(*NodeSetWriteNewSeriesBackoffDurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_GetWriteNewSeriesLimitPerShardPerSecond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetWriteNewSeriesLimitPerShardPerSecond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/GetWriteNewSeriesLimitPerShardPerSecond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetWriteNewSeriesLimitPerShardPerSecond(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_SetWriteNewSeriesLimitPerShardPerSecond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeSetWriteNewSeriesLimitPerShardPerSecondRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).SetWriteNewSeriesLimitPerShardPerSecond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/SetWriteNewSeriesLimitPerShardPerSecond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).SetWriteNewSeriesLimitPerShardPerSecond(ctx, req.(*NodeSetWriteNewSeriesLimitPerShardPerSecondRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_GetBootstrapStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetBootstrapStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Node/GetBootstrapStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetBootstrapStatus(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Node_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.Node",
+	HandlerType: (*NodeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Fetch",
+			Handler:    _Node_Fetch_Handler,
+		},
+		{
+			MethodName: "FetchTagged",
+			Handler:    _Node_FetchTagged_Handler,
+		},
+		{
+			MethodName: "Write",
+			Handler:    _Node_Write_Handler,
+		},
+		{
+			MethodName: "WriteTagged",
+			Handler:    _Node_WriteTagged_Handler,
+		},
+		{
+			MethodName: "FetchBatchRaw",
+			Handler:    _Node_FetchBatchRaw_Handler,
+		},
+		{
+			MethodName: "FetchBatchRawPaged",
+			Handler:    _Node_FetchBatchRawPaged_Handler,
+		},
+		{
+			MethodName: "FetchBlocksRaw",
+			Handler:    _Node_FetchBlocksRaw_Handler,
+		},
+		{
+			MethodName: "FetchBlocksMetadataRaw",
+			Handler:    _Node_FetchBlocksMetadataRaw_Handler,
+		},
+		{
+			MethodName: "FetchBlocksMetadataRawV2",
+			Handler:    _Node_FetchBlocksMetadataRawV2_Handler,
+		},
+		{
+			MethodName: "WriteBatchRaw",
+			Handler:    _Node_WriteBatchRaw_Handler,
+		},
+		{
+			MethodName: "WriteTaggedBatchRaw",
+			Handler:    _Node_WriteTaggedBatchRaw_Handler,
+		},
+		{
+			MethodName: "Repair",
+			Handler:    _Node_Repair_Handler,
+		},
+		{
+			MethodName: "Truncate",
+			Handler:    _Node_Truncate_Handler,
+		},
+		{
+			MethodName: "Health",
+			Handler:    _Node_Health_Handler,
+		},
+		{
+			MethodName: "GetPersistRateLimit",
+			Handler:    _Node_GetPersistRateLimit_Handler,
+		},
+		{
+			MethodName: "SetPersistRateLimit",
+			Handler:    _Node_SetPersistRateLimit_Handler,
+		},
+		{
+			MethodName: "GetWriteNewSeriesAsync",
+			Handler:    _Node_GetWriteNewSeriesAsync_Handler,
+		},
+		{
+			MethodName: "SetWriteNewSeriesAsync",
+			Handler:    _Node_SetWriteNewSeriesAsync_Handler,
+		},
+		{
+			MethodName: "GetWriteNewSeriesBackoffDuration",
+			Handler:    _Node_GetWriteNewSeriesBackoffDuration_Handler,
+		},
+		{
+			MethodName: "SetWriteNewSeriesBackoffDuration",
+			Handler:    _Node_SetWriteNewSeriesBackoffDuration_Handler,
+		},
+		{
+			MethodName: "GetWriteNewSeriesLimitPerShardPerSecond",
+			Handler:    _Node_GetWriteNewSeriesLimitPerShardPerSecond_Handler,
+		},
+		{
+			MethodName: "SetWriteNewSeriesLimitPerShardPerSecond",
+			Handler:    _Node_SetWriteNewSeriesLimitPerShardPerSecond_Handler,
+		},
+		{
+			MethodName: "GetBootstrapStatus",
+			Handler:    _Node_GetBootstrapStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc.proto",
+}
+
+// Client API for Cluster service
+
+type ClusterClient interface {
+	Health(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HealthResult, error)
+	Write(ctx context.Context, in *WriteRequest, opts ...grpc.CallOption) (*Empty, error)
+	WriteTagged(ctx context.Context, in *WriteTaggedRequest, opts ...grpc.CallOption) (*Empty, error)
+	Fetch(ctx context.Context, in *FetchRequest, opts ...grpc.CallOption) (*FetchResult, error)
+	FetchTagged(ctx context.Context, in *FetchTaggedRequest, opts ...grpc.CallOption) (*FetchTaggedResult, error)
+	Truncate(ctx context.Context, in *TruncateRequest, opts ...grpc.CallOption) (*TruncateResult, error)
+}
+
+type clusterClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewClusterClient(cc *grpc.ClientConn) ClusterClient {
+	return &clusterClient{cc}
+}
+
+func (c *clusterClient) Health(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HealthResult, error) {
+	out := new(HealthResult)
+	err := grpc.Invoke(ctx, "/rpcpb.Cluster/Health", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) Write(ctx context.Context, in *WriteRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/rpcpb.Cluster/Write", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) WriteTagged(ctx context.Context, in *WriteTaggedRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/rpcpb.Cluster/WriteTagged", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) Fetch(ctx context.Context, in *FetchRequest, opts ...grpc.CallOption) (*FetchResult, error) {
+	out := new(FetchResult)
+	err := grpc.Invoke(ctx, "/rpcpb.Cluster/Fetch", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) FetchTagged(ctx context.Context, in *FetchTaggedRequest, opts ...grpc.CallOption) (*FetchTaggedResult, error) {
+	out := new(FetchTaggedResult)
+	err := grpc.Invoke(ctx, "/rpcpb.Cluster/FetchTagged", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) Truncate(ctx context.Context, in *TruncateRequest, opts ...grpc.CallOption) (*TruncateResult, error) {
+	out := new(TruncateResult)
+	err := grpc.Invoke(ctx, "/rpcpb.Cluster/Truncate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Cluster service
+
+type ClusterServer interface {
+	Health(context.Context, *Empty) (*HealthResult, error)
+	Write(context.Context, *WriteRequest) (*Empty, error)
+	WriteTagged(context.Context, *WriteTaggedRequest) (*Empty, error)
+	Fetch(context.Context, *FetchRequest) (*FetchResult, error)
+	FetchTagged(context.Context, *FetchTaggedRequest) (*FetchTaggedResult, error)
+	Truncate(context.Context, *TruncateRequest) (*TruncateResult, error)
+}
+
+func RegisterClusterServer(s *grpc.Server, srv ClusterServer) {
+	s.RegisterService(&_Cluster_serviceDesc, srv)
+}
+
+func _Cluster_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Cluster/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).Health(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_Write_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).Write(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Cluster/Write",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).Write(ctx, req.(*WriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_WriteTagged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteTaggedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).WriteTagged(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Cluster/WriteTagged",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).WriteTagged(ctx, req.(*WriteTaggedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_Fetch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).Fetch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Cluster/Fetch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).Fetch(ctx, req.(*FetchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_FetchTagged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchTaggedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).FetchTagged(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Cluster/FetchTagged",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).FetchTagged(ctx, req.(*FetchTaggedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_Truncate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TruncateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).Truncate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.Cluster/Truncate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).Truncate(ctx, req.(*TruncateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Cluster_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.Cluster",
+	HandlerType: (*ClusterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Health",
+			Handler:    _Cluster_Health_Handler,
+		},
+		{
+			MethodName: "Write",
+			Handler:    _Cluster_Write_Handler,
+		},
+		{
+			MethodName: "WriteTagged",
+			Handler:    _Cluster_WriteTagged_Handler,
+		},
+		{
+			MethodName: "Fetch",
+			Handler:    _Cluster_Fetch_Handler,
+		},
+		{
+			MethodName: "FetchTagged",
+			Handler:    _Cluster_FetchTagged_Handler,
+		},
+		{
+			MethodName: "Truncate",
+			Handler:    _Cluster_Truncate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc.proto",
+}
+
+func init() { proto.RegisterFile("rpc.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 2632 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0xd5, 0x5a, 0xcd, 0x73, 0x1b, 0xb7,
+	0x15, 0xef, 0xf2, 0x9b, 0x8f, 0xb4, 0x44, 0x43, 0xb6, 0x4a, 0xd3, 0x5f, 0xd2, 0x5a, 0x8e, 0x5d,
+	0xb9, 0x95, 0x6d, 0xfa, 0x23, 0x4d, 0xd3, 0xc6, 0x43, 0x89, 0xb4, 0xac, 0x46, 0xa2, 0xe4, 0x25,
+	0x95, 0xf8, 0xd0, 0x54, 0x59, 0x91, 0xb0, 0xc4, 0x31, 0xb9, 0x64, 0xb9, 0x4b, 0x5b, 0xce, 0xa9,
+	0x87, 0x4e, 0xda, 0xe9, 0xf4, 0x0f, 0x68, 0x27, 0x33, 0x3d, 0xf5, 0xd0, 0x7f, 0xa1, 0x93, 0x53,
+	0x0f, 0x3d, 0xe5, 0x9e, 0x3f, 0x24, 0x33, 0xb9, 0x17, 0xc0, 0x02, 0xbb, 0x8b, 0x5d, 0xf0, 0x43,
+	0xce, 0x38, 0x69, 0x4f, 0x5c, 0x3c, 0x3c, 0x3c, 0x3c, 0xbc, 0x2f, 0xfc, 0x00, 0x10, 0xb2, 0xc3,
+	0x41, 0x6b, 0x6d, 0x30, 0xec, 0x3b, 0x7d, 0x94, 0x24, 0x9f, 0x83, 0x43, 0x7d, 0x13, 0x92, 0xb5,
+	0xe1, 0xb0, 0x3f, 0x44, 0x2b, 0x90, 0x70, 0x5e, 0x0f, 0x70, 0x51, 0x5b, 0xd2, 0x6e, 0xce, 0x95,
+	0x0b, 0x6b, 0xac, 0x7b, 0x8d, 0xf5, 0x35, 0x09, 0xdd, 0x60, 0xbd, 0xa8, 0x08, 0xe9, 0x1e, 0xb6,
+	0x6d, 0xf3, 0x08, 0x17, 0x63, 0x84, 0x31, 0x6b, 0x88, 0xa6, 0xfe, 0x04, 0x16, 0x3e, 0x1e, 0x76,
+	0x1c, 0xbc, 0x6e, 0x3a, 0xad, 0x63, 0xc3, 0x7c, 0xc5, 0x46, 0xda, 0xe8, 0x2e, 0xa4, 0x30, 0xfb,
+	0x22, 0x82, 0xe3, 0x37, 0x73, 0xe5, 0x0b, 0x5c, 0x70, 0x94, 0xd7, 0xe0, 0x8c, 0xfa, 0xbf, 0x63,
+	0x90, 0x7f, 0x8c, 0x69, 0x0f, 0xfe, 0xdd, 0x08, 0xdb, 0x0e, 0xba, 0x02, 0x30, 0x34, 0xad, 0x23,
+	0xdc, 0x70, 0xcc, 0xa1, 0xc3, 0x14, 0x8c, 0x1b, 0x01, 0x0a, 0x2a, 0x41, 0x86, 0xb5, 0x6a, 0x56,
+	0x9b, 0x69, 0x15, 0x37, 0xbc, 0x36, 0xba, 0x04, 0x59, 0xcb, 0xec, 0xe1, 0xc6, 0xc0, 0x6c, 0xe1,
+	0x62, 0x9c, 0xa9, 0xec, 0x13, 0xd0, 0x1c, 0xc4, 0x3a, 0xed, 0x62, 0x82, 0x91, 0xc9, 0x17, 0xfa,
+	0x19, 0x64, 0xd9, 0x48, 0xba, 0xe2, 0x62, 0x92, 0x59, 0x62, 0x9e, 0x2b, 0xdc, 0xec, 0xf4, 0x18,
+	0xd9, 0xf0, 0x39, 0xd0, 0xbb, 0x30, 0x37, 0xc4, 0xf6, 0xa8, 0xeb, 0x88, 0xce, 0x62, 0x4a, 0x3d,
+	0x26, 0xc4, 0x86, 0xae, 0x43, 0xc2, 0x76, 0xf0, 0xa0, 0x98, 0x26, 0xec, 0xb9, 0xf2, 0x59, 0xce,
+	0xbe, 0x65, 0x39, 0x0f, 0xef, 0x7f, 0x64, 0x76, 0x47, 0xc4, 0xda, 0xb4, 0x1b, 0xfd, 0x1c, 0x72,
+	0xe6, 0xd1, 0xd1, 0x10, 0x1f, 0x99, 0x4e, 0xa7, 0x6f, 0x15, 0x33, 0x4c, 0xf8, 0x22, 0xe7, 0xae,
+	0xf8, 0x3d, 0x6c, 0x8e, 0x20, 0xab, 0xfe, 0x08, 0x72, 0xdc, 0x84, 0x74, 0x5e, 0x74, 0x07, 0xa0,
+	0x6d, 0x3a, 0xe6, 0xa0, 0xdf, 0xb1, 0x1c, 0xe1, 0x09, 0xe1, 0xe2, 0xaa, 0xe8, 0x30, 0x02, 0x3c,
+	0xfa, 0xdf, 0x35, 0xc8, 0x7a, 0x3d, 0xd4, 0x8a, 0x0e, 0xd1, 0xdd, 0x76, 0xcc, 0xde, 0x80, 0x3b,
+	0xc0, 0x27, 0xa0, 0x73, 0x90, 0x7c, 0x49, 0xb5, 0x66, 0xc6, 0xd7, 0x0c, 0xb7, 0x41, 0xbd, 0x66,
+	0x5a, 0x56, 0xdf, 0x71, 0x75, 0xa7, 0xa6, 0xcf, 0x1b, 0x01, 0x0a, 0xfa, 0x15, 0x9c, 0xf5, 0x44,
+	0x78, 0xf6, 0x4b, 0xa8, 0xed, 0x17, 0xe5, 0xd4, 0xbb, 0x90, 0x67, 0x31, 0x24, 0x82, 0x44, 0x72,
+	0xb4, 0xa6, 0x76, 0x74, 0xcc, 0x73, 0xf4, 0x1a, 0x64, 0xbd, 0xc5, 0x32, 0xdd, 0x54, 0xf6, 0xf0,
+	0x59, 0xf4, 0xbf, 0x6a, 0x80, 0xd8, 0x74, 0x4d, 0x62, 0x65, 0xdc, 0x7e, 0xb3, 0x49, 0x69, 0x8a,
+	0x99, 0x47, 0x36, 0x99, 0x2f, 0x68, 0x7f, 0x22, 0xb1, 0xe1, 0x0c, 0x3b, 0xd6, 0x91, 0xc1, 0x7a,
+	0x65, 0xd5, 0x12, 0xd3, 0x55, 0xfb, 0x97, 0x06, 0xe7, 0x98, 0xaf, 0x45, 0x36, 0xbd, 0x95, 0xb4,
+	0xc9, 0x07, 0x17, 0x56, 0x80, 0x78, 0xa7, 0x6d, 0x13, 0xe5, 0xe2, 0x84, 0x4e, 0x3f, 0xd1, 0x03,
+	0x38, 0xe3, 0xa6, 0x85, 0x70, 0xe4, 0x98, 0xe4, 0x91, 0xb9, 0x68, 0xd1, 0x08, 0xa9, 0xce, 0xc2,
+	0xf5, 0x2e, 0x64, 0x70, 0x17, 0xf7, 0xb0, 0x1f, 0xac, 0xe7, 0xb9, 0x20, 0x37, 0xa8, 0x05, 0xa3,
+	0xe1, 0xb1, 0xe9, 0x9f, 0xc0, 0x9c, 0xdc, 0x87, 0x6e, 0x41, 0xc6, 0xc6, 0x47, 0x41, 0x21, 0x42,
+	0x9b, 0x06, 0x27, 0x1b, 0x1e, 0x03, 0xb1, 0x55, 0x9c, 0x54, 0x1f, 0x66, 0x86, 0x5c, 0x39, 0x1f,
+	0x2c, 0x7e, 0x06, 0xed, 0xd0, 0xbf, 0xd1, 0xe0, 0x82, 0xa4, 0xe9, 0x9e, 0x19, 0x08, 0x83, 0xef,
+	0xd3, 0xd2, 0x24, 0xd9, 0xba, 0x9d, 0x5e, 0xc7, 0x61, 0x16, 0x8e, 0x1b, 0x6e, 0x83, 0x4a, 0x19,
+	0x10, 0x8d, 0x9a, 0xfd, 0x17, 0xd8, 0x62, 0x45, 0x88, 0x48, 0xf1, 0x08, 0x51, 0xef, 0xa4, 0x67,
+	0xf2, 0xce, 0x09, 0x14, 0x55, 0x6b, 0x66, 0xd6, 0x7d, 0x18, 0x71, 0x51, 0x29, 0xe4, 0xa2, 0x00,
+	0xb7, 0xef, 0x27, 0x92, 0x03, 0x67, 0x2c, 0x7c, 0xe2, 0xec, 0x79, 0xca, 0xc6, 0x98, 0xb2, 0x32,
+	0x91, 0xcc, 0xbc, 0xa0, 0x10, 0x43, 0xd7, 0xde, 0xb1, 0xda, 0xf8, 0x84, 0x9b, 0xd8, 0x6d, 0x48,
+	0x8e, 0x8e, 0xcd, 0xe8, 0xe8, 0xf8, 0x38, 0x47, 0xff, 0x16, 0x32, 0x62, 0x14, 0x7a, 0x07, 0x52,
+	0x3d, 0x3c, 0x24, 0xd3, 0xb3, 0xf9, 0x72, 0xe5, 0x39, 0x59, 0xac, 0xc1, 0x7b, 0xd1, 0x2a, 0x64,
+	0x46, 0x16, 0xe7, 0x74, 0x15, 0x08, 0x73, 0x7a, 0xfd, 0xfa, 0x5d, 0x48, 0x73, 0x22, 0x42, 0x90,
+	0x38, 0xc6, 0xa6, 0x2b, 0x3c, 0x6f, 0xb0, 0x6f, 0x4a, 0x73, 0xcc, 0x4e, 0x97, 0x5b, 0x85, 0x7d,
+	0xeb, 0x5f, 0xc7, 0x00, 0x31, 0x6b, 0xc8, 0xb5, 0xe7, 0x3a, 0x24, 0xc9, 0xc7, 0xf0, 0x35, 0x57,
+	0x4e, 0xac, 0x79, 0xab, 0x7d, 0xf2, 0x94, 0x92, 0x0d, 0xb7, 0x37, 0x14, 0x9b, 0xb1, 0x89, 0xb1,
+	0x19, 0x8f, 0xc6, 0xe6, 0x73, 0x3a, 0x31, 0xad, 0x3b, 0xac, 0x14, 0x65, 0x0c, 0x9f, 0x80, 0x6e,
+	0x04, 0x23, 0x51, 0xb9, 0x8b, 0xf1, 0xe0, 0x8c, 0x84, 0x5f, 0x6a, 0x96, 0xf0, 0x7b, 0xfb, 0x9b,
+	0x24, 0xd9, 0xe3, 0x32, 0xc2, 0x5c, 0xa8, 0x0c, 0x99, 0xfe, 0x00, 0x0f, 0x4d, 0xa7, 0x3f, 0xe4,
+	0x18, 0x48, 0xc8, 0x58, 0xef, 0xf7, 0xbb, 0xd8, 0xb4, 0x76, 0x79, 0xaf, 0xe1, 0xf1, 0x11, 0xb8,
+	0x90, 0x7e, 0xde, 0xe9, 0x3a, 0x78, 0x28, 0x02, 0x6f, 0xc1, 0x77, 0x02, 0x71, 0xd6, 0x63, 0xd6,
+	0x67, 0x08, 0x1e, 0x74, 0x1b, 0xc0, 0x1e, 0x1d, 0xd2, 0xe9, 0x3a, 0x58, 0xec, 0x02, 0x11, 0xb7,
+	0x05, 0x58, 0xf4, 0xdf, 0x6b, 0x90, 0x0f, 0x8a, 0xa2, 0xf0, 0x8b, 0xec, 0x11, 0x75, 0x52, 0x1e,
+	0xf8, 0x6e, 0x23, 0x9a, 0x24, 0x56, 0xe7, 0xc8, 0x27, 0xb3, 0x8b, 0xcb, 0xcb, 0xf7, 0x9d, 0x10,
+	0x15, 0x2d, 0x42, 0xca, 0xa2, 0xeb, 0x77, 0x6b, 0x4d, 0xc6, 0xe0, 0x2d, 0x4a, 0x27, 0x76, 0xc1,
+	0x27, 0x03, 0xee, 0x67, 0xde, 0xd2, 0x5f, 0xc0, 0x59, 0x29, 0xf6, 0x66, 0x49, 0x7e, 0x97, 0x77,
+	0xab, 0x1a, 0x49, 0x7e, 0x12, 0x8b, 0xf8, 0xe4, 0xd8, 0x1c, 0xd9, 0x4e, 0xe7, 0xa5, 0x8b, 0x16,
+	0x32, 0x46, 0x80, 0xa2, 0x7f, 0xa9, 0xf1, 0xbc, 0x97, 0x25, 0xf0, 0x8d, 0x54, 0xf3, 0x36, 0x52,
+	0xa9, 0x66, 0xc6, 0xc2, 0xdb, 0xee, 0x6c, 0xdb, 0xac, 0x0c, 0x89, 0x92, 0xd3, 0x21, 0x91, 0x28,
+	0x1d, 0xa9, 0x71, 0xa5, 0xe3, 0x2f, 0x1a, 0x9c, 0x77, 0xeb, 0x65, 0xb7, 0xdf, 0x7a, 0x61, 0x07,
+	0x76, 0xe2, 0x08, 0x4c, 0x90, 0x6a, 0x3c, 0xa9, 0x6a, 0xf6, 0xb1, 0x39, 0x74, 0xb7, 0x86, 0xa4,
+	0xe1, 0x36, 0xd0, 0xa3, 0x80, 0x8d, 0xdd, 0x95, 0x5c, 0x0b, 0xda, 0x38, 0x3c, 0x47, 0xcd, 0xe5,
+	0x0d, 0xec, 0x88, 0x8f, 0xe1, 0xd2, 0x24, 0xce, 0x80, 0x51, 0xf3, 0xcc, 0xa8, 0x24, 0x02, 0x6c,
+	0x5a, 0x11, 0xdc, 0x58, 0x8e, 0x1b, 0xbc, 0xa5, 0x57, 0x04, 0xbc, 0xf0, 0xe5, 0x30, 0xa7, 0xfc,
+	0x24, 0x12, 0x04, 0x67, 0x44, 0xc2, 0xb8, 0x9c, 0xbe, 0x2a, 0x1f, 0x40, 0xca, 0xa5, 0x45, 0x26,
+	0x5d, 0x81, 0xd4, 0x21, 0xeb, 0xe1, 0x09, 0x94, 0x0f, 0x8a, 0x30, 0x78, 0x9f, 0xfe, 0x37, 0x0d,
+	0x92, 0x8c, 0xc2, 0x6c, 0x15, 0xd8, 0x64, 0xdd, 0x46, 0x68, 0x07, 0xd0, 0xbe, 0xd3, 0x0e, 0x40,
+	0x92, 0x3a, 0xd3, 0x3a, 0xc6, 0x64, 0xda, 0x51, 0x8f, 0xc3, 0x2f, 0x45, 0xe9, 0xf1, 0x58, 0xf4,
+	0x07, 0x90, 0xf5, 0x42, 0x8b, 0x96, 0x6f, 0xcb, 0x4f, 0x4e, 0xf6, 0x2d, 0xa3, 0xe3, 0x2c, 0x47,
+	0xc7, 0x7a, 0x19, 0x52, 0x64, 0x18, 0xb1, 0xa6, 0x34, 0x26, 0xaf, 0x1a, 0x93, 0x17, 0x63, 0x3e,
+	0x8f, 0xc3, 0xe5, 0x80, 0x2b, 0x76, 0xb0, 0x63, 0xd2, 0xf0, 0xfc, 0x8e, 0x81, 0x26, 0x6f, 0x10,
+	0xf1, 0x89, 0x1b, 0x44, 0x22, 0xb4, 0x41, 0xa8, 0xc1, 0xc8, 0xed, 0x30, 0x18, 0x51, 0x9a, 0x30,
+	0x80, 0x4f, 0xee, 0x43, 0xbe, 0x63, 0xb5, 0xba, 0xa3, 0x36, 0x6e, 0x74, 0x3e, 0x23, 0xa5, 0x31,
+	0x2d, 0xa1, 0x5e, 0x5a, 0x7f, 0xdd, 0x21, 0x12, 0x17, 0xfa, 0x25, 0x14, 0x78, 0x7b, 0x83, 0x3b,
+	0xc3, 0x66, 0xd5, 0x5f, 0x35, 0x32, 0xc2, 0x89, 0x7e, 0x01, 0xf3, 0x9c, 0xb6, 0x6d, 0xda, 0x8e,
+	0x41, 0x37, 0xe2, 0xec, 0x98, 0xc1, 0x61, 0x46, 0xfd, 0xcf, 0x9a, 0x94, 0x5b, 0x92, 0x23, 0xa6,
+	0x00, 0xd8, 0xd0, 0x08, 0xbf, 0x36, 0xbe, 0xab, 0x02, 0x46, 0x4a, 0xc3, 0x85, 0xb0, 0x52, 0x1d,
+	0xe6, 0x64, 0xa1, 0x91, 0x24, 0xfb, 0x69, 0x28, 0xc9, 0xce, 0x05, 0x75, 0xf1, 0x54, 0x11, 0xc9,
+	0xf6, 0xc7, 0x18, 0x9c, 0x91, 0x7a, 0x44, 0xc6, 0x68, 0xe3, 0x32, 0xc6, 0x4b, 0xca, 0x58, 0x30,
+	0x29, 0xe9, 0xf6, 0x4d, 0xfc, 0xc4, 0x13, 0x4d, 0xb9, 0x7d, 0x93, 0xee, 0x53, 0xa6, 0x1b, 0x65,
+	0xef, 0x0a, 0x7f, 0x8d, 0xc5, 0x1d, 0x1e, 0x0b, 0x7a, 0x1f, 0x0a, 0xe2, 0x7b, 0x1a, 0xfa, 0x88,
+	0x30, 0xea, 0xdf, 0xc6, 0xe0, 0xaa, 0xda, 0xcd, 0x1f, 0x95, 0xff, 0xb7, 0x32, 0x6e, 0x32, 0xfc,
+	0xff, 0x7f, 0x4b, 0xaf, 0xcf, 0xe0, 0xca, 0x78, 0xb3, 0xb3, 0xfc, 0x2a, 0x47, 0xf2, 0x6b, 0x51,
+	0x15, 0xd3, 0x84, 0xff, 0xb4, 0x27, 0x8f, 0x2f, 0x63, 0x30, 0x1f, 0x92, 0x11, 0xc9, 0x27, 0x75,
+	0xbc, 0x4f, 0xdb, 0x57, 0x44, 0x3e, 0x24, 0x66, 0xcf, 0x87, 0xe4, 0xe9, 0xf2, 0x21, 0xf5, 0x66,
+	0xf9, 0x90, 0x9e, 0x31, 0x1f, 0xd0, 0x12, 0xe4, 0xb0, 0xd5, 0xea, 0xb7, 0x71, 0xbb, 0x49, 0xf1,
+	0x55, 0x86, 0x19, 0x24, 0x48, 0xd2, 0x1d, 0x38, 0x27, 0x5d, 0xec, 0xcd, 0x96, 0x25, 0x1f, 0x04,
+	0xbc, 0xe9, 0x56, 0x28, 0x5d, 0x75, 0x4b, 0x38, 0x16, 0xe9, 0x7c, 0x02, 0x17, 0x27, 0x30, 0x46,
+	0xdc, 0x27, 0x5d, 0xb0, 0xc4, 0xa6, 0x5f, 0xb0, 0x10, 0x14, 0x5e, 0x0a, 0xdc, 0xfd, 0x9c, 0x6e,
+	0x6d, 0xd5, 0xc8, 0xda, 0x6e, 0x06, 0xd7, 0xa6, 0x14, 0x19, 0x5d, 0xe1, 0xe7, 0x1a, 0x2c, 0x4f,
+	0xe5, 0x8f, 0x2c, 0x74, 0x99, 0x03, 0xe1, 0x98, 0x84, 0xce, 0x5c, 0xd8, 0xa1, 0xba, 0x6c, 0x9a,
+	0xe1, 0x1e, 0xec, 0xd7, 0xfc, 0x1a, 0x4c, 0xba, 0xb9, 0x1d, 0x73, 0x2e, 0x9f, 0x76, 0xa7, 0x72,
+	0x1b, 0xe6, 0x9b, 0xc3, 0x91, 0xd5, 0x32, 0x27, 0x5c, 0xe2, 0x05, 0x6d, 0xa9, 0xaf, 0xc1, 0x9c,
+	0x3f, 0x80, 0xd5, 0x01, 0xca, 0x3f, 0xea, 0x35, 0xdc, 0x03, 0x15, 0xbf, 0x97, 0xf4, 0x08, 0xe4,
+	0x2c, 0x5f, 0xa8, 0x93, 0xd0, 0x7c, 0x82, 0xcd, 0xae, 0x73, 0xec, 0x1f, 0x25, 0xfa, 0x2f, 0x18,
+	0x6b, 0xc6, 0x20, 0x5f, 0x1c, 0xf5, 0x3a, 0x23, 0x9b, 0xc3, 0x33, 0xde, 0x42, 0x3a, 0xe4, 0x0f,
+	0xfb, 0x7d, 0xc7, 0x76, 0x86, 0xe6, 0x60, 0x80, 0xdb, 0xfc, 0xb4, 0x24, 0xd1, 0xf4, 0x3f, 0x91,
+	0xc0, 0xa0, 0x13, 0xec, 0x91, 0xc3, 0x5d, 0x87, 0xe4, 0x0a, 0xd1, 0x6b, 0x9b, 0x96, 0x63, 0x3e,
+	0x15, 0x11, 0xc1, 0xaa, 0x73, 0xcd, 0x32, 0x0f, 0xbb, 0xfc, 0x12, 0x81, 0x88, 0x08, 0xd2, 0xe8,
+	0x02, 0x58, 0x7b, 0xe7, 0x70, 0x60, 0xf3, 0xeb, 0x53, 0x9f, 0x80, 0x6e, 0xc2, 0x3c, 0x6b, 0xb0,
+	0xb2, 0x5a, 0x7b, 0x49, 0x0f, 0xfb, 0xee, 0x6e, 0x11, 0x26, 0xeb, 0xff, 0xd1, 0xe0, 0x0a, 0x55,
+	0xa5, 0x81, 0x9d, 0xa8, 0x36, 0xae, 0x6d, 0xef, 0x2b, 0xd4, 0x51, 0xee, 0x02, 0x92, 0x82, 0x77,
+	0xc2, 0x0a, 0xe6, 0xca, 0x48, 0x04, 0x48, 0x7f, 0x44, 0x58, 0x38, 0x98, 0xf3, 0x95, 0x7e, 0x5f,
+	0xad, 0xb4, 0xb2, 0x30, 0x45, 0xd6, 0xf1, 0x14, 0x2e, 0xd3, 0x65, 0xb0, 0x18, 0xab, 0xe3, 0x57,
+	0xae, 0x23, 0x2b, 0xf6, 0x6b, 0xab, 0xe5, 0xdd, 0x64, 0x2f, 0xbc, 0x8a, 0x76, 0x72, 0xdb, 0xaa,
+	0xba, 0xf4, 0x7d, 0x58, 0xe6, 0x96, 0x51, 0x4a, 0x75, 0x8d, 0x73, 0x7a, 0xb1, 0xff, 0xd0, 0xe0,
+	0x46, 0x54, 0xd5, 0x75, 0xb3, 0xf5, 0xa2, 0xff, 0xfc, 0x79, 0x75, 0x34, 0x64, 0x57, 0x0c, 0x5c,
+	0xe9, 0x2a, 0x5c, 0x7e, 0x35, 0x89, 0x8d, 0x87, 0xee, 0x64, 0x26, 0x74, 0x0f, 0xf2, 0x6d, 0xfe,
+	0xcd, 0xea, 0x76, 0x4c, 0x5d, 0xb7, 0x25, 0x26, 0xfd, 0x9f, 0x1a, 0xdc, 0x52, 0x2e, 0x3f, 0xa2,
+	0xa9, 0x6b, 0x88, 0x1f, 0x50, 0xd5, 0x3f, 0x68, 0xb0, 0x16, 0xb5, 0x28, 0x8b, 0x61, 0x12, 0xd3,
+	0x0d, 0x0a, 0x9d, 0xe8, 0x2f, 0x6e, 0xf5, 0x2d, 0x71, 0x11, 0x61, 0xc0, 0xca, 0xab, 0x19, 0xb8,
+	0xb9, 0xd2, 0x33, 0xf1, 0xd2, 0xac, 0x2e, 0x2b, 0x2d, 0x36, 0x4e, 0x13, 0xd7, 0x70, 0x6f, 0x43,
+	0x95, 0xdf, 0xc0, 0x05, 0xaa, 0xc9, 0xba, 0x28, 0x3a, 0x0d, 0x56, 0x9b, 0xf8, 0xda, 0x1f, 0x01,
+	0xd0, 0xd2, 0x68, 0xd3, 0xd2, 0x28, 0x50, 0xd0, 0x55, 0x6e, 0xe1, 0xba, 0xe8, 0x08, 0x0f, 0x0d,
+	0x0c, 0xd1, 0x7b, 0x50, 0x1c, 0xc7, 0x37, 0xe5, 0x61, 0xe3, 0x1e, 0x29, 0x9a, 0x54, 0x53, 0xb1,
+	0xb5, 0x5c, 0x14, 0xa7, 0x6d, 0x4a, 0x0c, 0x4f, 0xc9, 0x59, 0xf5, 0xaf, 0x35, 0x38, 0xa7, 0x62,
+	0xf0, 0x41, 0xb2, 0x16, 0x04, 0xc9, 0x77, 0x18, 0xc8, 0x72, 0x44, 0xec, 0x94, 0xc6, 0x4e, 0x81,
+	0x0d, 0x97, 0x91, 0x95, 0xf2, 0xfe, 0x68, 0xe8, 0xbd, 0xf3, 0xf1, 0x16, 0x05, 0x7e, 0x36, 0xb3,
+	0x32, 0x03, 0x95, 0x58, 0x60, 0x6a, 0x99, 0xc8, 0x0a, 0xfe, 0x6b, 0xc7, 0x67, 0x72, 0xf1, 0xb5,
+	0x44, 0xa3, 0x9a, 0xb2, 0x37, 0x4a, 0x86, 0xb4, 0xc8, 0x51, 0x9e, 0x35, 0xf4, 0x87, 0x90, 0x7f,
+	0x93, 0x2d, 0x46, 0xd7, 0x01, 0xfc, 0x52, 0xe8, 0x1f, 0xf9, 0xf9, 0x1e, 0xea, 0x1e, 0xf9, 0x97,
+	0x21, 0xeb, 0x55, 0x66, 0x99, 0x25, 0x23, 0x58, 0xae, 0x41, 0x2e, 0x50, 0x89, 0x65, 0x26, 0xf1,
+	0x18, 0xa7, 0xa7, 0x21, 0x59, 0xeb, 0x0d, 0x9c, 0xd7, 0xab, 0x9f, 0x42, 0xc6, 0xc3, 0x73, 0x05,
+	0xc8, 0xef, 0xd7, 0xb7, 0x9e, 0x1d, 0x34, 0x6a, 0x1b, 0xbb, 0xf5, 0x6a, 0xa3, 0xf0, 0x23, 0x74,
+	0x1e, 0xce, 0x32, 0xca, 0xce, 0xd6, 0x86, 0xb1, 0x2b, 0xc8, 0x5a, 0x80, 0xbc, 0xbd, 0xbd, 0x25,
+	0xc8, 0x31, 0x32, 0x55, 0x81, 0x91, 0xeb, 0x95, 0xba, 0xc7, 0x1c, 0x5f, 0xad, 0x42, 0xd6, 0x7b,
+	0x35, 0x46, 0x08, 0xe6, 0xb6, 0xea, 0xcd, 0x9a, 0x51, 0xaf, 0x6c, 0x1f, 0xd4, 0x0c, 0x63, 0xd7,
+	0x20, 0x93, 0xcc, 0x43, 0x6e, 0xbd, 0x52, 0x3d, 0x30, 0x6a, 0x4f, 0xf7, 0x6b, 0x8d, 0x26, 0x11,
+	0x4f, 0x98, 0x9e, 0xee, 0xef, 0x36, 0x2b, 0x07, 0xb5, 0x67, 0x1b, 0xb5, 0x5a, 0xb5, 0x56, 0x2d,
+	0xc4, 0x56, 0x3f, 0x84, 0xf9, 0xd0, 0xdd, 0x2d, 0xca, 0x40, 0x62, 0xa7, 0x56, 0xa9, 0x13, 0x09,
+	0x69, 0x88, 0xef, 0x6c, 0xd5, 0xc9, 0x48, 0xfa, 0x51, 0x79, 0x46, 0x54, 0x21, 0x1f, 0x8d, 0xfd,
+	0x9d, 0x42, 0x1c, 0x65, 0x21, 0xb9, 0xb1, 0xbb, 0x5f, 0x6f, 0x16, 0x12, 0x94, 0x7f, 0xbb, 0x42,
+	0x26, 0x48, 0xae, 0x5e, 0x23, 0x98, 0x5e, 0xbe, 0xc4, 0xa5, 0x6b, 0xaf, 0xd4, 0xab, 0x07, 0xbb,
+	0x7b, 0x35, 0xa3, 0xd2, 0xa4, 0x6a, 0xad, 0x36, 0x60, 0x41, 0x11, 0x5c, 0x28, 0x07, 0xe9, 0xbd,
+	0x5a, 0xbd, 0xba, 0x55, 0xdf, 0x24, 0x13, 0x9f, 0x25, 0x47, 0xe3, 0xdd, 0xdd, 0x66, 0xa3, 0x69,
+	0x54, 0xf6, 0xf6, 0x28, 0x49, 0xa3, 0x82, 0x7c, 0x12, 0x55, 0x1d, 0x01, 0xa4, 0x1e, 0x57, 0xb6,
+	0xb6, 0xc9, 0x77, 0xbc, 0xfc, 0xcd, 0x1c, 0x24, 0x68, 0x0a, 0xd3, 0x70, 0x66, 0x51, 0x84, 0x16,
+	0xa4, 0x67, 0x12, 0xb7, 0x78, 0x94, 0x90, 0x4c, 0x64, 0x61, 0xb4, 0xce, 0x9f, 0x70, 0x5d, 0xc8,
+	0x87, 0x2e, 0x44, 0x6f, 0x58, 0xc5, 0xe8, 0xa2, 0xaa, 0x8b, 0xc9, 0x58, 0x85, 0x24, 0xab, 0x61,
+	0xde, 0xac, 0xc1, 0x27, 0xd3, 0x92, 0x87, 0xc9, 0x68, 0x64, 0xa0, 0x87, 0x90, 0x0b, 0x40, 0x4c,
+	0x74, 0x21, 0x0a, 0x53, 0xd5, 0xe3, 0x9e, 0xc0, 0x19, 0xe9, 0x95, 0x08, 0x5d, 0x94, 0xee, 0x29,
+	0x65, 0xa8, 0x5a, 0x2a, 0xa9, 0x3b, 0x99, 0xb6, 0x1f, 0xf3, 0x77, 0x0e, 0xe9, 0xbd, 0x09, 0x2d,
+	0xa9, 0x46, 0x04, 0x9f, 0xdf, 0x4a, 0x57, 0x27, 0x70, 0x30, 0xc1, 0x1f, 0xf2, 0xc7, 0x41, 0xef,
+	0x0a, 0x13, 0x5d, 0x9a, 0x74, 0x97, 0x5a, 0xba, 0x38, 0xa6, 0x97, 0x09, 0x6b, 0xc1, 0xa2, 0xfa,
+	0x74, 0x8a, 0x56, 0xa2, 0xc3, 0xa2, 0x77, 0x74, 0xa5, 0x6b, 0x53, 0xb8, 0xd8, 0x24, 0x1d, 0xf1,
+	0xf4, 0x16, 0x3d, 0x02, 0xa3, 0x77, 0x26, 0x0a, 0xf0, 0xae, 0x26, 0x4a, 0xd7, 0xa7, 0xf2, 0xb1,
+	0xa9, 0x88, 0xff, 0x24, 0x48, 0xef, 0xf9, 0x4f, 0x75, 0xa6, 0xf2, 0xfc, 0xa7, 0xfa, 0xaf, 0x47,
+	0x93, 0xff, 0x05, 0x44, 0x3e, 0xa4, 0xa0, 0xe5, 0xa9, 0x07, 0x9e, 0x89, 0x52, 0x57, 0x20, 0x65,
+	0xe0, 0x81, 0xd9, 0x19, 0x22, 0x29, 0xee, 0x42, 0x51, 0xf8, 0x1e, 0xa9, 0x6b, 0xfc, 0x6c, 0x80,
+	0xc4, 0x5d, 0x40, 0xe8, 0x74, 0x51, 0x3a, 0x1f, 0xa1, 0x33, 0x03, 0xdc, 0x86, 0x94, 0x5b, 0xbf,
+	0x43, 0x13, 0xfc, 0x58, 0x6c, 0xa6, 0xe1, 0x33, 0xc4, 0x63, 0x58, 0xd8, 0x8c, 0xe2, 0xec, 0xd0,
+	0xe8, 0xe5, 0xc0, 0xe8, 0x31, 0x07, 0x84, 0x03, 0x52, 0x71, 0x14, 0x72, 0xae, 0x07, 0x46, 0x8e,
+	0xc7, 0xf3, 0xb3, 0x4c, 0xb0, 0x0d, 0x8b, 0x9b, 0x4a, 0xd8, 0x1b, 0xd2, 0x75, 0x25, 0x20, 0x6a,
+	0x3c, 0xf4, 0x3e, 0x86, 0x45, 0x35, 0x88, 0x46, 0x37, 0x65, 0x8d, 0xc7, 0xe3, 0xec, 0x19, 0x67,
+	0xfa, 0x14, 0x96, 0x36, 0xa7, 0xe0, 0xd5, 0xd0, 0x0a, 0xd6, 0xc6, 0xca, 0x55, 0x23, 0x72, 0x72,
+	0xa0, 0x5e, 0x9a, 0x06, 0x89, 0x51, 0x79, 0xd2, 0xb2, 0xd4, 0xf8, 0xf9, 0xd4, 0x8a, 0x58, 0x70,
+	0x63, 0x73, 0x36, 0xa0, 0x19, 0x5a, 0xf1, 0x83, 0xb1, 0x13, 0x4d, 0x44, 0xcc, 0x5f, 0x90, 0x63,
+	0xcb, 0x8c, 0xc8, 0x16, 0xbd, 0x37, 0x69, 0xfd, 0x13, 0xd1, 0xf0, 0x9b, 0x6a, 0x57, 0x05, 0x44,
+	0xac, 0x11, 0x06, 0x88, 0xf2, 0xc2, 0x97, 0x02, 0xa2, 0x95, 0xc8, 0xb8, 0xfc, 0x55, 0x0c, 0xd2,
+	0x1b, 0xdd, 0x91, 0x4d, 0xdf, 0x3b, 0x6f, 0x8d, 0x49, 0x6e, 0xb1, 0x21, 0x4a, 0x89, 0xfd, 0x7d,
+	0x6c, 0x97, 0x3f, 0x0c, 0x10, 0x78, 0xf3, 0xf2, 0x78, 0x98, 0x62, 0xff, 0x17, 0xbc, 0xf7, 0x5f,
+	0x7d, 0x43, 0x40, 0x25, 0x3c, 0x28, 0x00, 0x00,
+}
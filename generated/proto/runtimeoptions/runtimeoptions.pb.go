@@ -0,0 +1,268 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-go.
+// source: runtimeoptions.proto
+// DO NOT EDIT!
+
+/*
+Package runtimeoptions is a generated protocol buffer package.
+
+It is generated from these files:
+
+	runtimeoptions.proto
+
+It has these top-level messages:
+
+	BoolValue
+	Int64Value
+	DoubleValue
+	RuntimeOptions
+	RuntimeOptionsValue
+*/
+package runtimeoptions
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type BoolValue struct {
+	Value bool `protobuf:"varint,1,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *BoolValue) Reset()                    { *m = BoolValue{} }
+func (m *BoolValue) String() string            { return proto.CompactTextString(m) }
+func (*BoolValue) ProtoMessage()               {}
+func (*BoolValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *BoolValue) GetValue() bool {
+	if m != nil {
+		return m.Value
+	}
+	return false
+}
+
+type Int64Value struct {
+	Value int64 `protobuf:"varint,1,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *Int64Value) Reset()                    { *m = Int64Value{} }
+func (m *Int64Value) String() string            { return proto.CompactTextString(m) }
+func (*Int64Value) ProtoMessage()               {}
+func (*Int64Value) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Int64Value) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type DoubleValue struct {
+	Value float64 `protobuf:"fixed64,1,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *DoubleValue) Reset()                    { *m = DoubleValue{} }
+func (m *DoubleValue) String() string            { return proto.CompactTextString(m) }
+func (*DoubleValue) ProtoMessage()               {}
+func (*DoubleValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *DoubleValue) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type RuntimeOptions struct {
+	PersistRateLimitEnabled              *BoolValue   `protobuf:"bytes,1,opt,name=persistRateLimitEnabled" json:"persistRateLimitEnabled,omitempty"`
+	PersistRateLimitMbps                 *DoubleValue `protobuf:"bytes,2,opt,name=persistRateLimitMbps" json:"persistRateLimitMbps,omitempty"`
+	PersistRateLimitCheckEvery           *Int64Value  `protobuf:"bytes,3,opt,name=persistRateLimitCheckEvery" json:"persistRateLimitCheckEvery,omitempty"`
+	WriteNewSeriesAsync                  *BoolValue   `protobuf:"bytes,4,opt,name=writeNewSeriesAsync" json:"writeNewSeriesAsync,omitempty"`
+	WriteNewSeriesBackoffDurationNanos   *Int64Value  `protobuf:"bytes,5,opt,name=writeNewSeriesBackoffDurationNanos" json:"writeNewSeriesBackoffDurationNanos,omitempty"`
+	WriteNewSeriesLimitPerShardPerSecond *Int64Value  `protobuf:"bytes,6,opt,name=writeNewSeriesLimitPerShardPerSecond" json:"writeNewSeriesLimitPerShardPerSecond,omitempty"`
+	MaxSeriesPerShard                    *Int64Value  `protobuf:"bytes,7,opt,name=maxSeriesPerShard" json:"maxSeriesPerShard,omitempty"`
+	MaxSeriesPerNamespace                *Int64Value  `protobuf:"bytes,8,opt,name=maxSeriesPerNamespace" json:"maxSeriesPerNamespace,omitempty"`
+	TickSeriesBatchSize                  *Int64Value  `protobuf:"bytes,9,opt,name=tickSeriesBatchSize" json:"tickSeriesBatchSize,omitempty"`
+	TickPerSeriesSleepDurationNanos      *Int64Value  `protobuf:"bytes,10,opt,name=tickPerSeriesSleepDurationNanos" json:"tickPerSeriesSleepDurationNanos,omitempty"`
+	TickMinimumIntervalNanos             *Int64Value  `protobuf:"bytes,11,opt,name=tickMinimumIntervalNanos" json:"tickMinimumIntervalNanos,omitempty"`
+}
+
+func (m *RuntimeOptions) Reset()                    { *m = RuntimeOptions{} }
+func (m *RuntimeOptions) String() string            { return proto.CompactTextString(m) }
+func (*RuntimeOptions) ProtoMessage()               {}
+func (*RuntimeOptions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *RuntimeOptions) GetPersistRateLimitEnabled() *BoolValue {
+	if m != nil {
+		return m.PersistRateLimitEnabled
+	}
+	return nil
+}
+
+func (m *RuntimeOptions) GetPersistRateLimitMbps() *DoubleValue {
+	if m != nil {
+		return m.PersistRateLimitMbps
+	}
+	return nil
+}
+
+func (m *RuntimeOptions) GetPersistRateLimitCheckEvery() *Int64Value {
+	if m != nil {
+		return m.PersistRateLimitCheckEvery
+	}
+	return nil
+}
+
+func (m *RuntimeOptions) GetWriteNewSeriesAsync() *BoolValue {
+	if m != nil {
+		return m.WriteNewSeriesAsync
+	}
+	return nil
+}
+
+func (m *RuntimeOptions) GetWriteNewSeriesBackoffDurationNanos() *Int64Value {
+	if m != nil {
+		return m.WriteNewSeriesBackoffDurationNanos
+	}
+	return nil
+}
+
+func (m *RuntimeOptions) GetWriteNewSeriesLimitPerShardPerSecond() *Int64Value {
+	if m != nil {
+		return m.WriteNewSeriesLimitPerShardPerSecond
+	}
+	return nil
+}
+
+func (m *RuntimeOptions) GetMaxSeriesPerShard() *Int64Value {
+	if m != nil {
+		return m.MaxSeriesPerShard
+	}
+	return nil
+}
+
+func (m *RuntimeOptions) GetMaxSeriesPerNamespace() *Int64Value {
+	if m != nil {
+		return m.MaxSeriesPerNamespace
+	}
+	return nil
+}
+
+func (m *RuntimeOptions) GetTickSeriesBatchSize() *Int64Value {
+	if m != nil {
+		return m.TickSeriesBatchSize
+	}
+	return nil
+}
+
+func (m *RuntimeOptions) GetTickPerSeriesSleepDurationNanos() *Int64Value {
+	if m != nil {
+		return m.TickPerSeriesSleepDurationNanos
+	}
+	return nil
+}
+
+func (m *RuntimeOptions) GetTickMinimumIntervalNanos() *Int64Value {
+	if m != nil {
+		return m.TickMinimumIntervalNanos
+	}
+	return nil
+}
+
+type RuntimeOptionsValue struct {
+	Defaults      *RuntimeOptions            `protobuf:"bytes,1,opt,name=defaults" json:"defaults,omitempty"`
+	HostOverrides map[string]*RuntimeOptions `protobuf:"bytes,2,rep,name=hostOverrides" json:"hostOverrides,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *RuntimeOptionsValue) Reset()                    { *m = RuntimeOptionsValue{} }
+func (m *RuntimeOptionsValue) String() string            { return proto.CompactTextString(m) }
+func (*RuntimeOptionsValue) ProtoMessage()               {}
+func (*RuntimeOptionsValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *RuntimeOptionsValue) GetDefaults() *RuntimeOptions {
+	if m != nil {
+		return m.Defaults
+	}
+	return nil
+}
+
+func (m *RuntimeOptionsValue) GetHostOverrides() map[string]*RuntimeOptions {
+	if m != nil {
+		return m.HostOverrides
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BoolValue)(nil), "runtimeoptions.BoolValue")
+	proto.RegisterType((*Int64Value)(nil), "runtimeoptions.Int64Value")
+	proto.RegisterType((*DoubleValue)(nil), "runtimeoptions.DoubleValue")
+	proto.RegisterType((*RuntimeOptions)(nil), "runtimeoptions.RuntimeOptions")
+	proto.RegisterType((*RuntimeOptionsValue)(nil), "runtimeoptions.RuntimeOptionsValue")
+}
+
+func init() { proto.RegisterFile("runtimeoptions.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 487 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0x8d, 0x94, 0x4d, 0x4f, 0xdb, 0x40,
+	0x10, 0x86, 0x95, 0x84, 0x8f, 0x64, 0xa2, 0xa2, 0x76, 0x13, 0xd4, 0x6d, 0x2a, 0xf5, 0xc3, 0xed,
+	0x81, 0x53, 0x0e, 0x14, 0xa1, 0x8a, 0x5b, 0x29, 0x91, 0xa8, 0x0a, 0x09, 0xb2, 0x25, 0x0e, 0x55,
+	0x0f, 0xdd, 0xd8, 0x13, 0x65, 0x89, 0xbd, 0x6b, 0xed, 0xae, 0xa1, 0xe1, 0x5f, 0xf0, 0x1b, 0xfb,
+	0x47, 0xea, 0x8f, 0x9a, 0xc6, 0xc6, 0xe0, 0x9c, 0xbc, 0x9e, 0x7d, 0xdf, 0x67, 0x66, 0x76, 0x35,
+	0x0b, 0x7d, 0x15, 0x09, 0xc3, 0x03, 0x94, 0xa1, 0xe1, 0x52, 0xe8, 0x61, 0xa8, 0xa4, 0x91, 0x64,
+	0xa7, 0x18, 0xb5, 0xde, 0x43, 0xe7, 0x58, 0x4a, 0xff, 0x92, 0xf9, 0x11, 0x92, 0x3e, 0x6c, 0x5e,
+	0x27, 0x0b, 0xda, 0x78, 0xd7, 0xd8, 0x6b, 0xdb, 0xd9, 0x8f, 0x65, 0x01, 0x7c, 0x13, 0xe6, 0xf0,
+	0xa0, 0x42, 0xd3, 0xca, 0x35, 0x1f, 0xa0, 0x7b, 0x22, 0xa3, 0xa9, 0x8f, 0x15, 0xa2, 0x46, 0x2e,
+	0xfa, 0xb3, 0x0d, 0x3b, 0x76, 0x96, 0x7e, 0x92, 0xa5, 0x27, 0x0e, 0xbc, 0x0c, 0x51, 0x69, 0xae,
+	0x8d, 0xcd, 0x0c, 0x9e, 0xf1, 0x80, 0x9b, 0x91, 0x60, 0x31, 0xc7, 0x4b, 0xad, 0xdd, 0xfd, 0x57,
+	0xc3, 0x52, 0x1b, 0xf7, 0xd5, 0xda, 0x8f, 0x39, 0xc9, 0x04, 0xfa, 0xe5, 0xad, 0xf3, 0x69, 0xa8,
+	0x69, 0x33, 0x25, 0xbe, 0x2e, 0x13, 0x57, 0x0a, 0xb7, 0x2b, 0x8d, 0xe4, 0x07, 0x0c, 0xca, 0xf1,
+	0xaf, 0x73, 0x74, 0x17, 0xa3, 0x6b, 0x54, 0x4b, 0xda, 0x4a, 0xb1, 0x83, 0x32, 0xf6, 0xff, 0x99,
+	0xd9, 0x4f, 0xb8, 0xc9, 0x77, 0xe8, 0xdd, 0x28, 0x6e, 0x70, 0x8c, 0x37, 0x0e, 0x2a, 0x8e, 0xfa,
+	0x8b, 0x5e, 0x0a, 0x97, 0x6e, 0xd4, 0x75, 0x5f, 0xe5, 0x22, 0x57, 0x60, 0x15, 0xc3, 0xc7, 0xcc,
+	0x5d, 0xc8, 0xd9, 0xec, 0x24, 0x52, 0x2c, 0x01, 0x8c, 0x99, 0x90, 0x9a, 0x6e, 0xd6, 0x16, 0xbc,
+	0x06, 0x85, 0x08, 0xf8, 0x58, 0x54, 0xa5, 0x9d, 0x5d, 0xa0, 0x72, 0xe6, 0x4c, 0x79, 0xc9, 0x17,
+	0x5d, 0x29, 0x3c, 0xba, 0x55, 0x9b, 0x6d, 0x2d, 0x0e, 0x39, 0x85, 0x17, 0x01, 0xfb, 0x9d, 0x49,
+	0xf2, 0x5d, 0xba, 0x5d, 0x0b, 0x7f, 0x68, 0x22, 0x17, 0xb0, 0xbb, 0x1a, 0x1c, 0xb3, 0x00, 0x75,
+	0xc8, 0x5c, 0xa4, 0xed, 0x5a, 0x5a, 0xb5, 0x91, 0x9c, 0x41, 0xcf, 0x70, 0x77, 0x91, 0x9f, 0x96,
+	0x71, 0xe7, 0x0e, 0xbf, 0x45, 0xda, 0xa9, 0xe5, 0x55, 0xd9, 0x88, 0x07, 0x6f, 0x93, 0x70, 0xda,
+	0x7a, 0xb2, 0xe3, 0xf8, 0x88, 0x61, 0xf1, 0x0a, 0xa1, 0x96, 0x5c, 0x87, 0x20, 0x97, 0x40, 0x13,
+	0xc9, 0x39, 0x17, 0x3c, 0x88, 0x82, 0xd8, 0x89, 0x2a, 0x1e, 0xd3, 0x0c, 0xdf, 0xad, 0xc5, 0x3f,
+	0xea, 0xb5, 0xee, 0x9a, 0xd0, 0x2b, 0x4e, 0x79, 0xf6, 0x26, 0x1c, 0x41, 0xdb, 0xc3, 0x19, 0x8b,
+	0x7c, 0xa3, 0xff, 0xcd, 0xf6, 0x9b, 0x32, 0xbf, 0x68, 0xb3, 0xef, 0xf5, 0xe4, 0x27, 0x3c, 0x9b,
+	0x4b, 0x6d, 0x26, 0xf1, 0xc4, 0x28, 0xee, 0x61, 0x32, 0xca, 0xad, 0x18, 0x70, 0xf8, 0x34, 0x20,
+	0xcd, 0x3b, 0x3c, 0x5d, 0x35, 0x8e, 0x84, 0x51, 0x4b, 0xbb, 0x08, 0x1b, 0xfc, 0x02, 0xf2, 0x50,
+	0x44, 0x9e, 0x43, 0x6b, 0x81, 0xcb, 0xb4, 0xd4, 0x8e, 0x9d, 0x2c, 0xc9, 0x41, 0xfe, 0xaa, 0x35,
+	0xd7, 0x2a, 0x3f, 0x13, 0x1f, 0x35, 0x3f, 0x37, 0xa6, 0x5b, 0xe9, 0xe3, 0xfb, 0xe9, 0x2f, 0xe5,
+	0x86, 0x0f, 0x37, 0x94, 0x05, 0x00, 0x00,
+}
@@ -29,7 +29,8 @@ enum TimeType {
 
 enum ErrorType {
 	INTERNAL_ERROR,
-	BAD_REQUEST
+	BAD_REQUEST,
+	QUOTA_EXCEEDED
 }
 
 exception Error {
@@ -50,6 +51,7 @@ service Node {
 
 	// Performant read/write endpoints
 	FetchBatchRawResult fetchBatchRaw(1: FetchBatchRawRequest req) throws (1: Error err)
+	FetchBatchRawPagedResult fetchBatchRawPaged(1: FetchBatchRawPagedRequest req) throws (1: Error err)
 	FetchBlocksRawResult fetchBlocksRaw(1: FetchBlocksRawRequest req) throws (1: Error err)
 
 	// TODO(rartoul): Delete this once we delete the V1 code path
@@ -70,6 +72,7 @@ service Node {
 	NodeWriteNewSeriesBackoffDurationResult setWriteNewSeriesBackoffDuration(1: NodeSetWriteNewSeriesBackoffDurationRequest req) throws (1: Error err)
 	NodeWriteNewSeriesLimitPerShardPerSecondResult getWriteNewSeriesLimitPerShardPerSecond() throws (1: Error err)
 	NodeWriteNewSeriesLimitPerShardPerSecondResult setWriteNewSeriesLimitPerShardPerSecond(1: NodeSetWriteNewSeriesLimitPerShardPerSecondRequest req) throws (1: Error err)
+	NodeBootstrapStatusResult getBootstrapStatus() throws (1: Error err)
 }
 
 struct FetchRequest {
@@ -79,6 +82,17 @@ struct FetchRequest {
 	4: required string id
 	5: optional TimeType rangeType = TimeType.UNIX_SECONDS
 	6: optional TimeType resultTimeType = TimeType.UNIX_SECONDS
+	7: optional i64 step
+	8: optional AggregationType aggregation = AggregationType.MEAN
+}
+
+enum AggregationType {
+	MEAN,
+	MIN,
+	MAX,
+	SUM,
+	COUNT,
+	LAST
 }
 
 struct FetchResult {
@@ -122,6 +136,27 @@ struct FetchRawResult {
 	2: optional Error err
 }
 
+struct FetchBatchRawPagedRequest {
+	1: required i64 rangeStart
+	2: required i64 rangeEnd
+	3: required binary nameSpace
+	4: required list<binary> ids
+	5: required i64 limit
+	6: optional binary pageToken
+	7: optional TimeType rangeTimeType = TimeType.UNIX_SECONDS
+}
+
+struct FetchBatchRawPagedResult {
+	1: required list<FetchRawPagedResult> elements
+	2: optional binary nextPageToken
+}
+
+struct FetchRawPagedResult {
+	1: required i64 index
+	2: required list<Segments> segments
+	3: optional Error err
+}
+
 struct Segments {
 	1: optional Segment merged
 	2: optional list<Segment> unmerged
@@ -139,6 +174,8 @@ struct FetchTaggedRequest {
 	4: required bool fetchData
 	5: optional i64 limit
 	6: optional TimeType rangeTimeType = TimeType.UNIX_SECONDS
+	7: optional i64 step
+	8: optional AggregationType aggregation = AggregationType.MEAN
 }
 
 struct IdxQuery {
@@ -268,6 +305,7 @@ struct BlockMetadataV2 {
 	5: optional i64 checksum
 	6: optional i64 lastRead
 	7: optional TimeType lastReadTimeType = TimeType.UNIX_SECONDS
+	8: optional binary encodedTags
 }
 
 struct WriteBatchRawRequest {
@@ -348,6 +386,31 @@ struct NodeSetWriteNewSeriesLimitPerShardPerSecondRequest {
 	1: required i64 writeNewSeriesLimitPerShardPerSecond
 }
 
+enum ShardBootstrapState {
+	PENDING,
+	BOOTSTRAPPING,
+	BOOTSTRAPPED,
+	FAILED
+}
+
+struct NodeBootstrapStatusResult {
+	1: required list<NamespaceBootstrapStatus> namespaces
+}
+
+struct NamespaceBootstrapStatus {
+	1: required string nameSpace
+	2: required list<ShardBootstrapStatus> shards
+}
+
+struct ShardBootstrapStatus {
+	1: required i32 shard
+	2: required ShardBootstrapState state
+	3: optional string source
+	4: required i64 seriesFetched
+	5: required i64 bytesFetched
+	6: optional string error
+}
+
 service Cluster {
 	HealthResult health() throws (1: Error err)
 	void write(1: WriteRequest req) throws (1: Error err)
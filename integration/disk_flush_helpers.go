@@ -87,7 +87,7 @@ func verifyForTime(
 	for shard := range shards {
 		require.NoError(t, reader.Open(namespace, shard, timestamp))
 		for i := 0; i < reader.Entries(); i++ {
-			id, data, _, err := reader.Read()
+			id, _, data, _, err := reader.Read()
 			require.NoError(t, err)
 
 			data.IncRef()
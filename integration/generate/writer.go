@@ -131,7 +131,7 @@ func writeToDisk(
 			data[0] = segment.Head
 			data[1] = segment.Tail
 			checksum := digest.SegmentChecksum(segment)
-			if err := writer.WriteAll(series.ID, data, checksum); err != nil {
+			if err := writer.WriteAll(series.ID, series.Tags, data, checksum); err != nil {
 				return err
 			}
 		}
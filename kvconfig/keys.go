@@ -32,4 +32,8 @@ const (
 	// ClusterNewSeriesInsertLimitKey is the KV config key for the runtime
 	// configuration specifying a hard limit for a cluster new series insertions.
 	ClusterNewSeriesInsertLimitKey = "m3db.node.cluster-new-series-insert-limit"
+
+	// RuntimeOptionsKey is the KV config key for the runtime configuration
+	// specifying any of the runtime options, with optional per host overrides.
+	RuntimeOptionsKey = "m3db.node.runtime-options"
 )
@@ -0,0 +1,75 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package cluster
+
+import (
+	"net"
+
+	"github.com/m3db/m3db/client"
+	"github.com/m3db/m3db/generated/proto/rpcpb"
+	ns "github.com/m3db/m3db/network/server"
+	grpcserver "github.com/m3db/m3db/network/server/grpc"
+	ttcluster "github.com/m3db/m3db/network/server/tchannelthrift/cluster"
+	xclose "github.com/m3db/m3x/close"
+	"github.com/m3db/m3x/context"
+)
+
+type server struct {
+	client      client.Client
+	address     string
+	contextPool context.Pool
+	opts        grpcserver.ServerOptions
+}
+
+// NewServer creates a new cluster gRPC network service
+func NewServer(
+	client client.Client,
+	address string,
+	contextPool context.Pool,
+	opts grpcserver.ServerOptions,
+) ns.NetworkService {
+	if opts == nil {
+		opts = grpcserver.NewServerOptions()
+	}
+	return &server{
+		client:      client,
+		address:     address,
+		contextPool: contextPool,
+		opts:        opts,
+	}
+}
+
+func (s *server) ListenAndServe() (ns.Close, error) {
+	listener, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return nil, err
+	}
+
+	server := grpcserver.NewGRPCServer(s.opts)
+	service := ttcluster.NewService(s.client)
+	rpcpb.RegisterClusterServer(server, NewService(service, s.contextPool))
+
+	go server.Serve(listener)
+
+	return func() {
+		server.Stop()
+		xclose.TryClose(service)
+	}, nil
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package cluster
+
+import (
+	"github.com/m3db/m3db/generated/proto/rpcpb"
+	"github.com/m3db/m3db/generated/thrift/rpc"
+	"github.com/m3db/m3db/network/server/grpc/convert"
+	"github.com/m3db/m3db/network/server/tchannelthrift"
+	"github.com/m3db/m3x/context"
+
+	"github.com/uber/tchannel-go/thrift"
+	xnetcontext "golang.org/x/net/context"
+)
+
+type service struct {
+	service     rpc.TChanCluster
+	contextPool context.Pool
+}
+
+// NewService creates a new cluster gRPC service that serves requests using the
+// cluster service also exposed over TChannel Thrift
+func NewService(svc rpc.TChanCluster, contextPool context.Pool) rpcpb.ClusterServer {
+	return &service{
+		service:     svc,
+		contextPool: contextPool,
+	}
+}
+
+func (s *service) newContext(ctx xnetcontext.Context) (thrift.Context, context.Context) {
+	inner := s.contextPool.Get()
+	return tchannelthrift.WithContext(ctx, inner), inner
+}
+
+func (s *service) Health(ctx xnetcontext.Context, req *rpcpb.Empty) (*rpcpb.HealthResult, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	result, err := s.service.Health(tctx)
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return convert.ToProtoHealthResult(result), nil
+}
+
+func (s *service) Write(ctx xnetcontext.Context, req *rpcpb.WriteRequest) (*rpcpb.Empty, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	if err := s.service.Write(tctx, convert.ToRPCWriteRequest(req)); err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return &rpcpb.Empty{}, nil
+}
+
+func (s *service) WriteTagged(ctx xnetcontext.Context, req *rpcpb.WriteTaggedRequest) (*rpcpb.Empty, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	if err := s.service.WriteTagged(tctx, convert.ToRPCWriteTaggedRequest(req)); err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return &rpcpb.Empty{}, nil
+}
+
+func (s *service) Fetch(ctx xnetcontext.Context, req *rpcpb.FetchRequest) (*rpcpb.FetchResult, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	result, err := s.service.Fetch(tctx, convert.ToRPCFetchRequest(req))
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return convert.ToProtoFetchResult(result), nil
+}
+
+func (s *service) FetchTagged(ctx xnetcontext.Context, req *rpcpb.FetchTaggedRequest) (*rpcpb.FetchTaggedResult, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	result, err := s.service.FetchTagged(tctx, convert.ToRPCFetchTaggedRequest(req))
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return convert.ToProtoFetchTaggedResult(result), nil
+}
+
+func (s *service) Truncate(ctx xnetcontext.Context, req *rpcpb.TruncateRequest) (*rpcpb.TruncateResult, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	result, err := s.service.Truncate(tctx, convert.ToRPCTruncateRequest(req))
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return convert.ToProtoTruncateResult(result), nil
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cluster
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/m3db/m3db/generated/proto/rpcpb"
+	"github.com/m3db/m3db/generated/thrift/rpc"
+	grpcserver "github.com/m3db/m3db/network/server/grpc"
+	"github.com/m3db/m3db/network/server/tchannelthrift"
+	tterrors "github.com/m3db/m3db/network/server/tchannelthrift/errors"
+	"github.com/m3db/m3x/context"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/tchannel-go/thrift"
+	xnetcontext "golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// newTestClusterClient serves the cluster service over gRPC backed by a mock
+// cluster and returns a gRPC client connected to it
+func newTestClusterClient(
+	t *testing.T,
+	ctrl *gomock.Controller,
+) (*rpc.MockTChanCluster, rpcpb.ClusterClient, func()) {
+	cluster := rpc.NewMockTChanCluster(ctrl)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpcserver.NewGRPCServer(grpcserver.NewServerOptions())
+	rpcpb.RegisterClusterServer(server, NewService(cluster, context.NewPool(context.NewOptions())))
+	go server.Serve(listener)
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+
+	return cluster, rpcpb.NewClusterClient(conn), func() {
+		conn.Close()
+		server.Stop()
+	}
+}
+
+func TestServiceHealth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cluster, client, closer := newTestClusterClient(t, ctrl)
+	defer closer()
+
+	cluster.EXPECT().Health(gomock.Any()).
+		Return(&rpc.HealthResult_{Ok: true, Status: "up"}, nil)
+
+	result, err := client.Health(xnetcontext.Background(), &rpcpb.Empty{})
+	require.NoError(t, err)
+	assert.True(t, result.Ok)
+	assert.Equal(t, "up", result.Status)
+}
+
+func TestServiceWriteTagged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cluster, client, closer := newTestClusterClient(t, ctrl)
+	defer closer()
+
+	cluster.EXPECT().WriteTagged(gomock.Any(), &rpc.WriteTaggedRequest{
+		NameSpace: "metrics",
+		ID:        "foo",
+		Tags:      []*rpc.TagString{{Name: "city", Value: "new_york"}},
+		Datapoint: &rpc.Datapoint{
+			Timestamp:         1,
+			Value:             42,
+			TimestampTimeType: rpc.TimeType_UNIX_SECONDS,
+		},
+	}).Do(func(tctx thrift.Context, req *rpc.WriteTaggedRequest) {
+		// Ensure an M3DB context is available to the underlying service
+		assert.NotNil(t, tchannelthrift.Context(tctx))
+	}).Return(nil)
+
+	_, err := client.WriteTagged(xnetcontext.Background(), &rpcpb.WriteTaggedRequest{
+		NameSpace: "metrics",
+		Id:        "foo",
+		Tags:      []*rpcpb.TagString{{Name: "city", Value: "new_york"}},
+		Datapoint: &rpcpb.Datapoint{Timestamp: 1, Value: 42},
+	})
+	require.NoError(t, err)
+}
+
+func TestServiceWriteError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cluster, client, closer := newTestClusterClient(t, ctrl)
+	defer closer()
+
+	cluster.EXPECT().Write(gomock.Any(), gomock.Any()).
+		Return(tterrors.NewQuotaExceededError(errors.New("quota")))
+
+	_, err := client.Write(xnetcontext.Background(), &rpcpb.WriteRequest{
+		NameSpace: "metrics",
+		Id:        "foo",
+		Datapoint: &rpcpb.Datapoint{Timestamp: 1, Value: 42},
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, grpc.Code(err))
+	assert.Equal(t, "quota", grpc.ErrorDesc(err))
+}
+
+func TestServiceFetchTagged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cluster, client, closer := newTestClusterClient(t, ctrl)
+	defer closer()
+
+	limit := int64(10)
+	cluster.EXPECT().FetchTagged(gomock.Any(), &rpc.FetchTaggedRequest{
+		Query: &rpc.IdxQuery{
+			Filters: []*rpc.IdxTagFilter{{TagName: "city", TagValueFilter: "new_york"}},
+		},
+		RangeStart: 1,
+		RangeEnd:   2,
+		FetchData:  true,
+		Limit:      &limit,
+	}).Return(&rpc.FetchTaggedResult_{
+		Elements: []*rpc.FetchTaggedIDResult_{
+			{
+				ID:         "foo",
+				NameSpace:  "metrics",
+				Tags:       []*rpc.TagString{{Name: "city", Value: "new_york"}},
+				Datapoints: []*rpc.Datapoint{{Timestamp: 1, Value: 42}},
+			},
+			{
+				ID:        "bar",
+				NameSpace: "metrics",
+				Err:       &rpc.Error{Type: rpc.ErrorType_INTERNAL_ERROR, Message: "an error"},
+			},
+		},
+		Exhaustive: false,
+	}, nil)
+
+	result, err := client.FetchTagged(xnetcontext.Background(), &rpcpb.FetchTaggedRequest{
+		Query: &rpcpb.IdxQuery{
+			Filters: []*rpcpb.IdxTagFilter{{TagName: "city", TagValueFilter: "new_york"}},
+		},
+		RangeStart: 1,
+		RangeEnd:   2,
+		FetchData:  true,
+		Limit:      &rpcpb.Int64Value{Value: limit},
+	})
+	require.NoError(t, err)
+	assert.False(t, result.Exhaustive)
+	require.Equal(t, 2, len(result.Elements))
+
+	assert.Equal(t, "foo", result.Elements[0].Id)
+	require.Equal(t, 1, len(result.Elements[0].Datapoints))
+	assert.Equal(t, 42.0, result.Elements[0].Datapoints[0].Value)
+	assert.Nil(t, result.Elements[0].Err)
+
+	assert.Equal(t, "bar", result.Elements[1].Id)
+	require.NotNil(t, result.Elements[1].Err)
+	assert.Equal(t, rpcpb.ErrorType_INTERNAL_ERROR, result.Elements[1].Err.Type)
+	assert.Equal(t, "an error", result.Elements[1].Err.Message)
+}
+
+func TestServiceTruncate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cluster, client, closer := newTestClusterClient(t, ctrl)
+	defer closer()
+
+	cluster.EXPECT().Truncate(gomock.Any(), &rpc.TruncateRequest{
+		NameSpace: []byte("metrics"),
+	}).Return(&rpc.TruncateResult_{NumSeries: 3}, nil)
+
+	result, err := client.Truncate(xnetcontext.Background(), &rpcpb.TruncateRequest{
+		NameSpace: []byte("metrics"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), result.NumSeries)
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grpc
+
+import (
+	"google.golang.org/grpc/credentials"
+)
+
+// Configuration is the gRPC server configuration.
+type Configuration struct {
+	// MaxRecvMsgSize is the max size in bytes of an inbound message, defaults
+	// to 64MB when not set.
+	MaxRecvMsgSize int `yaml:"maxRecvMsgSize" validate:"min=0"`
+
+	// TLS is the TLS configuration, when not set connections are not encrypted.
+	TLS *TLSConfiguration `yaml:"tls"`
+}
+
+// TLSConfiguration is the gRPC server TLS configuration.
+type TLSConfiguration struct {
+	// CertFile is the path to the PEM encoded server certificate.
+	CertFile string `yaml:"certFile" validate:"nonzero"`
+
+	// KeyFile is the path to the PEM encoded server private key.
+	KeyFile string `yaml:"keyFile" validate:"nonzero"`
+}
+
+// NewServerOptions creates a new set of gRPC server options.
+func (c Configuration) NewServerOptions() (ServerOptions, error) {
+	opts := NewServerOptions()
+	if c.MaxRecvMsgSize > 0 {
+		opts = opts.SetMaxRecvMsgSize(c.MaxRecvMsgSize)
+	}
+	if c.TLS != nil {
+		creds, err := credentials.NewServerTLSFromFile(c.TLS.CertFile, c.TLS.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = opts.SetTransportCredentials(creds)
+	}
+	return opts, nil
+}
@@ -0,0 +1,906 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package convert
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/m3db/m3db/generated/proto/rpcpb"
+	"github.com/m3db/m3db/generated/thrift/rpc"
+	tterrors "github.com/m3db/m3db/network/server/tchannelthrift/errors"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestFetchRequestRoundTrip(t *testing.T) {
+	step := int64(10)
+	req := &rpc.FetchRequest{
+		RangeStart:     1,
+		RangeEnd:       2,
+		NameSpace:      "metrics",
+		ID:             "foo",
+		RangeType:      rpc.TimeType_UNIX_NANOSECONDS,
+		ResultTimeType: rpc.TimeType_UNIX_MILLISECONDS,
+		Step:           &step,
+		Aggregation:    rpc.AggregationType_MAX,
+	}
+
+	pb := ToProtoFetchRequest(req)
+	require.NotNil(t, pb.Step)
+	assert.Equal(t, step, pb.Step.Value)
+	assert.Equal(t, "foo", pb.Id)
+	assert.Equal(t, rpcpb.TimeType_UNIX_NANOSECONDS, pb.RangeType)
+	assert.Equal(t, rpcpb.AggregationType_MAX, pb.Aggregation)
+
+	assert.Equal(t, req, ToRPCFetchRequest(pb))
+
+	req.Step = nil
+	assert.Nil(t, ToProtoFetchRequest(req).Step)
+	assert.Equal(t, req, ToRPCFetchRequest(ToProtoFetchRequest(req)))
+}
+
+func TestFetchBatchRawResultCopiesBytes(t *testing.T) {
+	head := []byte("head")
+	result := &rpc.FetchBatchRawResult_{
+		Elements: []*rpc.FetchRawResult_{
+			{Segments: []*rpc.Segments{{Merged: &rpc.Segment{Head: head}}}},
+		},
+	}
+
+	pb := ToProtoFetchBatchRawResult(result)
+	head[0] = 'x'
+	assert.Equal(t, []byte("head"), pb.Elements[0].Segments[0].Merged.Head)
+}
+
+func TestWriteBatchRawErrorsRoundTrip(t *testing.T) {
+	errs := rpc.NewWriteBatchRawErrors()
+	errs.Errors = append(errs.Errors,
+		tterrors.NewBadRequestWriteBatchRawError(1, errors.New("bad")),
+		tterrors.NewWriteBatchRawError(3, errors.New("internal")))
+
+	assert.Equal(t, errs, ToRPCWriteBatchRawErrors(ToProtoWriteBatchRawErrors(errs)))
+}
+
+func TestNodeBootstrapStatusResultRoundTrip(t *testing.T) {
+	source, errMsg := "peers", "an error"
+	result := &rpc.NodeBootstrapStatusResult_{
+		Namespaces: []*rpc.NamespaceBootstrapStatus{
+			{
+				NameSpace: "metrics",
+				Shards: []*rpc.ShardBootstrapStatus{
+					{Shard: 0, State: rpc.ShardBootstrapState_PENDING},
+					{
+						Shard:         1,
+						State:         rpc.ShardBootstrapState_FAILED,
+						Source:        &source,
+						SeriesFetched: 2,
+						BytesFetched:  100,
+						Error:         &errMsg,
+					},
+				},
+			},
+		},
+	}
+
+	pb := ToProtoNodeBootstrapStatusResult(result)
+	require.Equal(t, 1, len(pb.Namespaces))
+	require.Equal(t, 2, len(pb.Namespaces[0].Shards))
+	assert.Equal(t, rpcpb.ShardBootstrapState_FAILED, pb.Namespaces[0].Shards[1].State)
+	assert.Equal(t, "an error", pb.Namespaces[0].Shards[1].Error)
+
+	assert.Equal(t, result, ToRPCNodeBootstrapStatusResult(pb))
+}
+
+func TestToGRPCError(t *testing.T) {
+	assert.NoError(t, ToGRPCError(nil))
+
+	err := ToGRPCError(tterrors.NewBadRequestError(errors.New("bad")))
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+	assert.Equal(t, "bad", grpc.ErrorDesc(err))
+
+	err = ToGRPCError(tterrors.NewQuotaExceededError(errors.New("quota")))
+	assert.Equal(t, codes.ResourceExhausted, grpc.Code(err))
+
+	err = ToGRPCError(tterrors.NewInternalError(errors.New("internal")))
+	assert.Equal(t, codes.Internal, grpc.Code(err))
+
+	err = ToGRPCError(errors.New("other"))
+	assert.Equal(t, codes.Internal, grpc.Code(err))
+	assert.Equal(t, "other", grpc.ErrorDesc(err))
+}
+
+func TestFromGRPCError(t *testing.T) {
+	assert.NoError(t, FromGRPCError(nil))
+
+	for _, test := range []struct {
+		code     codes.Code
+		expected rpc.ErrorType
+	}{
+		{codes.InvalidArgument, rpc.ErrorType_BAD_REQUEST},
+		{codes.ResourceExhausted, rpc.ErrorType_QUOTA_EXCEEDED},
+		{codes.Internal, rpc.ErrorType_INTERNAL_ERROR},
+	} {
+		err := FromGRPCError(grpc.Errorf(test.code, "an error"))
+		rpcErr, ok := err.(*rpc.Error)
+		require.True(t, ok)
+		assert.Equal(t, test.expected, rpcErr.Type)
+		assert.Equal(t, "an error", rpcErr.Message)
+	}
+
+	unavailable := grpc.Errorf(codes.Unavailable, "unavailable")
+	assert.Equal(t, unavailable, FromGRPCError(unavailable))
+}
+
+// roundTripTest converts a value to its proto message, marshals and unmarshals
+// it to exercise the wire encoding and then converts it back.
+type roundTripTest struct {
+	name      string
+	values    []interface{}
+	roundTrip func(t *testing.T, v interface{}) interface{}
+}
+
+func wireRoundTrip(t *testing.T, msg proto.Message, result proto.Message) {
+	b, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	require.NoError(t, proto.Unmarshal(b, result))
+}
+
+func TestMessagesRoundTrip(t *testing.T) {
+	var (
+		zero        = int64(0)
+		step        = int64(10)
+		limit       = int64(100)
+		checksum    = int64(42)
+		size        = int64(1024)
+		lastRead    = int64(5)
+		pageToken   = int64(7)
+		boolTrue    = true
+		boolFalse   = false
+		mbps        = 12.5
+		checkEvery  = int64(64)
+		source      = "peers"
+		errMsg      = "an error"
+		badRequest  = &rpc.Error{Type: rpc.ErrorType_BAD_REQUEST, Message: "bad"}
+		internalErr = &rpc.Error{Type: rpc.ErrorType_INTERNAL_ERROR, Message: "internal"}
+		datapoint   = &rpc.Datapoint{
+			Timestamp:         1,
+			Value:             1.5,
+			Annotation:        []byte("annotation"),
+			TimestampTimeType: rpc.TimeType_UNIX_NANOSECONDS,
+		}
+		segments = &rpc.Segments{
+			Merged: &rpc.Segment{Head: []byte("head"), Tail: []byte("tail")},
+		}
+		unmergedSegments = &rpc.Segments{
+			Unmerged: []*rpc.Segment{
+				{Head: []byte("a"), Tail: []byte("b")},
+				{Head: []byte("c")},
+			},
+		}
+		query = &rpc.IdxQuery{
+			Operator: rpc.BooleanOperator_AND_OPERATOR,
+			Filters: []*rpc.IdxTagFilter{
+				{TagName: "city", TagValueFilter: "new_york"},
+				{TagName: "host", TagValueFilter: "a.*", Negate: true, Regexp: true},
+			},
+			SubQueries: []*rpc.IdxQuery{
+				{Filters: []*rpc.IdxTagFilter{{TagName: "dc", TagValueFilter: "east"}}},
+			},
+		}
+	)
+
+	tests := []roundTripTest{
+		{
+			name: "Error",
+			values: []interface{}{
+				badRequest,
+				&rpc.Error{Type: rpc.ErrorType_QUOTA_EXCEEDED, Message: "quota"},
+				&rpc.Error{},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.Error{}
+				wireRoundTrip(t, ToProtoError(v.(*rpc.Error)), r)
+				return ToRPCError(r)
+			},
+		},
+		{
+			name: "WriteBatchRawErrors",
+			values: []interface{}{
+				&rpc.WriteBatchRawErrors{Errors: []*rpc.WriteBatchRawError{
+					{Index: 0, Err: badRequest},
+					{Index: 3, Err: internalErr},
+				}},
+				&rpc.WriteBatchRawErrors{},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.WriteBatchRawErrors{}
+				wireRoundTrip(t, ToProtoWriteBatchRawErrors(v.(*rpc.WriteBatchRawErrors)), r)
+				return ToRPCWriteBatchRawErrors(r)
+			},
+		},
+		{
+			name: "FetchRequest",
+			values: []interface{}{
+				&rpc.FetchRequest{
+					RangeStart:     1,
+					RangeEnd:       2,
+					NameSpace:      "metrics",
+					ID:             "foo",
+					RangeType:      rpc.TimeType_UNIX_MILLISECONDS,
+					ResultTimeType: rpc.TimeType_UNIX_NANOSECONDS,
+					Step:           &step,
+					Aggregation:    rpc.AggregationType_LAST,
+				},
+				&rpc.FetchRequest{NameSpace: "metrics", ID: "foo", Step: &zero},
+				&rpc.FetchRequest{NameSpace: "metrics", ID: "foo"},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.FetchRequest{}
+				wireRoundTrip(t, ToProtoFetchRequest(v.(*rpc.FetchRequest)), r)
+				return ToRPCFetchRequest(r)
+			},
+		},
+		{
+			name: "FetchResult",
+			values: []interface{}{
+				&rpc.FetchResult_{Datapoints: []*rpc.Datapoint{
+					datapoint,
+					{Timestamp: 2, Value: 2},
+				}},
+				&rpc.FetchResult_{},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.FetchResult{}
+				wireRoundTrip(t, ToProtoFetchResult(v.(*rpc.FetchResult_)), r)
+				return ToRPCFetchResult(r)
+			},
+		},
+		{
+			name: "WriteRequest",
+			values: []interface{}{
+				&rpc.WriteRequest{NameSpace: "metrics", ID: "foo", Datapoint: datapoint},
+				&rpc.WriteRequest{NameSpace: "metrics", ID: "foo"},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.WriteRequest{}
+				wireRoundTrip(t, ToProtoWriteRequest(v.(*rpc.WriteRequest)), r)
+				return ToRPCWriteRequest(r)
+			},
+		},
+		{
+			name: "WriteTaggedRequest",
+			values: []interface{}{
+				&rpc.WriteTaggedRequest{
+					NameSpace: "metrics",
+					ID:        "foo",
+					Tags: []*rpc.TagString{
+						{Name: "city", Value: "new_york"},
+						{Name: "host", Value: "a"},
+					},
+					Datapoint: datapoint,
+				},
+				&rpc.WriteTaggedRequest{NameSpace: "metrics", ID: "foo"},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.WriteTaggedRequest{}
+				wireRoundTrip(t, ToProtoWriteTaggedRequest(v.(*rpc.WriteTaggedRequest)), r)
+				return ToRPCWriteTaggedRequest(r)
+			},
+		},
+		{
+			name: "FetchBatchRawRequest",
+			values: []interface{}{
+				&rpc.FetchBatchRawRequest{
+					RangeStart:    1,
+					RangeEnd:      2,
+					NameSpace:     []byte("metrics"),
+					Ids:           [][]byte{[]byte("foo"), []byte("bar")},
+					RangeTimeType: rpc.TimeType_UNIX_MICROSECONDS,
+				},
+				&rpc.FetchBatchRawRequest{NameSpace: []byte("metrics")},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.FetchBatchRawRequest{}
+				wireRoundTrip(t, ToProtoFetchBatchRawRequest(v.(*rpc.FetchBatchRawRequest)), r)
+				return ToRPCFetchBatchRawRequest(r)
+			},
+		},
+		{
+			name: "FetchBatchRawResult",
+			values: []interface{}{
+				&rpc.FetchBatchRawResult_{Elements: []*rpc.FetchRawResult_{
+					{Segments: []*rpc.Segments{segments, unmergedSegments}},
+					{Err: badRequest},
+				}},
+				&rpc.FetchBatchRawResult_{},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.FetchBatchRawResult{}
+				wireRoundTrip(t, ToProtoFetchBatchRawResult(v.(*rpc.FetchBatchRawResult_)), r)
+				return ToRPCFetchBatchRawResult(r)
+			},
+		},
+		{
+			name: "FetchBatchRawPagedRequest",
+			values: []interface{}{
+				&rpc.FetchBatchRawPagedRequest{
+					RangeStart:    1,
+					RangeEnd:      2,
+					NameSpace:     []byte("metrics"),
+					Ids:           [][]byte{[]byte("foo")},
+					Limit:         10,
+					PageToken:     []byte("token"),
+					RangeTimeType: rpc.TimeType_UNIX_SECONDS,
+				},
+				&rpc.FetchBatchRawPagedRequest{NameSpace: []byte("metrics"), Limit: 10},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.FetchBatchRawPagedRequest{}
+				wireRoundTrip(t, ToProtoFetchBatchRawPagedRequest(v.(*rpc.FetchBatchRawPagedRequest)), r)
+				return ToRPCFetchBatchRawPagedRequest(r)
+			},
+		},
+		{
+			name: "FetchBatchRawPagedResult",
+			values: []interface{}{
+				&rpc.FetchBatchRawPagedResult_{
+					Elements: []*rpc.FetchRawPagedResult_{
+						{Index: 0, Segments: []*rpc.Segments{segments}},
+						{Index: 1, Err: internalErr},
+					},
+					NextPageToken: []byte("next"),
+				},
+				&rpc.FetchBatchRawPagedResult_{},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.FetchBatchRawPagedResult{}
+				wireRoundTrip(t, ToProtoFetchBatchRawPagedResult(v.(*rpc.FetchBatchRawPagedResult_)), r)
+				return ToRPCFetchBatchRawPagedResult(r)
+			},
+		},
+		{
+			name: "FetchTaggedRequest",
+			values: []interface{}{
+				&rpc.FetchTaggedRequest{
+					Query:         query,
+					RangeStart:    1,
+					RangeEnd:      2,
+					FetchData:     true,
+					Limit:         &limit,
+					RangeTimeType: rpc.TimeType_UNIX_MILLISECONDS,
+					Step:          &step,
+					Aggregation:   rpc.AggregationType_SUM,
+				},
+				&rpc.FetchTaggedRequest{Query: &rpc.IdxQuery{}, Limit: &zero},
+				&rpc.FetchTaggedRequest{},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.FetchTaggedRequest{}
+				wireRoundTrip(t, ToProtoFetchTaggedRequest(v.(*rpc.FetchTaggedRequest)), r)
+				return ToRPCFetchTaggedRequest(r)
+			},
+		},
+		{
+			name: "FetchTaggedResult",
+			values: []interface{}{
+				&rpc.FetchTaggedResult_{
+					Elements: []*rpc.FetchTaggedIDResult_{
+						{
+							ID:         "foo",
+							NameSpace:  "metrics",
+							Tags:       []*rpc.TagString{{Name: "city", Value: "new_york"}},
+							Datapoints: []*rpc.Datapoint{datapoint},
+						},
+						{ID: "bar", NameSpace: "metrics", Err: badRequest},
+					},
+					Exhaustive: true,
+				},
+				&rpc.FetchTaggedResult_{},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.FetchTaggedResult{}
+				wireRoundTrip(t, ToProtoFetchTaggedResult(v.(*rpc.FetchTaggedResult_)), r)
+				return ToRPCFetchTaggedResult(r)
+			},
+		},
+		{
+			name: "FetchBlocksRawRequest",
+			values: []interface{}{
+				&rpc.FetchBlocksRawRequest{
+					NameSpace: []byte("metrics"),
+					Shard:     3,
+					Elements: []*rpc.FetchBlocksRawRequestElement{
+						{ID: []byte("foo"), Starts: []int64{1, 2}},
+						{ID: []byte("bar")},
+					},
+				},
+				&rpc.FetchBlocksRawRequest{NameSpace: []byte("metrics")},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.FetchBlocksRawRequest{}
+				wireRoundTrip(t, ToProtoFetchBlocksRawRequest(v.(*rpc.FetchBlocksRawRequest)), r)
+				return ToRPCFetchBlocksRawRequest(r)
+			},
+		},
+		{
+			name: "FetchBlocksRawResult",
+			values: []interface{}{
+				&rpc.FetchBlocksRawResult_{Elements: []*rpc.Blocks{
+					{
+						ID: []byte("foo"),
+						Blocks: []*rpc.Block{
+							{Start: 1, Segments: segments, Checksum: &checksum},
+							{Start: 2, Segments: unmergedSegments, Checksum: &zero},
+							{Start: 3, Err: internalErr},
+						},
+					},
+					{ID: []byte("bar")},
+				}},
+				&rpc.FetchBlocksRawResult_{},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.FetchBlocksRawResult{}
+				wireRoundTrip(t, ToProtoFetchBlocksRawResult(v.(*rpc.FetchBlocksRawResult_)), r)
+				return ToRPCFetchBlocksRawResult(r)
+			},
+		},
+		{
+			name: "FetchBlocksMetadataRawRequest",
+			values: []interface{}{
+				&rpc.FetchBlocksMetadataRawRequest{
+					NameSpace:        []byte("metrics"),
+					Shard:            3,
+					RangeStart:       1,
+					RangeEnd:         2,
+					Limit:            10,
+					PageToken:        &pageToken,
+					IncludeSizes:     &boolTrue,
+					IncludeChecksums: &boolFalse,
+					IncludeLastRead:  &boolTrue,
+				},
+				&rpc.FetchBlocksMetadataRawRequest{NameSpace: []byte("metrics"), PageToken: &zero},
+				&rpc.FetchBlocksMetadataRawRequest{NameSpace: []byte("metrics")},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.FetchBlocksMetadataRawRequest{}
+				wireRoundTrip(t, ToProtoFetchBlocksMetadataRawRequest(v.(*rpc.FetchBlocksMetadataRawRequest)), r)
+				return ToRPCFetchBlocksMetadataRawRequest(r)
+			},
+		},
+		{
+			name: "FetchBlocksMetadataRawResult",
+			values: []interface{}{
+				&rpc.FetchBlocksMetadataRawResult_{
+					Elements: []*rpc.BlocksMetadata{
+						{
+							ID: []byte("foo"),
+							Blocks: []*rpc.BlockMetadata{
+								{
+									Start:            1,
+									Size:             &size,
+									Checksum:         &checksum,
+									LastRead:         &lastRead,
+									LastReadTimeType: rpc.TimeType_UNIX_SECONDS,
+								},
+								{Start: 2, Size: &zero},
+								{Start: 3, Err: internalErr},
+							},
+						},
+						{ID: []byte("bar")},
+					},
+					NextPageToken: &pageToken,
+				},
+				&rpc.FetchBlocksMetadataRawResult_{NextPageToken: &zero},
+				&rpc.FetchBlocksMetadataRawResult_{},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.FetchBlocksMetadataRawResult{}
+				wireRoundTrip(t, ToProtoFetchBlocksMetadataRawResult(v.(*rpc.FetchBlocksMetadataRawResult_)), r)
+				return ToRPCFetchBlocksMetadataRawResult(r)
+			},
+		},
+		{
+			name: "FetchBlocksMetadataRawV2Request",
+			values: []interface{}{
+				&rpc.FetchBlocksMetadataRawV2Request{
+					NameSpace:        []byte("metrics"),
+					Shard:            3,
+					RangeStart:       1,
+					RangeEnd:         2,
+					Limit:            10,
+					PageToken:        []byte("token"),
+					IncludeSizes:     &boolFalse,
+					IncludeChecksums: &boolTrue,
+					IncludeLastRead:  &boolFalse,
+				},
+				&rpc.FetchBlocksMetadataRawV2Request{NameSpace: []byte("metrics")},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.FetchBlocksMetadataRawV2Request{}
+				wireRoundTrip(t, ToProtoFetchBlocksMetadataRawV2Request(v.(*rpc.FetchBlocksMetadataRawV2Request)), r)
+				return ToRPCFetchBlocksMetadataRawV2Request(r)
+			},
+		},
+		{
+			name: "FetchBlocksMetadataRawV2Result",
+			values: []interface{}{
+				&rpc.FetchBlocksMetadataRawV2Result_{
+					Elements: []*rpc.BlockMetadataV2{
+						{
+							ID:               []byte("foo"),
+							Start:            1,
+							Size:             &size,
+							Checksum:         &checksum,
+							LastRead:         &lastRead,
+							LastReadTimeType: rpc.TimeType_UNIX_MILLISECONDS,
+							EncodedTags:      []byte("tags"),
+						},
+						{ID: []byte("bar"), Start: 2, Checksum: &zero},
+						{ID: []byte("baz"), Start: 3, Err: badRequest},
+					},
+					NextPageToken: []byte("next"),
+				},
+				&rpc.FetchBlocksMetadataRawV2Result_{},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.FetchBlocksMetadataRawV2Result{}
+				wireRoundTrip(t, ToProtoFetchBlocksMetadataRawV2Result(v.(*rpc.FetchBlocksMetadataRawV2Result_)), r)
+				return ToRPCFetchBlocksMetadataRawV2Result(r)
+			},
+		},
+		{
+			name: "WriteBatchRawRequest",
+			values: []interface{}{
+				&rpc.WriteBatchRawRequest{
+					NameSpace: []byte("metrics"),
+					Elements: []*rpc.WriteBatchRawRequestElement{
+						{ID: []byte("foo"), Datapoint: datapoint},
+						{ID: []byte("bar"), Datapoint: &rpc.Datapoint{Timestamp: 2}},
+					},
+				},
+				&rpc.WriteBatchRawRequest{NameSpace: []byte("metrics")},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.WriteBatchRawRequest{}
+				wireRoundTrip(t, ToProtoWriteBatchRawRequest(v.(*rpc.WriteBatchRawRequest)), r)
+				return ToRPCWriteBatchRawRequest(r)
+			},
+		},
+		{
+			name: "WriteTaggedBatchRawRequest",
+			values: []interface{}{
+				&rpc.WriteTaggedBatchRawRequest{
+					NameSpace: []byte("metrics"),
+					Elements: []*rpc.WriteTaggedBatchRawRequestElement{
+						{
+							ID:        []byte("foo"),
+							Tags:      []*rpc.TagRaw{{Name: []byte("city"), Value: []byte("new_york")}},
+							Datapoint: datapoint,
+						},
+						{ID: []byte("bar"), Datapoint: &rpc.Datapoint{Timestamp: 2}},
+					},
+				},
+				&rpc.WriteTaggedBatchRawRequest{NameSpace: []byte("metrics")},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.WriteTaggedBatchRawRequest{}
+				wireRoundTrip(t, ToProtoWriteTaggedBatchRawRequest(v.(*rpc.WriteTaggedBatchRawRequest)), r)
+				return ToRPCWriteTaggedBatchRawRequest(r)
+			},
+		},
+		{
+			name: "TruncateRequest",
+			values: []interface{}{
+				&rpc.TruncateRequest{NameSpace: []byte("metrics")},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.TruncateRequest{}
+				wireRoundTrip(t, ToProtoTruncateRequest(v.(*rpc.TruncateRequest)), r)
+				return ToRPCTruncateRequest(r)
+			},
+		},
+		{
+			name: "TruncateResult",
+			values: []interface{}{
+				&rpc.TruncateResult_{NumSeries: 42},
+				&rpc.TruncateResult_{},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.TruncateResult{}
+				wireRoundTrip(t, ToProtoTruncateResult(v.(*rpc.TruncateResult_)), r)
+				return ToRPCTruncateResult(r)
+			},
+		},
+		{
+			name: "HealthResult",
+			values: []interface{}{
+				&rpc.HealthResult_{Ok: true, Status: "up"},
+				&rpc.HealthResult_{},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.HealthResult{}
+				wireRoundTrip(t, ToProtoHealthResult(v.(*rpc.HealthResult_)), r)
+				return ToRPCHealthResult(r)
+			},
+		},
+		{
+			name: "NodeHealthResult",
+			values: []interface{}{
+				&rpc.NodeHealthResult_{Ok: true, Status: "up", Bootstrapped: true},
+				&rpc.NodeHealthResult_{},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.NodeHealthResult{}
+				wireRoundTrip(t, ToProtoNodeHealthResult(v.(*rpc.NodeHealthResult_)), r)
+				return ToRPCNodeHealthResult(r)
+			},
+		},
+		{
+			name: "NodePersistRateLimitResult",
+			values: []interface{}{
+				&rpc.NodePersistRateLimitResult_{LimitEnabled: true, LimitMbps: mbps, LimitCheckEvery: checkEvery},
+				&rpc.NodePersistRateLimitResult_{},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.NodePersistRateLimitResult{}
+				wireRoundTrip(t, ToProtoNodePersistRateLimitResult(v.(*rpc.NodePersistRateLimitResult_)), r)
+				return ToRPCNodePersistRateLimitResult(r)
+			},
+		},
+		{
+			name: "NodeSetPersistRateLimitRequest",
+			values: []interface{}{
+				&rpc.NodeSetPersistRateLimitRequest{
+					LimitEnabled:    &boolTrue,
+					LimitMbps:       &mbps,
+					LimitCheckEvery: &checkEvery,
+				},
+				&rpc.NodeSetPersistRateLimitRequest{LimitEnabled: &boolFalse, LimitCheckEvery: &zero},
+				&rpc.NodeSetPersistRateLimitRequest{},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.NodeSetPersistRateLimitRequest{}
+				wireRoundTrip(t, ToProtoNodeSetPersistRateLimitRequest(v.(*rpc.NodeSetPersistRateLimitRequest)), r)
+				return ToRPCNodeSetPersistRateLimitRequest(r)
+			},
+		},
+		{
+			name: "NodeWriteNewSeriesAsyncResult",
+			values: []interface{}{
+				&rpc.NodeWriteNewSeriesAsyncResult_{WriteNewSeriesAsync: true},
+				&rpc.NodeWriteNewSeriesAsyncResult_{},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.NodeWriteNewSeriesAsyncResult{}
+				wireRoundTrip(t, ToProtoNodeWriteNewSeriesAsyncResult(v.(*rpc.NodeWriteNewSeriesAsyncResult_)), r)
+				return ToRPCNodeWriteNewSeriesAsyncResult(r)
+			},
+		},
+		{
+			name: "NodeSetWriteNewSeriesAsyncRequest",
+			values: []interface{}{
+				&rpc.NodeSetWriteNewSeriesAsyncRequest{WriteNewSeriesAsync: true},
+				&rpc.NodeSetWriteNewSeriesAsyncRequest{},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.NodeSetWriteNewSeriesAsyncRequest{}
+				wireRoundTrip(t, ToProtoNodeSetWriteNewSeriesAsyncRequest(v.(*rpc.NodeSetWriteNewSeriesAsyncRequest)), r)
+				return ToRPCNodeSetWriteNewSeriesAsyncRequest(r)
+			},
+		},
+		{
+			name: "NodeWriteNewSeriesBackoffDurationResult",
+			values: []interface{}{
+				&rpc.NodeWriteNewSeriesBackoffDurationResult_{
+					WriteNewSeriesBackoffDuration: 10,
+					DurationType:                  rpc.TimeType_UNIX_MILLISECONDS,
+				},
+				&rpc.NodeWriteNewSeriesBackoffDurationResult_{},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.NodeWriteNewSeriesBackoffDurationResult{}
+				wireRoundTrip(t, ToProtoNodeWriteNewSeriesBackoffDurationResult(v.(*rpc.NodeWriteNewSeriesBackoffDurationResult_)), r)
+				return ToRPCNodeWriteNewSeriesBackoffDurationResult(r)
+			},
+		},
+		{
+			name: "NodeSetWriteNewSeriesBackoffDurationRequest",
+			values: []interface{}{
+				&rpc.NodeSetWriteNewSeriesBackoffDurationRequest{
+					WriteNewSeriesBackoffDuration: 10,
+					DurationType:                  rpc.TimeType_UNIX_NANOSECONDS,
+				},
+				&rpc.NodeSetWriteNewSeriesBackoffDurationRequest{},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.NodeSetWriteNewSeriesBackoffDurationRequest{}
+				wireRoundTrip(t, ToProtoNodeSetWriteNewSeriesBackoffDurationRequest(v.(*rpc.NodeSetWriteNewSeriesBackoffDurationRequest)), r)
+				return ToRPCNodeSetWriteNewSeriesBackoffDurationRequest(r)
+			},
+		},
+		{
+			name: "NodeWriteNewSeriesLimitPerShardPerSecondResult",
+			values: []interface{}{
+				&rpc.NodeWriteNewSeriesLimitPerShardPerSecondResult_{WriteNewSeriesLimitPerShardPerSecond: 1000},
+				&rpc.NodeWriteNewSeriesLimitPerShardPerSecondResult_{},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.NodeWriteNewSeriesLimitPerShardPerSecondResult{}
+				wireRoundTrip(t, ToProtoNodeWriteNewSeriesLimitPerShardPerSecondResult(v.(*rpc.NodeWriteNewSeriesLimitPerShardPerSecondResult_)), r)
+				return ToRPCNodeWriteNewSeriesLimitPerShardPerSecondResult(r)
+			},
+		},
+		{
+			name: "NodeSetWriteNewSeriesLimitPerShardPerSecondRequest",
+			values: []interface{}{
+				&rpc.NodeSetWriteNewSeriesLimitPerShardPerSecondRequest{WriteNewSeriesLimitPerShardPerSecond: 1000},
+				&rpc.NodeSetWriteNewSeriesLimitPerShardPerSecondRequest{},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.NodeSetWriteNewSeriesLimitPerShardPerSecondRequest{}
+				wireRoundTrip(t, ToProtoNodeSetWriteNewSeriesLimitPerShardPerSecondRequest(v.(*rpc.NodeSetWriteNewSeriesLimitPerShardPerSecondRequest)), r)
+				return ToRPCNodeSetWriteNewSeriesLimitPerShardPerSecondRequest(r)
+			},
+		},
+		{
+			name: "NodeBootstrapStatusResult",
+			values: []interface{}{
+				&rpc.NodeBootstrapStatusResult_{Namespaces: []*rpc.NamespaceBootstrapStatus{
+					{
+						NameSpace: "metrics",
+						Shards: []*rpc.ShardBootstrapStatus{
+							{Shard: 0, State: rpc.ShardBootstrapState_BOOTSTRAPPED, Source: &source},
+							{Shard: 1, State: rpc.ShardBootstrapState_FAILED, Error: &errMsg},
+						},
+					},
+					{NameSpace: "other"},
+				}},
+				&rpc.NodeBootstrapStatusResult_{},
+			},
+			roundTrip: func(t *testing.T, v interface{}) interface{} {
+				r := &rpcpb.NodeBootstrapStatusResult{}
+				wireRoundTrip(t, ToProtoNodeBootstrapStatusResult(v.(*rpc.NodeBootstrapStatusResult_)), r)
+				return ToRPCNodeBootstrapStatusResult(r)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		for i, value := range test.values {
+			assert.Equal(t, value, test.roundTrip(t, value), "%s value %d", test.name, i)
+		}
+	}
+}
+
+func TestMessagesNil(t *testing.T) {
+	assert.Nil(t, ToProtoError(nil))
+	assert.Nil(t, ToRPCError(nil))
+	assert.Nil(t, ToProtoWriteBatchRawErrors(nil))
+	assert.Nil(t, ToRPCWriteBatchRawErrors(nil))
+	assert.Nil(t, ToProtoFetchRequest(nil))
+	assert.Nil(t, ToRPCFetchRequest(nil))
+	assert.Nil(t, ToProtoFetchResult(nil))
+	assert.Nil(t, ToRPCFetchResult(nil))
+	assert.Nil(t, ToProtoDatapoint(nil))
+	assert.Nil(t, ToRPCDatapoint(nil))
+	assert.Nil(t, ToProtoWriteRequest(nil))
+	assert.Nil(t, ToRPCWriteRequest(nil))
+	assert.Nil(t, ToProtoWriteTaggedRequest(nil))
+	assert.Nil(t, ToRPCWriteTaggedRequest(nil))
+	assert.Nil(t, ToProtoFetchBatchRawRequest(nil))
+	assert.Nil(t, ToRPCFetchBatchRawRequest(nil))
+	assert.Nil(t, ToProtoFetchBatchRawResult(nil))
+	assert.Nil(t, ToRPCFetchBatchRawResult(nil))
+	assert.Nil(t, ToProtoFetchRawResult(nil))
+	assert.Nil(t, ToRPCFetchRawResult(nil))
+	assert.Nil(t, ToProtoFetchBatchRawPagedRequest(nil))
+	assert.Nil(t, ToRPCFetchBatchRawPagedRequest(nil))
+	assert.Nil(t, ToProtoFetchBatchRawPagedResult(nil))
+	assert.Nil(t, ToRPCFetchBatchRawPagedResult(nil))
+	assert.Nil(t, ToProtoFetchRawPagedResult(nil))
+	assert.Nil(t, ToRPCFetchRawPagedResult(nil))
+	assert.Nil(t, ToProtoSegments(nil))
+	assert.Nil(t, ToRPCSegments(nil))
+	assert.Nil(t, ToProtoSegment(nil))
+	assert.Nil(t, ToRPCSegment(nil))
+	assert.Nil(t, ToProtoFetchTaggedRequest(nil))
+	assert.Nil(t, ToRPCFetchTaggedRequest(nil))
+	assert.Nil(t, ToProtoIdxQuery(nil))
+	assert.Nil(t, ToRPCIdxQuery(nil))
+	assert.Nil(t, ToProtoIdxTagFilter(nil))
+	assert.Nil(t, ToRPCIdxTagFilter(nil))
+	assert.Nil(t, ToProtoFetchTaggedResult(nil))
+	assert.Nil(t, ToRPCFetchTaggedResult(nil))
+	assert.Nil(t, ToProtoFetchTaggedIDResult(nil))
+	assert.Nil(t, ToRPCFetchTaggedIDResult(nil))
+	assert.Nil(t, ToProtoFetchBlocksRawRequest(nil))
+	assert.Nil(t, ToRPCFetchBlocksRawRequest(nil))
+	assert.Nil(t, ToProtoFetchBlocksRawRequestElement(nil))
+	assert.Nil(t, ToRPCFetchBlocksRawRequestElement(nil))
+	assert.Nil(t, ToProtoFetchBlocksRawResult(nil))
+	assert.Nil(t, ToRPCFetchBlocksRawResult(nil))
+	assert.Nil(t, ToProtoBlocks(nil))
+	assert.Nil(t, ToRPCBlocks(nil))
+	assert.Nil(t, ToProtoBlock(nil))
+	assert.Nil(t, ToRPCBlock(nil))
+	assert.Nil(t, ToProtoTagString(nil))
+	assert.Nil(t, ToRPCTagString(nil))
+	assert.Nil(t, ToProtoTagRaw(nil))
+	assert.Nil(t, ToRPCTagRaw(nil))
+	assert.Nil(t, ToProtoFetchBlocksMetadataRawRequest(nil))
+	assert.Nil(t, ToRPCFetchBlocksMetadataRawRequest(nil))
+	assert.Nil(t, ToProtoFetchBlocksMetadataRawResult(nil))
+	assert.Nil(t, ToRPCFetchBlocksMetadataRawResult(nil))
+	assert.Nil(t, ToProtoBlocksMetadata(nil))
+	assert.Nil(t, ToRPCBlocksMetadata(nil))
+	assert.Nil(t, ToProtoBlockMetadata(nil))
+	assert.Nil(t, ToRPCBlockMetadata(nil))
+	assert.Nil(t, ToProtoFetchBlocksMetadataRawV2Request(nil))
+	assert.Nil(t, ToRPCFetchBlocksMetadataRawV2Request(nil))
+	assert.Nil(t, ToProtoFetchBlocksMetadataRawV2Result(nil))
+	assert.Nil(t, ToRPCFetchBlocksMetadataRawV2Result(nil))
+	assert.Nil(t, ToProtoBlockMetadataV2(nil))
+	assert.Nil(t, ToRPCBlockMetadataV2(nil))
+	assert.Nil(t, ToProtoWriteBatchRawRequest(nil))
+	assert.Nil(t, ToRPCWriteBatchRawRequest(nil))
+	assert.Nil(t, ToProtoWriteBatchRawRequestElement(nil))
+	assert.Nil(t, ToRPCWriteBatchRawRequestElement(nil))
+	assert.Nil(t, ToProtoWriteTaggedBatchRawRequest(nil))
+	assert.Nil(t, ToRPCWriteTaggedBatchRawRequest(nil))
+	assert.Nil(t, ToProtoWriteTaggedBatchRawRequestElement(nil))
+	assert.Nil(t, ToRPCWriteTaggedBatchRawRequestElement(nil))
+	assert.Nil(t, ToProtoWriteBatchRawError(nil))
+	assert.Nil(t, ToRPCWriteBatchRawError(nil))
+	assert.Nil(t, ToProtoTruncateRequest(nil))
+	assert.Nil(t, ToRPCTruncateRequest(nil))
+	assert.Nil(t, ToProtoTruncateResult(nil))
+	assert.Nil(t, ToRPCTruncateResult(nil))
+	assert.Nil(t, ToProtoNodeHealthResult(nil))
+	assert.Nil(t, ToRPCNodeHealthResult(nil))
+	assert.Nil(t, ToProtoNodePersistRateLimitResult(nil))
+	assert.Nil(t, ToRPCNodePersistRateLimitResult(nil))
+	assert.Nil(t, ToProtoNodeSetPersistRateLimitRequest(nil))
+	assert.Nil(t, ToRPCNodeSetPersistRateLimitRequest(nil))
+	assert.Nil(t, ToProtoNodeWriteNewSeriesAsyncResult(nil))
+	assert.Nil(t, ToRPCNodeWriteNewSeriesAsyncResult(nil))
+	assert.Nil(t, ToProtoNodeSetWriteNewSeriesAsyncRequest(nil))
+	assert.Nil(t, ToRPCNodeSetWriteNewSeriesAsyncRequest(nil))
+	assert.Nil(t, ToProtoNodeWriteNewSeriesBackoffDurationResult(nil))
+	assert.Nil(t, ToRPCNodeWriteNewSeriesBackoffDurationResult(nil))
+	assert.Nil(t, ToProtoNodeSetWriteNewSeriesBackoffDurationRequest(nil))
+	assert.Nil(t, ToRPCNodeSetWriteNewSeriesBackoffDurationRequest(nil))
+	assert.Nil(t, ToProtoNodeWriteNewSeriesLimitPerShardPerSecondResult(nil))
+	assert.Nil(t, ToRPCNodeWriteNewSeriesLimitPerShardPerSecondResult(nil))
+	assert.Nil(t, ToProtoNodeSetWriteNewSeriesLimitPerShardPerSecondRequest(nil))
+	assert.Nil(t, ToRPCNodeSetWriteNewSeriesLimitPerShardPerSecondRequest(nil))
+	assert.Nil(t, ToProtoNodeBootstrapStatusResult(nil))
+	assert.Nil(t, ToRPCNodeBootstrapStatusResult(nil))
+	assert.Nil(t, ToProtoNamespaceBootstrapStatus(nil))
+	assert.Nil(t, ToRPCNamespaceBootstrapStatus(nil))
+	assert.Nil(t, ToProtoShardBootstrapStatus(nil))
+	assert.Nil(t, ToRPCShardBootstrapStatus(nil))
+	assert.Nil(t, ToProtoHealthResult(nil))
+	assert.Nil(t, ToRPCHealthResult(nil))
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package convert
+
+import (
+	"github.com/m3db/m3db/generated/thrift/rpc"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// ToGRPCError converts an error returned by a service to a gRPC error, RPC
+// errors are mapped to the gRPC code matching their error type
+func ToGRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+	rpcErr, ok := err.(*rpc.Error)
+	if !ok {
+		return grpc.Errorf(codes.Internal, "%v", err)
+	}
+	switch rpcErr.Type {
+	case rpc.ErrorType_BAD_REQUEST:
+		return grpc.Errorf(codes.InvalidArgument, "%s", rpcErr.Message)
+	case rpc.ErrorType_QUOTA_EXCEEDED:
+		return grpc.Errorf(codes.ResourceExhausted, "%s", rpcErr.Message)
+	}
+	return grpc.Errorf(codes.Internal, "%s", rpcErr.Message)
+}
+
+// FromGRPCError converts an error returned by a gRPC call to an RPC error when
+// its code maps to an RPC error type, other errors such as transport errors are
+// returned as is
+func FromGRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var errType rpc.ErrorType
+	switch grpc.Code(err) {
+	case codes.InvalidArgument:
+		errType = rpc.ErrorType_BAD_REQUEST
+	case codes.ResourceExhausted:
+		errType = rpc.ErrorType_QUOTA_EXCEEDED
+	case codes.Internal:
+		errType = rpc.ErrorType_INTERNAL_ERROR
+	default:
+		return err
+	}
+	rpcErr := rpc.NewError()
+	rpcErr.Type = errType
+	rpcErr.Message = grpc.ErrorDesc(err)
+	return rpcErr
+}
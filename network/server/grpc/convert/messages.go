@@ -0,0 +1,1743 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package convert
+
+import (
+	"github.com/m3db/m3db/generated/proto/rpcpb"
+	"github.com/m3db/m3db/generated/thrift/rpc"
+)
+
+// ToProtoError converts a rpc.Error to a rpcpb.Error.
+func ToProtoError(v *rpc.Error) *rpcpb.Error {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.Error{
+		Type:    rpcpb.ErrorType(v.Type),
+		Message: v.Message,
+	}
+}
+
+// ToRPCError converts a rpcpb.Error to a rpc.Error.
+func ToRPCError(v *rpcpb.Error) *rpc.Error {
+	if v == nil {
+		return nil
+	}
+	return &rpc.Error{
+		Type:    rpc.ErrorType(v.Type),
+		Message: v.Message,
+	}
+}
+
+// ToProtoWriteBatchRawErrors converts a rpc.WriteBatchRawErrors to a rpcpb.WriteBatchRawErrors.
+func ToProtoWriteBatchRawErrors(v *rpc.WriteBatchRawErrors) *rpcpb.WriteBatchRawErrors {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.WriteBatchRawErrors{}
+	if v.Errors != nil {
+		r.Errors = make([]*rpcpb.WriteBatchRawError, 0, len(v.Errors))
+		for _, elem := range v.Errors {
+			r.Errors = append(r.Errors, ToProtoWriteBatchRawError(elem))
+		}
+	}
+	return r
+}
+
+// ToRPCWriteBatchRawErrors converts a rpcpb.WriteBatchRawErrors to a rpc.WriteBatchRawErrors.
+func ToRPCWriteBatchRawErrors(v *rpcpb.WriteBatchRawErrors) *rpc.WriteBatchRawErrors {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.WriteBatchRawErrors{}
+	if v.Errors != nil {
+		r.Errors = make([]*rpc.WriteBatchRawError, 0, len(v.Errors))
+		for _, elem := range v.Errors {
+			r.Errors = append(r.Errors, ToRPCWriteBatchRawError(elem))
+		}
+	}
+	return r
+}
+
+// ToProtoFetchRequest converts a rpc.FetchRequest to a rpcpb.FetchRequest.
+func ToProtoFetchRequest(v *rpc.FetchRequest) *rpcpb.FetchRequest {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.FetchRequest{
+		RangeStart:     v.RangeStart,
+		RangeEnd:       v.RangeEnd,
+		NameSpace:      v.NameSpace,
+		Id:             v.ID,
+		RangeType:      rpcpb.TimeType(v.RangeType),
+		ResultTimeType: rpcpb.TimeType(v.ResultTimeType),
+		Aggregation:    rpcpb.AggregationType(v.Aggregation),
+	}
+	if v.Step != nil {
+		r.Step = &rpcpb.Int64Value{Value: *v.Step}
+	}
+	return r
+}
+
+// ToRPCFetchRequest converts a rpcpb.FetchRequest to a rpc.FetchRequest.
+func ToRPCFetchRequest(v *rpcpb.FetchRequest) *rpc.FetchRequest {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.FetchRequest{
+		RangeStart:     v.RangeStart,
+		RangeEnd:       v.RangeEnd,
+		NameSpace:      v.NameSpace,
+		ID:             v.Id,
+		RangeType:      rpc.TimeType(v.RangeType),
+		ResultTimeType: rpc.TimeType(v.ResultTimeType),
+		Aggregation:    rpc.AggregationType(v.Aggregation),
+	}
+	if v.Step != nil {
+		value := v.Step.Value
+		r.Step = &value
+	}
+	return r
+}
+
+// ToProtoFetchResult converts a rpc.FetchResult_ to a rpcpb.FetchResult.
+func ToProtoFetchResult(v *rpc.FetchResult_) *rpcpb.FetchResult {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.FetchResult{}
+	if v.Datapoints != nil {
+		r.Datapoints = make([]*rpcpb.Datapoint, 0, len(v.Datapoints))
+		for _, elem := range v.Datapoints {
+			r.Datapoints = append(r.Datapoints, ToProtoDatapoint(elem))
+		}
+	}
+	return r
+}
+
+// ToRPCFetchResult converts a rpcpb.FetchResult to a rpc.FetchResult_.
+func ToRPCFetchResult(v *rpcpb.FetchResult) *rpc.FetchResult_ {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.FetchResult_{}
+	if v.Datapoints != nil {
+		r.Datapoints = make([]*rpc.Datapoint, 0, len(v.Datapoints))
+		for _, elem := range v.Datapoints {
+			r.Datapoints = append(r.Datapoints, ToRPCDatapoint(elem))
+		}
+	}
+	return r
+}
+
+// ToProtoDatapoint converts a rpc.Datapoint to a rpcpb.Datapoint.
+func ToProtoDatapoint(v *rpc.Datapoint) *rpcpb.Datapoint {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.Datapoint{
+		Timestamp:         v.Timestamp,
+		Value:             v.Value,
+		Annotation:        copyBytes(v.Annotation),
+		TimestampTimeType: rpcpb.TimeType(v.TimestampTimeType),
+	}
+}
+
+// ToRPCDatapoint converts a rpcpb.Datapoint to a rpc.Datapoint.
+func ToRPCDatapoint(v *rpcpb.Datapoint) *rpc.Datapoint {
+	if v == nil {
+		return nil
+	}
+	return &rpc.Datapoint{
+		Timestamp:         v.Timestamp,
+		Value:             v.Value,
+		Annotation:        v.Annotation,
+		TimestampTimeType: rpc.TimeType(v.TimestampTimeType),
+	}
+}
+
+// ToProtoWriteRequest converts a rpc.WriteRequest to a rpcpb.WriteRequest.
+func ToProtoWriteRequest(v *rpc.WriteRequest) *rpcpb.WriteRequest {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.WriteRequest{
+		NameSpace: v.NameSpace,
+		Id:        v.ID,
+		Datapoint: ToProtoDatapoint(v.Datapoint),
+	}
+}
+
+// ToRPCWriteRequest converts a rpcpb.WriteRequest to a rpc.WriteRequest.
+func ToRPCWriteRequest(v *rpcpb.WriteRequest) *rpc.WriteRequest {
+	if v == nil {
+		return nil
+	}
+	return &rpc.WriteRequest{
+		NameSpace: v.NameSpace,
+		ID:        v.Id,
+		Datapoint: ToRPCDatapoint(v.Datapoint),
+	}
+}
+
+// ToProtoWriteTaggedRequest converts a rpc.WriteTaggedRequest to a rpcpb.WriteTaggedRequest.
+func ToProtoWriteTaggedRequest(v *rpc.WriteTaggedRequest) *rpcpb.WriteTaggedRequest {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.WriteTaggedRequest{
+		NameSpace: v.NameSpace,
+		Id:        v.ID,
+		Datapoint: ToProtoDatapoint(v.Datapoint),
+	}
+	if v.Tags != nil {
+		r.Tags = make([]*rpcpb.TagString, 0, len(v.Tags))
+		for _, elem := range v.Tags {
+			r.Tags = append(r.Tags, ToProtoTagString(elem))
+		}
+	}
+	return r
+}
+
+// ToRPCWriteTaggedRequest converts a rpcpb.WriteTaggedRequest to a rpc.WriteTaggedRequest.
+func ToRPCWriteTaggedRequest(v *rpcpb.WriteTaggedRequest) *rpc.WriteTaggedRequest {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.WriteTaggedRequest{
+		NameSpace: v.NameSpace,
+		ID:        v.Id,
+		Datapoint: ToRPCDatapoint(v.Datapoint),
+	}
+	if v.Tags != nil {
+		r.Tags = make([]*rpc.TagString, 0, len(v.Tags))
+		for _, elem := range v.Tags {
+			r.Tags = append(r.Tags, ToRPCTagString(elem))
+		}
+	}
+	return r
+}
+
+// ToProtoFetchBatchRawRequest converts a rpc.FetchBatchRawRequest to a rpcpb.FetchBatchRawRequest.
+func ToProtoFetchBatchRawRequest(v *rpc.FetchBatchRawRequest) *rpcpb.FetchBatchRawRequest {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.FetchBatchRawRequest{
+		RangeStart:    v.RangeStart,
+		RangeEnd:      v.RangeEnd,
+		NameSpace:     copyBytes(v.NameSpace),
+		Ids:           copyBytesSlice(v.Ids),
+		RangeTimeType: rpcpb.TimeType(v.RangeTimeType),
+	}
+}
+
+// ToRPCFetchBatchRawRequest converts a rpcpb.FetchBatchRawRequest to a rpc.FetchBatchRawRequest.
+func ToRPCFetchBatchRawRequest(v *rpcpb.FetchBatchRawRequest) *rpc.FetchBatchRawRequest {
+	if v == nil {
+		return nil
+	}
+	return &rpc.FetchBatchRawRequest{
+		RangeStart:    v.RangeStart,
+		RangeEnd:      v.RangeEnd,
+		NameSpace:     v.NameSpace,
+		Ids:           v.Ids,
+		RangeTimeType: rpc.TimeType(v.RangeTimeType),
+	}
+}
+
+// ToProtoFetchBatchRawResult converts a rpc.FetchBatchRawResult_ to a rpcpb.FetchBatchRawResult.
+func ToProtoFetchBatchRawResult(v *rpc.FetchBatchRawResult_) *rpcpb.FetchBatchRawResult {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.FetchBatchRawResult{}
+	if v.Elements != nil {
+		r.Elements = make([]*rpcpb.FetchRawResult, 0, len(v.Elements))
+		for _, elem := range v.Elements {
+			r.Elements = append(r.Elements, ToProtoFetchRawResult(elem))
+		}
+	}
+	return r
+}
+
+// ToRPCFetchBatchRawResult converts a rpcpb.FetchBatchRawResult to a rpc.FetchBatchRawResult_.
+func ToRPCFetchBatchRawResult(v *rpcpb.FetchBatchRawResult) *rpc.FetchBatchRawResult_ {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.FetchBatchRawResult_{}
+	if v.Elements != nil {
+		r.Elements = make([]*rpc.FetchRawResult_, 0, len(v.Elements))
+		for _, elem := range v.Elements {
+			r.Elements = append(r.Elements, ToRPCFetchRawResult(elem))
+		}
+	}
+	return r
+}
+
+// ToProtoFetchRawResult converts a rpc.FetchRawResult_ to a rpcpb.FetchRawResult.
+func ToProtoFetchRawResult(v *rpc.FetchRawResult_) *rpcpb.FetchRawResult {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.FetchRawResult{
+		Err: ToProtoError(v.Err),
+	}
+	if v.Segments != nil {
+		r.Segments = make([]*rpcpb.Segments, 0, len(v.Segments))
+		for _, elem := range v.Segments {
+			r.Segments = append(r.Segments, ToProtoSegments(elem))
+		}
+	}
+	return r
+}
+
+// ToRPCFetchRawResult converts a rpcpb.FetchRawResult to a rpc.FetchRawResult_.
+func ToRPCFetchRawResult(v *rpcpb.FetchRawResult) *rpc.FetchRawResult_ {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.FetchRawResult_{
+		Err: ToRPCError(v.Err),
+	}
+	if v.Segments != nil {
+		r.Segments = make([]*rpc.Segments, 0, len(v.Segments))
+		for _, elem := range v.Segments {
+			r.Segments = append(r.Segments, ToRPCSegments(elem))
+		}
+	}
+	return r
+}
+
+// ToProtoFetchBatchRawPagedRequest converts a rpc.FetchBatchRawPagedRequest to a rpcpb.FetchBatchRawPagedRequest.
+func ToProtoFetchBatchRawPagedRequest(v *rpc.FetchBatchRawPagedRequest) *rpcpb.FetchBatchRawPagedRequest {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.FetchBatchRawPagedRequest{
+		RangeStart:    v.RangeStart,
+		RangeEnd:      v.RangeEnd,
+		NameSpace:     copyBytes(v.NameSpace),
+		Ids:           copyBytesSlice(v.Ids),
+		Limit:         v.Limit,
+		PageToken:     copyBytes(v.PageToken),
+		RangeTimeType: rpcpb.TimeType(v.RangeTimeType),
+	}
+}
+
+// ToRPCFetchBatchRawPagedRequest converts a rpcpb.FetchBatchRawPagedRequest to a rpc.FetchBatchRawPagedRequest.
+func ToRPCFetchBatchRawPagedRequest(v *rpcpb.FetchBatchRawPagedRequest) *rpc.FetchBatchRawPagedRequest {
+	if v == nil {
+		return nil
+	}
+	return &rpc.FetchBatchRawPagedRequest{
+		RangeStart:    v.RangeStart,
+		RangeEnd:      v.RangeEnd,
+		NameSpace:     v.NameSpace,
+		Ids:           v.Ids,
+		Limit:         v.Limit,
+		PageToken:     v.PageToken,
+		RangeTimeType: rpc.TimeType(v.RangeTimeType),
+	}
+}
+
+// ToProtoFetchBatchRawPagedResult converts a rpc.FetchBatchRawPagedResult_ to a rpcpb.FetchBatchRawPagedResult.
+func ToProtoFetchBatchRawPagedResult(v *rpc.FetchBatchRawPagedResult_) *rpcpb.FetchBatchRawPagedResult {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.FetchBatchRawPagedResult{
+		NextPageToken: copyBytes(v.NextPageToken),
+	}
+	if v.Elements != nil {
+		r.Elements = make([]*rpcpb.FetchRawPagedResult, 0, len(v.Elements))
+		for _, elem := range v.Elements {
+			r.Elements = append(r.Elements, ToProtoFetchRawPagedResult(elem))
+		}
+	}
+	return r
+}
+
+// ToRPCFetchBatchRawPagedResult converts a rpcpb.FetchBatchRawPagedResult to a rpc.FetchBatchRawPagedResult_.
+func ToRPCFetchBatchRawPagedResult(v *rpcpb.FetchBatchRawPagedResult) *rpc.FetchBatchRawPagedResult_ {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.FetchBatchRawPagedResult_{
+		NextPageToken: v.NextPageToken,
+	}
+	if v.Elements != nil {
+		r.Elements = make([]*rpc.FetchRawPagedResult_, 0, len(v.Elements))
+		for _, elem := range v.Elements {
+			r.Elements = append(r.Elements, ToRPCFetchRawPagedResult(elem))
+		}
+	}
+	return r
+}
+
+// ToProtoFetchRawPagedResult converts a rpc.FetchRawPagedResult_ to a rpcpb.FetchRawPagedResult.
+func ToProtoFetchRawPagedResult(v *rpc.FetchRawPagedResult_) *rpcpb.FetchRawPagedResult {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.FetchRawPagedResult{
+		Index: v.Index,
+		Err:   ToProtoError(v.Err),
+	}
+	if v.Segments != nil {
+		r.Segments = make([]*rpcpb.Segments, 0, len(v.Segments))
+		for _, elem := range v.Segments {
+			r.Segments = append(r.Segments, ToProtoSegments(elem))
+		}
+	}
+	return r
+}
+
+// ToRPCFetchRawPagedResult converts a rpcpb.FetchRawPagedResult to a rpc.FetchRawPagedResult_.
+func ToRPCFetchRawPagedResult(v *rpcpb.FetchRawPagedResult) *rpc.FetchRawPagedResult_ {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.FetchRawPagedResult_{
+		Index: v.Index,
+		Err:   ToRPCError(v.Err),
+	}
+	if v.Segments != nil {
+		r.Segments = make([]*rpc.Segments, 0, len(v.Segments))
+		for _, elem := range v.Segments {
+			r.Segments = append(r.Segments, ToRPCSegments(elem))
+		}
+	}
+	return r
+}
+
+// ToProtoSegments converts a rpc.Segments to a rpcpb.Segments.
+func ToProtoSegments(v *rpc.Segments) *rpcpb.Segments {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.Segments{
+		Merged: ToProtoSegment(v.Merged),
+	}
+	if v.Unmerged != nil {
+		r.Unmerged = make([]*rpcpb.Segment, 0, len(v.Unmerged))
+		for _, elem := range v.Unmerged {
+			r.Unmerged = append(r.Unmerged, ToProtoSegment(elem))
+		}
+	}
+	return r
+}
+
+// ToRPCSegments converts a rpcpb.Segments to a rpc.Segments.
+func ToRPCSegments(v *rpcpb.Segments) *rpc.Segments {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.Segments{
+		Merged: ToRPCSegment(v.Merged),
+	}
+	if v.Unmerged != nil {
+		r.Unmerged = make([]*rpc.Segment, 0, len(v.Unmerged))
+		for _, elem := range v.Unmerged {
+			r.Unmerged = append(r.Unmerged, ToRPCSegment(elem))
+		}
+	}
+	return r
+}
+
+// ToProtoSegment converts a rpc.Segment to a rpcpb.Segment.
+func ToProtoSegment(v *rpc.Segment) *rpcpb.Segment {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.Segment{
+		Head: copyBytes(v.Head),
+		Tail: copyBytes(v.Tail),
+	}
+}
+
+// ToRPCSegment converts a rpcpb.Segment to a rpc.Segment.
+func ToRPCSegment(v *rpcpb.Segment) *rpc.Segment {
+	if v == nil {
+		return nil
+	}
+	return &rpc.Segment{
+		Head: v.Head,
+		Tail: v.Tail,
+	}
+}
+
+// ToProtoFetchTaggedRequest converts a rpc.FetchTaggedRequest to a rpcpb.FetchTaggedRequest.
+func ToProtoFetchTaggedRequest(v *rpc.FetchTaggedRequest) *rpcpb.FetchTaggedRequest {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.FetchTaggedRequest{
+		Query:         ToProtoIdxQuery(v.Query),
+		RangeStart:    v.RangeStart,
+		RangeEnd:      v.RangeEnd,
+		FetchData:     v.FetchData,
+		RangeTimeType: rpcpb.TimeType(v.RangeTimeType),
+		Aggregation:   rpcpb.AggregationType(v.Aggregation),
+	}
+	if v.Limit != nil {
+		r.Limit = &rpcpb.Int64Value{Value: *v.Limit}
+	}
+	if v.Step != nil {
+		r.Step = &rpcpb.Int64Value{Value: *v.Step}
+	}
+	return r
+}
+
+// ToRPCFetchTaggedRequest converts a rpcpb.FetchTaggedRequest to a rpc.FetchTaggedRequest.
+func ToRPCFetchTaggedRequest(v *rpcpb.FetchTaggedRequest) *rpc.FetchTaggedRequest {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.FetchTaggedRequest{
+		Query:         ToRPCIdxQuery(v.Query),
+		RangeStart:    v.RangeStart,
+		RangeEnd:      v.RangeEnd,
+		FetchData:     v.FetchData,
+		RangeTimeType: rpc.TimeType(v.RangeTimeType),
+		Aggregation:   rpc.AggregationType(v.Aggregation),
+	}
+	if v.Limit != nil {
+		value := v.Limit.Value
+		r.Limit = &value
+	}
+	if v.Step != nil {
+		value := v.Step.Value
+		r.Step = &value
+	}
+	return r
+}
+
+// ToProtoIdxQuery converts a rpc.IdxQuery to a rpcpb.IdxQuery.
+func ToProtoIdxQuery(v *rpc.IdxQuery) *rpcpb.IdxQuery {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.IdxQuery{
+		Operator: rpcpb.BooleanOperator(v.Operator),
+	}
+	if v.Filters != nil {
+		r.Filters = make([]*rpcpb.IdxTagFilter, 0, len(v.Filters))
+		for _, elem := range v.Filters {
+			r.Filters = append(r.Filters, ToProtoIdxTagFilter(elem))
+		}
+	}
+	if v.SubQueries != nil {
+		r.SubQueries = make([]*rpcpb.IdxQuery, 0, len(v.SubQueries))
+		for _, elem := range v.SubQueries {
+			r.SubQueries = append(r.SubQueries, ToProtoIdxQuery(elem))
+		}
+	}
+	return r
+}
+
+// ToRPCIdxQuery converts a rpcpb.IdxQuery to a rpc.IdxQuery.
+func ToRPCIdxQuery(v *rpcpb.IdxQuery) *rpc.IdxQuery {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.IdxQuery{
+		Operator: rpc.BooleanOperator(v.Operator),
+	}
+	if v.Filters != nil {
+		r.Filters = make([]*rpc.IdxTagFilter, 0, len(v.Filters))
+		for _, elem := range v.Filters {
+			r.Filters = append(r.Filters, ToRPCIdxTagFilter(elem))
+		}
+	}
+	if v.SubQueries != nil {
+		r.SubQueries = make([]*rpc.IdxQuery, 0, len(v.SubQueries))
+		for _, elem := range v.SubQueries {
+			r.SubQueries = append(r.SubQueries, ToRPCIdxQuery(elem))
+		}
+	}
+	return r
+}
+
+// ToProtoIdxTagFilter converts a rpc.IdxTagFilter to a rpcpb.IdxTagFilter.
+func ToProtoIdxTagFilter(v *rpc.IdxTagFilter) *rpcpb.IdxTagFilter {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.IdxTagFilter{
+		TagName:        v.TagName,
+		TagValueFilter: v.TagValueFilter,
+		Negate:         v.Negate,
+		Regexp:         v.Regexp,
+	}
+}
+
+// ToRPCIdxTagFilter converts a rpcpb.IdxTagFilter to a rpc.IdxTagFilter.
+func ToRPCIdxTagFilter(v *rpcpb.IdxTagFilter) *rpc.IdxTagFilter {
+	if v == nil {
+		return nil
+	}
+	return &rpc.IdxTagFilter{
+		TagName:        v.TagName,
+		TagValueFilter: v.TagValueFilter,
+		Negate:         v.Negate,
+		Regexp:         v.Regexp,
+	}
+}
+
+// ToProtoFetchTaggedResult converts a rpc.FetchTaggedResult_ to a rpcpb.FetchTaggedResult.
+func ToProtoFetchTaggedResult(v *rpc.FetchTaggedResult_) *rpcpb.FetchTaggedResult {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.FetchTaggedResult{
+		Exhaustive: v.Exhaustive,
+	}
+	if v.Elements != nil {
+		r.Elements = make([]*rpcpb.FetchTaggedIDResult, 0, len(v.Elements))
+		for _, elem := range v.Elements {
+			r.Elements = append(r.Elements, ToProtoFetchTaggedIDResult(elem))
+		}
+	}
+	return r
+}
+
+// ToRPCFetchTaggedResult converts a rpcpb.FetchTaggedResult to a rpc.FetchTaggedResult_.
+func ToRPCFetchTaggedResult(v *rpcpb.FetchTaggedResult) *rpc.FetchTaggedResult_ {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.FetchTaggedResult_{
+		Exhaustive: v.Exhaustive,
+	}
+	if v.Elements != nil {
+		r.Elements = make([]*rpc.FetchTaggedIDResult_, 0, len(v.Elements))
+		for _, elem := range v.Elements {
+			r.Elements = append(r.Elements, ToRPCFetchTaggedIDResult(elem))
+		}
+	}
+	return r
+}
+
+// ToProtoFetchTaggedIDResult converts a rpc.FetchTaggedIDResult_ to a rpcpb.FetchTaggedIDResult.
+func ToProtoFetchTaggedIDResult(v *rpc.FetchTaggedIDResult_) *rpcpb.FetchTaggedIDResult {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.FetchTaggedIDResult{
+		Id:        v.ID,
+		NameSpace: v.NameSpace,
+		Err:       ToProtoError(v.Err),
+	}
+	if v.Tags != nil {
+		r.Tags = make([]*rpcpb.TagString, 0, len(v.Tags))
+		for _, elem := range v.Tags {
+			r.Tags = append(r.Tags, ToProtoTagString(elem))
+		}
+	}
+	if v.Datapoints != nil {
+		r.Datapoints = make([]*rpcpb.Datapoint, 0, len(v.Datapoints))
+		for _, elem := range v.Datapoints {
+			r.Datapoints = append(r.Datapoints, ToProtoDatapoint(elem))
+		}
+	}
+	return r
+}
+
+// ToRPCFetchTaggedIDResult converts a rpcpb.FetchTaggedIDResult to a rpc.FetchTaggedIDResult_.
+func ToRPCFetchTaggedIDResult(v *rpcpb.FetchTaggedIDResult) *rpc.FetchTaggedIDResult_ {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.FetchTaggedIDResult_{
+		ID:        v.Id,
+		NameSpace: v.NameSpace,
+		Err:       ToRPCError(v.Err),
+	}
+	if v.Tags != nil {
+		r.Tags = make([]*rpc.TagString, 0, len(v.Tags))
+		for _, elem := range v.Tags {
+			r.Tags = append(r.Tags, ToRPCTagString(elem))
+		}
+	}
+	if v.Datapoints != nil {
+		r.Datapoints = make([]*rpc.Datapoint, 0, len(v.Datapoints))
+		for _, elem := range v.Datapoints {
+			r.Datapoints = append(r.Datapoints, ToRPCDatapoint(elem))
+		}
+	}
+	return r
+}
+
+// ToProtoFetchBlocksRawRequest converts a rpc.FetchBlocksRawRequest to a rpcpb.FetchBlocksRawRequest.
+func ToProtoFetchBlocksRawRequest(v *rpc.FetchBlocksRawRequest) *rpcpb.FetchBlocksRawRequest {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.FetchBlocksRawRequest{
+		NameSpace: copyBytes(v.NameSpace),
+		Shard:     v.Shard,
+	}
+	if v.Elements != nil {
+		r.Elements = make([]*rpcpb.FetchBlocksRawRequestElement, 0, len(v.Elements))
+		for _, elem := range v.Elements {
+			r.Elements = append(r.Elements, ToProtoFetchBlocksRawRequestElement(elem))
+		}
+	}
+	return r
+}
+
+// ToRPCFetchBlocksRawRequest converts a rpcpb.FetchBlocksRawRequest to a rpc.FetchBlocksRawRequest.
+func ToRPCFetchBlocksRawRequest(v *rpcpb.FetchBlocksRawRequest) *rpc.FetchBlocksRawRequest {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.FetchBlocksRawRequest{
+		NameSpace: v.NameSpace,
+		Shard:     v.Shard,
+	}
+	if v.Elements != nil {
+		r.Elements = make([]*rpc.FetchBlocksRawRequestElement, 0, len(v.Elements))
+		for _, elem := range v.Elements {
+			r.Elements = append(r.Elements, ToRPCFetchBlocksRawRequestElement(elem))
+		}
+	}
+	return r
+}
+
+// ToProtoFetchBlocksRawRequestElement converts a rpc.FetchBlocksRawRequestElement to a rpcpb.FetchBlocksRawRequestElement.
+func ToProtoFetchBlocksRawRequestElement(v *rpc.FetchBlocksRawRequestElement) *rpcpb.FetchBlocksRawRequestElement {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.FetchBlocksRawRequestElement{
+		Id:     copyBytes(v.ID),
+		Starts: append([]int64(nil), v.Starts...),
+	}
+}
+
+// ToRPCFetchBlocksRawRequestElement converts a rpcpb.FetchBlocksRawRequestElement to a rpc.FetchBlocksRawRequestElement.
+func ToRPCFetchBlocksRawRequestElement(v *rpcpb.FetchBlocksRawRequestElement) *rpc.FetchBlocksRawRequestElement {
+	if v == nil {
+		return nil
+	}
+	return &rpc.FetchBlocksRawRequestElement{
+		ID:     v.Id,
+		Starts: v.Starts,
+	}
+}
+
+// ToProtoFetchBlocksRawResult converts a rpc.FetchBlocksRawResult_ to a rpcpb.FetchBlocksRawResult.
+func ToProtoFetchBlocksRawResult(v *rpc.FetchBlocksRawResult_) *rpcpb.FetchBlocksRawResult {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.FetchBlocksRawResult{}
+	if v.Elements != nil {
+		r.Elements = make([]*rpcpb.Blocks, 0, len(v.Elements))
+		for _, elem := range v.Elements {
+			r.Elements = append(r.Elements, ToProtoBlocks(elem))
+		}
+	}
+	return r
+}
+
+// ToRPCFetchBlocksRawResult converts a rpcpb.FetchBlocksRawResult to a rpc.FetchBlocksRawResult_.
+func ToRPCFetchBlocksRawResult(v *rpcpb.FetchBlocksRawResult) *rpc.FetchBlocksRawResult_ {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.FetchBlocksRawResult_{}
+	if v.Elements != nil {
+		r.Elements = make([]*rpc.Blocks, 0, len(v.Elements))
+		for _, elem := range v.Elements {
+			r.Elements = append(r.Elements, ToRPCBlocks(elem))
+		}
+	}
+	return r
+}
+
+// ToProtoBlocks converts a rpc.Blocks to a rpcpb.Blocks.
+func ToProtoBlocks(v *rpc.Blocks) *rpcpb.Blocks {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.Blocks{
+		Id: copyBytes(v.ID),
+	}
+	if v.Blocks != nil {
+		r.Blocks = make([]*rpcpb.Block, 0, len(v.Blocks))
+		for _, elem := range v.Blocks {
+			r.Blocks = append(r.Blocks, ToProtoBlock(elem))
+		}
+	}
+	return r
+}
+
+// ToRPCBlocks converts a rpcpb.Blocks to a rpc.Blocks.
+func ToRPCBlocks(v *rpcpb.Blocks) *rpc.Blocks {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.Blocks{
+		ID: v.Id,
+	}
+	if v.Blocks != nil {
+		r.Blocks = make([]*rpc.Block, 0, len(v.Blocks))
+		for _, elem := range v.Blocks {
+			r.Blocks = append(r.Blocks, ToRPCBlock(elem))
+		}
+	}
+	return r
+}
+
+// ToProtoBlock converts a rpc.Block to a rpcpb.Block.
+func ToProtoBlock(v *rpc.Block) *rpcpb.Block {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.Block{
+		Start:    v.Start,
+		Segments: ToProtoSegments(v.Segments),
+		Err:      ToProtoError(v.Err),
+	}
+	if v.Checksum != nil {
+		r.Checksum = &rpcpb.Int64Value{Value: *v.Checksum}
+	}
+	return r
+}
+
+// ToRPCBlock converts a rpcpb.Block to a rpc.Block.
+func ToRPCBlock(v *rpcpb.Block) *rpc.Block {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.Block{
+		Start:    v.Start,
+		Segments: ToRPCSegments(v.Segments),
+		Err:      ToRPCError(v.Err),
+	}
+	if v.Checksum != nil {
+		value := v.Checksum.Value
+		r.Checksum = &value
+	}
+	return r
+}
+
+// ToProtoTagString converts a rpc.TagString to a rpcpb.TagString.
+func ToProtoTagString(v *rpc.TagString) *rpcpb.TagString {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.TagString{
+		Name:  v.Name,
+		Value: v.Value,
+	}
+}
+
+// ToRPCTagString converts a rpcpb.TagString to a rpc.TagString.
+func ToRPCTagString(v *rpcpb.TagString) *rpc.TagString {
+	if v == nil {
+		return nil
+	}
+	return &rpc.TagString{
+		Name:  v.Name,
+		Value: v.Value,
+	}
+}
+
+// ToProtoTagRaw converts a rpc.TagRaw to a rpcpb.TagRaw.
+func ToProtoTagRaw(v *rpc.TagRaw) *rpcpb.TagRaw {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.TagRaw{
+		Name:  copyBytes(v.Name),
+		Value: copyBytes(v.Value),
+	}
+}
+
+// ToRPCTagRaw converts a rpcpb.TagRaw to a rpc.TagRaw.
+func ToRPCTagRaw(v *rpcpb.TagRaw) *rpc.TagRaw {
+	if v == nil {
+		return nil
+	}
+	return &rpc.TagRaw{
+		Name:  v.Name,
+		Value: v.Value,
+	}
+}
+
+// ToProtoFetchBlocksMetadataRawRequest converts a rpc.FetchBlocksMetadataRawRequest to a rpcpb.FetchBlocksMetadataRawRequest.
+func ToProtoFetchBlocksMetadataRawRequest(v *rpc.FetchBlocksMetadataRawRequest) *rpcpb.FetchBlocksMetadataRawRequest {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.FetchBlocksMetadataRawRequest{
+		NameSpace:  copyBytes(v.NameSpace),
+		Shard:      v.Shard,
+		RangeStart: v.RangeStart,
+		RangeEnd:   v.RangeEnd,
+		Limit:      v.Limit,
+	}
+	if v.PageToken != nil {
+		r.PageToken = &rpcpb.Int64Value{Value: *v.PageToken}
+	}
+	if v.IncludeSizes != nil {
+		r.IncludeSizes = &rpcpb.BoolValue{Value: *v.IncludeSizes}
+	}
+	if v.IncludeChecksums != nil {
+		r.IncludeChecksums = &rpcpb.BoolValue{Value: *v.IncludeChecksums}
+	}
+	if v.IncludeLastRead != nil {
+		r.IncludeLastRead = &rpcpb.BoolValue{Value: *v.IncludeLastRead}
+	}
+	return r
+}
+
+// ToRPCFetchBlocksMetadataRawRequest converts a rpcpb.FetchBlocksMetadataRawRequest to a rpc.FetchBlocksMetadataRawRequest.
+func ToRPCFetchBlocksMetadataRawRequest(v *rpcpb.FetchBlocksMetadataRawRequest) *rpc.FetchBlocksMetadataRawRequest {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.FetchBlocksMetadataRawRequest{
+		NameSpace:  v.NameSpace,
+		Shard:      v.Shard,
+		RangeStart: v.RangeStart,
+		RangeEnd:   v.RangeEnd,
+		Limit:      v.Limit,
+	}
+	if v.PageToken != nil {
+		value := v.PageToken.Value
+		r.PageToken = &value
+	}
+	if v.IncludeSizes != nil {
+		value := v.IncludeSizes.Value
+		r.IncludeSizes = &value
+	}
+	if v.IncludeChecksums != nil {
+		value := v.IncludeChecksums.Value
+		r.IncludeChecksums = &value
+	}
+	if v.IncludeLastRead != nil {
+		value := v.IncludeLastRead.Value
+		r.IncludeLastRead = &value
+	}
+	return r
+}
+
+// ToProtoFetchBlocksMetadataRawResult converts a rpc.FetchBlocksMetadataRawResult_ to a rpcpb.FetchBlocksMetadataRawResult.
+func ToProtoFetchBlocksMetadataRawResult(v *rpc.FetchBlocksMetadataRawResult_) *rpcpb.FetchBlocksMetadataRawResult {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.FetchBlocksMetadataRawResult{}
+	if v.Elements != nil {
+		r.Elements = make([]*rpcpb.BlocksMetadata, 0, len(v.Elements))
+		for _, elem := range v.Elements {
+			r.Elements = append(r.Elements, ToProtoBlocksMetadata(elem))
+		}
+	}
+	if v.NextPageToken != nil {
+		r.NextPageToken = &rpcpb.Int64Value{Value: *v.NextPageToken}
+	}
+	return r
+}
+
+// ToRPCFetchBlocksMetadataRawResult converts a rpcpb.FetchBlocksMetadataRawResult to a rpc.FetchBlocksMetadataRawResult_.
+func ToRPCFetchBlocksMetadataRawResult(v *rpcpb.FetchBlocksMetadataRawResult) *rpc.FetchBlocksMetadataRawResult_ {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.FetchBlocksMetadataRawResult_{}
+	if v.Elements != nil {
+		r.Elements = make([]*rpc.BlocksMetadata, 0, len(v.Elements))
+		for _, elem := range v.Elements {
+			r.Elements = append(r.Elements, ToRPCBlocksMetadata(elem))
+		}
+	}
+	if v.NextPageToken != nil {
+		value := v.NextPageToken.Value
+		r.NextPageToken = &value
+	}
+	return r
+}
+
+// ToProtoBlocksMetadata converts a rpc.BlocksMetadata to a rpcpb.BlocksMetadata.
+func ToProtoBlocksMetadata(v *rpc.BlocksMetadata) *rpcpb.BlocksMetadata {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.BlocksMetadata{
+		Id: copyBytes(v.ID),
+	}
+	if v.Blocks != nil {
+		r.Blocks = make([]*rpcpb.BlockMetadata, 0, len(v.Blocks))
+		for _, elem := range v.Blocks {
+			r.Blocks = append(r.Blocks, ToProtoBlockMetadata(elem))
+		}
+	}
+	return r
+}
+
+// ToRPCBlocksMetadata converts a rpcpb.BlocksMetadata to a rpc.BlocksMetadata.
+func ToRPCBlocksMetadata(v *rpcpb.BlocksMetadata) *rpc.BlocksMetadata {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.BlocksMetadata{
+		ID: v.Id,
+	}
+	if v.Blocks != nil {
+		r.Blocks = make([]*rpc.BlockMetadata, 0, len(v.Blocks))
+		for _, elem := range v.Blocks {
+			r.Blocks = append(r.Blocks, ToRPCBlockMetadata(elem))
+		}
+	}
+	return r
+}
+
+// ToProtoBlockMetadata converts a rpc.BlockMetadata to a rpcpb.BlockMetadata.
+func ToProtoBlockMetadata(v *rpc.BlockMetadata) *rpcpb.BlockMetadata {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.BlockMetadata{
+		Err:              ToProtoError(v.Err),
+		Start:            v.Start,
+		LastReadTimeType: rpcpb.TimeType(v.LastReadTimeType),
+	}
+	if v.Size != nil {
+		r.Size = &rpcpb.Int64Value{Value: *v.Size}
+	}
+	if v.Checksum != nil {
+		r.Checksum = &rpcpb.Int64Value{Value: *v.Checksum}
+	}
+	if v.LastRead != nil {
+		r.LastRead = &rpcpb.Int64Value{Value: *v.LastRead}
+	}
+	return r
+}
+
+// ToRPCBlockMetadata converts a rpcpb.BlockMetadata to a rpc.BlockMetadata.
+func ToRPCBlockMetadata(v *rpcpb.BlockMetadata) *rpc.BlockMetadata {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.BlockMetadata{
+		Err:              ToRPCError(v.Err),
+		Start:            v.Start,
+		LastReadTimeType: rpc.TimeType(v.LastReadTimeType),
+	}
+	if v.Size != nil {
+		value := v.Size.Value
+		r.Size = &value
+	}
+	if v.Checksum != nil {
+		value := v.Checksum.Value
+		r.Checksum = &value
+	}
+	if v.LastRead != nil {
+		value := v.LastRead.Value
+		r.LastRead = &value
+	}
+	return r
+}
+
+// ToProtoFetchBlocksMetadataRawV2Request converts a rpc.FetchBlocksMetadataRawV2Request to a rpcpb.FetchBlocksMetadataRawV2Request.
+func ToProtoFetchBlocksMetadataRawV2Request(v *rpc.FetchBlocksMetadataRawV2Request) *rpcpb.FetchBlocksMetadataRawV2Request {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.FetchBlocksMetadataRawV2Request{
+		NameSpace:  copyBytes(v.NameSpace),
+		Shard:      v.Shard,
+		RangeStart: v.RangeStart,
+		RangeEnd:   v.RangeEnd,
+		Limit:      v.Limit,
+		PageToken:  copyBytes(v.PageToken),
+	}
+	if v.IncludeSizes != nil {
+		r.IncludeSizes = &rpcpb.BoolValue{Value: *v.IncludeSizes}
+	}
+	if v.IncludeChecksums != nil {
+		r.IncludeChecksums = &rpcpb.BoolValue{Value: *v.IncludeChecksums}
+	}
+	if v.IncludeLastRead != nil {
+		r.IncludeLastRead = &rpcpb.BoolValue{Value: *v.IncludeLastRead}
+	}
+	return r
+}
+
+// ToRPCFetchBlocksMetadataRawV2Request converts a rpcpb.FetchBlocksMetadataRawV2Request to a rpc.FetchBlocksMetadataRawV2Request.
+func ToRPCFetchBlocksMetadataRawV2Request(v *rpcpb.FetchBlocksMetadataRawV2Request) *rpc.FetchBlocksMetadataRawV2Request {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.FetchBlocksMetadataRawV2Request{
+		NameSpace:  v.NameSpace,
+		Shard:      v.Shard,
+		RangeStart: v.RangeStart,
+		RangeEnd:   v.RangeEnd,
+		Limit:      v.Limit,
+		PageToken:  v.PageToken,
+	}
+	if v.IncludeSizes != nil {
+		value := v.IncludeSizes.Value
+		r.IncludeSizes = &value
+	}
+	if v.IncludeChecksums != nil {
+		value := v.IncludeChecksums.Value
+		r.IncludeChecksums = &value
+	}
+	if v.IncludeLastRead != nil {
+		value := v.IncludeLastRead.Value
+		r.IncludeLastRead = &value
+	}
+	return r
+}
+
+// ToProtoFetchBlocksMetadataRawV2Result converts a rpc.FetchBlocksMetadataRawV2Result_ to a rpcpb.FetchBlocksMetadataRawV2Result.
+func ToProtoFetchBlocksMetadataRawV2Result(v *rpc.FetchBlocksMetadataRawV2Result_) *rpcpb.FetchBlocksMetadataRawV2Result {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.FetchBlocksMetadataRawV2Result{
+		NextPageToken: copyBytes(v.NextPageToken),
+	}
+	if v.Elements != nil {
+		r.Elements = make([]*rpcpb.BlockMetadataV2, 0, len(v.Elements))
+		for _, elem := range v.Elements {
+			r.Elements = append(r.Elements, ToProtoBlockMetadataV2(elem))
+		}
+	}
+	return r
+}
+
+// ToRPCFetchBlocksMetadataRawV2Result converts a rpcpb.FetchBlocksMetadataRawV2Result to a rpc.FetchBlocksMetadataRawV2Result_.
+func ToRPCFetchBlocksMetadataRawV2Result(v *rpcpb.FetchBlocksMetadataRawV2Result) *rpc.FetchBlocksMetadataRawV2Result_ {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.FetchBlocksMetadataRawV2Result_{
+		NextPageToken: v.NextPageToken,
+	}
+	if v.Elements != nil {
+		r.Elements = make([]*rpc.BlockMetadataV2, 0, len(v.Elements))
+		for _, elem := range v.Elements {
+			r.Elements = append(r.Elements, ToRPCBlockMetadataV2(elem))
+		}
+	}
+	return r
+}
+
+// ToProtoBlockMetadataV2 converts a rpc.BlockMetadataV2 to a rpcpb.BlockMetadataV2.
+func ToProtoBlockMetadataV2(v *rpc.BlockMetadataV2) *rpcpb.BlockMetadataV2 {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.BlockMetadataV2{
+		Id:               copyBytes(v.ID),
+		Start:            v.Start,
+		Err:              ToProtoError(v.Err),
+		LastReadTimeType: rpcpb.TimeType(v.LastReadTimeType),
+		EncodedTags:      copyBytes(v.EncodedTags),
+	}
+	if v.Size != nil {
+		r.Size = &rpcpb.Int64Value{Value: *v.Size}
+	}
+	if v.Checksum != nil {
+		r.Checksum = &rpcpb.Int64Value{Value: *v.Checksum}
+	}
+	if v.LastRead != nil {
+		r.LastRead = &rpcpb.Int64Value{Value: *v.LastRead}
+	}
+	return r
+}
+
+// ToRPCBlockMetadataV2 converts a rpcpb.BlockMetadataV2 to a rpc.BlockMetadataV2.
+func ToRPCBlockMetadataV2(v *rpcpb.BlockMetadataV2) *rpc.BlockMetadataV2 {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.BlockMetadataV2{
+		ID:               v.Id,
+		Start:            v.Start,
+		Err:              ToRPCError(v.Err),
+		LastReadTimeType: rpc.TimeType(v.LastReadTimeType),
+		EncodedTags:      v.EncodedTags,
+	}
+	if v.Size != nil {
+		value := v.Size.Value
+		r.Size = &value
+	}
+	if v.Checksum != nil {
+		value := v.Checksum.Value
+		r.Checksum = &value
+	}
+	if v.LastRead != nil {
+		value := v.LastRead.Value
+		r.LastRead = &value
+	}
+	return r
+}
+
+// ToProtoWriteBatchRawRequest converts a rpc.WriteBatchRawRequest to a rpcpb.WriteBatchRawRequest.
+func ToProtoWriteBatchRawRequest(v *rpc.WriteBatchRawRequest) *rpcpb.WriteBatchRawRequest {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.WriteBatchRawRequest{
+		NameSpace: copyBytes(v.NameSpace),
+	}
+	if v.Elements != nil {
+		r.Elements = make([]*rpcpb.WriteBatchRawRequestElement, 0, len(v.Elements))
+		for _, elem := range v.Elements {
+			r.Elements = append(r.Elements, ToProtoWriteBatchRawRequestElement(elem))
+		}
+	}
+	return r
+}
+
+// ToRPCWriteBatchRawRequest converts a rpcpb.WriteBatchRawRequest to a rpc.WriteBatchRawRequest.
+func ToRPCWriteBatchRawRequest(v *rpcpb.WriteBatchRawRequest) *rpc.WriteBatchRawRequest {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.WriteBatchRawRequest{
+		NameSpace: v.NameSpace,
+	}
+	if v.Elements != nil {
+		r.Elements = make([]*rpc.WriteBatchRawRequestElement, 0, len(v.Elements))
+		for _, elem := range v.Elements {
+			r.Elements = append(r.Elements, ToRPCWriteBatchRawRequestElement(elem))
+		}
+	}
+	return r
+}
+
+// ToProtoWriteBatchRawRequestElement converts a rpc.WriteBatchRawRequestElement to a rpcpb.WriteBatchRawRequestElement.
+func ToProtoWriteBatchRawRequestElement(v *rpc.WriteBatchRawRequestElement) *rpcpb.WriteBatchRawRequestElement {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.WriteBatchRawRequestElement{
+		Id:        copyBytes(v.ID),
+		Datapoint: ToProtoDatapoint(v.Datapoint),
+	}
+}
+
+// ToRPCWriteBatchRawRequestElement converts a rpcpb.WriteBatchRawRequestElement to a rpc.WriteBatchRawRequestElement.
+func ToRPCWriteBatchRawRequestElement(v *rpcpb.WriteBatchRawRequestElement) *rpc.WriteBatchRawRequestElement {
+	if v == nil {
+		return nil
+	}
+	return &rpc.WriteBatchRawRequestElement{
+		ID:        v.Id,
+		Datapoint: ToRPCDatapoint(v.Datapoint),
+	}
+}
+
+// ToProtoWriteTaggedBatchRawRequest converts a rpc.WriteTaggedBatchRawRequest to a rpcpb.WriteTaggedBatchRawRequest.
+func ToProtoWriteTaggedBatchRawRequest(v *rpc.WriteTaggedBatchRawRequest) *rpcpb.WriteTaggedBatchRawRequest {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.WriteTaggedBatchRawRequest{
+		NameSpace: copyBytes(v.NameSpace),
+	}
+	if v.Elements != nil {
+		r.Elements = make([]*rpcpb.WriteTaggedBatchRawRequestElement, 0, len(v.Elements))
+		for _, elem := range v.Elements {
+			r.Elements = append(r.Elements, ToProtoWriteTaggedBatchRawRequestElement(elem))
+		}
+	}
+	return r
+}
+
+// ToRPCWriteTaggedBatchRawRequest converts a rpcpb.WriteTaggedBatchRawRequest to a rpc.WriteTaggedBatchRawRequest.
+func ToRPCWriteTaggedBatchRawRequest(v *rpcpb.WriteTaggedBatchRawRequest) *rpc.WriteTaggedBatchRawRequest {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.WriteTaggedBatchRawRequest{
+		NameSpace: v.NameSpace,
+	}
+	if v.Elements != nil {
+		r.Elements = make([]*rpc.WriteTaggedBatchRawRequestElement, 0, len(v.Elements))
+		for _, elem := range v.Elements {
+			r.Elements = append(r.Elements, ToRPCWriteTaggedBatchRawRequestElement(elem))
+		}
+	}
+	return r
+}
+
+// ToProtoWriteTaggedBatchRawRequestElement converts a rpc.WriteTaggedBatchRawRequestElement to a rpcpb.WriteTaggedBatchRawRequestElement.
+func ToProtoWriteTaggedBatchRawRequestElement(v *rpc.WriteTaggedBatchRawRequestElement) *rpcpb.WriteTaggedBatchRawRequestElement {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.WriteTaggedBatchRawRequestElement{
+		Id:        copyBytes(v.ID),
+		Datapoint: ToProtoDatapoint(v.Datapoint),
+	}
+	if v.Tags != nil {
+		r.Tags = make([]*rpcpb.TagRaw, 0, len(v.Tags))
+		for _, elem := range v.Tags {
+			r.Tags = append(r.Tags, ToProtoTagRaw(elem))
+		}
+	}
+	return r
+}
+
+// ToRPCWriteTaggedBatchRawRequestElement converts a rpcpb.WriteTaggedBatchRawRequestElement to a rpc.WriteTaggedBatchRawRequestElement.
+func ToRPCWriteTaggedBatchRawRequestElement(v *rpcpb.WriteTaggedBatchRawRequestElement) *rpc.WriteTaggedBatchRawRequestElement {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.WriteTaggedBatchRawRequestElement{
+		ID:        v.Id,
+		Datapoint: ToRPCDatapoint(v.Datapoint),
+	}
+	if v.Tags != nil {
+		r.Tags = make([]*rpc.TagRaw, 0, len(v.Tags))
+		for _, elem := range v.Tags {
+			r.Tags = append(r.Tags, ToRPCTagRaw(elem))
+		}
+	}
+	return r
+}
+
+// ToProtoWriteBatchRawError converts a rpc.WriteBatchRawError to a rpcpb.WriteBatchRawError.
+func ToProtoWriteBatchRawError(v *rpc.WriteBatchRawError) *rpcpb.WriteBatchRawError {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.WriteBatchRawError{
+		Index: v.Index,
+		Err:   ToProtoError(v.Err),
+	}
+}
+
+// ToRPCWriteBatchRawError converts a rpcpb.WriteBatchRawError to a rpc.WriteBatchRawError.
+func ToRPCWriteBatchRawError(v *rpcpb.WriteBatchRawError) *rpc.WriteBatchRawError {
+	if v == nil {
+		return nil
+	}
+	return &rpc.WriteBatchRawError{
+		Index: v.Index,
+		Err:   ToRPCError(v.Err),
+	}
+}
+
+// ToProtoTruncateRequest converts a rpc.TruncateRequest to a rpcpb.TruncateRequest.
+func ToProtoTruncateRequest(v *rpc.TruncateRequest) *rpcpb.TruncateRequest {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.TruncateRequest{
+		NameSpace: copyBytes(v.NameSpace),
+	}
+}
+
+// ToRPCTruncateRequest converts a rpcpb.TruncateRequest to a rpc.TruncateRequest.
+func ToRPCTruncateRequest(v *rpcpb.TruncateRequest) *rpc.TruncateRequest {
+	if v == nil {
+		return nil
+	}
+	return &rpc.TruncateRequest{
+		NameSpace: v.NameSpace,
+	}
+}
+
+// ToProtoTruncateResult converts a rpc.TruncateResult_ to a rpcpb.TruncateResult.
+func ToProtoTruncateResult(v *rpc.TruncateResult_) *rpcpb.TruncateResult {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.TruncateResult{
+		NumSeries: v.NumSeries,
+	}
+}
+
+// ToRPCTruncateResult converts a rpcpb.TruncateResult to a rpc.TruncateResult_.
+func ToRPCTruncateResult(v *rpcpb.TruncateResult) *rpc.TruncateResult_ {
+	if v == nil {
+		return nil
+	}
+	return &rpc.TruncateResult_{
+		NumSeries: v.NumSeries,
+	}
+}
+
+// ToProtoNodeHealthResult converts a rpc.NodeHealthResult_ to a rpcpb.NodeHealthResult.
+func ToProtoNodeHealthResult(v *rpc.NodeHealthResult_) *rpcpb.NodeHealthResult {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.NodeHealthResult{
+		Ok:           v.Ok,
+		Status:       v.Status,
+		Bootstrapped: v.Bootstrapped,
+	}
+}
+
+// ToRPCNodeHealthResult converts a rpcpb.NodeHealthResult to a rpc.NodeHealthResult_.
+func ToRPCNodeHealthResult(v *rpcpb.NodeHealthResult) *rpc.NodeHealthResult_ {
+	if v == nil {
+		return nil
+	}
+	return &rpc.NodeHealthResult_{
+		Ok:           v.Ok,
+		Status:       v.Status,
+		Bootstrapped: v.Bootstrapped,
+	}
+}
+
+// ToProtoNodePersistRateLimitResult converts a rpc.NodePersistRateLimitResult_ to a rpcpb.NodePersistRateLimitResult.
+func ToProtoNodePersistRateLimitResult(v *rpc.NodePersistRateLimitResult_) *rpcpb.NodePersistRateLimitResult {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.NodePersistRateLimitResult{
+		LimitEnabled:    v.LimitEnabled,
+		LimitMbps:       v.LimitMbps,
+		LimitCheckEvery: v.LimitCheckEvery,
+	}
+}
+
+// ToRPCNodePersistRateLimitResult converts a rpcpb.NodePersistRateLimitResult to a rpc.NodePersistRateLimitResult_.
+func ToRPCNodePersistRateLimitResult(v *rpcpb.NodePersistRateLimitResult) *rpc.NodePersistRateLimitResult_ {
+	if v == nil {
+		return nil
+	}
+	return &rpc.NodePersistRateLimitResult_{
+		LimitEnabled:    v.LimitEnabled,
+		LimitMbps:       v.LimitMbps,
+		LimitCheckEvery: v.LimitCheckEvery,
+	}
+}
+
+// ToProtoNodeSetPersistRateLimitRequest converts a rpc.NodeSetPersistRateLimitRequest to a rpcpb.NodeSetPersistRateLimitRequest.
+func ToProtoNodeSetPersistRateLimitRequest(v *rpc.NodeSetPersistRateLimitRequest) *rpcpb.NodeSetPersistRateLimitRequest {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.NodeSetPersistRateLimitRequest{}
+	if v.LimitEnabled != nil {
+		r.LimitEnabled = &rpcpb.BoolValue{Value: *v.LimitEnabled}
+	}
+	if v.LimitMbps != nil {
+		r.LimitMbps = &rpcpb.DoubleValue{Value: *v.LimitMbps}
+	}
+	if v.LimitCheckEvery != nil {
+		r.LimitCheckEvery = &rpcpb.Int64Value{Value: *v.LimitCheckEvery}
+	}
+	return r
+}
+
+// ToRPCNodeSetPersistRateLimitRequest converts a rpcpb.NodeSetPersistRateLimitRequest to a rpc.NodeSetPersistRateLimitRequest.
+func ToRPCNodeSetPersistRateLimitRequest(v *rpcpb.NodeSetPersistRateLimitRequest) *rpc.NodeSetPersistRateLimitRequest {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.NodeSetPersistRateLimitRequest{}
+	if v.LimitEnabled != nil {
+		value := v.LimitEnabled.Value
+		r.LimitEnabled = &value
+	}
+	if v.LimitMbps != nil {
+		value := v.LimitMbps.Value
+		r.LimitMbps = &value
+	}
+	if v.LimitCheckEvery != nil {
+		value := v.LimitCheckEvery.Value
+		r.LimitCheckEvery = &value
+	}
+	return r
+}
+
+// ToProtoNodeWriteNewSeriesAsyncResult converts a rpc.NodeWriteNewSeriesAsyncResult_ to a rpcpb.NodeWriteNewSeriesAsyncResult.
+func ToProtoNodeWriteNewSeriesAsyncResult(v *rpc.NodeWriteNewSeriesAsyncResult_) *rpcpb.NodeWriteNewSeriesAsyncResult {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.NodeWriteNewSeriesAsyncResult{
+		WriteNewSeriesAsync: v.WriteNewSeriesAsync,
+	}
+}
+
+// ToRPCNodeWriteNewSeriesAsyncResult converts a rpcpb.NodeWriteNewSeriesAsyncResult to a rpc.NodeWriteNewSeriesAsyncResult_.
+func ToRPCNodeWriteNewSeriesAsyncResult(v *rpcpb.NodeWriteNewSeriesAsyncResult) *rpc.NodeWriteNewSeriesAsyncResult_ {
+	if v == nil {
+		return nil
+	}
+	return &rpc.NodeWriteNewSeriesAsyncResult_{
+		WriteNewSeriesAsync: v.WriteNewSeriesAsync,
+	}
+}
+
+// ToProtoNodeSetWriteNewSeriesAsyncRequest converts a rpc.NodeSetWriteNewSeriesAsyncRequest to a rpcpb.NodeSetWriteNewSeriesAsyncRequest.
+func ToProtoNodeSetWriteNewSeriesAsyncRequest(v *rpc.NodeSetWriteNewSeriesAsyncRequest) *rpcpb.NodeSetWriteNewSeriesAsyncRequest {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.NodeSetWriteNewSeriesAsyncRequest{
+		WriteNewSeriesAsync: v.WriteNewSeriesAsync,
+	}
+}
+
+// ToRPCNodeSetWriteNewSeriesAsyncRequest converts a rpcpb.NodeSetWriteNewSeriesAsyncRequest to a rpc.NodeSetWriteNewSeriesAsyncRequest.
+func ToRPCNodeSetWriteNewSeriesAsyncRequest(v *rpcpb.NodeSetWriteNewSeriesAsyncRequest) *rpc.NodeSetWriteNewSeriesAsyncRequest {
+	if v == nil {
+		return nil
+	}
+	return &rpc.NodeSetWriteNewSeriesAsyncRequest{
+		WriteNewSeriesAsync: v.WriteNewSeriesAsync,
+	}
+}
+
+// ToProtoNodeWriteNewSeriesBackoffDurationResult converts a rpc.NodeWriteNewSeriesBackoffDurationResult_ to a rpcpb.NodeWriteNewSeriesBackoffDurationResult.
+func ToProtoNodeWriteNewSeriesBackoffDurationResult(v *rpc.NodeWriteNewSeriesBackoffDurationResult_) *rpcpb.NodeWriteNewSeriesBackoffDurationResult {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.NodeWriteNewSeriesBackoffDurationResult{
+		WriteNewSeriesBackoffDuration: v.WriteNewSeriesBackoffDuration,
+		DurationType:                  rpcpb.TimeType(v.DurationType),
+	}
+}
+
+// ToRPCNodeWriteNewSeriesBackoffDurationResult converts a rpcpb.NodeWriteNewSeriesBackoffDurationResult to a rpc.NodeWriteNewSeriesBackoffDurationResult_.
+func ToRPCNodeWriteNewSeriesBackoffDurationResult(v *rpcpb.NodeWriteNewSeriesBackoffDurationResult) *rpc.NodeWriteNewSeriesBackoffDurationResult_ {
+	if v == nil {
+		return nil
+	}
+	return &rpc.NodeWriteNewSeriesBackoffDurationResult_{
+		WriteNewSeriesBackoffDuration: v.WriteNewSeriesBackoffDuration,
+		DurationType:                  rpc.TimeType(v.DurationType),
+	}
+}
+
+// ToProtoNodeSetWriteNewSeriesBackoffDurationRequest converts a rpc.NodeSetWriteNewSeriesBackoffDurationRequest to a rpcpb.NodeSetWriteNewSeriesBackoffDurationRequest.
+func ToProtoNodeSetWriteNewSeriesBackoffDurationRequest(v *rpc.NodeSetWriteNewSeriesBackoffDurationRequest) *rpcpb.NodeSetWriteNewSeriesBackoffDurationRequest {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.NodeSetWriteNewSeriesBackoffDurationRequest{
+		WriteNewSeriesBackoffDuration: v.WriteNewSeriesBackoffDuration,
+		DurationType:                  rpcpb.TimeType(v.DurationType),
+	}
+}
+
+// ToRPCNodeSetWriteNewSeriesBackoffDurationRequest converts a rpcpb.NodeSetWriteNewSeriesBackoffDurationRequest to a rpc.NodeSetWriteNewSeriesBackoffDurationRequest.
+func ToRPCNodeSetWriteNewSeriesBackoffDurationRequest(v *rpcpb.NodeSetWriteNewSeriesBackoffDurationRequest) *rpc.NodeSetWriteNewSeriesBackoffDurationRequest {
+	if v == nil {
+		return nil
+	}
+	return &rpc.NodeSetWriteNewSeriesBackoffDurationRequest{
+		WriteNewSeriesBackoffDuration: v.WriteNewSeriesBackoffDuration,
+		DurationType:                  rpc.TimeType(v.DurationType),
+	}
+}
+
+// ToProtoNodeWriteNewSeriesLimitPerShardPerSecondResult converts a rpc.NodeWriteNewSeriesLimitPerShardPerSecondResult_ to a rpcpb.NodeWriteNewSeriesLimitPerShardPerSecondResult.
+func ToProtoNodeWriteNewSeriesLimitPerShardPerSecondResult(v *rpc.NodeWriteNewSeriesLimitPerShardPerSecondResult_) *rpcpb.NodeWriteNewSeriesLimitPerShardPerSecondResult {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.NodeWriteNewSeriesLimitPerShardPerSecondResult{
+		WriteNewSeriesLimitPerShardPerSecond: v.WriteNewSeriesLimitPerShardPerSecond,
+	}
+}
+
+// ToRPCNodeWriteNewSeriesLimitPerShardPerSecondResult converts a rpcpb.NodeWriteNewSeriesLimitPerShardPerSecondResult to a rpc.NodeWriteNewSeriesLimitPerShardPerSecondResult_.
+func ToRPCNodeWriteNewSeriesLimitPerShardPerSecondResult(v *rpcpb.NodeWriteNewSeriesLimitPerShardPerSecondResult) *rpc.NodeWriteNewSeriesLimitPerShardPerSecondResult_ {
+	if v == nil {
+		return nil
+	}
+	return &rpc.NodeWriteNewSeriesLimitPerShardPerSecondResult_{
+		WriteNewSeriesLimitPerShardPerSecond: v.WriteNewSeriesLimitPerShardPerSecond,
+	}
+}
+
+// ToProtoNodeSetWriteNewSeriesLimitPerShardPerSecondRequest converts a rpc.NodeSetWriteNewSeriesLimitPerShardPerSecondRequest to a rpcpb.NodeSetWriteNewSeriesLimitPerShardPerSecondRequest.
+func ToProtoNodeSetWriteNewSeriesLimitPerShardPerSecondRequest(v *rpc.NodeSetWriteNewSeriesLimitPerShardPerSecondRequest) *rpcpb.NodeSetWriteNewSeriesLimitPerShardPerSecondRequest {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.NodeSetWriteNewSeriesLimitPerShardPerSecondRequest{
+		WriteNewSeriesLimitPerShardPerSecond: v.WriteNewSeriesLimitPerShardPerSecond,
+	}
+}
+
+// ToRPCNodeSetWriteNewSeriesLimitPerShardPerSecondRequest converts a rpcpb.NodeSetWriteNewSeriesLimitPerShardPerSecondRequest to a rpc.NodeSetWriteNewSeriesLimitPerShardPerSecondRequest.
+func ToRPCNodeSetWriteNewSeriesLimitPerShardPerSecondRequest(v *rpcpb.NodeSetWriteNewSeriesLimitPerShardPerSecondRequest) *rpc.NodeSetWriteNewSeriesLimitPerShardPerSecondRequest {
+	if v == nil {
+		return nil
+	}
+	return &rpc.NodeSetWriteNewSeriesLimitPerShardPerSecondRequest{
+		WriteNewSeriesLimitPerShardPerSecond: v.WriteNewSeriesLimitPerShardPerSecond,
+	}
+}
+
+// ToProtoNodeBootstrapStatusResult converts a rpc.NodeBootstrapStatusResult_ to a rpcpb.NodeBootstrapStatusResult.
+func ToProtoNodeBootstrapStatusResult(v *rpc.NodeBootstrapStatusResult_) *rpcpb.NodeBootstrapStatusResult {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.NodeBootstrapStatusResult{}
+	if v.Namespaces != nil {
+		r.Namespaces = make([]*rpcpb.NamespaceBootstrapStatus, 0, len(v.Namespaces))
+		for _, elem := range v.Namespaces {
+			r.Namespaces = append(r.Namespaces, ToProtoNamespaceBootstrapStatus(elem))
+		}
+	}
+	return r
+}
+
+// ToRPCNodeBootstrapStatusResult converts a rpcpb.NodeBootstrapStatusResult to a rpc.NodeBootstrapStatusResult_.
+func ToRPCNodeBootstrapStatusResult(v *rpcpb.NodeBootstrapStatusResult) *rpc.NodeBootstrapStatusResult_ {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.NodeBootstrapStatusResult_{}
+	if v.Namespaces != nil {
+		r.Namespaces = make([]*rpc.NamespaceBootstrapStatus, 0, len(v.Namespaces))
+		for _, elem := range v.Namespaces {
+			r.Namespaces = append(r.Namespaces, ToRPCNamespaceBootstrapStatus(elem))
+		}
+	}
+	return r
+}
+
+// ToProtoNamespaceBootstrapStatus converts a rpc.NamespaceBootstrapStatus to a rpcpb.NamespaceBootstrapStatus.
+func ToProtoNamespaceBootstrapStatus(v *rpc.NamespaceBootstrapStatus) *rpcpb.NamespaceBootstrapStatus {
+	if v == nil {
+		return nil
+	}
+	r := &rpcpb.NamespaceBootstrapStatus{
+		NameSpace: v.NameSpace,
+	}
+	if v.Shards != nil {
+		r.Shards = make([]*rpcpb.ShardBootstrapStatus, 0, len(v.Shards))
+		for _, elem := range v.Shards {
+			r.Shards = append(r.Shards, ToProtoShardBootstrapStatus(elem))
+		}
+	}
+	return r
+}
+
+// ToRPCNamespaceBootstrapStatus converts a rpcpb.NamespaceBootstrapStatus to a rpc.NamespaceBootstrapStatus.
+func ToRPCNamespaceBootstrapStatus(v *rpcpb.NamespaceBootstrapStatus) *rpc.NamespaceBootstrapStatus {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.NamespaceBootstrapStatus{
+		NameSpace: v.NameSpace,
+	}
+	if v.Shards != nil {
+		r.Shards = make([]*rpc.ShardBootstrapStatus, 0, len(v.Shards))
+		for _, elem := range v.Shards {
+			r.Shards = append(r.Shards, ToRPCShardBootstrapStatus(elem))
+		}
+	}
+	return r
+}
+
+// ToProtoShardBootstrapStatus converts a rpc.ShardBootstrapStatus to a rpcpb.ShardBootstrapStatus.
+func ToProtoShardBootstrapStatus(v *rpc.ShardBootstrapStatus) *rpcpb.ShardBootstrapStatus {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.ShardBootstrapStatus{
+		Shard:         v.Shard,
+		State:         rpcpb.ShardBootstrapState(v.State),
+		Source:        v.GetSource(),
+		SeriesFetched: v.SeriesFetched,
+		BytesFetched:  v.BytesFetched,
+		Error:         v.GetError(),
+	}
+}
+
+// ToRPCShardBootstrapStatus converts a rpcpb.ShardBootstrapStatus to a rpc.ShardBootstrapStatus.
+func ToRPCShardBootstrapStatus(v *rpcpb.ShardBootstrapStatus) *rpc.ShardBootstrapStatus {
+	if v == nil {
+		return nil
+	}
+	r := &rpc.ShardBootstrapStatus{
+		Shard:         v.Shard,
+		State:         rpc.ShardBootstrapState(v.State),
+		SeriesFetched: v.SeriesFetched,
+		BytesFetched:  v.BytesFetched,
+	}
+	// NB: unset optional strings are empty in proto3.
+	if v.Source != "" {
+		source := v.Source
+		r.Source = &source
+	}
+	if v.Error != "" {
+		errMsg := v.Error
+		r.Error = &errMsg
+	}
+	return r
+}
+
+// ToProtoHealthResult converts a rpc.HealthResult_ to a rpcpb.HealthResult.
+func ToProtoHealthResult(v *rpc.HealthResult_) *rpcpb.HealthResult {
+	if v == nil {
+		return nil
+	}
+	return &rpcpb.HealthResult{
+		Ok:     v.Ok,
+		Status: v.Status,
+	}
+}
+
+// ToRPCHealthResult converts a rpcpb.HealthResult to a rpc.HealthResult_.
+func ToRPCHealthResult(v *rpcpb.HealthResult) *rpc.HealthResult_ {
+	if v == nil {
+		return nil
+	}
+	return &rpc.HealthResult_{
+		Ok:     v.Ok,
+		Status: v.Status,
+	}
+}
+
+func copyBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	return append([]byte(nil), b...)
+}
+
+func copyBytesSlice(b [][]byte) [][]byte {
+	if b == nil {
+		return nil
+	}
+	r := make([][]byte, 0, len(b))
+	for _, elem := range b {
+		r = append(r, copyBytes(elem))
+	}
+	return r
+}
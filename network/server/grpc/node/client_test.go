@@ -0,0 +1,156 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package node
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3cluster/shard"
+	"github.com/m3db/m3db/client"
+	"github.com/m3db/m3db/generated/proto/rpcpb"
+	"github.com/m3db/m3db/generated/thrift/rpc"
+	grpcserver "github.com/m3db/m3db/network/server/grpc"
+	tterrors "github.com/m3db/m3db/network/server/tchannelthrift/errors"
+	"github.com/m3db/m3db/sharding"
+	"github.com/m3db/m3db/topology"
+	"github.com/m3db/m3x/context"
+	"github.com/m3db/m3x/ident"
+	xtime "github.com/m3db/m3x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/tchannel-go/thrift"
+)
+
+// newTestClientServer serves the node service over gRPC backed by a mock node
+// and returns an admin session that talks to it using the gRPC transport
+func newTestClientServer(
+	t *testing.T,
+	ctrl *gomock.Controller,
+) (*rpc.MockTChanNode, client.AdminSession, func()) {
+	node := rpc.NewMockTChanNode(ctrl)
+	node.EXPECT().Health(gomock.Any()).Return(&rpc.NodeHealthResult_{
+		Ok:           true,
+		Status:       "up",
+		Bootstrapped: true,
+	}, nil).AnyTimes()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpcserver.NewGRPCServer(grpcserver.NewServerOptions())
+	rpcpb.RegisterNodeServer(server, NewService(node, context.NewPool(context.NewOptions())))
+	go server.Serve(listener)
+
+	shardSet, err := sharding.NewShardSet(
+		sharding.NewShards([]uint32{0}, shard.Available),
+		sharding.DefaultHashFn(1))
+	require.NoError(t, err)
+
+	host := topology.NewHost("testhost", listener.Addr().String())
+	opts := client.NewAdminOptions().
+		SetTransport(client.GRPCTransport).
+		SetClusterConnectConsistencyLevel(client.ConnectConsistencyLevelAll).
+		SetWriteConsistencyLevel(topology.ConsistencyLevelOne).
+		SetTopologyInitializer(topology.NewStaticInitializer(
+			topology.NewStaticOptions().
+				SetReplicas(1).
+				SetShardSet(shardSet).
+				SetHostShardSets([]topology.HostShardSet{
+					topology.NewHostShardSet(host, shardSet),
+				})))
+
+	c, err := client.NewAdminClient(opts.(client.AdminOptions))
+	require.NoError(t, err)
+
+	session, err := c.DefaultAdminSession()
+	require.NoError(t, err)
+
+	return node, session, func() {
+		session.Close()
+		server.Stop()
+	}
+}
+
+func TestClientWriteOverGRPC(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	node, session, closer := newTestClientServer(t, ctrl)
+	defer closer()
+
+	written := make(chan *rpc.WriteBatchRawRequest, 1)
+	node.EXPECT().WriteBatchRaw(gomock.Any(), gomock.Any()).
+		Do(func(ctx thrift.Context, req *rpc.WriteBatchRawRequest) {
+			written <- req
+		}).Return(nil)
+
+	now := time.Now()
+	err := session.Write(ident.StringID("metrics"), ident.StringID("foo"),
+		now, 42, xtime.Second, []byte("annotation"))
+	require.NoError(t, err)
+
+	req := <-written
+	assert.Equal(t, []byte("metrics"), req.NameSpace)
+	require.Equal(t, 1, len(req.Elements))
+	assert.Equal(t, []byte("foo"), req.Elements[0].ID)
+	assert.Equal(t, now.Unix(), req.Elements[0].Datapoint.Timestamp)
+	assert.Equal(t, 42.0, req.Elements[0].Datapoint.Value)
+	assert.Equal(t, []byte("annotation"), req.Elements[0].Datapoint.Annotation)
+}
+
+func TestClientWriteErrorOverGRPC(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	node, session, closer := newTestClientServer(t, ctrl)
+	defer closer()
+
+	batchErrs := rpc.NewWriteBatchRawErrors()
+	batchErrs.Errors = append(batchErrs.Errors,
+		tterrors.NewBadRequestWriteBatchRawError(0, errors.New("bad")))
+	node.EXPECT().WriteBatchRaw(gomock.Any(), gomock.Any()).Return(batchErrs)
+
+	err := session.Write(ident.StringID("metrics"), ident.StringID("foo"),
+		time.Now(), 42, xtime.Second, nil)
+	require.Error(t, err)
+	assert.True(t, client.IsBadRequestError(err))
+}
+
+func TestClientTruncateOverGRPC(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	node, session, closer := newTestClientServer(t, ctrl)
+	defer closer()
+
+	node.EXPECT().Truncate(gomock.Any(), &rpc.TruncateRequest{
+		NameSpace: []byte("metrics"),
+	}).Return(&rpc.TruncateResult_{NumSeries: 3}, nil)
+
+	truncated, err := session.Truncate(ident.StringID("metrics"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), truncated)
+}
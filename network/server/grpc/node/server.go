@@ -0,0 +1,78 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package node
+
+import (
+	"net"
+
+	"github.com/m3db/m3db/generated/proto/rpcpb"
+	ns "github.com/m3db/m3db/network/server"
+	grpcserver "github.com/m3db/m3db/network/server/grpc"
+	"github.com/m3db/m3db/network/server/tchannelthrift"
+	ttnode "github.com/m3db/m3db/network/server/tchannelthrift/node"
+	"github.com/m3db/m3db/storage"
+	"github.com/m3db/m3x/context"
+)
+
+type server struct {
+	db          storage.Database
+	address     string
+	contextPool context.Pool
+	opts        grpcserver.ServerOptions
+	ttopts      tchannelthrift.Options
+}
+
+// NewServer creates a new node gRPC network service
+func NewServer(
+	db storage.Database,
+	address string,
+	contextPool context.Pool,
+	opts grpcserver.ServerOptions,
+	ttopts tchannelthrift.Options,
+) ns.NetworkService {
+	if opts == nil {
+		opts = grpcserver.NewServerOptions()
+	}
+	if ttopts == nil {
+		ttopts = tchannelthrift.NewOptions()
+	}
+	return &server{
+		db:          db,
+		address:     address,
+		contextPool: contextPool,
+		opts:        opts,
+		ttopts:      ttopts,
+	}
+}
+
+func (s *server) ListenAndServe() (ns.Close, error) {
+	listener, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return nil, err
+	}
+
+	server := grpcserver.NewGRPCServer(s.opts)
+	service := NewService(ttnode.NewService(s.db, s.ttopts), s.contextPool)
+	rpcpb.RegisterNodeServer(server, service)
+
+	go server.Serve(listener)
+
+	return server.Stop, nil
+}
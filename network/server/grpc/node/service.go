@@ -0,0 +1,309 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package node
+
+import (
+	"github.com/m3db/m3db/generated/proto/rpcpb"
+	"github.com/m3db/m3db/generated/thrift/rpc"
+	"github.com/m3db/m3db/network/server/grpc/convert"
+	"github.com/m3db/m3db/network/server/tchannelthrift"
+	"github.com/m3db/m3x/context"
+
+	"github.com/uber/tchannel-go/thrift"
+	xnetcontext "golang.org/x/net/context"
+)
+
+type service struct {
+	service     rpc.TChanNode
+	contextPool context.Pool
+}
+
+// NewService creates a new node gRPC service that serves requests using the
+// node service also exposed over TChannel Thrift, converting requests and
+// results between their protobuf and Thrift representations
+func NewService(svc rpc.TChanNode, contextPool context.Pool) rpcpb.NodeServer {
+	return &service{
+		service:     svc,
+		contextPool: contextPool,
+	}
+}
+
+// newContext returns a thrift context with an embedded M3DB context that must be
+// closed once the result has been converted, as results may reference pooled data
+func (s *service) newContext(ctx xnetcontext.Context) (thrift.Context, context.Context) {
+	inner := s.contextPool.Get()
+	return tchannelthrift.WithContext(ctx, inner), inner
+}
+
+func (s *service) Fetch(ctx xnetcontext.Context, req *rpcpb.FetchRequest) (*rpcpb.FetchResult, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	result, err := s.service.Fetch(tctx, convert.ToRPCFetchRequest(req))
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return convert.ToProtoFetchResult(result), nil
+}
+
+func (s *service) FetchTagged(ctx xnetcontext.Context, req *rpcpb.FetchTaggedRequest) (*rpcpb.FetchTaggedResult, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	result, err := s.service.FetchTagged(tctx, convert.ToRPCFetchTaggedRequest(req))
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return convert.ToProtoFetchTaggedResult(result), nil
+}
+
+func (s *service) Write(ctx xnetcontext.Context, req *rpcpb.WriteRequest) (*rpcpb.Empty, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	if err := s.service.Write(tctx, convert.ToRPCWriteRequest(req)); err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return &rpcpb.Empty{}, nil
+}
+
+func (s *service) WriteTagged(ctx xnetcontext.Context, req *rpcpb.WriteTaggedRequest) (*rpcpb.Empty, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	if err := s.service.WriteTagged(tctx, convert.ToRPCWriteTaggedRequest(req)); err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return &rpcpb.Empty{}, nil
+}
+
+func (s *service) FetchBatchRaw(ctx xnetcontext.Context, req *rpcpb.FetchBatchRawRequest) (*rpcpb.FetchBatchRawResult, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	result, err := s.service.FetchBatchRaw(tctx, convert.ToRPCFetchBatchRawRequest(req))
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return convert.ToProtoFetchBatchRawResult(result), nil
+}
+
+func (s *service) FetchBatchRawPaged(ctx xnetcontext.Context, req *rpcpb.FetchBatchRawPagedRequest) (*rpcpb.FetchBatchRawPagedResult, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	result, err := s.service.FetchBatchRawPaged(tctx, convert.ToRPCFetchBatchRawPagedRequest(req))
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return convert.ToProtoFetchBatchRawPagedResult(result), nil
+}
+
+func (s *service) FetchBlocksRaw(ctx xnetcontext.Context, req *rpcpb.FetchBlocksRawRequest) (*rpcpb.FetchBlocksRawResult, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	result, err := s.service.FetchBlocksRaw(tctx, convert.ToRPCFetchBlocksRawRequest(req))
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return convert.ToProtoFetchBlocksRawResult(result), nil
+}
+
+func (s *service) FetchBlocksMetadataRaw(ctx xnetcontext.Context, req *rpcpb.FetchBlocksMetadataRawRequest) (*rpcpb.FetchBlocksMetadataRawResult, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	result, err := s.service.FetchBlocksMetadataRaw(tctx, convert.ToRPCFetchBlocksMetadataRawRequest(req))
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return convert.ToProtoFetchBlocksMetadataRawResult(result), nil
+}
+
+func (s *service) FetchBlocksMetadataRawV2(ctx xnetcontext.Context, req *rpcpb.FetchBlocksMetadataRawV2Request) (*rpcpb.FetchBlocksMetadataRawV2Result, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	result, err := s.service.FetchBlocksMetadataRawV2(tctx, convert.ToRPCFetchBlocksMetadataRawV2Request(req))
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return convert.ToProtoFetchBlocksMetadataRawV2Result(result), nil
+}
+
+func (s *service) WriteBatchRaw(ctx xnetcontext.Context, req *rpcpb.WriteBatchRawRequest) (*rpcpb.WriteBatchRawErrors, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	err := s.service.WriteBatchRaw(tctx, convert.ToRPCWriteBatchRawRequest(req))
+	if batchErrs, ok := err.(*rpc.WriteBatchRawErrors); ok {
+		return convert.ToProtoWriteBatchRawErrors(batchErrs), nil
+	}
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return &rpcpb.WriteBatchRawErrors{}, nil
+}
+
+func (s *service) WriteTaggedBatchRaw(ctx xnetcontext.Context, req *rpcpb.WriteTaggedBatchRawRequest) (*rpcpb.WriteBatchRawErrors, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	err := s.service.WriteTaggedBatchRaw(tctx, convert.ToRPCWriteTaggedBatchRawRequest(req))
+	if batchErrs, ok := err.(*rpc.WriteBatchRawErrors); ok {
+		return convert.ToProtoWriteBatchRawErrors(batchErrs), nil
+	}
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return &rpcpb.WriteBatchRawErrors{}, nil
+}
+
+func (s *service) Repair(ctx xnetcontext.Context, req *rpcpb.Empty) (*rpcpb.Empty, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	if err := s.service.Repair(tctx); err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return &rpcpb.Empty{}, nil
+}
+
+func (s *service) Truncate(ctx xnetcontext.Context, req *rpcpb.TruncateRequest) (*rpcpb.TruncateResult, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	result, err := s.service.Truncate(tctx, convert.ToRPCTruncateRequest(req))
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return convert.ToProtoTruncateResult(result), nil
+}
+
+func (s *service) Health(ctx xnetcontext.Context, req *rpcpb.Empty) (*rpcpb.NodeHealthResult, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	result, err := s.service.Health(tctx)
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return convert.ToProtoNodeHealthResult(result), nil
+}
+
+func (s *service) GetPersistRateLimit(ctx xnetcontext.Context, req *rpcpb.Empty) (*rpcpb.NodePersistRateLimitResult, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	result, err := s.service.GetPersistRateLimit(tctx)
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return convert.ToProtoNodePersistRateLimitResult(result), nil
+}
+
+func (s *service) SetPersistRateLimit(ctx xnetcontext.Context, req *rpcpb.NodeSetPersistRateLimitRequest) (*rpcpb.NodePersistRateLimitResult, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	result, err := s.service.SetPersistRateLimit(tctx, convert.ToRPCNodeSetPersistRateLimitRequest(req))
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return convert.ToProtoNodePersistRateLimitResult(result), nil
+}
+
+func (s *service) GetWriteNewSeriesAsync(ctx xnetcontext.Context, req *rpcpb.Empty) (*rpcpb.NodeWriteNewSeriesAsyncResult, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	result, err := s.service.GetWriteNewSeriesAsync(tctx)
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return convert.ToProtoNodeWriteNewSeriesAsyncResult(result), nil
+}
+
+func (s *service) SetWriteNewSeriesAsync(ctx xnetcontext.Context, req *rpcpb.NodeSetWriteNewSeriesAsyncRequest) (*rpcpb.NodeWriteNewSeriesAsyncResult, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	result, err := s.service.SetWriteNewSeriesAsync(tctx, convert.ToRPCNodeSetWriteNewSeriesAsyncRequest(req))
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return convert.ToProtoNodeWriteNewSeriesAsyncResult(result), nil
+}
+
+func (s *service) GetWriteNewSeriesBackoffDuration(ctx xnetcontext.Context, req *rpcpb.Empty) (*rpcpb.NodeWriteNewSeriesBackoffDurationResult, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	result, err := s.service.GetWriteNewSeriesBackoffDuration(tctx)
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return convert.ToProtoNodeWriteNewSeriesBackoffDurationResult(result), nil
+}
+
+func (s *service) SetWriteNewSeriesBackoffDuration(ctx xnetcontext.Context, req *rpcpb.NodeSetWriteNewSeriesBackoffDurationRequest) (*rpcpb.NodeWriteNewSeriesBackoffDurationResult, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	result, err := s.service.SetWriteNewSeriesBackoffDuration(tctx, convert.ToRPCNodeSetWriteNewSeriesBackoffDurationRequest(req))
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return convert.ToProtoNodeWriteNewSeriesBackoffDurationResult(result), nil
+}
+
+func (s *service) GetWriteNewSeriesLimitPerShardPerSecond(ctx xnetcontext.Context, req *rpcpb.Empty) (*rpcpb.NodeWriteNewSeriesLimitPerShardPerSecondResult, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	result, err := s.service.GetWriteNewSeriesLimitPerShardPerSecond(tctx)
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return convert.ToProtoNodeWriteNewSeriesLimitPerShardPerSecondResult(result), nil
+}
+
+func (s *service) SetWriteNewSeriesLimitPerShardPerSecond(ctx xnetcontext.Context, req *rpcpb.NodeSetWriteNewSeriesLimitPerShardPerSecondRequest) (*rpcpb.NodeWriteNewSeriesLimitPerShardPerSecondResult, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	result, err := s.service.SetWriteNewSeriesLimitPerShardPerSecond(tctx, convert.ToRPCNodeSetWriteNewSeriesLimitPerShardPerSecondRequest(req))
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return convert.ToProtoNodeWriteNewSeriesLimitPerShardPerSecondResult(result), nil
+}
+
+func (s *service) GetBootstrapStatus(ctx xnetcontext.Context, req *rpcpb.Empty) (*rpcpb.NodeBootstrapStatusResult, error) {
+	tctx, inner := s.newContext(ctx)
+	defer inner.Close()
+
+	result, err := s.service.GetBootstrapStatus(tctx)
+	if err != nil {
+		return nil, convert.ToGRPCError(err)
+	}
+	return convert.ToProtoNodeBootstrapStatusResult(result), nil
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package node
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/m3db/m3db/generated/proto/rpcpb"
+	"github.com/m3db/m3db/generated/thrift/rpc"
+	"github.com/m3db/m3db/network/server/tchannelthrift"
+	tterrors "github.com/m3db/m3db/network/server/tchannelthrift/errors"
+	"github.com/m3db/m3x/context"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/tchannel-go/thrift"
+	xnetcontext "golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func newTestService(ctrl *gomock.Controller) (*rpc.MockTChanNode, rpcpb.NodeServer) {
+	node := rpc.NewMockTChanNode(ctrl)
+	return node, NewService(node, context.NewPool(context.NewOptions()))
+}
+
+func TestServiceFetch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	node, service := newTestService(ctrl)
+	node.EXPECT().Fetch(gomock.Any(), &rpc.FetchRequest{
+		RangeStart: 1,
+		RangeEnd:   2,
+		NameSpace:  "metrics",
+		ID:         "foo",
+	}).Do(func(tctx thrift.Context, req *rpc.FetchRequest) {
+		// Ensure an M3DB context is available to the underlying service
+		assert.NotNil(t, tchannelthrift.Context(tctx))
+	}).Return(&rpc.FetchResult_{
+		Datapoints: []*rpc.Datapoint{{Timestamp: 1, Value: 42}},
+	}, nil)
+
+	result, err := service.Fetch(xnetcontext.Background(), &rpcpb.FetchRequest{
+		RangeStart: 1,
+		RangeEnd:   2,
+		NameSpace:  "metrics",
+		Id:         "foo",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(result.Datapoints))
+	assert.Equal(t, int64(1), result.Datapoints[0].Timestamp)
+	assert.Equal(t, 42.0, result.Datapoints[0].Value)
+}
+
+func TestServiceFetchError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	node, service := newTestService(ctrl)
+	node.EXPECT().Fetch(gomock.Any(), gomock.Any()).
+		Return(nil, tterrors.NewBadRequestError(errors.New("bad")))
+
+	_, err := service.Fetch(xnetcontext.Background(), &rpcpb.FetchRequest{})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+}
+
+func TestServiceWriteBatchRaw(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	node, service := newTestService(ctrl)
+
+	node.EXPECT().WriteBatchRaw(gomock.Any(), gomock.Any()).Return(nil)
+	result, err := service.WriteBatchRaw(xnetcontext.Background(), &rpcpb.WriteBatchRawRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(result.Errors))
+
+	batchErrs := rpc.NewWriteBatchRawErrors()
+	batchErrs.Errors = append(batchErrs.Errors,
+		tterrors.NewBadRequestWriteBatchRawError(2, errors.New("bad")))
+	node.EXPECT().WriteBatchRaw(gomock.Any(), gomock.Any()).Return(batchErrs)
+	result, err = service.WriteBatchRaw(xnetcontext.Background(), &rpcpb.WriteBatchRawRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(result.Errors))
+	assert.Equal(t, int64(2), result.Errors[0].Index)
+	assert.Equal(t, rpcpb.ErrorType_BAD_REQUEST, result.Errors[0].Err.Type)
+}
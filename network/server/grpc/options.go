@@ -0,0 +1,94 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grpc
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// defaultMaxRecvMsgSize raises the gRPC default of 4MB so that large
+	// fetch batch and block requests from peers are not rejected
+	defaultMaxRecvMsgSize = 64 * 1024 * 1024
+)
+
+// ServerOptions is a set of gRPC server options
+type ServerOptions interface {
+	// SetMaxRecvMsgSize sets the max size in bytes of an inbound message and
+	// returns a new ServerOptions
+	SetMaxRecvMsgSize(value int) ServerOptions
+
+	// MaxRecvMsgSize returns the max size in bytes of an inbound message
+	MaxRecvMsgSize() int
+
+	// SetTransportCredentials sets the transport credentials used to secure
+	// connections, when nil connections are not encrypted
+	SetTransportCredentials(value credentials.TransportCredentials) ServerOptions
+
+	// TransportCredentials returns the transport credentials used to secure
+	// connections
+	TransportCredentials() credentials.TransportCredentials
+}
+
+type serverOptions struct {
+	maxRecvMsgSize       int
+	transportCredentials credentials.TransportCredentials
+}
+
+// NewServerOptions creates a new set of gRPC server options with defaults
+func NewServerOptions() ServerOptions {
+	return &serverOptions{
+		maxRecvMsgSize: defaultMaxRecvMsgSize,
+	}
+}
+
+func (o *serverOptions) SetMaxRecvMsgSize(value int) ServerOptions {
+	opts := *o
+	opts.maxRecvMsgSize = value
+	return &opts
+}
+
+func (o *serverOptions) MaxRecvMsgSize() int {
+	return o.maxRecvMsgSize
+}
+
+func (o *serverOptions) SetTransportCredentials(value credentials.TransportCredentials) ServerOptions {
+	opts := *o
+	opts.transportCredentials = value
+	return &opts
+}
+
+func (o *serverOptions) TransportCredentials() credentials.TransportCredentials {
+	return o.transportCredentials
+}
+
+// NewGRPCServer creates a new gRPC server configured by the server options,
+// outbound messages are not size limited by the vendored gRPC version
+func NewGRPCServer(opts ServerOptions) *grpc.Server {
+	serverOpts := []grpc.ServerOption{
+		grpc.MaxMsgSize(opts.MaxRecvMsgSize()),
+	}
+	if creds := opts.TransportCredentials(); creds != nil {
+		serverOpts = append(serverOpts, grpc.Creds(creds))
+	}
+	return grpc.NewServer(serverOpts...)
+}
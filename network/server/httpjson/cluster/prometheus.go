@@ -0,0 +1,248 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cluster
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/m3db/m3db/client"
+	"github.com/m3db/m3db/promql"
+)
+
+const (
+	queryRangeURL = "/api/v1/query_range"
+	seriesURL     = "/api/v1/series"
+
+	promErrorTypeBadData   = "bad_data"
+	promErrorTypeExecution = "execution"
+)
+
+var (
+	errMissingMatch = errors.New("no match[] parameter provided")
+
+	// minTime and maxTime bound series requests that omit start or end.
+	minTime = time.Unix(0, 0).UTC()
+	maxTime = time.Unix(math.MaxInt64/int64(time.Second), 0).UTC()
+)
+
+type promResponse struct {
+	Status    string      `json:"status"`
+	Data      interface{} `json:"data,omitempty"`
+	ErrorType string      `json:"errorType,omitempty"`
+	Error     string      `json:"error,omitempty"`
+}
+
+type promQueryData struct {
+	ResultType string             `json:"resultType"`
+	Result     []promMatrixSeries `json:"result"`
+}
+
+type promMatrixSeries struct {
+	Metric map[string]string `json:"metric"`
+	Values [][]interface{}   `json:"values"`
+}
+
+type promHandlers struct {
+	client client.Client
+}
+
+// registerPromHandlers registers the Prometheus compatible query handlers.
+func registerPromHandlers(mux *http.ServeMux, client client.Client) {
+	h := &promHandlers{client: client}
+	mux.HandleFunc(queryRangeURL, h.queryRange)
+	mux.HandleFunc(seriesURL, h.series)
+}
+
+func (h *promHandlers) engine() (promql.Engine, error) {
+	session, err := h.client.DefaultSession()
+	if err != nil {
+		return nil, err
+	}
+	return promql.NewEngine(session), nil
+}
+
+func (h *promHandlers) queryRange(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writePromError(w, http.StatusBadRequest, promErrorTypeBadData, err)
+		return
+	}
+
+	start, err := parseTime(r.Form.Get("start"))
+	if err != nil {
+		writePromError(w, http.StatusBadRequest, promErrorTypeBadData,
+			fmt.Errorf("invalid parameter 'start': %v", err))
+		return
+	}
+	end, err := parseTime(r.Form.Get("end"))
+	if err != nil {
+		writePromError(w, http.StatusBadRequest, promErrorTypeBadData,
+			fmt.Errorf("invalid parameter 'end': %v", err))
+		return
+	}
+	step, err := parseStep(r.Form.Get("step"))
+	if err != nil {
+		writePromError(w, http.StatusBadRequest, promErrorTypeBadData,
+			fmt.Errorf("invalid parameter 'step': %v", err))
+		return
+	}
+	if err := promql.ValidateRange(start, end, step); err != nil {
+		writePromError(w, http.StatusBadRequest, promErrorTypeBadData, err)
+		return
+	}
+
+	query := r.Form.Get("query")
+	if _, err := promql.ParseExpr(query); err != nil {
+		writePromError(w, http.StatusBadRequest, promErrorTypeBadData, err)
+		return
+	}
+
+	engine, err := h.engine()
+	if err != nil {
+		writePromError(w, http.StatusInternalServerError, promErrorTypeExecution, err)
+		return
+	}
+	matrix, err := engine.QueryRange(query, start, end, step)
+	if err != nil {
+		writePromError(w, http.StatusUnprocessableEntity, promErrorTypeExecution, err)
+		return
+	}
+
+	result := make([]promMatrixSeries, 0, len(matrix))
+	for _, series := range matrix {
+		values := make([][]interface{}, 0, len(series.Points))
+		for _, p := range series.Points {
+			values = append(values, []interface{}{
+				float64(p.T.UnixNano()) / float64(time.Second),
+				strconv.FormatFloat(p.V, 'f', -1, 64),
+			})
+		}
+		result = append(result, promMatrixSeries{
+			Metric: series.Labels.Map(),
+			Values: values,
+		})
+	}
+
+	writePromSuccess(w, promQueryData{ResultType: "matrix", Result: result})
+}
+
+func (h *promHandlers) series(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writePromError(w, http.StatusBadRequest, promErrorTypeBadData, err)
+		return
+	}
+
+	selectors := r.Form["match[]"]
+	if len(selectors) == 0 {
+		writePromError(w, http.StatusBadRequest, promErrorTypeBadData, errMissingMatch)
+		return
+	}
+	for _, selector := range selectors {
+		if _, err := promql.ParseMatchers(selector); err != nil {
+			writePromError(w, http.StatusBadRequest, promErrorTypeBadData, err)
+			return
+		}
+	}
+
+	start, end := minTime, maxTime
+	if v := r.Form.Get("start"); v != "" {
+		t, err := parseTime(v)
+		if err != nil {
+			writePromError(w, http.StatusBadRequest, promErrorTypeBadData,
+				fmt.Errorf("invalid parameter 'start': %v", err))
+			return
+		}
+		start = t
+	}
+	if v := r.Form.Get("end"); v != "" {
+		t, err := parseTime(v)
+		if err != nil {
+			writePromError(w, http.StatusBadRequest, promErrorTypeBadData,
+				fmt.Errorf("invalid parameter 'end': %v", err))
+			return
+		}
+		end = t
+	}
+
+	engine, err := h.engine()
+	if err != nil {
+		writePromError(w, http.StatusInternalServerError, promErrorTypeExecution, err)
+		return
+	}
+	series, err := engine.Series(selectors, start, end)
+	if err != nil {
+		writePromError(w, http.StatusUnprocessableEntity, promErrorTypeExecution, err)
+		return
+	}
+
+	result := make([]map[string]string, 0, len(series))
+	for _, labels := range series {
+		result = append(result, labels.Map())
+	}
+
+	writePromSuccess(w, result)
+}
+
+// parseTime parses either a unix timestamp in seconds or an RFC3339 time.
+func parseTime(s string) (time.Time, error) {
+	if t, err := strconv.ParseFloat(s, 64); err == nil {
+		sec, frac := math.Modf(t)
+		return time.Unix(int64(sec), int64(frac*float64(time.Second))).UTC(), nil
+	}
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("cannot parse %q to a valid timestamp", s)
+}
+
+// parseStep parses either a number of seconds or a duration.
+func parseStep(s string) (time.Duration, error) {
+	if d, err := strconv.ParseFloat(s, 64); err == nil {
+		return time.Duration(d * float64(time.Second)), nil
+	}
+	if d, err := promql.ParseDuration(s); err == nil {
+		return d, nil
+	}
+	return 0, fmt.Errorf("cannot parse %q to a valid duration", s)
+}
+
+func writePromSuccess(w http.ResponseWriter, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(&promResponse{
+		Status: "success",
+		Data:   data,
+	})
+}
+
+func writePromError(w http.ResponseWriter, code int, errType string, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(&promResponse{
+		Status:    "error",
+		ErrorType: errType,
+		Error:     err.Error(),
+	})
+}
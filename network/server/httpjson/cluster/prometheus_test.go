@@ -0,0 +1,279 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cluster
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3db/client"
+	"github.com/m3db/m3db/encoding"
+	"github.com/m3db/m3db/promql"
+	"github.com/m3db/m3db/storage/index"
+	"github.com/m3db/m3db/ts"
+	"github.com/m3db/m3x/ident"
+	xtime "github.com/m3db/m3x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testPromStart = time.Unix(1500000000, 0).UTC()
+
+type testSeriesIterator struct {
+	encoding.SeriesIterator
+
+	tags   ident.Tags
+	points []ts.Datapoint
+	idx    int
+}
+
+func (it *testSeriesIterator) Next() bool {
+	it.idx++
+	return it.idx < len(it.points)
+}
+
+func (it *testSeriesIterator) Current() (ts.Datapoint, xtime.Unit, ts.Annotation) {
+	return it.points[it.idx], xtime.Second, nil
+}
+
+func (it *testSeriesIterator) Err() error              { return nil }
+func (it *testSeriesIterator) Close()                  {}
+func (it *testSeriesIterator) Tags() ident.TagIterator { return ident.NewTagSliceIterator(it.tags) }
+
+func testPromTags() ident.Tags {
+	return ident.Tags{
+		{Name: ident.StringID(promql.MetricNameLabel), Value: ident.StringID("up")},
+		{Name: ident.StringID("job"), Value: ident.StringID("node")},
+	}
+}
+
+func newTestPromHandlers(ctrl *gomock.Controller) (*promHandlers, *client.MockSession) {
+	session := client.NewMockSession(ctrl)
+	c := client.NewMockClient(ctrl)
+	c.EXPECT().DefaultSession().Return(session, nil).AnyTimes()
+	return &promHandlers{client: c}, session
+}
+
+type testPromResponse struct {
+	Status    string          `json:"status"`
+	Data      json.RawMessage `json:"data"`
+	ErrorType string          `json:"errorType"`
+	Error     string          `json:"error"`
+}
+
+func servePromRequest(
+	t *testing.T,
+	handler http.HandlerFunc,
+	path string,
+	params url.Values,
+) (int, testPromResponse) {
+	w := httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodGet, path+"?"+params.Encode(), nil))
+
+	var resp testPromResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	return w.Code, resp
+}
+
+func queryRangeParams(query, start, end, step string) url.Values {
+	return url.Values{
+		"query": []string{query},
+		"start": []string{start},
+		"end":   []string{end},
+		"step":  []string{step},
+	}
+}
+
+func TestPromQueryRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h, session := newTestPromHandlers(ctrl)
+	iter := &testSeriesIterator{
+		tags: testPromTags(),
+		points: []ts.Datapoint{
+			{Timestamp: testPromStart, Value: 1},
+			{Timestamp: testPromStart.Add(30 * time.Second), Value: 2.5},
+		},
+		idx: -1,
+	}
+	session.EXPECT().FetchTagged(gomock.Any(), gomock.Any()).
+		Return(encoding.NewSeriesIterators([]encoding.SeriesIterator{iter}, nil), true, nil)
+
+	code, resp := servePromRequest(t, h.queryRange, queryRangeURL,
+		queryRangeParams("up", "1500000000", "2017-07-14T02:40:30Z", "30s"))
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "success", resp.Status)
+
+	var data struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Metric map[string]string `json:"metric"`
+			Values [][]interface{}   `json:"values"`
+		} `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &data))
+	assert.Equal(t, "matrix", data.ResultType)
+	require.Equal(t, 1, len(data.Result))
+	assert.Equal(t, map[string]string{
+		promql.MetricNameLabel: "up",
+		"job":                  "node",
+	}, data.Result[0].Metric)
+	assert.Equal(t, [][]interface{}{
+		{1500000000.0, "1"},
+		{1500000030.0, "2.5"},
+	}, data.Result[0].Values)
+}
+
+func TestPromQueryRangeBadRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h, _ := newTestPromHandlers(ctrl)
+	for _, test := range []struct {
+		name   string
+		params url.Values
+	}{
+		{"bad start", queryRangeParams("up", "foo", "1500000060", "30")},
+		{"bad end", queryRangeParams("up", "1500000000", "foo", "30")},
+		{"bad step", queryRangeParams("up", "1500000000", "1500000060", "foo")},
+		{"zero step", queryRangeParams("up", "1500000000", "1500000060", "0")},
+		{"negative step", queryRangeParams("up", "1500000000", "1500000060", "-30")},
+		{"end before start", queryRangeParams("up", "1500000060", "1500000000", "30")},
+		{"too many points", queryRangeParams("up", "1500000000", "1600000000", "1")},
+		{"bad query", queryRangeParams("up{", "1500000000", "1500000060", "30")},
+	} {
+		code, resp := servePromRequest(t, h.queryRange, queryRangeURL, test.params)
+		assert.Equal(t, http.StatusBadRequest, code, test.name)
+		assert.Equal(t, "error", resp.Status, test.name)
+		assert.Equal(t, promErrorTypeBadData, resp.ErrorType, test.name)
+		assert.NotEmpty(t, resp.Error, test.name)
+	}
+}
+
+func TestPromQueryRangeSessionError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := client.NewMockClient(ctrl)
+	c.EXPECT().DefaultSession().Return(nil, errors.New("no session"))
+	h := &promHandlers{client: c}
+
+	code, resp := servePromRequest(t, h.queryRange, queryRangeURL,
+		queryRangeParams("up", "1500000000", "1500000060", "30"))
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Equal(t, promErrorTypeExecution, resp.ErrorType)
+	assert.Equal(t, "no session", resp.Error)
+}
+
+func TestPromQueryRangeFetchError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h, session := newTestPromHandlers(ctrl)
+	session.EXPECT().FetchTagged(gomock.Any(), gomock.Any()).
+		Return(nil, false, errors.New("fetch failed"))
+
+	code, resp := servePromRequest(t, h.queryRange, queryRangeURL,
+		queryRangeParams("up", "1500000000", "1500000060", "30"))
+	assert.Equal(t, http.StatusUnprocessableEntity, code)
+	assert.Equal(t, "error", resp.Status)
+	assert.Equal(t, promErrorTypeExecution, resp.ErrorType)
+	assert.Contains(t, resp.Error, "fetch failed")
+}
+
+func TestPromSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h, session := newTestPromHandlers(ctrl)
+	results := index.NewResults(ident.StringID("testns"))
+	results.Add(ident.StringID("a"), testPromTags())
+	session.EXPECT().FetchTaggedIDs(gomock.Any(), gomock.Any()).
+		Do(func(q index.Query, opts index.QueryOptions) {
+			// The end is optional and defaults to the max time
+			assert.True(t, testPromStart.Equal(opts.StartInclusive))
+			assert.True(t, maxTime.Before(opts.EndExclusive))
+		}).
+		Return(index.QueryResults{Iter: results.Iter(), Exhaustive: true}, nil)
+
+	code, resp := servePromRequest(t, h.series, seriesURL, url.Values{
+		"match[]": []string{`up{job="node"}`},
+		"start":   []string{"1500000000"},
+	})
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "success", resp.Status)
+
+	var data []map[string]string
+	require.NoError(t, json.Unmarshal(resp.Data, &data))
+	assert.Equal(t, []map[string]string{{
+		promql.MetricNameLabel: "up",
+		"job":                  "node",
+	}}, data)
+}
+
+func TestPromSeriesBadRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h, _ := newTestPromHandlers(ctrl)
+	for _, test := range []struct {
+		name   string
+		params url.Values
+	}{
+		{"missing match", url.Values{}},
+		{"bad match", url.Values{"match[]": []string{"up{"}}},
+		{"bad start", url.Values{"match[]": []string{"up"}, "start": []string{"foo"}}},
+		{"bad end", url.Values{"match[]": []string{"up"}, "end": []string{"foo"}}},
+	} {
+		code, resp := servePromRequest(t, h.series, seriesURL, test.params)
+		assert.Equal(t, http.StatusBadRequest, code, test.name)
+		assert.Equal(t, promErrorTypeBadData, resp.ErrorType, test.name)
+	}
+
+	code, resp := servePromRequest(t, h.series, seriesURL, url.Values{})
+	require.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, errMissingMatch.Error(), resp.Error)
+}
+
+func TestPromSeriesFetchError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h, session := newTestPromHandlers(ctrl)
+	session.EXPECT().FetchTaggedIDs(gomock.Any(), gomock.Any()).
+		Return(index.QueryResults{}, errors.New("fetch failed"))
+
+	code, resp := servePromRequest(t, h.series, seriesURL, url.Values{
+		"match[]": []string{"up"},
+	})
+	assert.Equal(t, http.StatusUnprocessableEntity, code)
+	assert.Equal(t, promErrorTypeExecution, resp.ErrorType)
+	assert.Contains(t, resp.Error, "fetch failed")
+}
@@ -65,6 +65,7 @@ func (s *server) ListenAndServe() (ns.Close, error) {
 	if err := httpjson.RegisterHandlers(mux, service, s.opts); err != nil {
 		return nil, err
 	}
+	registerPromHandlers(mux, s.client)
 
 	listener, err := net.Listen("tcp", s.address)
 	if err != nil {
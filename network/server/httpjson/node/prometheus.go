@@ -0,0 +1,345 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package node
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/m3db/m3db/encoding"
+	"github.com/m3db/m3db/generated/proto/prompb"
+	"github.com/m3db/m3db/promql"
+	"github.com/m3db/m3db/storage"
+	"github.com/m3db/m3db/storage/index"
+	"github.com/m3db/m3db/storage/namespace"
+	"github.com/m3db/m3db/x/xio"
+	"github.com/m3db/m3x/context"
+	xerrors "github.com/m3db/m3x/errors"
+	"github.com/m3db/m3x/ident"
+	xtime "github.com/m3db/m3x/time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
+)
+
+const (
+	promRemoteWriteURL = "/api/v1/prom/remote/write"
+	promRemoteReadURL  = "/api/v1/prom/remote/read"
+
+	// promRemoteNamespaceParam is the optional query parameter selecting the
+	// namespace remote writes go to and remote reads are restricted to.
+	promRemoteNamespaceParam = "namespace"
+)
+
+var (
+	errPromRemoteNamespaceRequired = errors.New(
+		"namespace parameter required when the database has more than one namespace")
+	errPromRemoteInvalidMethod = errors.New("method must be POST")
+)
+
+type promRemoteHandlers struct {
+	db          storage.Database
+	contextPool context.Pool
+}
+
+// registerPromRemoteHandlers registers the Prometheus remote write and
+// remote read handlers.
+func registerPromRemoteHandlers(
+	mux *http.ServeMux,
+	db storage.Database,
+	contextPool context.Pool,
+) {
+	h := &promRemoteHandlers{db: db, contextPool: contextPool}
+	mux.HandleFunc(promRemoteWriteURL, h.write)
+	mux.HandleFunc(promRemoteReadURL, h.read)
+}
+
+func (h *promRemoteHandlers) write(w http.ResponseWriter, r *http.Request) {
+	var req prompb.WriteRequest
+	if err := readSnappyProto(r, &req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	nsID, err := h.writeNamespace(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx := h.contextPool.Get()
+	defer ctx.Close()
+
+	for _, series := range req.Timeseries {
+		labels := fromPromLabels(series.Labels)
+		id := ident.StringID(labels.String())
+		tags := toTags(labels)
+		for _, sample := range series.Samples {
+			timestamp := time.Unix(0, sample.Timestamp*int64(time.Millisecond))
+			if err := h.db.WriteTagged(ctx, nsID, id, ident.NewTagSliceIterator(tags),
+				timestamp, sample.Value, xtime.Millisecond, nil); err != nil {
+				http.Error(w, err.Error(), writeErrorStatus(err))
+				return
+			}
+		}
+	}
+}
+
+func (h *promRemoteHandlers) read(w http.ResponseWriter, r *http.Request) {
+	var req prompb.ReadRequest
+	if err := readSnappyProto(r, &req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Reads span all namespaces unless restricted to one
+	var nsID ident.ID
+	if ns := r.URL.Query().Get(promRemoteNamespaceParam); ns != "" {
+		nsID = ident.StringID(ns)
+	}
+
+	ctx := h.contextPool.Get()
+	defer ctx.Close()
+
+	resp := &prompb.ReadResponse{
+		Results: make([]*prompb.QueryResult, 0, len(req.Queries)),
+	}
+	for _, query := range req.Queries {
+		result, err := h.query(ctx, nsID, query)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if xerrors.IsInvalidParams(err) {
+				status = http.StatusBadRequest
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	data, err := proto.Marshal(resp)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.Header().Set("Content-Encoding", "snappy")
+	w.Write(snappy.Encode(nil, data))
+}
+
+// writeNamespace returns the namespace selected by the request, defaulting to
+// the only namespace of the database if there is just one.
+func (h *promRemoteHandlers) writeNamespace(r *http.Request) (ident.ID, error) {
+	if ns := r.URL.Query().Get(promRemoteNamespaceParam); ns != "" {
+		return ident.StringID(ns), nil
+	}
+	namespaces := h.db.Namespaces()
+	if len(namespaces) != 1 {
+		return nil, errPromRemoteNamespaceRequired
+	}
+	return namespaces[0].ID(), nil
+}
+
+func (h *promRemoteHandlers) query(
+	ctx context.Context,
+	nsID ident.ID,
+	query *prompb.Query,
+) (*prompb.QueryResult, error) {
+	matchers, err := fromPromMatchers(query.Matchers)
+	if err != nil {
+		return nil, xerrors.NewInvalidParamsError(err)
+	}
+	compiled, err := promql.CompileMatchers(matchers)
+	if err != nil {
+		return nil, xerrors.NewInvalidParamsError(err)
+	}
+
+	var (
+		start = time.Unix(0, query.StartTimestampMs*int64(time.Millisecond))
+		// Remote read time ranges are inclusive of the end
+		end = time.Unix(0, query.EndTimestampMs*int64(time.Millisecond)).Add(time.Nanosecond)
+	)
+	results, err := h.db.QueryIDs(ctx, promql.ToIndexQuery(matchers), index.QueryOptions{
+		StartInclusive: start,
+		EndExclusive:   end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &prompb.QueryResult{Timeseries: make([]*prompb.TimeSeries, 0)}
+	iter := results.Iter
+	for iter.Next() {
+		seriesNsID, seriesID, tags := iter.Current()
+		if nsID != nil && !nsID.Equal(seriesNsID) {
+			continue
+		}
+
+		labels, err := fromTags(tags)
+		if err != nil {
+			return nil, err
+		}
+		if !compiled.Matches(labels) {
+			continue
+		}
+
+		samples, err := h.readSamples(ctx, seriesNsID, seriesID, start, end)
+		if err != nil {
+			return nil, err
+		}
+		result.Timeseries = append(result.Timeseries, &prompb.TimeSeries{
+			Labels:  toPromLabels(labels),
+			Samples: samples,
+		})
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (h *promRemoteHandlers) readSamples(
+	ctx context.Context,
+	nsID ident.ID,
+	id ident.ID,
+	start, end time.Time,
+) ([]*prompb.Sample, error) {
+	encoded, err := h.db.ReadEncoded(ctx, nsID, id, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	multiIt := h.db.Options().MultiReaderIteratorPool().Get()
+	multiIt.ResetSliceOfSlices(xio.NewReaderSliceOfSlicesFromSegmentReadersIterator(encoded))
+
+	// NB: the series iterator takes ownership of the IDs and finalizes them on close
+	iter := encoding.NewSeriesIterator(ident.StringID(id.String()),
+		ident.StringID(nsID.String()), start, end, []encoding.Iterator{multiIt}, nil)
+	defer iter.Close()
+
+	samples := make([]*prompb.Sample, 0)
+	for iter.Next() {
+		dp, _, _ := iter.Current()
+		samples = append(samples, &prompb.Sample{
+			Value:     dp.Value,
+			Timestamp: dp.Timestamp.UnixNano() / int64(time.Millisecond),
+		})
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	return samples, nil
+}
+
+func readSnappyProto(r *http.Request, msg proto.Message) error {
+	if r.Method != http.MethodPost {
+		return errPromRemoteInvalidMethod
+	}
+	compressed, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	data, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(data, msg)
+}
+
+func writeErrorStatus(err error) int {
+	switch {
+	case xerrors.IsInvalidParams(err):
+		return http.StatusBadRequest
+	case namespace.IsQuotaExceededError(err):
+		return http.StatusTooManyRequests
+	}
+	return http.StatusInternalServerError
+}
+
+func fromPromLabels(labels []*prompb.Label) promql.Labels {
+	result := make([]promql.Label, 0, len(labels))
+	for _, label := range labels {
+		result = append(result, promql.Label{Name: label.Name, Value: label.Value})
+	}
+	return promql.NewLabels(result...)
+}
+
+func toPromLabels(labels promql.Labels) []*prompb.Label {
+	result := make([]*prompb.Label, 0, len(labels))
+	for _, label := range labels {
+		result = append(result, &prompb.Label{Name: label.Name, Value: label.Value})
+	}
+	return result
+}
+
+func toTags(labels promql.Labels) ident.Tags {
+	tags := make(ident.Tags, 0, len(labels))
+	for _, label := range labels {
+		tags = append(tags, ident.Tag{
+			Name:  ident.StringID(label.Name),
+			Value: ident.StringID(label.Value),
+		})
+	}
+	return tags
+}
+
+func fromTags(tags ident.TagIterator) (promql.Labels, error) {
+	var labels []promql.Label
+	for tags.Next() {
+		tag := tags.Current()
+		labels = append(labels, promql.Label{
+			Name:  tag.Name.String(),
+			Value: tag.Value.String(),
+		})
+	}
+	if err := tags.Err(); err != nil {
+		return nil, err
+	}
+	return promql.NewLabels(labels...), nil
+}
+
+func fromPromMatchers(matchers []*prompb.LabelMatcher) ([]promql.LabelMatcher, error) {
+	result := make([]promql.LabelMatcher, 0, len(matchers))
+	for _, m := range matchers {
+		var matchType promql.MatchType
+		switch m.Type {
+		case prompb.LabelMatcher_EQ:
+			matchType = promql.MatchEqual
+		case prompb.LabelMatcher_NEQ:
+			matchType = promql.MatchNotEqual
+		case prompb.LabelMatcher_RE:
+			matchType = promql.MatchRegexp
+		case prompb.LabelMatcher_NRE:
+			matchType = promql.MatchNotRegexp
+		default:
+			return nil, fmt.Errorf("unknown label matcher type: %v", m.Type)
+		}
+		result = append(result, promql.LabelMatcher{
+			Type:  matchType,
+			Name:  m.Name,
+			Value: m.Value,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package node
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3db/generated/proto/prompb"
+	"github.com/m3db/m3db/promql"
+	"github.com/m3db/m3db/storage"
+	"github.com/m3db/m3x/context"
+	"github.com/m3db/m3x/ident"
+	xtime "github.com/m3db/m3x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPromRemoteTestRequest(t *testing.T, url string, msg proto.Message) *http.Request {
+	data, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	return httptest.NewRequest(http.MethodPost, url, bytes.NewReader(snappy.Encode(nil, data)))
+}
+
+func TestPromRemoteWrite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		db  = storage.NewMockDatabase(ctrl)
+		now = time.Now().Truncate(time.Millisecond)
+		ids []string
+	)
+	db.EXPECT().
+		WriteTagged(gomock.Any(), ident.NewIDMatcher("metrics"), gomock.Any(), gomock.Any(),
+			gomock.Any(), 42.0, xtime.Millisecond, gomock.Any()).
+		Do(func(ctx context.Context, ns ident.ID, id ident.ID, tags ident.TagIterator,
+			timestamp time.Time, value float64, unit xtime.Unit, annotation []byte) {
+			ids = append(ids, id.String())
+			assert.True(t, now.Equal(timestamp))
+			labels, err := fromTags(tags)
+			require.NoError(t, err)
+			assert.Equal(t, promql.Labels{
+				{Name: "__name__", Value: "up"},
+				{Name: "job", Value: "node"},
+			}, labels)
+		}).
+		Return(nil)
+
+	h := &promRemoteHandlers{db: db, contextPool: context.NewPool(context.NewOptions())}
+	req := newPromRemoteTestRequest(t, promRemoteWriteURL+"?namespace=metrics", &prompb.WriteRequest{
+		Timeseries: []*prompb.TimeSeries{{
+			Labels: []*prompb.Label{
+				{Name: "job", Value: "node"},
+				{Name: "__name__", Value: "up"},
+			},
+			Samples: []*prompb.Sample{
+				{Value: 42, Timestamp: now.UnixNano() / int64(time.Millisecond)},
+			},
+		}},
+	})
+	w := httptest.NewRecorder()
+	h.write(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{`{__name__="up",job="node"}`}, ids)
+}
+
+func TestPromRemoteWriteRequiresNamespace(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := storage.NewMockDatabase(ctrl)
+	db.EXPECT().Namespaces().Return([]storage.Namespace{
+		storage.NewMockNamespace(ctrl),
+		storage.NewMockNamespace(ctrl),
+	})
+
+	h := &promRemoteHandlers{db: db, contextPool: context.NewPool(context.NewOptions())}
+	req := newPromRemoteTestRequest(t, promRemoteWriteURL, &prompb.WriteRequest{})
+	w := httptest.NewRecorder()
+	h.write(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestPromRemoteFromPromMatchers(t *testing.T) {
+	matchers, err := fromPromMatchers([]*prompb.LabelMatcher{
+		{Type: prompb.LabelMatcher_EQ, Name: "a", Value: "1"},
+		{Type: prompb.LabelMatcher_NEQ, Name: "b", Value: "2"},
+		{Type: prompb.LabelMatcher_RE, Name: "c", Value: "3.*"},
+		{Type: prompb.LabelMatcher_NRE, Name: "d", Value: "4.*"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []promql.LabelMatcher{
+		{Type: promql.MatchEqual, Name: "a", Value: "1"},
+		{Type: promql.MatchNotEqual, Name: "b", Value: "2"},
+		{Type: promql.MatchRegexp, Name: "c", Value: "3.*"},
+		{Type: promql.MatchNotRegexp, Name: "d", Value: "4.*"},
+	}, matchers)
+
+	_, err = fromPromMatchers([]*prompb.LabelMatcher{{Type: prompb.LabelMatcher_Type(10)}})
+	assert.Error(t, err)
+}
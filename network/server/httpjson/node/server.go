@@ -33,10 +33,11 @@ import (
 )
 
 type server struct {
-	address string
-	db      storage.Database
-	opts    httpjson.ServerOptions
-	ttopts  tchannelthrift.Options
+	address     string
+	db          storage.Database
+	contextPool context.Pool
+	opts        httpjson.ServerOptions
+	ttopts      tchannelthrift.Options
 }
 
 // NewServer creates a node HTTP network service
@@ -57,10 +58,11 @@ func NewServer(
 		SetContextFn(httpjson.NewDefaultContextFn(contextPool)).
 		SetPostResponseFn(httpjson.DefaulPostResponseFn)
 	return &server{
-		address: address,
-		db:      db,
-		opts:    opts,
-		ttopts:  ttopts,
+		address:     address,
+		db:          db,
+		contextPool: contextPool,
+		opts:        opts,
+		ttopts:      ttopts,
 	}
 }
 
@@ -69,6 +71,7 @@ func (s *server) ListenAndServe() (ns.Close, error) {
 	if err := httpjson.RegisterHandlers(mux, ttnode.NewService(s.db, s.ttopts), s.opts); err != nil {
 		return nil, err
 	}
+	registerPromRemoteHandlers(mux, s.db, s.contextPool)
 
 	listener, err := net.Listen("tcp", s.address)
 	if err != nil {
@@ -24,6 +24,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/m3db/m3db/client"
 	"github.com/m3db/m3db/generated/thrift/rpc"
@@ -122,6 +123,10 @@ func (s *service) Fetch(tctx thrift.Context, req *rpc.FetchRequest) (*rpc.FetchR
 	nsID := s.idPool.GetStringID(ctx, req.NameSpace)
 	tsID := s.idPool.GetStringID(ctx, req.ID)
 
+	if req.IsSetStep() {
+		return s.fetchAggregated(session, nsID, tsID, start, end, req)
+	}
+
 	it, err := session.Fetch(nsID, tsID, start, end)
 	if err != nil {
 		if client.IsBadRequestError(err) {
@@ -156,6 +161,51 @@ func (s *service) Fetch(tctx thrift.Context, req *rpc.FetchRequest) (*rpc.FetchR
 	return result, nil
 }
 
+func (s *service) fetchAggregated(
+	session client.Session,
+	nsID, tsID ident.ID,
+	start, end time.Time,
+	req *rpc.FetchRequest,
+) (*rpc.FetchResult_, error) {
+	stepUnit, err := convert.ToDuration(req.RangeType)
+	if err != nil {
+		return nil, tterrors.NewBadRequestError(err)
+	}
+	aggregation, err := convert.ToAggregationType(req.Aggregation)
+	if err != nil {
+		return nil, tterrors.NewBadRequestError(err)
+	}
+
+	datapoints, err := session.FetchAggregated(nsID, tsID, start, end, client.FetchAggregatedOptions{
+		Step:        time.Duration(req.GetStep()) * stepUnit,
+		Aggregation: aggregation,
+	})
+	if err != nil {
+		if client.IsBadRequestError(err) || xerrors.IsInvalidParams(err) {
+			return nil, tterrors.NewBadRequestError(err)
+		}
+		return nil, tterrors.NewInternalError(err)
+	}
+
+	result := rpc.NewFetchResult_()
+	// Make datapoints an initialized empty array for JSON serialization as empty array than null
+	result.Datapoints = make([]*rpc.Datapoint, 0, len(datapoints))
+
+	for _, dp := range datapoints {
+		ts, tsErr := convert.ToValue(dp.Timestamp, req.ResultTimeType)
+		if tsErr != nil {
+			return nil, tterrors.NewBadRequestError(tsErr)
+		}
+
+		datapoint := rpc.NewDatapoint()
+		datapoint.Timestamp = ts
+		datapoint.Value = dp.Value
+		result.Datapoints = append(result.Datapoints, datapoint)
+	}
+
+	return result, nil
+}
+
 func (s *service) FetchTagged(ctx thrift.Context, req *rpc.FetchTaggedRequest) (*rpc.FetchTaggedResult_, error) {
 	return nil, tterrors.NewInternalError(errNotImplemented)
 }
@@ -52,6 +52,13 @@ func NewContext(timeout time.Duration) (thrift.Context, xnetcontext.CancelFunc)
 	return thrift.WithHeaders(ctxWithValue, nil), cancel
 }
 
+// WithContext returns a thrift context derived from a parent context with an embedded
+// M3DB context, used to serve requests received over transports other than TChannel
+func WithContext(ctx xnetcontext.Context, inner context.Context) thrift.Context {
+	ctxWithValue := xnetcontext.WithValue(ctx, contextKey, inner)
+	return thrift.WithHeaders(ctxWithValue, nil)
+}
+
 // Context returns an M3DB context from the thrift context
 func Context(ctx thrift.Context) context.Context {
 	return ctx.Value(contextKey).(context.Context)
@@ -27,7 +27,10 @@ import (
 	"github.com/m3db/m3db/digest"
 	"github.com/m3db/m3db/generated/thrift/rpc"
 	tterrors "github.com/m3db/m3db/network/server/tchannelthrift/errors"
+	"github.com/m3db/m3db/storage/index"
+	"github.com/m3db/m3db/storage/namespace"
 	"github.com/m3db/m3db/x/xio"
+	"github.com/m3db/m3ninx/index/segment"
 	"github.com/m3db/m3x/checked"
 	xerrors "github.com/m3db/m3x/errors"
 	xtime "github.com/m3db/m3x/time"
@@ -36,6 +39,10 @@ import (
 var (
 	errUnknownTimeType = errors.New("unknown time type")
 	errUnknownUnit     = errors.New("unknown unit")
+	errUnknownAggType  = errors.New("unknown aggregation type")
+	errUnknownBoolOp   = errors.New("unknown boolean operator")
+	errNilQuery        = errors.New("query is nil")
+	errUnknownConj     = errors.New("unknown query conjunction")
 	timeZero           time.Time
 )
 
@@ -100,6 +107,109 @@ func ToTimeType(unit xtime.Unit) (rpc.TimeType, error) {
 	return 0, errUnknownUnit
 }
 
+// ToAggregationType converts a RPC aggregation type to an aggregation type
+func ToAggregationType(aggType rpc.AggregationType) (namespace.AggregationType, error) {
+	switch aggType {
+	case rpc.AggregationType_MEAN:
+		return namespace.AggregationMean, nil
+	case rpc.AggregationType_MIN:
+		return namespace.AggregationMin, nil
+	case rpc.AggregationType_MAX:
+		return namespace.AggregationMax, nil
+	case rpc.AggregationType_SUM:
+		return namespace.AggregationSum, nil
+	case rpc.AggregationType_COUNT:
+		return namespace.AggregationCount, nil
+	case rpc.AggregationType_LAST:
+		return namespace.AggregationLast, nil
+	}
+	return 0, errUnknownAggType
+}
+
+// ToRPCAggregationType converts an aggregation type to a RPC aggregation type
+func ToRPCAggregationType(aggType namespace.AggregationType) (rpc.AggregationType, error) {
+	switch aggType {
+	case namespace.AggregationMean:
+		return rpc.AggregationType_MEAN, nil
+	case namespace.AggregationMin:
+		return rpc.AggregationType_MIN, nil
+	case namespace.AggregationMax:
+		return rpc.AggregationType_MAX, nil
+	case namespace.AggregationSum:
+		return rpc.AggregationType_SUM, nil
+	case namespace.AggregationCount:
+		return rpc.AggregationType_COUNT, nil
+	case namespace.AggregationLast:
+		return rpc.AggregationType_LAST, nil
+	}
+	return 0, errUnknownAggType
+}
+
+// ToIndexQuery converts a RPC index query to an index query
+func ToIndexQuery(q *rpc.IdxQuery) (index.Query, error) {
+	query, err := toSegmentQuery(q)
+	if err != nil {
+		return index.Query{}, err
+	}
+	return index.Query{Query: query}, nil
+}
+
+func toSegmentQuery(q *rpc.IdxQuery) (segment.Query, error) {
+	if q == nil {
+		return segment.Query{}, errNilQuery
+	}
+	if q.Operator != rpc.BooleanOperator_AND_OPERATOR {
+		return segment.Query{}, errUnknownBoolOp
+	}
+
+	query := segment.Query{Conjunction: segment.AndConjunction}
+	for _, f := range q.Filters {
+		query.Filters = append(query.Filters, segment.Filter{
+			FieldName:        []byte(f.TagName),
+			FieldValueFilter: []byte(f.TagValueFilter),
+			Negate:           f.Negate,
+			Regexp:           f.Regexp,
+		})
+	}
+	for _, sub := range q.SubQueries {
+		subQuery, err := toSegmentQuery(sub)
+		if err != nil {
+			return segment.Query{}, err
+		}
+		query.SubQueries = append(query.SubQueries, subQuery)
+	}
+	return query, nil
+}
+
+// ToRPCIndexQuery converts an index query to a RPC index query
+func ToRPCIndexQuery(q index.Query) (*rpc.IdxQuery, error) {
+	return toRPCIdxQuery(q.Query)
+}
+
+func toRPCIdxQuery(q segment.Query) (*rpc.IdxQuery, error) {
+	if q.Conjunction != segment.AndConjunction {
+		return nil, errUnknownConj
+	}
+
+	query := &rpc.IdxQuery{Operator: rpc.BooleanOperator_AND_OPERATOR}
+	for _, f := range q.Filters {
+		query.Filters = append(query.Filters, &rpc.IdxTagFilter{
+			TagName:        string(f.FieldName),
+			TagValueFilter: string(f.FieldValueFilter),
+			Negate:         f.Negate,
+			Regexp:         f.Regexp,
+		})
+	}
+	for _, sub := range q.SubQueries {
+		subQuery, err := toRPCIdxQuery(sub)
+		if err != nil {
+			return nil, err
+		}
+		query.SubQueries = append(query.SubQueries, subQuery)
+	}
+	return query, nil
+}
+
 // ToSegmentsResult is the result of a convert to segments call,
 // if the segments were merged then checksum is ptr to the checksum
 // otherwise it is nil.
@@ -170,5 +280,8 @@ func ToRPCError(err error) *rpc.Error {
 	if xerrors.IsInvalidParams(err) {
 		return tterrors.NewBadRequestError(err)
 	}
+	if namespace.IsQuotaExceededError(err) {
+		return tterrors.NewQuotaExceededError(err)
+	}
 	return tterrors.NewInternalError(err)
 }
@@ -43,6 +43,11 @@ func IsBadRequestError(err *rpc.Error) bool {
 	return err != nil && err.Type == rpc.ErrorType_BAD_REQUEST
 }
 
+// IsQuotaExceededError returns whether the error is a quota exceeded error
+func IsQuotaExceededError(err *rpc.Error) bool {
+	return err != nil && err.Type == rpc.ErrorType_QUOTA_EXCEEDED
+}
+
 // NewInternalError creates a new internal error
 func NewInternalError(err error) *rpc.Error {
 	return newError(rpc.ErrorType_INTERNAL_ERROR, err)
@@ -53,6 +58,11 @@ func NewBadRequestError(err error) *rpc.Error {
 	return newError(rpc.ErrorType_BAD_REQUEST, err)
 }
 
+// NewQuotaExceededError creates a new quota exceeded error
+func NewQuotaExceededError(err error) *rpc.Error {
+	return newError(rpc.ErrorType_QUOTA_EXCEEDED, err)
+}
+
 // NewWriteBatchRawError creates a new write batch error
 func NewWriteBatchRawError(index int, err error) *rpc.WriteBatchRawError {
 	batchErr := rpc.NewWriteBatchRawError()
@@ -68,3 +78,11 @@ func NewBadRequestWriteBatchRawError(index int, err error) *rpc.WriteBatchRawErr
 	batchErr.Err = NewBadRequestError(err)
 	return batchErr
 }
+
+// NewQuotaExceededWriteBatchRawError creates a new quota exceeded write batch error
+func NewQuotaExceededWriteBatchRawError(index int, err error) *rpc.WriteBatchRawError {
+	batchErr := rpc.NewWriteBatchRawError()
+	batchErr.Index = int64(index)
+	batchErr.Err = NewQuotaExceededError(err)
+	return batchErr
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package node
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/m3db/m3db/encoding"
+	"github.com/m3db/m3db/generated/thrift/rpc"
+	"github.com/m3db/m3db/network/server/tchannelthrift/convert"
+	"github.com/m3db/m3db/storage/namespace"
+	"github.com/m3db/m3db/ts"
+)
+
+var (
+	errStepNotPositive = errors.New("step must be positive")
+)
+
+// consolidator consolidates the datapoints of a series into a single
+// datapoint per step, timestamped at the start of the step.
+type consolidator struct {
+	start       time.Time
+	step        time.Duration
+	aggregation namespace.AggregationType
+
+	stepStart time.Time
+	count     int64
+	sum       float64
+	min       float64
+	max       float64
+	last      float64
+}
+
+func newConsolidator(
+	start time.Time,
+	step time.Duration,
+	aggregation namespace.AggregationType,
+) *consolidator {
+	return &consolidator{
+		start:       start,
+		step:        step,
+		aggregation: aggregation,
+	}
+}
+
+// newRequestConsolidator returns a consolidator for the step and aggregation
+// of a fetch request, with the step given in units of the request time type.
+func newRequestConsolidator(
+	start time.Time,
+	step int64,
+	stepType rpc.TimeType,
+	aggType rpc.AggregationType,
+) (*consolidator, error) {
+	if step <= 0 {
+		return nil, errStepNotPositive
+	}
+	unit, err := convert.ToDuration(stepType)
+	if err != nil {
+		return nil, err
+	}
+	aggregation, err := convert.ToAggregationType(aggType)
+	if err != nil {
+		return nil, err
+	}
+	return newConsolidator(start, time.Duration(step)*unit, aggregation), nil
+}
+
+// Consolidate iterates the series, calling fn with each consolidated datapoint.
+func (c *consolidator) Consolidate(iter encoding.Iterator, fn func(dp ts.Datapoint)) error {
+	c.reset(time.Time{})
+	for iter.Next() {
+		dp, _, _ := iter.Current()
+		if math.IsNaN(dp.Value) {
+			continue
+		}
+
+		stepStart := c.stepStartFor(dp.Timestamp)
+		if c.count == 0 || !stepStart.Equal(c.stepStart) {
+			if c.count > 0 {
+				fn(c.datapoint())
+			}
+			c.reset(stepStart)
+		}
+		c.add(dp.Value)
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+	if c.count > 0 {
+		fn(c.datapoint())
+	}
+	return nil
+}
+
+func (c *consolidator) stepStartFor(t time.Time) time.Time {
+	steps := t.Sub(c.start) / c.step
+	return c.start.Add(steps * c.step)
+}
+
+func (c *consolidator) reset(stepStart time.Time) {
+	c.stepStart = stepStart
+	c.count = 0
+	c.sum = 0
+	c.min = 0
+	c.max = 0
+	c.last = 0
+}
+
+func (c *consolidator) add(v float64) {
+	if c.count == 0 || v < c.min {
+		c.min = v
+	}
+	if c.count == 0 || v > c.max {
+		c.max = v
+	}
+	c.count++
+	c.sum += v
+	c.last = v
+}
+
+func (c *consolidator) datapoint() ts.Datapoint {
+	dp := ts.Datapoint{Timestamp: c.stepStart}
+	switch c.aggregation {
+	case namespace.AggregationMin:
+		dp.Value = c.min
+	case namespace.AggregationMax:
+		dp.Value = c.max
+	case namespace.AggregationSum:
+		dp.Value = c.sum
+	case namespace.AggregationCount:
+		dp.Value = float64(c.count)
+	case namespace.AggregationLast:
+		dp.Value = c.last
+	default:
+		dp.Value = c.sum / float64(c.count)
+	}
+	return dp
+}
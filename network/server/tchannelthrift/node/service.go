@@ -27,12 +27,17 @@ import (
 	"time"
 
 	"github.com/m3db/m3db/clock"
+	"github.com/m3db/m3db/generated/proto/pagetoken"
 	"github.com/m3db/m3db/generated/thrift/rpc"
 	"github.com/m3db/m3db/network/server/tchannelthrift"
 	"github.com/m3db/m3db/network/server/tchannelthrift/convert"
 	tterrors "github.com/m3db/m3db/network/server/tchannelthrift/errors"
 	"github.com/m3db/m3db/storage"
 	"github.com/m3db/m3db/storage/block"
+	"github.com/m3db/m3db/storage/index"
+	"github.com/m3db/m3db/storage/namespace"
+	"github.com/m3db/m3db/ts"
+	"github.com/m3db/m3db/x/serialize"
 	"github.com/m3db/m3db/x/xio"
 	"github.com/m3db/m3x/checked"
 	"github.com/m3db/m3x/context"
@@ -43,6 +48,7 @@ import (
 	"github.com/m3db/m3x/resource"
 	xtime "github.com/m3db/m3x/time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/uber-go/tally"
 	"github.com/uber/tchannel-go/thrift"
 )
@@ -57,16 +63,27 @@ var (
 
 	// errNotImplemented raised when attempting to execute an un-implemented method
 	errNotImplemented = errors.New("method is not implemented")
+
+	// errInvalidPageLimit raised when a paged fetch does not specify a positive limit
+	errInvalidPageLimit = errors.New("page limit must be positive")
+
+	// errInvalidPageToken raised when a paged fetch page token cannot be decoded
+	errInvalidPageToken = errors.New("page token is invalid")
+
+	// errUnknownShardBootstrapState raised when a shard bootstrap state cannot be converted
+	errUnknownShardBootstrapState = errors.New("unknown shard bootstrap state")
 )
 
 type serviceMetrics struct {
 	fetch               instrument.MethodMetrics
+	fetchTagged         instrument.MethodMetrics
 	write               instrument.MethodMetrics
 	fetchBlocks         instrument.MethodMetrics
 	fetchBlocksMetadata instrument.MethodMetrics
 	repair              instrument.MethodMetrics
 	truncate            instrument.MethodMetrics
 	fetchBatchRaw       instrument.BatchMethodMetrics
+	fetchBatchRawPaged  instrument.BatchMethodMetrics
 	writeBatchRaw       instrument.BatchMethodMetrics
 	overloadRejected    tally.Counter
 }
@@ -74,12 +91,14 @@ type serviceMetrics struct {
 func newServiceMetrics(scope tally.Scope, samplingRate float64) serviceMetrics {
 	return serviceMetrics{
 		fetch:               instrument.NewMethodMetrics(scope, "fetch", samplingRate),
+		fetchTagged:         instrument.NewMethodMetrics(scope, "fetchTagged", samplingRate),
 		write:               instrument.NewMethodMetrics(scope, "write", samplingRate),
 		fetchBlocks:         instrument.NewMethodMetrics(scope, "fetchBlocks", samplingRate),
 		fetchBlocksMetadata: instrument.NewMethodMetrics(scope, "fetchBlocksMetadata", samplingRate),
 		repair:              instrument.NewMethodMetrics(scope, "repair", samplingRate),
 		truncate:            instrument.NewMethodMetrics(scope, "truncate", samplingRate),
 		fetchBatchRaw:       instrument.NewBatchMethodMetrics(scope, "fetchBatchRaw", samplingRate),
+		fetchBatchRawPaged:  instrument.NewBatchMethodMetrics(scope, "fetchBatchRawPaged", samplingRate),
 		writeBatchRaw:       instrument.NewBatchMethodMetrics(scope, "writeBatchRaw", samplingRate),
 		overloadRejected:    scope.Counter("overload-rejected"),
 	}
@@ -185,6 +204,16 @@ func (s *service) Fetch(tctx thrift.Context, req *rpc.FetchRequest) (*rpc.FetchR
 		return nil, tterrors.NewBadRequestError(xerrors.FirstError(rangeStartErr, rangeEndErr))
 	}
 
+	var consolidator *consolidator
+	if req.IsSetStep() {
+		var err error
+		consolidator, err = newRequestConsolidator(start, req.GetStep(), req.RangeType, req.Aggregation)
+		if err != nil {
+			s.metrics.fetch.ReportError(s.nowFn().Sub(callStart))
+			return nil, tterrors.NewBadRequestError(err)
+		}
+	}
+
 	tsID := s.idPool.GetStringID(ctx, req.ID)
 	nsID := s.idPool.GetStringID(ctx, req.NameSpace)
 	encoded, err := s.db.ReadEncoded(ctx, nsID, tsID, start, end)
@@ -194,44 +223,162 @@ func (s *service) Fetch(tctx thrift.Context, req *rpc.FetchRequest) (*rpc.FetchR
 		return nil, rpcErr
 	}
 
+	datapoints, rpcErr := s.readDatapoints(encoded, consolidator, req.ResultTimeType)
+	if rpcErr != nil {
+		s.metrics.fetch.ReportError(s.nowFn().Sub(callStart))
+		return nil, rpcErr
+	}
+
 	result := rpc.NewFetchResult_()
+	result.Datapoints = datapoints
+
+	s.metrics.fetch.ReportSuccess(s.nowFn().Sub(callStart))
+
+	return result, nil
+}
+
+func (s *service) FetchTagged(tctx thrift.Context, req *rpc.FetchTaggedRequest) (*rpc.FetchTaggedResult_, error) {
+	callStart := s.nowFn()
+	ctx := tchannelthrift.Context(tctx)
+
+	start, rangeStartErr := convert.ToTime(req.RangeStart, req.RangeTimeType)
+	end, rangeEndErr := convert.ToTime(req.RangeEnd, req.RangeTimeType)
 
+	if rangeStartErr != nil || rangeEndErr != nil {
+		s.metrics.fetchTagged.ReportError(s.nowFn().Sub(callStart))
+		return nil, tterrors.NewBadRequestError(xerrors.FirstError(rangeStartErr, rangeEndErr))
+	}
+
+	query, err := convert.ToIndexQuery(req.Query)
+	if err != nil {
+		s.metrics.fetchTagged.ReportError(s.nowFn().Sub(callStart))
+		return nil, tterrors.NewBadRequestError(err)
+	}
+
+	var consolidator *consolidator
+	if req.IsSetStep() {
+		consolidator, err = newRequestConsolidator(start, req.GetStep(), req.RangeTimeType, req.Aggregation)
+		if err != nil {
+			s.metrics.fetchTagged.ReportError(s.nowFn().Sub(callStart))
+			return nil, tterrors.NewBadRequestError(err)
+		}
+	}
+
+	opts := index.QueryOptions{
+		StartInclusive: start,
+		EndExclusive:   end,
+	}
+	if req.IsSetLimit() {
+		opts.Limit = int(req.GetLimit())
+	}
+
+	queryResult, err := s.db.QueryIDs(ctx, query, opts)
+	if err != nil {
+		s.metrics.fetchTagged.ReportError(s.nowFn().Sub(callStart))
+		return nil, convert.ToRPCError(err)
+	}
+
+	result := rpc.NewFetchTaggedResult_()
+	result.Exhaustive = queryResult.Exhaustive
+	// Make elements an initialized empty array for JSON serialization as empty array than null
+	result.Elements = make([]*rpc.FetchTaggedIDResult_, 0)
+
+	iter := queryResult.Iter
+	for iter.Next() {
+		nsID, tsID, tags := iter.Current()
+		elem := rpc.NewFetchTaggedIDResult_()
+		elem.ID = tsID.String()
+		elem.NameSpace = nsID.String()
+		elem.Tags = make([]*rpc.TagString, 0)
+		for tags.Next() {
+			tag := tags.Current()
+			elem.Tags = append(elem.Tags, &rpc.TagString{
+				Name:  tag.Name.String(),
+				Value: tag.Value.String(),
+			})
+		}
+		if err := tags.Err(); err != nil {
+			s.metrics.fetchTagged.ReportError(s.nowFn().Sub(callStart))
+			return nil, tterrors.NewInternalError(err)
+		}
+		result.Elements = append(result.Elements, elem)
+
+		if !req.FetchData {
+			continue
+		}
+
+		encoded, err := s.db.ReadEncoded(ctx, nsID, tsID, start, end)
+		if err != nil {
+			elem.Err = convert.ToRPCError(err)
+			continue
+		}
+		datapoints, rpcErr := s.readDatapoints(encoded, consolidator, req.RangeTimeType)
+		if rpcErr != nil {
+			elem.Err = rpcErr
+			continue
+		}
+		elem.Datapoints = datapoints
+	}
+
+	if err := iter.Err(); err != nil {
+		s.metrics.fetchTagged.ReportError(s.nowFn().Sub(callStart))
+		return nil, convert.ToRPCError(err)
+	}
+
+	s.metrics.fetchTagged.ReportSuccess(s.nowFn().Sub(callStart))
+
+	return result, nil
+}
+
+// readDatapoints decodes the encoded series, consolidating the datapoints
+// into a datapoint per step if a consolidator is given.
+func (s *service) readDatapoints(
+	encoded [][]xio.SegmentReader,
+	consolidator *consolidator,
+	timeType rpc.TimeType,
+) ([]*rpc.Datapoint, *rpc.Error) {
 	// Make datapoints an initialized empty array for JSON serialization as empty array than null
-	result.Datapoints = make([]*rpc.Datapoint, 0)
+	datapoints := make([]*rpc.Datapoint, 0)
 
 	multiIt := s.db.Options().MultiReaderIteratorPool().Get()
 	multiIt.ResetSliceOfSlices(xio.NewReaderSliceOfSlicesFromSegmentReadersIterator(encoded))
 	defer multiIt.Close()
 
-	for multiIt.Next() {
-		dp, _, annotation := multiIt.Current()
+	// NB: Validate the time type upfront so converting timestamps cannot fail
+	if _, err := convert.ToDuration(timeType); err != nil {
+		return nil, tterrors.NewBadRequestError(err)
+	}
 
-		timestamp, timestampErr := convert.ToValue(dp.Timestamp, req.ResultTimeType)
-		if timestampErr != nil {
-			s.metrics.fetch.ReportError(s.nowFn().Sub(callStart))
-			return nil, tterrors.NewBadRequestError(timestampErr)
-		}
+	appendDatapoint := func(dp ts.Datapoint, annotation ts.Annotation) {
+		timestamp, _ := convert.ToValue(dp.Timestamp, timeType)
 
 		datapoint := rpc.NewDatapoint()
 		datapoint.Timestamp = timestamp
 		datapoint.Value = dp.Value
 		datapoint.Annotation = annotation
 
-		result.Datapoints = append(result.Datapoints, datapoint)
+		datapoints = append(datapoints, datapoint)
 	}
 
-	if err := multiIt.Err(); err != nil {
-		s.metrics.fetch.ReportError(s.nowFn().Sub(callStart))
-		return nil, tterrors.NewInternalError(err)
+	if consolidator != nil {
+		if err := consolidator.Consolidate(multiIt, func(dp ts.Datapoint) {
+			appendDatapoint(dp, nil)
+		}); err != nil {
+			return nil, tterrors.NewInternalError(err)
+		}
+		return datapoints, nil
 	}
 
-	s.metrics.fetch.ReportSuccess(s.nowFn().Sub(callStart))
+	for multiIt.Next() {
+		dp, _, annotation := multiIt.Current()
+		appendDatapoint(dp, annotation)
+	}
 
-	return result, nil
-}
+	if err := multiIt.Err(); err != nil {
+		return nil, tterrors.NewInternalError(err)
+	}
 
-func (s *service) FetchTagged(ctx thrift.Context, req *rpc.FetchTaggedRequest) (*rpc.FetchTaggedResult_, error) {
-	return nil, tterrors.NewInternalError(errNotImplemented)
+	return datapoints, nil
 }
 
 func (s *service) FetchBatchRaw(tctx thrift.Context, req *rpc.FetchBatchRawRequest) (*rpc.FetchBatchRawResult_, error) {
@@ -265,7 +412,8 @@ func (s *service) FetchBatchRaw(tctx thrift.Context, req *rpc.FetchBatchRawReque
 		encoded, err := s.db.ReadEncoded(ctx, nsID, tsID, start, end)
 		if err != nil {
 			rawResult.Err = convert.ToRPCError(err)
-			if tterrors.IsBadRequestError(rawResult.Err) {
+			if tterrors.IsBadRequestError(rawResult.Err) ||
+				tterrors.IsQuotaExceededError(rawResult.Err) {
 				nonRetryableErrors++
 			} else {
 				retryableErrors++
@@ -280,7 +428,8 @@ func (s *service) FetchBatchRaw(tctx thrift.Context, req *rpc.FetchBatchRawReque
 			converted, streamErr = convert.ToSegments(readers)
 			if streamErr != nil {
 				rawResult.Err = convert.ToRPCError(err)
-				if tterrors.IsBadRequestError(rawResult.Err) {
+				if tterrors.IsBadRequestError(rawResult.Err) ||
+					tterrors.IsQuotaExceededError(rawResult.Err) {
 					nonRetryableErrors++
 				} else {
 					retryableErrors++
@@ -309,6 +458,155 @@ func (s *service) FetchBatchRaw(tctx thrift.Context, req *rpc.FetchBatchRawReque
 	return result, nil
 }
 
+// FetchBatchRawPaged returns segments for a batch of series one block at a
+// time, returning at most limit blocks per call along with a page token to
+// resume from. The page token only depends on the request and the number of
+// blocks visited so every replica returns the same token for the same request.
+func (s *service) FetchBatchRawPaged(tctx thrift.Context, req *rpc.FetchBatchRawPagedRequest) (*rpc.FetchBatchRawPagedResult_, error) {
+	callStart := s.nowFn()
+	ctx := tchannelthrift.Context(tctx)
+
+	start, rangeStartErr := convert.ToTime(req.RangeStart, req.RangeTimeType)
+	end, rangeEndErr := convert.ToTime(req.RangeEnd, req.RangeTimeType)
+
+	if rangeStartErr != nil || rangeEndErr != nil {
+		s.metrics.fetchBatchRawPaged.ReportNonRetryableErrors(len(req.Ids))
+		s.metrics.fetchBatchRawPaged.ReportLatency(s.nowFn().Sub(callStart))
+		return nil, tterrors.NewBadRequestError(xerrors.FirstError(rangeStartErr, rangeEndErr))
+	}
+
+	if req.Limit <= 0 {
+		s.metrics.fetchBatchRawPaged.ReportNonRetryableErrors(len(req.Ids))
+		s.metrics.fetchBatchRawPaged.ReportLatency(s.nowFn().Sub(callStart))
+		return nil, tterrors.NewBadRequestError(errInvalidPageLimit)
+	}
+
+	token := new(pagetoken.FetchBatchRawPageToken)
+	if req.PageToken != nil {
+		if err := proto.Unmarshal(req.PageToken, token); err != nil {
+			s.metrics.fetchBatchRawPaged.ReportNonRetryableErrors(len(req.Ids))
+			s.metrics.fetchBatchRawPaged.ReportLatency(s.nowFn().Sub(callStart))
+			return nil, tterrors.NewBadRequestError(errInvalidPageToken)
+		}
+	}
+
+	nsID := s.newID(ctx, req.NameSpace)
+	nsMetadata, ok := s.db.Namespace(nsID)
+	if !ok {
+		s.metrics.fetchBatchRawPaged.ReportNonRetryableErrors(len(req.Ids))
+		s.metrics.fetchBatchRawPaged.ReportLatency(s.nowFn().Sub(callStart))
+		return nil, tterrors.NewBadRequestError(fmt.Errorf("unable to find specified namespace: %v", nsID.String()))
+	}
+
+	var (
+		result     = rpc.NewFetchBatchRawPagedResult_()
+		blockSize  = nsMetadata.Options().RetentionOptions().BlockSize()
+		firstBlock = start.Truncate(blockSize)
+		blockStart = firstBlock
+		remaining  = req.Limit
+		idx        = int(token.IdIndex)
+
+		success            int
+		retryableErrors    int
+		nonRetryableErrors int
+	)
+	if req.PageToken != nil {
+		blockStart = time.Unix(0, token.BlockStartUnixNanos)
+	}
+
+	for ; idx < len(req.Ids) && remaining > 0; idx++ {
+		rawResult := rpc.NewFetchRawPagedResult_()
+		rawResult.Index = int64(idx)
+		rawResult.Segments = make([]*rpc.Segments, 0)
+		result.Elements = append(result.Elements, rawResult)
+
+		tsID := s.newID(ctx, req.Ids[idx])
+		for ; blockStart.Before(end) && remaining > 0; blockStart = blockStart.Add(blockSize) {
+			// Blocks are still counted against the limit after an error so the
+			// next page token does not depend on which reads failed.
+			remaining--
+			if rawResult.Err != nil {
+				continue
+			}
+
+			readStart, readEnd := blockStart, blockStart.Add(blockSize)
+			if readStart.Before(start) {
+				readStart = start
+			}
+			if readEnd.After(end) {
+				readEnd = end
+			}
+
+			segments, err := s.readEncodedSegments(ctx, nsID, tsID, readStart, readEnd)
+			if err != nil {
+				rawResult.Err = convert.ToRPCError(err)
+				continue
+			}
+			rawResult.Segments = append(rawResult.Segments, segments...)
+		}
+
+		if rawResult.Err == nil {
+			success++
+		} else if tterrors.IsBadRequestError(rawResult.Err) ||
+			tterrors.IsQuotaExceededError(rawResult.Err) {
+			nonRetryableErrors++
+		} else {
+			retryableErrors++
+		}
+
+		if blockStart.Before(end) {
+			// Ran out of room part way through this series, resume from here.
+			break
+		}
+		blockStart = firstBlock
+	}
+
+	if idx < len(req.Ids) {
+		nextToken, err := proto.Marshal(&pagetoken.FetchBatchRawPageToken{
+			IdIndex:             int64(idx),
+			BlockStartUnixNanos: blockStart.UnixNano(),
+		})
+		if err != nil {
+			s.metrics.fetchBatchRawPaged.ReportNonRetryableErrors(len(result.Elements))
+			s.metrics.fetchBatchRawPaged.ReportLatency(s.nowFn().Sub(callStart))
+			return nil, tterrors.NewInternalError(err)
+		}
+		result.NextPageToken = nextToken
+	}
+
+	s.metrics.fetchBatchRawPaged.ReportSuccess(success)
+	s.metrics.fetchBatchRawPaged.ReportRetryableErrors(retryableErrors)
+	s.metrics.fetchBatchRawPaged.ReportNonRetryableErrors(nonRetryableErrors)
+	s.metrics.fetchBatchRawPaged.ReportLatency(s.nowFn().Sub(callStart))
+
+	return result, nil
+}
+
+func (s *service) readEncodedSegments(
+	ctx context.Context,
+	nsID ident.ID,
+	tsID ident.ID,
+	start, end time.Time,
+) ([]*rpc.Segments, error) {
+	encoded, err := s.db.ReadEncoded(ctx, nsID, tsID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := make([]*rpc.Segments, 0, len(encoded))
+	for _, readers := range encoded {
+		converted, err := convert.ToSegments(readers)
+		if err != nil {
+			return nil, err
+		}
+		if converted.Segments == nil {
+			continue
+		}
+		segments = append(segments, converted.Segments)
+	}
+	return segments, nil
+}
+
 func (s *service) FetchBlocksRaw(tctx thrift.Context, req *rpc.FetchBlocksRawRequest) (*rpc.FetchBlocksRawResult_, error) {
 	if s.isOverloaded() {
 		s.metrics.overloadRejected.Inc(1)
@@ -532,7 +830,8 @@ func (s *service) FetchBlocksMetadataRawV2(tctx thrift.Context, req *rpc.FetchBl
 	// case result contains pooled objects that we need to return.
 	ctx.RegisterFinalizer(s.newCloseableMetadataV2Result(result))
 	if err != nil {
-		return nil, err
+		s.metrics.fetchBlocksMetadata.ReportError(s.nowFn().Sub(callStart))
+		return nil, convert.ToRPCError(err)
 	}
 
 	return result, nil
@@ -545,24 +844,33 @@ func (s *service) getFetchBlocksMetadataRawV2Result(
 ) (*rpc.FetchBlocksMetadataRawV2Result_, error) {
 	result := rpc.NewFetchBlocksMetadataRawV2Result_()
 	result.NextPageToken = nextPageToken
-	result.Elements = s.getBlocksMetadataV2FromResult(opts, results)
-	return result, nil
+	elements, err := s.getBlocksMetadataV2FromResult(opts, results)
+	result.Elements = elements
+	return result, err
 }
 
 func (s *service) getBlocksMetadataV2FromResult(
 	opts block.FetchBlocksMetadataOptions,
 	results block.FetchBlocksMetadataResults,
-) []*rpc.BlockMetadataV2 {
+) ([]*rpc.BlockMetadataV2, error) {
 	blocks := s.blockMetadataV2SlicePool.Get()
 
 	for _, fetchedMetadata := range results.Results() {
 		fetchedMetadataBlocks := fetchedMetadata.Blocks.Results()
 		id := fetchedMetadata.ID.Data().Get()
 
+		// NB: Tags are sent with every block of the series so that peers
+		// can restore them regardless of which blocks they bootstrap.
+		encodedTags, err := serialize.EncodeTags(fetchedMetadata.Tags)
+		if err != nil {
+			return blocks, err
+		}
+
 		for _, fetchedMetadataBlock := range fetchedMetadataBlocks {
 			blockMetadata := s.blockMetadataV2Pool.Get()
 			blockMetadata.ID = id
 			blockMetadata.Start = fetchedMetadataBlock.Start.UnixNano()
+			blockMetadata.EncodedTags = encodedTags
 
 			if opts.IncludeSizes {
 				size := fetchedMetadataBlock.Size
@@ -598,7 +906,7 @@ func (s *service) getBlocksMetadataV2FromResult(
 		}
 	}
 
-	return blocks
+	return blocks, nil
 }
 
 func (s *service) Write(tctx thrift.Context, req *rpc.WriteRequest) error {
@@ -675,6 +983,9 @@ func (s *service) WriteBatchRaw(tctx thrift.Context, req *rpc.WriteBatchRawReque
 		); err != nil && xerrors.IsInvalidParams(err) {
 			nonRetryableErrors++
 			errs = append(errs, tterrors.NewBadRequestWriteBatchRawError(i, err))
+		} else if err != nil && namespace.IsQuotaExceededError(err) {
+			nonRetryableErrors++
+			errs = append(errs, tterrors.NewQuotaExceededWriteBatchRawError(i, err))
 		} else if err != nil {
 			retryableErrors++
 			errs = append(errs, tterrors.NewWriteBatchRawError(i, err))
@@ -855,6 +1166,61 @@ func (s *service) SetWriteNewSeriesLimitPerShardPerSecond(
 	return s.GetWriteNewSeriesLimitPerShardPerSecond(ctx)
 }
 
+func (s *service) GetBootstrapStatus(
+	ctx thrift.Context,
+) (
+	*rpc.NodeBootstrapStatusResult_,
+	error,
+) {
+	statuses := s.db.BootstrapStatus()
+	result := &rpc.NodeBootstrapStatusResult_{
+		Namespaces: make([]*rpc.NamespaceBootstrapStatus, 0, len(statuses)),
+	}
+	for _, nsStatus := range statuses {
+		shards := make([]*rpc.ShardBootstrapStatus, 0, len(nsStatus.Shards))
+		for _, status := range nsStatus.Shards {
+			state, err := toRPCShardBootstrapState(status.State)
+			if err != nil {
+				return nil, convert.ToRPCError(err)
+			}
+			shard := &rpc.ShardBootstrapStatus{
+				Shard:         int32(status.Shard),
+				State:         state,
+				SeriesFetched: status.NumSeries,
+				BytesFetched:  status.NumBytes,
+			}
+			if status.Source != "" {
+				source := status.Source
+				shard.Source = &source
+			}
+			if status.Err != nil {
+				errMsg := status.Err.Error()
+				shard.Error = &errMsg
+			}
+			shards = append(shards, shard)
+		}
+		result.Namespaces = append(result.Namespaces, &rpc.NamespaceBootstrapStatus{
+			NameSpace: nsStatus.Namespace.String(),
+			Shards:    shards,
+		})
+	}
+	return result, nil
+}
+
+func toRPCShardBootstrapState(state storage.ShardBootstrapState) (rpc.ShardBootstrapState, error) {
+	switch state {
+	case storage.ShardBootstrapPending:
+		return rpc.ShardBootstrapState_PENDING, nil
+	case storage.ShardBootstrapping:
+		return rpc.ShardBootstrapState_BOOTSTRAPPING, nil
+	case storage.ShardBootstrapped:
+		return rpc.ShardBootstrapState_BOOTSTRAPPED, nil
+	case storage.ShardBootstrapFailed:
+		return rpc.ShardBootstrapState_FAILED, nil
+	}
+	return 0, errUnknownShardBootstrapState
+}
+
 func (s *service) isOverloaded() bool {
 	// NB(xichen): for now we only use the database load to determine
 	// whether the server is overloaded. In the future we may also take
@@ -21,18 +21,23 @@
 package node
 
 import (
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/m3db/m3db/digest"
 	"github.com/m3db/m3db/generated/thrift/rpc"
 	"github.com/m3db/m3db/network/server/tchannelthrift"
+	tterrors "github.com/m3db/m3db/network/server/tchannelthrift/errors"
+	"github.com/m3db/m3db/retention"
 	"github.com/m3db/m3db/runtime"
 	"github.com/m3db/m3db/storage"
 	"github.com/m3db/m3db/storage/block"
+	"github.com/m3db/m3db/storage/index"
 	"github.com/m3db/m3db/storage/namespace"
 	"github.com/m3db/m3db/ts"
 	"github.com/m3db/m3db/x/xio"
+	"github.com/m3db/m3ninx/index/segment"
 	"github.com/m3db/m3x/ident"
 	xtime "github.com/m3db/m3x/time"
 
@@ -140,6 +145,202 @@ func TestServiceFetch(t *testing.T) {
 	}
 }
 
+func TestServiceFetchAggregated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testServiceOpts).AnyTimes()
+
+	service := NewService(mockDB, nil).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	start := time.Now().Add(-2 * time.Hour).Truncate(time.Minute)
+	end := start.Add(2 * time.Hour)
+
+	enc := testServiceOpts.EncoderPool().Get()
+	enc.Reset(start, 0)
+
+	nsID := "metrics"
+
+	values := []struct {
+		t time.Time
+		v float64
+	}{
+		{start.Add(10 * time.Second), 1.0},
+		{start.Add(20 * time.Second), 5.0},
+		{start.Add(70 * time.Second), 2.0},
+		{start.Add(200 * time.Second), 4.0},
+		{start.Add(230 * time.Second), 6.0},
+	}
+	for _, v := range values {
+		dp := ts.Datapoint{
+			Timestamp: v.t,
+			Value:     v.v,
+		}
+		require.NoError(t, enc.Encode(dp, xtime.Second, nil))
+	}
+
+	mockDB.EXPECT().
+		ReadEncoded(ctx, ident.NewIDMatcher(nsID), ident.NewIDMatcher("foo"), start, end).
+		Return([][]xio.SegmentReader{
+			[]xio.SegmentReader{enc.Stream()},
+		}, nil)
+
+	step := int64(60)
+	r, err := service.Fetch(tctx, &rpc.FetchRequest{
+		RangeStart:     start.Unix(),
+		RangeEnd:       end.Unix(),
+		RangeType:      rpc.TimeType_UNIX_SECONDS,
+		NameSpace:      nsID,
+		ID:             "foo",
+		ResultTimeType: rpc.TimeType_UNIX_SECONDS,
+		Step:           &step,
+		Aggregation:    rpc.AggregationType_MEAN,
+	})
+	require.NoError(t, err)
+
+	expected := []struct {
+		t time.Time
+		v float64
+	}{
+		{start, 3.0},
+		{start.Add(time.Minute), 2.0},
+		{start.Add(3 * time.Minute), 5.0},
+	}
+	require.Equal(t, len(expected), len(r.Datapoints))
+	for i, v := range expected {
+		assert.Equal(t, v.t, time.Unix(r.Datapoints[i].Timestamp, 0))
+		assert.Equal(t, v.v, r.Datapoints[i].Value)
+	}
+}
+
+func TestServiceFetchAggregatedInvalidStep(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testServiceOpts).AnyTimes()
+
+	service := NewService(mockDB, nil).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	start := time.Now().Add(-2 * time.Hour).Truncate(time.Second)
+	end := start.Add(2 * time.Hour)
+
+	step := int64(0)
+	_, err := service.Fetch(tctx, &rpc.FetchRequest{
+		RangeStart: start.Unix(),
+		RangeEnd:   end.Unix(),
+		RangeType:  rpc.TimeType_UNIX_SECONDS,
+		NameSpace:  "metrics",
+		ID:         "foo",
+		Step:       &step,
+	})
+	require.Error(t, err)
+
+	rpcErr, ok := err.(*rpc.Error)
+	require.True(t, ok)
+	assert.Equal(t, rpc.ErrorType_BAD_REQUEST, rpcErr.Type)
+}
+
+func TestServiceFetchTagged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testServiceOpts).AnyTimes()
+
+	service := NewService(mockDB, nil).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	start := time.Now().Add(-2 * time.Hour).Truncate(time.Minute)
+	end := start.Add(2 * time.Hour)
+
+	nsID := "metrics"
+
+	streams := map[string]xio.SegmentReader{}
+	for _, id := range []string{"foo", "bar"} {
+		enc := testServiceOpts.EncoderPool().Get()
+		enc.Reset(start, 0)
+		for _, dp := range []ts.Datapoint{
+			{Timestamp: start.Add(10 * time.Second), Value: 1.0},
+			{Timestamp: start.Add(20 * time.Second), Value: 3.0},
+		} {
+			require.NoError(t, enc.Encode(dp, xtime.Second, nil))
+		}
+		streams[id] = enc.Stream()
+	}
+
+	results := index.NewResults(ident.StringID(nsID))
+	results.Add(ident.StringID("foo"), ident.Tags{
+		{Name: ident.StringID("city"), Value: ident.StringID("nyc")},
+	})
+	results.Add(ident.StringID("bar"), ident.Tags{
+		{Name: ident.StringID("city"), Value: ident.StringID("nyc")},
+	})
+
+	mockDB.EXPECT().QueryIDs(ctx, index.Query{segment.Query{
+		Conjunction: segment.AndConjunction,
+		Filters: []segment.Filter{
+			{FieldName: []byte("city"), FieldValueFilter: []byte("nyc")},
+		},
+	}}, index.QueryOptions{
+		StartInclusive: start,
+		EndExclusive:   end,
+		Limit:          10,
+	}).Return(index.QueryResults{
+		Iter:       index.NewMultiTaggedIDsIter(results),
+		Exhaustive: true,
+	}, nil)
+	for id, stream := range streams {
+		mockDB.EXPECT().
+			ReadEncoded(ctx, ident.NewIDMatcher(nsID), ident.NewIDMatcher(id), start, end).
+			Return([][]xio.SegmentReader{
+				[]xio.SegmentReader{stream},
+			}, nil)
+	}
+
+	limit := int64(10)
+	step := int64(60)
+	r, err := service.FetchTagged(tctx, &rpc.FetchTaggedRequest{
+		Query: &rpc.IdxQuery{
+			Operator: rpc.BooleanOperator_AND_OPERATOR,
+			Filters: []*rpc.IdxTagFilter{
+				{TagName: "city", TagValueFilter: "nyc"},
+			},
+		},
+		RangeStart:    start.Unix(),
+		RangeEnd:      end.Unix(),
+		RangeTimeType: rpc.TimeType_UNIX_SECONDS,
+		FetchData:     true,
+		Limit:         &limit,
+		Step:          &step,
+		Aggregation:   rpc.AggregationType_SUM,
+	})
+	require.NoError(t, err)
+	require.True(t, r.Exhaustive)
+
+	require.Equal(t, 2, len(r.Elements))
+	for _, elem := range r.Elements {
+		require.Nil(t, elem.Err)
+		assert.Equal(t, nsID, elem.NameSpace)
+		assert.Equal(t, []*rpc.TagString{{Name: "city", Value: "nyc"}}, elem.Tags)
+		require.Equal(t, 1, len(elem.Datapoints))
+		assert.Equal(t, start, time.Unix(elem.Datapoints[0].Timestamp, 0))
+		assert.Equal(t, 4.0, elem.Datapoints[0].Value)
+	}
+}
+
 func TestServiceFetchBatchRaw(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -232,6 +433,118 @@ func TestServiceFetchBatchRaw(t *testing.T) {
 	}
 }
 
+func TestServiceFetchBatchRawPaged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	nsID := "metrics"
+	nsOpts := namespace.NewOptions().
+		SetRetentionOptions(retention.NewOptions().SetBlockSize(time.Hour))
+	mockNs := storage.NewMockNamespace(ctrl)
+	mockNs.EXPECT().Options().Return(nsOpts).AnyTimes()
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Namespace(ident.NewIDMatcher(nsID)).Return(mockNs, true).AnyTimes()
+	mockDB.EXPECT().Options().Return(testServiceOpts).AnyTimes()
+
+	service := NewService(mockDB, nil).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	// Range spans three one hour blocks, the first and last only partially.
+	blockStart := time.Now().Add(-4 * time.Hour).Truncate(time.Hour)
+	start := blockStart.Add(30 * time.Minute)
+	end := start.Add(2 * time.Hour)
+
+	enc := testServiceOpts.EncoderPool().Get()
+	enc.Reset(start, 0)
+	dp := ts.Datapoint{Timestamp: start.Add(time.Second), Value: 1.0}
+	require.NoError(t, enc.Encode(dp, xtime.Second, nil))
+
+	gomock.InOrder(
+		mockDB.EXPECT().
+			ReadEncoded(ctx, ident.NewIDMatcher(nsID), ident.NewIDMatcher("foo"), start, blockStart.Add(time.Hour)).
+			Return([][]xio.SegmentReader{
+				[]xio.SegmentReader{enc.Stream()},
+			}, nil),
+		mockDB.EXPECT().
+			ReadEncoded(ctx, ident.NewIDMatcher(nsID), ident.NewIDMatcher("foo"), blockStart.Add(time.Hour), blockStart.Add(2*time.Hour)).
+			Return(nil, nil),
+		mockDB.EXPECT().
+			ReadEncoded(ctx, ident.NewIDMatcher(nsID), ident.NewIDMatcher("foo"), blockStart.Add(2*time.Hour), end).
+			Return(nil, nil),
+		mockDB.EXPECT().
+			ReadEncoded(ctx, ident.NewIDMatcher(nsID), ident.NewIDMatcher("bar"), start, blockStart.Add(time.Hour)).
+			Return(nil, errors.New("an error")),
+	)
+
+	ids := [][]byte{[]byte("foo"), []byte("bar")}
+	req := &rpc.FetchBatchRawPagedRequest{
+		RangeStart:    start.Unix(),
+		RangeEnd:      end.Unix(),
+		RangeTimeType: rpc.TimeType_UNIX_SECONDS,
+		NameSpace:     []byte(nsID),
+		Ids:           ids,
+		Limit:         5,
+	}
+	r, err := service.FetchBatchRawPaged(tctx, req)
+	require.NoError(t, err)
+	require.NotNil(t, r.NextPageToken)
+
+	require.Equal(t, 2, len(r.Elements))
+	assert.Equal(t, int64(0), r.Elements[0].Index)
+	assert.Nil(t, r.Elements[0].Err)
+	require.Equal(t, 1, len(r.Elements[0].Segments))
+	require.NotNil(t, r.Elements[0].Segments[0].Merged)
+	assert.Equal(t, int64(1), r.Elements[1].Index)
+	assert.NotNil(t, r.Elements[1].Err)
+	assert.Equal(t, 0, len(r.Elements[1].Segments))
+
+	// The error on the first block of bar skips reading its second block but
+	// both are still counted, so the next page resumes at the third block.
+	mockDB.EXPECT().
+		ReadEncoded(ctx, ident.NewIDMatcher(nsID), ident.NewIDMatcher("bar"), blockStart.Add(2*time.Hour), end).
+		Return(nil, nil)
+
+	req.PageToken = r.NextPageToken
+	r, err = service.FetchBatchRawPaged(tctx, req)
+	require.NoError(t, err)
+	assert.Nil(t, r.NextPageToken)
+
+	require.Equal(t, 1, len(r.Elements))
+	assert.Equal(t, int64(1), r.Elements[0].Index)
+	assert.Nil(t, r.Elements[0].Err)
+	assert.Equal(t, 0, len(r.Elements[0].Segments))
+}
+
+func TestServiceFetchBatchRawPagedInvalidPageToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testServiceOpts).AnyTimes()
+
+	service := NewService(mockDB, nil).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	start := time.Now().Add(-2 * time.Hour).Truncate(time.Second)
+	_, err := service.FetchBatchRawPaged(tctx, &rpc.FetchBatchRawPagedRequest{
+		RangeStart:    start.Unix(),
+		RangeEnd:      start.Add(time.Hour).Unix(),
+		RangeTimeType: rpc.TimeType_UNIX_SECONDS,
+		NameSpace:     []byte("metrics"),
+		Ids:           [][]byte{[]byte("foo")},
+		Limit:         1,
+		PageToken:     []byte{0xff},
+	})
+	require.Error(t, err)
+	assert.True(t, tterrors.IsBadRequestError(err.(*rpc.Error)))
+}
+
 func TestServiceFetchBlocksRaw(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -647,6 +960,47 @@ func TestServiceWriteBatchRaw(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestServiceWriteBatchRawQuotaExceeded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testServiceOpts).AnyTimes()
+
+	service := NewService(mockDB, nil).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	nsID := "metrics"
+	id := "foo"
+	at := time.Now().Truncate(time.Second)
+	quotaErr := namespace.NewQuotaExceededError(errors.New("quota exceeded"))
+	mockDB.EXPECT().
+		Write(ctx, ident.NewIDMatcher(nsID), ident.NewIDMatcher(id), at, 1.0, xtime.Second, nil).
+		Return(quotaErr)
+
+	err := service.WriteBatchRaw(tctx, &rpc.WriteBatchRawRequest{
+		NameSpace: []byte(nsID),
+		Elements: []*rpc.WriteBatchRawRequestElement{
+			{
+				ID: []byte(id),
+				Datapoint: &rpc.Datapoint{
+					Timestamp:         at.Unix(),
+					TimestampTimeType: rpc.TimeType_UNIX_SECONDS,
+					Value:             1.0,
+				},
+			},
+		},
+	})
+	require.Error(t, err)
+	batchErrs, ok := err.(*rpc.WriteBatchRawErrors)
+	require.True(t, ok)
+	require.Len(t, batchErrs.Errors, 1)
+	require.Equal(t, rpc.ErrorType_QUOTA_EXCEEDED, batchErrs.Errors[0].Err.Type)
+}
+
 func TestServiceRepair(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -819,3 +1173,56 @@ func TestServiceSetWriteNewSeriesLimitPerShardPerSecond(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, int64(84), setResp.WriteNewSeriesLimitPerShardPerSecond)
 }
+
+func TestServiceGetBootstrapStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testServiceOpts).AnyTimes()
+	mockDB.EXPECT().BootstrapStatus().Return([]storage.NamespaceBootstrapStatus{
+		{
+			Namespace: ident.StringID("foo"),
+			Shards: []storage.ShardBootstrapStatus{
+				{Shard: 0, State: storage.ShardBootstrapped, Source: "filesystem", NumSeries: 2, NumBytes: 100},
+				{Shard: 1, State: storage.ShardBootstrapping, Source: "peers", NumSeries: 1, NumBytes: 50},
+				{Shard: 2, State: storage.ShardBootstrapFailed, Source: "peers", Err: errors.New("an error")},
+				{Shard: 3, State: storage.ShardBootstrapPending},
+			},
+		},
+	})
+
+	service := NewService(mockDB, nil).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	result, err := service.GetBootstrapStatus(tctx)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, len(result.Namespaces))
+	assert.Equal(t, "foo", result.Namespaces[0].NameSpace)
+
+	shards := result.Namespaces[0].Shards
+	require.Equal(t, 4, len(shards))
+
+	assert.Equal(t, int32(0), shards[0].Shard)
+	assert.Equal(t, rpc.ShardBootstrapState_BOOTSTRAPPED, shards[0].State)
+	assert.Equal(t, "filesystem", shards[0].GetSource())
+	assert.Equal(t, int64(2), shards[0].SeriesFetched)
+	assert.Equal(t, int64(100), shards[0].BytesFetched)
+	assert.False(t, shards[0].IsSetError())
+
+	assert.Equal(t, rpc.ShardBootstrapState_BOOTSTRAPPING, shards[1].State)
+	assert.Equal(t, "peers", shards[1].GetSource())
+	assert.Equal(t, int64(1), shards[1].SeriesFetched)
+	assert.Equal(t, int64(50), shards[1].BytesFetched)
+
+	assert.Equal(t, rpc.ShardBootstrapState_FAILED, shards[2].State)
+	assert.Equal(t, "an error", shards[2].GetError())
+
+	assert.Equal(t, rpc.ShardBootstrapState_PENDING, shards[3].State)
+	assert.False(t, shards[3].IsSetSource())
+	assert.False(t, shards[3].IsSetError())
+}
@@ -0,0 +1,461 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m3db/m3db/persist/fs"
+	"github.com/m3db/m3x/ident"
+	xlog "github.com/m3db/m3x/log"
+	xtime "github.com/m3db/m3x/time"
+)
+
+const (
+	manifestKeySuffix = "manifest.json"
+	keySeparator      = "/"
+
+	// maxFilesetBackupAttempts is the number of times the latest volume of a
+	// fileset is resolved when its files are removed while being uploaded.
+	maxFilesetBackupAttempts = 3
+)
+
+var (
+	errInvalidBackupID = errors.New("backup ID must be non-empty and not contain a slash")
+	errBackupExists    = errors.New("backup already exists")
+)
+
+type manager struct {
+	fsOpts fs.Options
+	store  BlobStore
+	log    xlog.Logger
+}
+
+// NewManager creates a new backup manager.
+func NewManager(opts Options) (Manager, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	fsOpts := opts.FilesystemOptions()
+	return &manager{
+		fsOpts: fsOpts,
+		store:  opts.BlobStore(),
+		log:    fsOpts.InstrumentOptions().Logger(),
+	}, nil
+}
+
+func (m *manager) Backup(backupID string, namespaces []ident.ID) (Manifest, error) {
+	if err := validateBackupID(backupID); err != nil {
+		return Manifest{}, err
+	}
+	existing, err := m.store.List(manifestKey(backupID))
+	if err != nil {
+		return Manifest{}, err
+	}
+	if len(existing) > 0 {
+		return Manifest{}, errBackupExists
+	}
+
+	manifest := Manifest{
+		ID:        backupID,
+		CreatedAt: m.fsOpts.ClockOptions().NowFn()(),
+	}
+	for _, namespace := range namespaces {
+		entries, err := m.backupNamespace(backupID, namespace)
+		if err != nil {
+			return Manifest{}, err
+		}
+		manifest.Entries = append(manifest.Entries, entries...)
+	}
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return Manifest{}, err
+	}
+	if err := m.store.Put(manifestKey(backupID), bytes.NewReader(data)); err != nil {
+		return Manifest{}, err
+	}
+
+	m.log.WithFields(
+		xlog.NewField("backupID", backupID),
+		xlog.NewField("entries", len(manifest.Entries)),
+	).Info("backup complete")
+	return manifest, nil
+}
+
+func (m *manager) backupNamespace(
+	backupID string,
+	namespace ident.ID,
+) ([]ManifestEntry, error) {
+	var (
+		prefix       = m.fsOpts.FilePathPrefix()
+		bufferSize   = m.fsOpts.InfoReaderBufferSize()
+		decodingOpts = m.fsOpts.DecodingOptions()
+		entries      []ManifestEntry
+	)
+
+	shards, err := shardsInDir(fs.NamespaceDirPath(prefix, namespace))
+	if err != nil {
+		return nil, err
+	}
+	for _, shard := range shards {
+		infos := fs.ReadInfoFiles(prefix, namespace, shard, bufferSize, decodingOpts)
+		for _, info := range infos {
+			blockStart := xtime.FromNanoseconds(info.Start)
+			entry, ok, err := m.backupLatestFiles(backupID, ManifestEntry{
+				Type:       DataFileSetType,
+				Namespace:  namespace.String(),
+				Shard:      shard,
+				BlockStart: blockStart,
+				BlockSize:  time.Duration(info.BlockSize),
+			}, func() []string {
+				return fs.LatestFilesetVolumeFiles(prefix, namespace, shard, blockStart)
+			})
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				entries = append(entries, entry)
+			}
+		}
+	}
+
+	shards, err = shardsInDir(fs.NamespaceSnapshotsDirPath(prefix, namespace))
+	if err != nil {
+		return nil, err
+	}
+	for _, shard := range shards {
+		infos := fs.ReadSnapshotInfoFiles(prefix, namespace, shard, bufferSize, decodingOpts)
+		for _, info := range infos {
+			blockStart := xtime.FromNanoseconds(info.Start)
+			entry, ok, err := m.backupLatestFiles(backupID, ManifestEntry{
+				Type:       SnapshotFileSetType,
+				Namespace:  namespace.String(),
+				Shard:      shard,
+				BlockStart: blockStart,
+				BlockSize:  time.Duration(info.BlockSize),
+			}, func() []string {
+				return fs.LatestSnapshotVolumeFiles(prefix, namespace, shard, blockStart)
+			})
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				entries = append(entries, entry)
+			}
+		}
+	}
+
+	infos := fs.ReadIndexInfoFiles(prefix, namespace, bufferSize, decodingOpts)
+	for _, info := range infos {
+		blockStart := xtime.FromNanoseconds(info.BlockStart)
+		entry, ok, err := m.backupLatestFiles(backupID, ManifestEntry{
+			Type:       IndexFileSetType,
+			Namespace:  namespace.String(),
+			BlockStart: blockStart,
+			BlockSize:  time.Duration(info.BlockSize),
+		}, func() []string {
+			return fs.IndexFilesetFiles(prefix, namespace, blockStart)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
+
+// backupLatestFiles uploads the files of the latest volume of a fileset
+// returned by latestFiles. Volumes are removed by compaction and cleanup
+// while the node keeps running, so if the files are removed while being
+// uploaded the latest volume is resolved again. Returns false if the fileset
+// no longer exists.
+func (m *manager) backupLatestFiles(
+	backupID string,
+	entry ManifestEntry,
+	latestFiles func() []string,
+) (ManifestEntry, bool, error) {
+	for attempt := 0; attempt < maxFilesetBackupAttempts; attempt++ {
+		files := latestFiles()
+		if len(files) == 0 {
+			// Removed by cleanup since it was listed
+			return ManifestEntry{}, false, nil
+		}
+		backedUp, err := m.backupFiles(backupID, entry, files)
+		if err == nil {
+			return backedUp, true, nil
+		}
+		if !os.IsNotExist(err) {
+			return ManifestEntry{}, false, err
+		}
+	}
+
+	m.log.WithFields(
+		xlog.NewField("backupID", backupID),
+		xlog.NewField("namespace", entry.Namespace),
+		xlog.NewField("shard", entry.Shard),
+		xlog.NewField("blockStart", entry.BlockStart),
+	).Warn("skipping fileset removed while backing up")
+	return ManifestEntry{}, false, nil
+}
+
+// backupFiles uploads the files of a fileset and returns the entry with
+// the files set, filesets are immutable once complete so they can be
+// uploaded while the node keeps running.
+func (m *manager) backupFiles(
+	backupID string,
+	entry ManifestEntry,
+	filePaths []string,
+) (ManifestEntry, error) {
+	prefix := m.fsOpts.FilePathPrefix()
+	for _, filePath := range filePaths {
+		rel, err := filepath.Rel(prefix, filePath)
+		if err != nil {
+			return ManifestEntry{}, err
+		}
+		rel = filepath.ToSlash(rel)
+
+		fd, err := os.Open(filePath)
+		if err != nil {
+			return ManifestEntry{}, err
+		}
+		err = m.store.Put(fileKey(backupID, rel), fd)
+		fd.Close()
+		if err != nil {
+			return ManifestEntry{}, err
+		}
+		entry.Files = append(entry.Files, rel)
+	}
+	entry.BackupID = backupID
+	return entry, nil
+}
+
+func (m *manager) Backups() ([]string, error) {
+	keys, err := m.store.List("")
+	if err != nil {
+		return nil, err
+	}
+	var backupIDs []string
+	for _, key := range keys {
+		components := strings.Split(key, keySeparator)
+		if len(components) == 2 && components[1] == manifestKeySuffix {
+			backupIDs = append(backupIDs, components[0])
+		}
+	}
+	return backupIDs, nil
+}
+
+func (m *manager) Manifest(backupID string) (Manifest, error) {
+	if err := validateBackupID(backupID); err != nil {
+		return Manifest{}, err
+	}
+	r, err := m.store.Get(manifestKey(backupID))
+	if err != nil {
+		return Manifest{}, err
+	}
+	defer r.Close()
+
+	var manifest Manifest
+	if err := json.NewDecoder(r).Decode(&manifest); err != nil {
+		return Manifest{}, err
+	}
+	for i := range manifest.Entries {
+		manifest.Entries[i].BackupID = backupID
+	}
+	return manifest, nil
+}
+
+func (m *manager) LatestManifest() (Manifest, error) {
+	backupIDs, err := m.Backups()
+	if err != nil {
+		return Manifest{}, err
+	}
+	manifests := make([]Manifest, 0, len(backupIDs))
+	for _, backupID := range backupIDs {
+		manifest, err := m.Manifest(backupID)
+		if err != nil {
+			return Manifest{}, err
+		}
+		manifests = append(manifests, manifest)
+	}
+	return mergeManifests(manifests), nil
+}
+
+type manifestEntryKey struct {
+	fileSetType FileSetType
+	namespace   string
+	shard       uint32
+	blockStart  xtime.UnixNano
+}
+
+// mergeManifests merges manifests keeping the entry of the most recently
+// created manifest for each fileset, the merged manifest has no ID.
+func mergeManifests(manifests []Manifest) Manifest {
+	sort.SliceStable(manifests, func(i, j int) bool {
+		return manifests[i].CreatedAt.Before(manifests[j].CreatedAt)
+	})
+
+	var (
+		merged  Manifest
+		indexes = make(map[manifestEntryKey]int)
+	)
+	for _, manifest := range manifests {
+		merged.CreatedAt = manifest.CreatedAt
+		for _, entry := range manifest.Entries {
+			key := manifestEntryKey{
+				fileSetType: entry.Type,
+				namespace:   entry.Namespace,
+				shard:       entry.Shard,
+				blockStart:  xtime.ToUnixNano(entry.BlockStart),
+			}
+			if idx, ok := indexes[key]; ok {
+				merged.Entries[idx] = entry
+				continue
+			}
+			indexes[key] = len(merged.Entries)
+			merged.Entries = append(merged.Entries, entry)
+		}
+	}
+	return merged
+}
+
+func (m *manager) Restore(entry ManifestEntry) error {
+	if err := validateBackupID(entry.BackupID); err != nil {
+		return err
+	}
+
+	var (
+		prefix     = m.fsOpts.FilePathPrefix()
+		files      = make([]string, 0, len(entry.Files))
+		checkpoint string
+	)
+	for _, rel := range entry.Files {
+		if fs.IsCheckpointFile(rel) {
+			checkpoint = rel
+			continue
+		}
+		files = append(files, rel)
+	}
+	if checkpoint == "" {
+		return fmt.Errorf("%s fileset of namespace %s shard %d at %s has no checkpoint file",
+			entry.Type, entry.Namespace, entry.Shard, entry.BlockStart.String())
+	}
+	if fs.FileExists(localFilePath(prefix, checkpoint)) {
+		// Already complete locally
+		return nil
+	}
+
+	// Restore the checkpoint file last so that a partially restored
+	// fileset is never considered complete
+	files = append(files, checkpoint)
+	for _, rel := range files {
+		if err := m.restoreFile(entry.BackupID, rel); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *manager) restoreFile(backupID string, rel string) error {
+	filePath := localFilePath(m.fsOpts.FilePathPrefix(), rel)
+	if err := os.MkdirAll(filepath.Dir(filePath), m.fsOpts.NewDirectoryMode()); err != nil {
+		return err
+	}
+
+	r, err := m.store.Get(fileKey(backupID, rel))
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	fd, err := fs.OpenWritable(filePath, m.fsOpts.NewFileMode())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(fd, r); err != nil {
+		fd.Close()
+		return err
+	}
+	if err := fd.Sync(); err != nil {
+		fd.Close()
+		return err
+	}
+	return fd.Close()
+}
+
+func validateBackupID(backupID string) error {
+	if backupID == "" || strings.Contains(backupID, keySeparator) {
+		return errInvalidBackupID
+	}
+	return nil
+}
+
+func manifestKey(backupID string) string {
+	return path.Join(backupID, manifestKeySuffix)
+}
+
+func fileKey(backupID string, rel string) string {
+	return path.Join(backupID, rel)
+}
+
+func localFilePath(prefix string, rel string) string {
+	return filepath.Join(prefix, filepath.FromSlash(rel))
+}
+
+// shardsInDir returns the shards that have a directory in the namespace
+// directory in ascending order.
+func shardsInDir(dir string) ([]uint32, error) {
+	fileInfos, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var shards []uint32
+	for _, fileInfo := range fileInfos {
+		if !fileInfo.IsDir() {
+			continue
+		}
+		shard, err := strconv.ParseUint(fileInfo.Name(), 10, 32)
+		if err != nil {
+			continue
+		}
+		shards = append(shards, uint32(shard))
+	}
+	sort.Slice(shards, func(i, j int) bool { return shards[i] < shards[j] })
+	return shards, nil
+}
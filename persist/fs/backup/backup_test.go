@@ -0,0 +1,288 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3db/persist/fs"
+	"github.com/m3db/m3x/checked"
+	"github.com/m3db/m3x/clock"
+	"github.com/m3db/m3x/ident"
+	"github.com/m3db/m3x/pool"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testBlockSize = 2 * time.Hour
+)
+
+var (
+	testNamespace  = ident.StringID("testns")
+	testBlockStart = time.Now().Truncate(testBlockSize).Add(-testBlockSize)
+	testTags       = ident.Tags{{Name: ident.StringID("city"), Value: ident.StringID("nyc")}}
+)
+
+func newTestBytesPool() pool.CheckedBytesPool {
+	bytesPool := pool.NewCheckedBytesPool([]pool.Bucket{pool.Bucket{
+		Capacity: 1024,
+		Count:    10,
+	}}, nil, func(s []pool.Bucket) pool.BytesPool {
+		return pool.NewBytesPool(s, nil)
+	})
+	bytesPool.Init()
+	return bytesPool
+}
+
+func newTestManager(t *testing.T, filePathPrefix, backupDir string) Manager {
+	m, err := NewManager(NewOptions().
+		SetFilesystemOptions(fs.NewOptions().SetFilePathPrefix(filePathPrefix)).
+		SetBlobStore(NewLocalBlobStore(backupDir)))
+	require.NoError(t, err)
+	return m
+}
+
+func writeTestFileset(t *testing.T, filePathPrefix string, shard uint32) {
+	w, err := fs.NewWriter(fs.NewOptions().SetFilePathPrefix(filePathPrefix))
+	require.NoError(t, err)
+	require.NoError(t, w.Open(testNamespace, testBlockSize, shard, testBlockStart))
+	for i := 0; i < 10; i++ {
+		data := checked.NewBytes([]byte(fmt.Sprintf("data-%d-%d", shard, i)), nil)
+		data.IncRef()
+		require.NoError(t, w.Write(ident.StringID(fmt.Sprintf("foo.%d", i)), testTags, data, uint32(i)))
+		data.DecRef()
+	}
+	require.NoError(t, w.Close())
+}
+
+func writeTestIndexFileset(t *testing.T, filePathPrefix string) {
+	w, err := fs.NewIndexWriter(fs.NewOptions().SetFilePathPrefix(filePathPrefix))
+	require.NoError(t, err)
+	require.NoError(t, w.Open(testNamespace, testBlockSize, testBlockStart))
+	require.NoError(t, w.Write(ident.StringID("foo.0"), testTags))
+	require.NoError(t, w.Close())
+}
+
+func TestManagerBackupAndRestore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		srcPrefix  = filepath.Join(dir, "src")
+		destPrefix = filepath.Join(dir, "dest")
+		backupDir  = filepath.Join(dir, "backups")
+		shards     = []uint32{1, 3}
+	)
+	for _, shard := range shards {
+		writeTestFileset(t, srcPrefix, shard)
+	}
+	writeTestIndexFileset(t, srcPrefix)
+
+	src := newTestManager(t, srcPrefix, backupDir)
+	manifest, err := src.Backup("first", []ident.ID{testNamespace})
+	require.NoError(t, err)
+	require.Equal(t, "first", manifest.ID)
+	require.Len(t, manifest.Entries, 3)
+
+	_, err = src.Backup("first", []ident.ID{testNamespace})
+	require.Equal(t, errBackupExists, err)
+
+	backupIDs, err := src.Backups()
+	require.NoError(t, err)
+	require.Equal(t, []string{"first"}, backupIDs)
+
+	// Restore from the manifest as read back from the blob store
+	dest := newTestManager(t, destPrefix, backupDir)
+	restored, err := dest.Manifest("first")
+	require.NoError(t, err)
+	require.Equal(t, len(manifest.Entries), len(restored.Entries))
+
+	for _, shard := range shards {
+		entry, ok := restored.Entry(DataFileSetType, testNamespace, shard, testBlockStart)
+		require.True(t, ok)
+		require.Equal(t, testBlockSize, entry.BlockSize)
+		require.Equal(t, testBlockStart.Add(testBlockSize), entry.Range().End)
+		require.NoError(t, dest.Restore(entry))
+		require.True(t, fs.FilesetExistsAt(destPrefix, testNamespace, shard, testBlockStart))
+		requireFilesetEqual(t, srcPrefix, destPrefix, shard)
+	}
+	_, ok := restored.Entry(DataFileSetType, testNamespace, 2, testBlockStart)
+	require.False(t, ok)
+
+	indexEntries := restored.ShardEntries(IndexFileSetType, testNamespace, 0)
+	require.Len(t, indexEntries, 1)
+	require.NoError(t, dest.Restore(indexEntries[0]))
+	require.True(t, fs.IndexFilesetExistsAt(destPrefix, testNamespace, testBlockStart))
+
+	// Restoring a fileset that is already complete locally is a no-op
+	entry, ok := restored.Entry(DataFileSetType, testNamespace, shards[0], testBlockStart)
+	require.True(t, ok)
+	require.NoError(t, os.RemoveAll(backupDir))
+	require.NoError(t, dest.Restore(entry))
+}
+
+func TestManagerLatestManifest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		srcPrefix = filepath.Join(dir, "src")
+		backupDir = filepath.Join(dir, "backups")
+		now       = time.Now()
+		nowFn     = func() time.Time { return now }
+	)
+	src, err := NewManager(NewOptions().
+		SetFilesystemOptions(fs.NewOptions().
+			SetFilePathPrefix(srcPrefix).
+			SetClockOptions(clock.NewOptions().SetNowFn(nowFn))).
+		SetBlobStore(NewLocalBlobStore(backupDir)))
+	require.NoError(t, err)
+
+	// The later backup sorts first by ID but is created last
+	writeTestFileset(t, srcPrefix, 1)
+	_, err = src.Backup("b", []ident.ID{testNamespace})
+	require.NoError(t, err)
+
+	now = now.Add(time.Minute)
+	writeTestFileset(t, srcPrefix, 2)
+	_, err = src.Backup("a", []ident.ID{testNamespace})
+	require.NoError(t, err)
+
+	latest, err := src.LatestManifest()
+	require.NoError(t, err)
+	require.Equal(t, "", latest.ID)
+	require.True(t, now.Equal(latest.CreatedAt))
+	require.Len(t, latest.Entries, 2)
+
+	for _, shard := range []uint32{1, 2} {
+		entry, ok := latest.Entry(DataFileSetType, testNamespace, shard, testBlockStart)
+		require.True(t, ok)
+		require.Equal(t, "a", entry.BackupID)
+	}
+}
+
+type onPutBlobStore struct {
+	BlobStore
+	onPut func(key string)
+}
+
+func (s *onPutBlobStore) Put(key string, r io.Reader) error {
+	if s.onPut != nil {
+		s.onPut(key)
+	}
+	return s.BlobStore.Put(key, r)
+}
+
+func TestManagerBackupFilesetCompactedWhileBackingUp(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		srcPrefix  = filepath.Join(dir, "src")
+		destPrefix = filepath.Join(dir, "dest")
+		backupDir  = filepath.Join(dir, "backups")
+		shard      = uint32(1)
+	)
+	writeTestFileset(t, srcPrefix, shard)
+
+	// Supersede and remove the volume being uploaded once its first file
+	// has been opened, as compaction would
+	store := &onPutBlobStore{BlobStore: NewLocalBlobStore(backupDir)}
+	store.onPut = func(string) {
+		store.onPut = nil
+		writeTestFileset(t, srcPrefix, shard)
+		require.NoError(t, fs.DeleteFilesetVolume(srcPrefix, testNamespace, shard, testBlockStart, 0))
+	}
+	src, err := NewManager(NewOptions().
+		SetFilesystemOptions(fs.NewOptions().SetFilePathPrefix(srcPrefix)).
+		SetBlobStore(store))
+	require.NoError(t, err)
+
+	manifest, err := src.Backup("first", []ident.ID{testNamespace})
+	require.NoError(t, err)
+	require.Len(t, manifest.Entries, 1)
+
+	dest := newTestManager(t, destPrefix, backupDir)
+	require.NoError(t, dest.Restore(manifest.Entries[0]))
+	requireFilesetEqual(t, srcPrefix, destPrefix, shard)
+}
+
+func TestManagerRestoreMissingBackup(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	m := newTestManager(t, filepath.Join(dir, "data"), filepath.Join(dir, "backups"))
+	_, err = m.Manifest("missing")
+	require.Equal(t, ErrBlobNotFound, err)
+
+	require.Equal(t, errInvalidBackupID, m.Restore(ManifestEntry{}))
+
+	_, err = m.Backup("invalid/id", nil)
+	require.Equal(t, errInvalidBackupID, err)
+}
+
+func TestNewManagerRequiresBlobStore(t *testing.T) {
+	_, err := NewManager(NewOptions())
+	require.Equal(t, errBlobStoreNotSet, err)
+}
+
+func requireFilesetEqual(t *testing.T, srcPrefix, destPrefix string, shard uint32) {
+	bytesPool := newTestBytesPool()
+	readers := make([]fs.FileSetReader, 0, 2)
+	for _, prefix := range []string{srcPrefix, destPrefix} {
+		r, err := fs.NewReader(bytesPool, fs.NewOptions().SetFilePathPrefix(prefix))
+		require.NoError(t, err)
+		require.NoError(t, r.Open(testNamespace, shard, testBlockStart))
+		defer r.Close()
+		readers = append(readers, r)
+	}
+
+	for {
+		id1, tags1, data1, checksum1, err1 := readers[0].Read()
+		id2, tags2, data2, checksum2, err2 := readers[1].Read()
+		if err1 == io.EOF {
+			require.Equal(t, io.EOF, err2)
+			break
+		}
+		require.NoError(t, err1)
+		require.NoError(t, err2)
+		require.Equal(t, id1.String(), id2.String())
+		require.Equal(t, len(tags1), len(tags2))
+		data1.IncRef()
+		data2.IncRef()
+		require.Equal(t, data1.Get(), data2.Get())
+		data1.DecRef()
+		data2.DecRef()
+		require.Equal(t, checksum1, checksum2)
+	}
+	require.NoError(t, readers[1].Validate())
+}
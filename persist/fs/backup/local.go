@@ -0,0 +1,143 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	localBlobTmpSuffix = ".tmp"
+	localBlobFilePerm  = 0666
+	localBlobDirPerm   = 0755
+)
+
+var (
+	// ErrBlobNotFound is returned when no blob is stored under a key.
+	ErrBlobNotFound = errors.New("blob not found")
+)
+
+type localBlobStore struct {
+	dir string
+}
+
+// NewLocalBlobStore creates a new blob store that keeps blobs as files in
+// a local directory, mostly useful for testing and for backing up to a
+// mounted network file system.
+func NewLocalBlobStore(dir string) BlobStore {
+	return &localBlobStore{dir: dir}
+}
+
+func (s *localBlobStore) Put(key string, r io.Reader) error {
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), localBlobDirPerm); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that a partially written
+	// blob is never visible under the key
+	tmpFilePath := filePath + localBlobTmpSuffix
+	fd, err := os.OpenFile(tmpFilePath,
+		os.O_WRONLY|os.O_CREATE|os.O_TRUNC, localBlobFilePerm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(fd, r); err != nil {
+		fd.Close()
+		os.Remove(tmpFilePath)
+		return err
+	}
+	if err := fd.Sync(); err != nil {
+		fd.Close()
+		os.Remove(tmpFilePath)
+		return err
+	}
+	if err := fd.Close(); err != nil {
+		os.Remove(tmpFilePath)
+		return err
+	}
+	return os.Rename(tmpFilePath, filePath)
+}
+
+func (s *localBlobStore) Get(key string) (io.ReadCloser, error) {
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return nil, err
+	}
+	fd, err := os.Open(filePath)
+	if os.IsNotExist(err) {
+		return nil, ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return fd, nil
+}
+
+func (s *localBlobStore) List(prefix string) ([]string, error) {
+	if _, err := os.Stat(s.dir); os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	var keys []string
+	err := filepath.Walk(s.dir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || strings.HasSuffix(filePath, localBlobTmpSuffix) {
+			return nil
+		}
+		rel, err := filepath.Rel(s.dir, filePath)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// filePath returns the path of the file for a key, keys must be clean
+// relative paths so that blobs are always kept within the directory.
+func (s *localBlobStore) filePath(key string) (string, error) {
+	if key == "" || path.IsAbs(key) || path.Clean(key) != key ||
+		strings.HasPrefix(key, "../") || key == ".." {
+		return "", fmt.Errorf("invalid blob key: %s", key)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
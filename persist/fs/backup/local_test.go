@@ -0,0 +1,86 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalBlobStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "blobs")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store := NewLocalBlobStore(filepath.Join(dir, "store"))
+
+	keys, err := store.List("")
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	_, err = store.Get("a/b")
+	require.Equal(t, ErrBlobNotFound, err)
+
+	for _, key := range []string{"a/b", "a/c/d", "b"} {
+		require.NoError(t, store.Put(key, bytes.NewReader([]byte(key))))
+	}
+	require.NoError(t, store.Put("b", bytes.NewReader([]byte("replaced"))))
+
+	r, err := store.Get("a/c/d")
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, "a/c/d", string(data))
+
+	r, err = store.Get("b")
+	require.NoError(t, err)
+	data, err = ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, "replaced", string(data))
+
+	keys, err = store.List("")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a/b", "a/c/d", "b"}, keys)
+
+	keys, err = store.List("a/")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a/b", "a/c/d"}, keys)
+}
+
+func TestLocalBlobStoreInvalidKeys(t *testing.T) {
+	dir, err := ioutil.TempDir("", "blobs")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store := NewLocalBlobStore(dir)
+	for _, key := range []string{"", "/a", "../a", "a/../../b", "a//b"} {
+		require.Error(t, store.Put(key, bytes.NewReader(nil)), key)
+		_, err := store.Get(key)
+		require.Error(t, err, key)
+	}
+}
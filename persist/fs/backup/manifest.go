@@ -0,0 +1,124 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"time"
+
+	"github.com/m3db/m3x/ident"
+	xtime "github.com/m3db/m3x/time"
+)
+
+// FileSetType is the type of fileset a manifest entry holds.
+type FileSetType string
+
+const (
+	// DataFileSetType is a flushed data fileset of a shard.
+	DataFileSetType FileSetType = "data"
+
+	// SnapshotFileSetType is a snapshot fileset of a shard.
+	SnapshotFileSetType FileSetType = "snapshot"
+
+	// IndexFileSetType is a reverse index fileset of a namespace.
+	IndexFileSetType FileSetType = "index"
+)
+
+// Manifest describes the filesets held by a backup.
+type Manifest struct {
+	// ID is the ID of the backup.
+	ID string `json:"id"`
+
+	// CreatedAt is when the backup was started.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Entries are the filesets held by the backup.
+	Entries []ManifestEntry `json:"entries"`
+}
+
+// ManifestEntry describes a single fileset held by a backup, keyed by
+// namespace, shard and block start.
+type ManifestEntry struct {
+	// Type is the type of the fileset.
+	Type FileSetType `json:"type"`
+
+	// Namespace is the namespace of the fileset.
+	Namespace string `json:"namespace"`
+
+	// Shard is the shard of the fileset, it is unused by reverse index
+	// filesets as they hold the series of all shards of a namespace.
+	Shard uint32 `json:"shard"`
+
+	// BlockStart is the block start of the fileset.
+	BlockStart time.Time `json:"blockStart"`
+
+	// BlockSize is the block size of the fileset.
+	BlockSize time.Duration `json:"blockSize"`
+
+	// Files are the paths of the fileset files relative to the file
+	// path prefix, using slash separators.
+	Files []string `json:"files"`
+
+	// BackupID is the ID of the backup holding the fileset, it is set
+	// when the manifest is read rather than stored with each entry.
+	BackupID string `json:"-"`
+}
+
+// Range returns the time range covered by the fileset.
+func (e ManifestEntry) Range() xtime.Range {
+	return xtime.Range{Start: e.BlockStart, End: e.BlockStart.Add(e.BlockSize)}
+}
+
+// Entry returns the entry of a fileset type for a namespace, shard and
+// block start, the shard is ignored for reverse index filesets.
+func (m Manifest) Entry(
+	fileSetType FileSetType,
+	namespace ident.ID,
+	shard uint32,
+	blockStart time.Time,
+) (ManifestEntry, bool) {
+	for _, entry := range m.ShardEntries(fileSetType, namespace, shard) {
+		if entry.BlockStart.Equal(blockStart) {
+			return entry, true
+		}
+	}
+	return ManifestEntry{}, false
+}
+
+// ShardEntries returns the entries of a fileset type for a namespace and
+// shard in the order they were backed up, the shard is ignored for reverse
+// index filesets.
+func (m Manifest) ShardEntries(
+	fileSetType FileSetType,
+	namespace ident.ID,
+	shard uint32,
+) []ManifestEntry {
+	var entries []ManifestEntry
+	for _, entry := range m.Entries {
+		if entry.Type != fileSetType || entry.Namespace != namespace.String() {
+			continue
+		}
+		if fileSetType != IndexFileSetType && entry.Shard != shard {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
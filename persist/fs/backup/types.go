@@ -0,0 +1,87 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"io"
+
+	"github.com/m3db/m3db/persist/fs"
+	"github.com/m3db/m3x/ident"
+)
+
+// BlobStore is a store of blobs addressed by slash separated keys, such
+// as a bucket of an object store.
+type BlobStore interface {
+	// Put stores the contents of the reader under the key, replacing any
+	// blob already stored under the key.
+	Put(key string, r io.Reader) error
+
+	// Get returns a reader of the blob stored under the key, it returns
+	// ErrBlobNotFound if no blob is stored under the key.
+	Get(key string) (io.ReadCloser, error)
+
+	// List returns the keys of all blobs with the prefix in ascending order.
+	List(prefix string) ([]string, error)
+}
+
+// Manager backs up filesets to a blob store and restores them from it.
+type Manager interface {
+	// Backup uploads the complete data filesets, snapshots and reverse index
+	// filesets of the namespaces as a new backup, the manifest is uploaded
+	// last so that a backup is only visible once all its files are uploaded.
+	Backup(backupID string, namespaces []ident.ID) (Manifest, error)
+
+	// Backups returns the IDs of all complete backups in ascending order.
+	Backups() ([]string, error)
+
+	// Manifest returns the manifest of a complete backup.
+	Manifest(backupID string) (Manifest, error)
+
+	// LatestManifest returns a manifest merging all complete backups, for
+	// each fileset type, namespace, shard and block start it holds the
+	// entry of the most recently created backup holding such a fileset.
+	LatestManifest() (Manifest, error)
+
+	// Restore downloads the files of a manifest entry from the backup
+	// holding it to the file path prefix, an entry that is already complete
+	// locally is left untouched.
+	Restore(entry ManifestEntry) error
+}
+
+// Options represents the options for backing up and restoring filesets.
+type Options interface {
+	// Validate validates the options.
+	Validate() error
+
+	// SetFilesystemOptions sets the filesystem options, the file path
+	// prefix is where filesets are backed up from and restored to.
+	SetFilesystemOptions(value fs.Options) Options
+
+	// FilesystemOptions returns the filesystem options, the file path
+	// prefix is where filesets are backed up from and restored to.
+	FilesystemOptions() fs.Options
+
+	// SetBlobStore sets the blob store backups are kept in.
+	SetBlobStore(value BlobStore) Options
+
+	// BlobStore returns the blob store backups are kept in.
+	BlobStore() BlobStore
+}
@@ -26,7 +26,8 @@ func (c *cloner) Clone(src FilesetID, dest FilesetID, destBlocksize time.Duratio
 		SetInfoReaderBufferSize(c.opts.BufferSize()).
 		SetWriterBufferSize(c.opts.BufferSize()).
 		SetNewFileMode(c.opts.FileMode()).
-		SetNewDirectoryMode(c.opts.DirMode())
+		SetNewDirectoryMode(c.opts.DirMode()).
+		SetBlockCodec(c.opts.BlockCodec())
 	reader, err := fs.NewReader(nil, fsopts.SetFilePathPrefix(src.PathPrefix))
 	if err != nil {
 		return fmt.Errorf("unable to create fileset reader: %v", err)
@@ -44,7 +45,7 @@ func (c *cloner) Clone(src FilesetID, dest FilesetID, destBlocksize time.Duratio
 	}
 
 	for {
-		id, data, checksum, err := reader.Read()
+		id, tags, data, checksum, err := reader.Read()
 		if err != nil {
 			if err == io.EOF {
 				break
@@ -53,7 +54,7 @@ func (c *cloner) Clone(src FilesetID, dest FilesetID, destBlocksize time.Duratio
 		}
 
 		data.IncRef()
-		if err := writer.Write(id, data, checksum); err != nil {
+		if err := writer.Write(id, tags, data, checksum); err != nil {
 			return fmt.Errorf("unexpected error while writing data: %v", err)
 		}
 		data.DecRef()
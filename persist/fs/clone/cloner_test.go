@@ -74,14 +74,15 @@ func TestCloner(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, r2.Open(ident.StringID(dest.Namespace), dest.Shard, dest.Blockstart))
 	for {
-		t1, b1, c1, e1 := r1.Read()
-		t2, b2, c2, e2 := r2.Read()
+		t1, g1, b1, c1, e1 := r1.Read()
+		t2, g2, b2, c2, e2 := r2.Read()
 		if e1 == e2 && e1 == io.EOF {
 			break
 		}
 		b1.IncRef()
 		b2.IncRef()
 		require.Equal(t, t1.String(), t2.String())
+		require.Equal(t, g1, g2)
 		require.Equal(t, b1.Get(), b2.Get())
 		require.Equal(t, c1, c2)
 		b1.DecRef()
@@ -101,8 +102,9 @@ func writeTestData(t *testing.T, bs time.Duration, src FilesetID, opts Options)
 	require.NoError(t, w.Open(ident.StringID(src.Namespace), bs, src.Shard, src.Blockstart))
 	for i := 0; i < numTestSeries; i++ {
 		id := ident.StringID(fmt.Sprintf("testSeries.%d", i))
+		tags := ident.Tags{{Name: ident.StringID("series"), Value: ident.StringID(fmt.Sprintf("%d", i))}}
 		for j := 0; j < numTestPoints; j++ {
-			require.NoError(t, w.Write(id, testBytes, 1234))
+			require.NoError(t, w.Write(id, tags, testBytes, 1234))
 		}
 	}
 	require.NoError(t, w.Close())
@@ -3,6 +3,7 @@ package clone
 import (
 	"os"
 
+	"github.com/m3db/m3db/persist/fs"
 	"github.com/m3db/m3db/persist/fs/msgpack"
 	"github.com/m3db/m3x/pool"
 )
@@ -19,6 +20,7 @@ type opts struct {
 	bufferSize int
 	fileMode   os.FileMode
 	dirMode    os.FileMode
+	blockCodec fs.BlockCodec
 }
 
 // NewOptions returns the new options
@@ -29,6 +31,7 @@ func NewOptions() Options {
 		bufferSize: defaultBufferSize,
 		fileMode:   defaultFileMode,
 		dirMode:    defaultDirMode,
+		blockCodec: fs.NewOptions().BlockCodec(),
 	}
 }
 
@@ -76,3 +79,12 @@ func (o *opts) SetDirMode(d os.FileMode) Options {
 func (o *opts) DirMode() os.FileMode {
 	return o.dirMode
 }
+
+func (o *opts) SetBlockCodec(c fs.BlockCodec) Options {
+	o.blockCodec = c
+	return o
+}
+
+func (o *opts) BlockCodec() fs.BlockCodec {
+	return o.blockCodec
+}
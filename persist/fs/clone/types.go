@@ -4,6 +4,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/m3db/m3db/persist/fs"
 	"github.com/m3db/m3db/persist/fs/msgpack"
 	"github.com/m3db/m3x/pool"
 )
@@ -54,4 +55,12 @@ type Options interface {
 
 	// DirMode returns the file mode used for dir creation
 	DirMode() os.FileMode
+
+	// SetBlockCodec sets the codec used to decode the source data blocks
+	// and encode the destination data blocks
+	SetBlockCodec(fs.BlockCodec) Options
+
+	// BlockCodec returns the codec used to decode the source data blocks
+	// and encode the destination data blocks
+	BlockCodec() fs.BlockCodec
 }
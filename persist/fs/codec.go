@@ -0,0 +1,281 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/m3db/m3db/persist/schema"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+var (
+	errEncryptionKeyRequired  = errors.New("encryption key required to encrypt or decrypt fileset data")
+	errEncryptionKeyMismatch  = errors.New("encryption key does not match key fileset data was encrypted with")
+	errEncryptedBlockTooShort = errors.New("encrypted block is too short")
+	errInvalidBlockLength     = errors.New("invalid encoded block length prefix")
+)
+
+// The zstd encoder and decoder are safe for concurrent use when encoding and
+// decoding whole blocks so they are shared by all codecs, they can only fail
+// to be created with invalid options.
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// CompressionType is the compression applied to blocks of fileset data files.
+type CompressionType int
+
+// List of compression types, the values are recorded in fileset info files
+// so must never be reordered.
+const (
+	CompressionNone CompressionType = iota
+	CompressionSnappy
+	CompressionZstd
+)
+
+var validCompressionTypes = []CompressionType{
+	CompressionNone,
+	CompressionSnappy,
+	CompressionZstd,
+}
+
+func (t CompressionType) String() string {
+	switch t {
+	case CompressionNone:
+		return "none"
+	case CompressionSnappy:
+		return "snappy"
+	case CompressionZstd:
+		return "zstd"
+	}
+	return "unknown"
+}
+
+// ParseCompressionType parses a compression type from its string value.
+func ParseCompressionType(str string) (CompressionType, error) {
+	for _, valid := range validCompressionTypes {
+		if valid.String() == str {
+			return valid, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown compression type %q, valid types are: %v",
+		str, validCompressionTypes)
+}
+
+// EncryptionType is the encryption applied to blocks of fileset data files.
+type EncryptionType int
+
+// List of encryption types, the values are recorded in fileset info files
+// so must never be reordered.
+const (
+	EncryptionNone EncryptionType = iota
+	EncryptionAESGCM
+)
+
+func (t EncryptionType) String() string {
+	switch t {
+	case EncryptionNone:
+		return "none"
+	case EncryptionAESGCM:
+		return "aes-gcm"
+	}
+	return "unknown"
+}
+
+type blockCodec struct {
+	info      schema.IndexCodecInfo
+	aead      cipher.AEAD
+	keyDigest int64
+}
+
+func newNoopBlockCodec() BlockCodec {
+	return &blockCodec{}
+}
+
+// NewBlockCodec returns a block codec that compresses and then encrypts
+// blocks with the given types. The encryption key, if provided, is also used
+// to decrypt blocks of filesets encrypted with the same key so the key must
+// be set to read encrypted filesets even when writing unencrypted filesets.
+func NewBlockCodec(
+	compression CompressionType,
+	encryption EncryptionType,
+	encryptionKey []byte,
+) (BlockCodec, error) {
+	switch compression {
+	case CompressionNone, CompressionSnappy, CompressionZstd:
+	default:
+		return nil, fmt.Errorf("unknown compression type: %d", compression)
+	}
+
+	c := &blockCodec{
+		info: schema.IndexCodecInfo{
+			Compression: int64(compression),
+			Encryption:  int64(encryption),
+		},
+	}
+	if len(encryptionKey) > 0 {
+		block, err := aes.NewCipher(encryptionKey)
+		if err != nil {
+			return nil, err
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+		c.aead = aead
+		c.keyDigest = encryptionKeyDigest(encryptionKey)
+	}
+
+	switch encryption {
+	case EncryptionNone:
+	case EncryptionAESGCM:
+		if c.aead == nil {
+			return nil, errEncryptionKeyRequired
+		}
+		c.info.EncryptionKeyDigest = c.keyDigest
+	default:
+		return nil, fmt.Errorf("unknown encryption type: %d", encryption)
+	}
+
+	return c, nil
+}
+
+// ReadEncryptionKeyFile reads a hex encoded AES-128, AES-192 or AES-256 key
+// from a key file.
+func ReadEncryptionKeyFile(filePath string) ([]byte, error) {
+	contents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	key, err := hex.DecodeString(strings.TrimSpace(string(contents)))
+	if err != nil {
+		return nil, fmt.Errorf("could not decode key file %s: %v", filePath, err)
+	}
+	switch len(key) {
+	case 16, 24, 32:
+	default:
+		return nil, fmt.Errorf("invalid key length in key file %s: %d bytes", filePath, len(key))
+	}
+	return key, nil
+}
+
+// encryptionKeyDigest returns a digest identifying the key to record with
+// encrypted filesets so decrypting with the wrong key fails early.
+func encryptionKeyDigest(key []byte) int64 {
+	sum := sha256.Sum256(key)
+	return int64(binary.BigEndian.Uint64(sum[:8]))
+}
+
+func (c *blockCodec) Info() schema.IndexCodecInfo {
+	return c.info
+}
+
+func (c *blockCodec) Encode(block []byte) ([]byte, error) {
+	switch CompressionType(c.info.Compression) {
+	case CompressionSnappy:
+		block = snappy.Encode(nil, block)
+	case CompressionZstd:
+		block = zstdEncoder.EncodeAll(block, nil)
+	}
+
+	if EncryptionType(c.info.Encryption) == EncryptionAESGCM {
+		nonce := make([]byte, c.aead.NonceSize(), c.aead.NonceSize()+len(block)+c.aead.Overhead())
+		if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+			return nil, err
+		}
+		block = c.aead.Seal(nonce, nonce, block, nil)
+	}
+
+	return block, nil
+}
+
+func (c *blockCodec) Decode(info schema.IndexCodecInfo, block []byte) ([]byte, error) {
+	switch EncryptionType(info.Encryption) {
+	case EncryptionNone:
+	case EncryptionAESGCM:
+		if c.aead == nil {
+			return nil, errEncryptionKeyRequired
+		}
+		if info.EncryptionKeyDigest != c.keyDigest {
+			return nil, errEncryptionKeyMismatch
+		}
+		nonceSize := c.aead.NonceSize()
+		if len(block) < nonceSize {
+			return nil, errEncryptedBlockTooShort
+		}
+		decrypted, err := c.aead.Open(nil, block[:nonceSize], block[nonceSize:], nil)
+		if err != nil {
+			return nil, err
+		}
+		block = decrypted
+	default:
+		return nil, fmt.Errorf("unknown encryption type: %d", info.Encryption)
+	}
+
+	switch CompressionType(info.Compression) {
+	case CompressionNone:
+		return block, nil
+	case CompressionSnappy:
+		return snappy.Decode(nil, block)
+	case CompressionZstd:
+		return zstdDecoder.DecodeAll(block, nil)
+	}
+	return nil, fmt.Errorf("unknown compression type: %d", info.Compression)
+}
+
+// blockCodecInfoEncodes returns whether blocks written with the codec
+// described by the codec info are encoded at all.
+func blockCodecInfoEncodes(info schema.IndexCodecInfo) bool {
+	return info.Compression != int64(CompressionNone) ||
+		info.Encryption != int64(EncryptionNone)
+}
+
+// encodedBlock returns the encoded block at the offset of the data file along
+// with the length of the block including its length prefix.
+func encodedBlock(data []byte, offset int64) ([]byte, int, error) {
+	if offset < 0 || offset >= int64(len(data)) {
+		return nil, 0, errInvalidDataFileOffset
+	}
+	data = data[offset:]
+	length, n := binary.Uvarint(data)
+	if n <= 0 {
+		return nil, 0, errInvalidBlockLength
+	}
+	if uint64(len(data)-n) < length {
+		return nil, 0, errNotEnoughBytes
+	}
+	total := n + int(length)
+	return data[n:total], total, nil
+}
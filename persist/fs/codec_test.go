@@ -0,0 +1,268 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/m3db/m3db/digest"
+	"github.com/m3db/m3db/persist/schema"
+	"github.com/m3db/m3x/ident"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testEncryptionKey      = bytes.Repeat([]byte{0x2a}, 32)
+	testOtherEncryptionKey = bytes.Repeat([]byte{0x2b}, 32)
+)
+
+type testBlockCodecConfig struct {
+	compression CompressionType
+	encryption  EncryptionType
+}
+
+func testBlockCodecConfigs() []testBlockCodecConfig {
+	var configs []testBlockCodecConfig
+	for _, compression := range validCompressionTypes {
+		for _, encryption := range []EncryptionType{EncryptionNone, EncryptionAESGCM} {
+			configs = append(configs, testBlockCodecConfig{
+				compression: compression,
+				encryption:  encryption,
+			})
+		}
+	}
+	return configs
+}
+
+func newTestBlockCodec(
+	t *testing.T,
+	compression CompressionType,
+	encryption EncryptionType,
+) BlockCodec {
+	codec, err := NewBlockCodec(compression, encryption, testEncryptionKey)
+	require.NoError(t, err)
+	return codec
+}
+
+func TestBlockCodecRoundTrip(t *testing.T) {
+	block := bytes.Repeat([]byte("some block data "), 64)
+	for _, cfg := range testBlockCodecConfigs() {
+		codec := newTestBlockCodec(t, cfg.compression, cfg.encryption)
+		assert.Equal(t, int64(cfg.compression), codec.Info().Compression)
+		assert.Equal(t, int64(cfg.encryption), codec.Info().Encryption)
+
+		encoded, err := codec.Encode(block)
+		require.NoError(t, err)
+		if cfg.encryption == EncryptionAESGCM {
+			assert.False(t, bytes.Contains(encoded, []byte("some block data")))
+		}
+
+		decoded, err := codec.Decode(codec.Info(), encoded)
+		require.NoError(t, err)
+		assert.Equal(t, block, decoded)
+	}
+}
+
+func TestBlockCodecDecodesBlocksOfOtherCodecs(t *testing.T) {
+	block := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	reader := newTestBlockCodec(t, CompressionNone, EncryptionNone)
+	for _, cfg := range testBlockCodecConfigs() {
+		writer := newTestBlockCodec(t, cfg.compression, cfg.encryption)
+		encoded, err := writer.Encode(block)
+		require.NoError(t, err)
+
+		decoded, err := reader.Decode(writer.Info(), encoded)
+		require.NoError(t, err)
+		assert.Equal(t, block, decoded)
+	}
+}
+
+func TestBlockCodecEncryptionKeyErrors(t *testing.T) {
+	_, err := NewBlockCodec(CompressionNone, EncryptionAESGCM, nil)
+	assert.Equal(t, errEncryptionKeyRequired, err)
+
+	_, err = NewBlockCodec(CompressionNone, EncryptionAESGCM, []byte{1, 2, 3})
+	assert.Error(t, err)
+
+	writer := newTestBlockCodec(t, CompressionSnappy, EncryptionAESGCM)
+	encoded, err := writer.Encode([]byte{1, 2, 3})
+	require.NoError(t, err)
+
+	noKey, err := NewBlockCodec(CompressionNone, EncryptionNone, nil)
+	require.NoError(t, err)
+	_, err = noKey.Decode(writer.Info(), encoded)
+	assert.Equal(t, errEncryptionKeyRequired, err)
+
+	otherKey, err := NewBlockCodec(CompressionNone, EncryptionNone, testOtherEncryptionKey)
+	require.NoError(t, err)
+	_, err = otherKey.Decode(writer.Info(), encoded)
+	assert.Equal(t, errEncryptionKeyMismatch, err)
+
+	// Tampering with the block must fail authentication
+	encoded[len(encoded)-1] ^= 0xff
+	_, err = writer.Decode(writer.Info(), encoded)
+	assert.Error(t, err)
+}
+
+func TestBlockCodecUnknownTypes(t *testing.T) {
+	_, err := NewBlockCodec(CompressionType(99), EncryptionNone, nil)
+	assert.Error(t, err)
+
+	_, err = NewBlockCodec(CompressionNone, EncryptionType(99), testEncryptionKey)
+	assert.Error(t, err)
+
+	codec := newTestBlockCodec(t, CompressionNone, EncryptionNone)
+	_, err = codec.Decode(schema.IndexCodecInfo{Compression: 99}, []byte{1})
+	assert.Error(t, err)
+}
+
+func TestParseCompressionType(t *testing.T) {
+	for _, valid := range validCompressionTypes {
+		parsed, err := ParseCompressionType(valid.String())
+		require.NoError(t, err)
+		assert.Equal(t, valid, parsed)
+	}
+
+	_, err := ParseCompressionType("lz4")
+	assert.Error(t, err)
+}
+
+func TestReadEncryptionKeyFile(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+
+	filePath := filepath.Join(dir, "key")
+	contents := "2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a\n"
+	require.NoError(t, ioutil.WriteFile(filePath, []byte(contents), 0600))
+
+	key, err := ReadEncryptionKeyFile(filePath)
+	require.NoError(t, err)
+	assert.Equal(t, testEncryptionKey, key)
+
+	require.NoError(t, ioutil.WriteFile(filePath, []byte("2a2a2a"), 0600))
+	_, err = ReadEncryptionKeyFile(filePath)
+	assert.Error(t, err)
+
+	require.NoError(t, ioutil.WriteFile(filePath, []byte("not hex"), 0600))
+	_, err = ReadEncryptionKeyFile(filePath)
+	assert.Error(t, err)
+
+	_, err = ReadEncryptionKeyFile(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
+
+func TestReadWriteWithBlockCodec(t *testing.T) {
+	entries := []testEntry{
+		{"foo", []byte{1, 2, 3}},
+		{"bar", []byte{4, 5, 6}},
+		{"baz", bytes.Repeat([]byte{7}, 65536)},
+		{"cat", make([]byte, 100000)},
+		{"echo", []byte{7, 8, 9}},
+	}
+
+	for _, cfg := range testBlockCodecConfigs() {
+		func() {
+			dir := createTempDir(t)
+			filePathPrefix := filepath.Join(dir, "")
+			defer os.RemoveAll(dir)
+
+			writerCodec := newTestBlockCodec(t, cfg.compression, cfg.encryption)
+			w, err := NewWriter(NewOptions().
+				SetFilePathPrefix(filePathPrefix).
+				SetWriterBufferSize(testWriterBufferSize).
+				SetBlockCodec(writerCodec))
+			require.NoError(t, err)
+			writeTestData(t, w, 0, testWriterStart, entries)
+
+			infoFiles := ReadInfoFiles(filePathPrefix, testNs1ID, 0, 16, nil)
+			require.Equal(t, 1, len(infoFiles))
+			if cfg.compression == CompressionNone && cfg.encryption == EncryptionNone {
+				assert.Equal(t, schema.IndexCodecInfo{}, infoFiles[0].Codec)
+			} else {
+				assert.Equal(t, writerCodec.Info(), infoFiles[0].Codec)
+			}
+
+			// Read back with a codec that encodes differently to verify the
+			// codec is resolved from the info file
+			readerCodec := newTestBlockCodec(t, CompressionZstd, EncryptionNone)
+			r, err := NewReader(testBytesPool, NewOptions().
+				SetFilePathPrefix(filePathPrefix).
+				SetInfoReaderBufferSize(testReaderBufferSize).
+				SetDataReaderBufferSize(testReaderBufferSize).
+				SetBlockCodec(readerCodec))
+			require.NoError(t, err)
+			readTestData(t, r, 0, testWriterStart, entries)
+
+			require.NoError(t, r.Open(testNs1ID, 0, testWriterStart))
+			for range entries {
+				_, _, _, _, err := r.Read()
+				require.NoError(t, err)
+			}
+			assert.NoError(t, r.Validate())
+			require.NoError(t, r.Close())
+
+			s := NewSeeker(filePathPrefix, testReaderBufferSize, testReaderBufferSize,
+				testReaderBufferSize, testBytesPool, false, nil,
+				NewOptions().SetBlockCodec(readerCodec))
+			require.NoError(t, s.Open(testNs1ID, 0, testWriterStart))
+			for _, entry := range entries {
+				data, err := s.SeekByID(ident.StringID(entry.id))
+				require.NoError(t, err)
+				data.IncRef()
+				assert.Equal(t, entry.data, data.Get())
+				assert.Equal(t, digest.Checksum(entry.data), digest.Checksum(data.Get()))
+				data.DecRef()
+			}
+			require.NoError(t, s.Close())
+		}()
+	}
+}
+
+func TestReadEncryptedWithoutKey(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "")
+	defer os.RemoveAll(dir)
+
+	w, err := NewWriter(NewOptions().
+		SetFilePathPrefix(filePathPrefix).
+		SetWriterBufferSize(testWriterBufferSize).
+		SetBlockCodec(newTestBlockCodec(t, CompressionSnappy, EncryptionAESGCM)))
+	require.NoError(t, err)
+	writeTestData(t, w, 0, testWriterStart, []testEntry{{"foo", []byte{1, 2, 3}}})
+
+	r := newTestReader(t, filePathPrefix)
+	require.NoError(t, r.Open(testNs1ID, 0, testWriterStart))
+	_, _, _, _, err = r.Read()
+	assert.Error(t, err)
+	require.NoError(t, r.Close())
+
+	s := newTestSeeker(filePathPrefix)
+	require.NoError(t, s.Open(testNs1ID, 0, testWriterStart))
+	_, err = s.SeekByID(ident.StringID("foo"))
+	assert.Error(t, err)
+	require.NoError(t, s.Close())
+}
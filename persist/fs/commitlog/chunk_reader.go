@@ -22,9 +22,12 @@ package commitlog
 
 import (
 	"bufio"
+	"io"
 	"os"
 
 	"github.com/m3db/m3db/digest"
+	"github.com/m3db/m3db/persist/fs"
+	"github.com/m3db/m3db/persist/schema"
 )
 
 const (
@@ -37,16 +40,22 @@ const (
 )
 
 type chunkReader struct {
-	fd        *os.File
-	buffer    *bufio.Reader
-	remaining int
-	charBuff  []byte
+	fd           *os.File
+	buffer       *bufio.Reader
+	remaining    int
+	charBuff     []byte
+	codec        fs.BlockCodec
+	codecInfo    schema.IndexCodecInfo
+	decodeChunks bool
+	encoded      []byte
+	decoded      []byte
 }
 
-func newChunkReader(bufferLen int) *chunkReader {
+func newChunkReader(bufferLen int, codec fs.BlockCodec) *chunkReader {
 	return &chunkReader{
 		buffer:   bufio.NewReaderSize(nil, bufferLen),
 		charBuff: make([]byte, 1),
+		codec:    codec,
 	}
 }
 
@@ -54,6 +63,15 @@ func (r *chunkReader) reset(fd *os.File) {
 	r.fd = fd
 	r.buffer.Reset(fd)
 	r.remaining = 0
+	r.setCodecInfo(emptyLogCodecInfo)
+}
+
+// setCodecInfo sets the codec info of the chunks that follow, chunks are
+// read as is if the codec info is empty.
+func (r *chunkReader) setCodecInfo(info schema.LogCodecInfo) {
+	r.codecInfo = schema.IndexCodecInfo(info)
+	r.decodeChunks = info != emptyLogCodecInfo
+	r.decoded = nil
 }
 
 func (r *chunkReader) readHeader() error {
@@ -80,6 +98,10 @@ func (r *chunkReader) readHeader() error {
 		return err
 	}
 
+	if r.decodeChunks {
+		return r.readEncodedChunk(int(size), checksumData)
+	}
+
 	// Verify data checksum
 	data, err := r.buffer.Peek(int(size))
 	if err != nil {
@@ -96,6 +118,45 @@ func (r *chunkReader) readHeader() error {
 	return nil
 }
 
+func (r *chunkReader) readEncodedChunk(size int, checksumData uint32) error {
+	// Encoded chunks are read in full rather than peeked since encoding may
+	// grow a chunk beyond the size of the read buffer
+	if cap(r.encoded) < size {
+		r.encoded = make([]byte, size)
+	}
+	r.encoded = r.encoded[:size]
+	if _, err := io.ReadFull(r.buffer, r.encoded); err != nil {
+		return err
+	}
+
+	if digest.Checksum(r.encoded) != checksumData {
+		return errCommitLogReaderChunkSizeChecksumMismatch
+	}
+
+	decoded, err := r.codec.Decode(r.codecInfo, r.encoded)
+	if err != nil {
+		return err
+	}
+
+	// Set decoded data to be consumed
+	r.decoded = decoded
+	r.remaining = len(decoded)
+
+	return nil
+}
+
+func (r *chunkReader) readRemaining(p []byte) (int, error) {
+	if r.decodeChunks {
+		n := copy(p, r.decoded[len(r.decoded)-r.remaining:])
+		r.remaining -= n
+		return n, nil
+	}
+
+	n, err := r.buffer.Read(p)
+	r.remaining -= n
+	return n, err
+}
+
 func (r *chunkReader) Read(p []byte) (int, error) {
 	size := len(p)
 	read := 0
@@ -103,8 +164,7 @@ func (r *chunkReader) Read(p []byte) (int, error) {
 	if r.remaining < size {
 		// Copy any remaining
 		if r.remaining > 0 {
-			n, err := r.buffer.Read(p[:r.remaining])
-			r.remaining -= n
+			n, err := r.readRemaining(p[:r.remaining])
 			read += n
 			if err != nil {
 				return read, err
@@ -125,8 +185,7 @@ func (r *chunkReader) Read(p []byte) (int, error) {
 		return read, err
 	}
 
-	n, err := r.buffer.Read(p)
-	r.remaining -= n
+	n, err := r.readRemaining(p)
 	read += n
 	return read, err
 }
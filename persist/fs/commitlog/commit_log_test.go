@@ -34,6 +34,7 @@ import (
 	"github.com/m3db/bitset"
 	"github.com/m3db/m3db/clock"
 	"github.com/m3db/m3db/persist/fs"
+	"github.com/m3db/m3db/persist/schema"
 	"github.com/m3db/m3db/ts"
 	"github.com/m3db/m3x/context"
 	"github.com/m3db/m3x/ident"
@@ -321,6 +322,7 @@ func assertCommitLogWritesByIterating(t *testing.T, l *commitLog, writes []testW
 		write := seriesWrites.writes[seriesWrites.readPosition]
 
 		write.assert(t, series, datapoint, unit, annotation)
+		assert.False(t, iter.CurrentWrittenAt().IsZero())
 
 		seriesWrites.readPosition++
 		writesBySeries[series.ID.String()] = seriesWrites
@@ -810,3 +812,100 @@ func TestCommitLogFailOnFlushError(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, int64(1), flushErrors.Value())
 }
+
+func TestCommitLogWriteWithChunkCodec(t *testing.T) {
+	key := []byte("0123456789abcdef")
+	tests := []struct {
+		compression fs.CompressionType
+		encryption  fs.EncryptionType
+	}{
+		{fs.CompressionSnappy, fs.EncryptionNone},
+		{fs.CompressionZstd, fs.EncryptionNone},
+		{fs.CompressionNone, fs.EncryptionAESGCM},
+		{fs.CompressionZstd, fs.EncryptionAESGCM},
+	}
+
+	for _, test := range tests {
+		codec, err := fs.NewBlockCodec(test.compression, test.encryption, key)
+		require.NoError(t, err)
+
+		opts, scope := newTestOptions(t, overrides{
+			strategy: StrategyWriteWait,
+		})
+		opts = opts.SetChunkCodec(codec)
+
+		commitLog := newTestCommitLog(t, opts)
+
+		// Write enough to span several chunks
+		var writes []testWrite
+		for i := 0; i < 128; i++ {
+			id := fmt.Sprintf("foo.bar.%d", i%16)
+			annotation := []byte(strings.Repeat("a", 64))
+			writes = append(writes, testWrite{testSeries(uint64(i%16), id, 127), time.Now(), float64(i), xtime.Second, annotation, nil})
+		}
+		writeCommitLogs(t, scope, commitLog, writes).Wait()
+		require.NoError(t, commitLog.Close())
+
+		// Assert the codec is declared by the commit log
+		files, err := fs.CommitLogFiles(fs.CommitLogsDirPath(opts.FilesystemOptions().FilePathPrefix()))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(files))
+		info, err := ReadLogCodecInfo(files[0], opts)
+		require.NoError(t, err)
+		assert.Equal(t, schema.LogCodecInfo(codec.Info()), info)
+
+		// Assert writes are decoded by reading the commit log
+		assertCommitLogWritesByIterating(t, commitLog, writes)
+		assert.Equal(t, len(writes), countCommitLogEntries(t, opts))
+
+		cleanup(t, opts)
+	}
+}
+
+func TestCommitLogReadEncryptedWithoutKey(t *testing.T) {
+	key := []byte("0123456789abcdef")
+	codec, err := fs.NewBlockCodec(fs.CompressionSnappy, fs.EncryptionAESGCM, key)
+	require.NoError(t, err)
+
+	opts, scope := newTestOptions(t, overrides{
+		strategy: StrategyWriteWait,
+	})
+	defer cleanup(t, opts)
+
+	commitLog := newTestCommitLog(t, opts.SetChunkCodec(codec))
+
+	writes := []testWrite{
+		{testSeries(0, "foo.bar", 127), time.Now(), 123.456, xtime.Second, []byte{1, 2, 3}, nil},
+	}
+	writeCommitLogs(t, scope, commitLog, writes).Wait()
+	require.NoError(t, commitLog.Close())
+
+	// Assert the commit log can not be read without the key
+	iter, err := NewIterator(IteratorOpts{
+		CommitLogOptions:      opts,
+		FileFilterPredicate:   ReadAllPredicate(),
+		SeriesFilterPredicate: ReadAllSeriesPredicate(),
+	})
+	require.NoError(t, err)
+	defer iter.Close()
+
+	assert.False(t, iter.Next())
+	assert.Error(t, iter.Err())
+}
+
+func countCommitLogEntries(t *testing.T, opts Options) int {
+	iter, err := NewIterator(IteratorOpts{
+		CommitLogOptions:      opts,
+		FileFilterPredicate:   ReadAllPredicate(),
+		SeriesFilterPredicate: ReadAllSeriesPredicate(),
+	})
+	require.NoError(t, err)
+	defer iter.Close()
+
+	count := 0
+	for iter.Next() {
+		count++
+	}
+	require.NoError(t, iter.Err())
+	return count
+}
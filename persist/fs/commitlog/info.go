@@ -26,10 +26,29 @@ import (
 	"time"
 
 	"github.com/m3db/m3db/persist/fs/msgpack"
+	"github.com/m3db/m3db/persist/schema"
 )
 
 // ReadLogInfo reads the commit log info out of a commitlog file
 func ReadLogInfo(filePath string, opts Options) (time.Time, time.Duration, int64, error) {
+	logInfo, err := readLogInfo(filePath, opts)
+	if err != nil {
+		return time.Time{}, 0, 0, err
+	}
+	return time.Unix(0, logInfo.Start), time.Duration(logInfo.Duration), logInfo.Index, nil
+}
+
+// ReadLogCodecInfo reads the info of the codec used to encode the chunks of
+// a commitlog file, the info is empty if the chunks are written as is
+func ReadLogCodecInfo(filePath string, opts Options) (schema.LogCodecInfo, error) {
+	logInfo, err := readLogInfo(filePath, opts)
+	if err != nil {
+		return emptyLogCodecInfo, err
+	}
+	return logInfo.Codec, nil
+}
+
+func readLogInfo(filePath string, opts Options) (schema.LogInfo, error) {
 	var fd *os.File
 	var err error
 	defer func() {
@@ -40,20 +59,20 @@ func ReadLogInfo(filePath string, opts Options) (time.Time, time.Duration, int64
 
 	fd, err = os.Open(filePath)
 	if err != nil {
-		return time.Time{}, 0, 0, err
+		return emptyLogInfo, err
 	}
 
-	chunkReader := newChunkReader(opts.FlushSize())
+	chunkReader := newChunkReader(opts.FlushSize(), opts.ChunkCodec())
 	chunkReader.reset(fd)
 	size, err := binary.ReadUvarint(chunkReader)
 	if err != nil {
-		return time.Time{}, 0, 0, err
+		return emptyLogInfo, err
 	}
 
 	bytes := make([]byte, size)
 	_, err = chunkReader.Read(bytes)
 	if err != nil {
-		return time.Time{}, 0, 0, err
+		return emptyLogInfo, err
 	}
 	logDecoder := msgpack.NewDecoder(nil)
 	logDecoder.Reset(msgpack.NewDecoderStream(bytes))
@@ -62,8 +81,8 @@ func ReadLogInfo(filePath string, opts Options) (time.Time, time.Duration, int64
 	err = fd.Close()
 	fd = nil
 	if err != nil {
-		return time.Time{}, 0, 0, err
+		return emptyLogInfo, err
 	}
 
-	return time.Unix(0, logInfo.Start), time.Duration(logInfo.Duration), logInfo.Index, decoderErr
+	return logInfo, decoderErr
 }
@@ -63,6 +63,7 @@ type iteratorRead struct {
 	datapoint  ts.Datapoint
 	unit       xtime.Unit
 	annotation []byte
+	writtenAt  time.Time
 }
 
 // ReadAllPredicate can be passed as the ReadCommitLogPredicate for callers
@@ -110,7 +111,7 @@ func (i *iterator) Next() bool {
 		}
 	}
 	var err error
-	i.read.series, i.read.datapoint, i.read.unit, i.read.annotation, err = i.reader.Read()
+	i.read.series, i.read.datapoint, i.read.unit, i.read.annotation, i.read.writtenAt, err = i.reader.Read()
 	if err == io.EOF {
 		closeErr := i.closeAndResetReader()
 		if closeErr != nil {
@@ -142,6 +143,13 @@ func (i *iterator) Current() (Series, ts.Datapoint, xtime.Unit, ts.Annotation) {
 	return read.series, read.datapoint, read.unit, read.annotation
 }
 
+func (i *iterator) CurrentWrittenAt() time.Time {
+	if i.hasError() || i.closed || !i.setRead {
+		return time.Time{}
+	}
+	return i.read.writtenAt
+}
+
 func (i *iterator) Err() error {
 	return i.err
 }
@@ -62,6 +62,7 @@ var (
 	errRetentionPeriodPositive        = errors.New("retention period must be a positive duration")
 	errRetentionGreaterEqualBlockSize = errors.New("retention period must be >= block size")
 	errReadConcurrencyPositive        = errors.New("read concurrency must be a positive integer")
+	errChunkCodecNotSet               = errors.New("chunk codec not set")
 )
 
 type options struct {
@@ -76,16 +77,18 @@ type options struct {
 	backlogQueueSize int
 	bytesPool        pool.CheckedBytesPool
 	readConcurrency  int
+	chunkCodec       fs.BlockCodec
 }
 
 // NewOptions creates new commit log options
 func NewOptions() Options {
+	fsOpts := fs.NewOptions()
 	o := &options{
 		clockOpts:        clock.NewOptions(),
 		instrumentOpts:   instrument.NewOptions(),
 		retentionPeriod:  defaultRetentionPeriod,
 		blockSize:        defaultBlockSize,
-		fsOpts:           fs.NewOptions(),
+		fsOpts:           fsOpts,
 		strategy:         defaultStrategy,
 		flushSize:        defaultFlushSize,
 		flushInterval:    defaultFlushInterval,
@@ -94,6 +97,7 @@ func NewOptions() Options {
 			return pool.NewBytesPool(s, nil)
 		}),
 		readConcurrency: defaultReadConcurrency,
+		chunkCodec:      fsOpts.BlockCodec(),
 	}
 	o.bytesPool.Init()
 	return o
@@ -115,6 +119,9 @@ func (o *options) Validate() error {
 	if o.ReadConcurrency() <= 0 {
 		return errReadConcurrencyPositive
 	}
+	if o.ChunkCodec() == nil {
+		return errChunkCodecNotSet
+	}
 	return nil
 }
 
@@ -227,3 +234,13 @@ func (o *options) SetReadConcurrency(concurrency int) Options {
 func (o *options) ReadConcurrency() int {
 	return o.readConcurrency
 }
+
+func (o *options) SetChunkCodec(value fs.BlockCodec) Options {
+	opts := *o
+	opts.chunkCodec = value
+	return &opts
+}
+
+func (o *options) ChunkCodec() fs.BlockCodec {
+	return o.chunkCodec
+}
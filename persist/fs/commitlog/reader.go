@@ -43,7 +43,8 @@ const decoderInBufChanSize = 1000
 const decoderOutBufChanSize = 1000
 
 var (
-	emptyLogInfo schema.LogInfo
+	emptyLogInfo      schema.LogInfo
+	emptyLogCodecInfo schema.LogCodecInfo
 
 	errCommitLogReaderChunkSizeChecksumMismatch = errors.New("commit log reader encountered chunk size checksum mismatch")
 	errCommitLogReaderIsNotReusable             = errors.New("commit log reader is not reusable")
@@ -67,7 +68,7 @@ type commitLogReader interface {
 	Open(filePath string) (time.Time, time.Duration, int, error)
 
 	// Read returns the next id and data pair or error, will return io.EOF at end of volume
-	Read() (Series, ts.Datapoint, xtime.Unit, ts.Annotation, error)
+	Read() (Series, ts.Datapoint, xtime.Unit, ts.Annotation, time.Time, error)
 
 	// Close the reader
 	Close() error
@@ -78,6 +79,7 @@ type readResponse struct {
 	datapoint  ts.Datapoint
 	unit       xtime.Unit
 	annotation ts.Annotation
+	writtenAt  time.Time
 	resultErr  error
 }
 
@@ -137,7 +139,7 @@ func newCommitLogReader(opts Options, seriesPredicate SeriesFilterPredicate) com
 		opts:              opts,
 		numConc:           int64(numConc),
 		checkedBytesPool:  opts.BytesPool(),
-		chunkReader:       newChunkReader(opts.FlushSize()),
+		chunkReader:       newChunkReader(opts.FlushSize(), opts.ChunkCodec()),
 		infoDecoder:       msgpack.NewDecoder(decodingOpts),
 		infoDecoderStream: msgpack.NewDecoderStream(nil),
 		decoderQueues:     decoderQueues,
@@ -171,6 +173,10 @@ func (r *reader) Open(filePath string) (time.Time, time.Duration, int, error) {
 		r.Close()
 		return timeZero, 0, 0, err
 	}
+
+	// All chunks following the info are encoded with the codec it declares
+	r.chunkReader.setCodecInfo(info.Codec)
+
 	start := time.Unix(0, info.Start)
 	duration := time.Duration(info.Duration)
 	index := int(info.Index)
@@ -189,20 +195,21 @@ func (r *reader) Read() (
 	datapoint ts.Datapoint,
 	unit xtime.Unit,
 	annotation ts.Annotation,
+	writtenAt time.Time,
 	resultErr error,
 ) {
 	if r.nextIndex == 0 {
 		err := r.startBackgroundWorkers()
 		if err != nil {
-			return Series{}, ts.Datapoint{}, xtime.Unit(0), ts.Annotation(nil), err
+			return Series{}, ts.Datapoint{}, xtime.Unit(0), ts.Annotation(nil), time.Time{}, err
 		}
 	}
 	rr, ok := <-r.outChan
 	if !ok {
-		return Series{}, ts.Datapoint{}, xtime.Unit(0), ts.Annotation(nil), io.EOF
+		return Series{}, ts.Datapoint{}, xtime.Unit(0), ts.Annotation(nil), time.Time{}, io.EOF
 	}
 	r.nextIndex++
-	return rr.series, rr.datapoint, rr.unit, rr.annotation, rr.resultErr
+	return rr.series, rr.datapoint, rr.unit, rr.annotation, rr.writtenAt, rr.resultErr
 }
 
 func (r *reader) startBackgroundWorkers() error {
@@ -355,6 +362,7 @@ func (r *reader) decoderLoop(inBuf <-chan decoderArg, outBuf chan<- readResponse
 			Value:     entry.Value,
 		}
 		readResponse.unit = xtime.Unit(byte(entry.Unit))
+		readResponse.writtenAt = time.Unix(0, entry.Create)
 		// Copy annotation to prevent reference to pooled byte slice
 		if len(entry.Annotation) > 0 {
 			readResponse.annotation = append([]byte(nil), entry.Annotation...)
@@ -74,6 +74,9 @@ type Iterator interface {
 	// Current returns the current commit log entry
 	Current() (Series, ts.Datapoint, xtime.Unit, ts.Annotation)
 
+	// CurrentWrittenAt returns the time the current commit log entry was written
+	CurrentWrittenAt() time.Time
+
 	// Err returns an error if an error occurred
 	Err() error
 
@@ -173,6 +176,14 @@ type Options interface {
 
 	// ReadConcurrency returns the concurrency of the reader
 	ReadConcurrency() int
+
+	// SetChunkCodec sets the codec used to compress and encrypt the chunks
+	// of new commit logs and to decode the chunks of existing commit logs
+	SetChunkCodec(value fs.BlockCodec) Options
+
+	// ChunkCodec returns the codec used to compress and encrypt the chunks
+	// of new commit logs and to decode the chunks of existing commit logs
+	ChunkCodec() fs.BlockCodec
 }
 
 // FileFilterPredicate is a predicate that allows the caller to determine
@@ -86,6 +86,7 @@ type writer struct {
 	nowFn              clock.NowFn
 	start              time.Time
 	duration           time.Duration
+	chunkCodec         fs.BlockCodec
 	chunkWriter        *chunkWriter
 	chunkReserveHeader []byte
 	buffer             *bufio.Writer
@@ -105,6 +106,7 @@ func newCommitLogWriter(
 		newFileMode:        opts.FilesystemOptions().NewFileMode(),
 		newDirectoryMode:   opts.FilesystemOptions().NewDirectoryMode(),
 		nowFn:              opts.ClockOptions().NowFn(),
+		chunkCodec:         opts.ChunkCodec(),
 		chunkWriter:        newChunkWriter(flushFn, shouldFsync),
 		chunkReserveHeader: make([]byte, chunkHeaderLen),
 		buffer:             bufio.NewWriterSize(nil, opts.FlushSize()),
@@ -130,6 +132,7 @@ func (w *writer) Open(start time.Time, duration time.Duration) error {
 		Start:    start.UnixNano(),
 		Duration: int64(duration),
 		Index:    int64(index),
+		Codec:    schema.LogCodecInfo(w.chunkCodec.Info()),
 	}
 	w.logEncoder.Reset()
 	if err := w.logEncoder.EncodeLogInfo(logInfo); err != nil {
@@ -141,12 +144,23 @@ func (w *writer) Open(start time.Time, duration time.Duration) error {
 	}
 
 	w.chunkWriter.fd = fd
+	w.chunkWriter.codec = nil
 	w.buffer.Reset(w.chunkWriter)
 	if err := w.write(w.logEncoder.Bytes()); err != nil {
 		w.Close()
 		return err
 	}
 
+	if logInfo.Codec != emptyLogCodecInfo {
+		// The info is always written as is in a chunk of its own so readers
+		// can determine the codec used to encode all subsequent chunks
+		if err := w.Flush(); err != nil {
+			w.Close()
+			return err
+		}
+		w.chunkWriter.codec = w.chunkCodec
+	}
+
 	w.start = start
 	w.duration = duration
 	return nil
@@ -246,6 +260,7 @@ func (w *writer) write(data []byte) error {
 
 type chunkWriter struct {
 	fd      *os.File
+	codec   fs.BlockCodec
 	flushFn flushFn
 	buff    []byte
 	fsync   bool
@@ -260,7 +275,17 @@ func newChunkWriter(flushFn flushFn, fsync bool) *chunkWriter {
 }
 
 func (w *chunkWriter) Write(p []byte) (int, error) {
-	size := len(p)
+	data := p
+	if w.codec != nil {
+		encoded, err := w.codec.Encode(p)
+		if err != nil {
+			w.flushFn(err)
+			return 0, err
+		}
+		data = encoded
+	}
+
+	size := len(data)
 
 	sizeStart, sizeEnd :=
 		0, chunkHeaderSizeLen
@@ -274,7 +299,7 @@ func (w *chunkWriter) Write(p []byte) (int, error) {
 
 	// Calculate checksums
 	checksumSize := digest.Checksum(w.buff[sizeStart:sizeEnd])
-	checksumData := digest.Checksum(p)
+	checksumData := digest.Checksum(data)
 
 	// Write checksums
 	digest.
@@ -285,7 +310,7 @@ func (w *chunkWriter) Write(p []byte) (int, error) {
 		WriteDigest(checksumData)
 
 	// Combine buffers to reduce to a single syscall
-	w.buff = append(w.buff[:chunkHeaderLen], p...)
+	w.buff = append(w.buff[:chunkHeaderLen], data...)
 
 	// Write contents to file descriptor
 	n, err := w.fd.Write(w.buff)
@@ -301,5 +326,10 @@ func (w *chunkWriter) Write(p []byte) (int, error) {
 
 	// Fire flush callback
 	w.flushFn(err)
+	if err == nil && n < len(p) {
+		// Encoded chunks may be smaller than the data they encode, report
+		// all of the data as written so the buffered writer does not retry
+		n = len(p)
+	}
 	return n, err
 }
@@ -36,13 +36,16 @@ import (
 	xclose "github.com/m3db/m3x/close"
 	xerrors "github.com/m3db/m3x/errors"
 	"github.com/m3db/m3x/ident"
+	xtime "github.com/m3db/m3x/time"
 )
 
 var timeZero time.Time
 
 const (
 	dataDirName       = "data"
+	indexDirName      = "index"
 	commitLogsDirName = "commitlogs"
+	snapshotsDirName  = "snapshots"
 )
 
 type fileOpener func(filePath string) (*os.File, error)
@@ -138,6 +141,22 @@ func (a byTimeAndIndexAscending) Less(i, j int) bool {
 	return ti.Equal(tj) && ii < ij
 }
 
+// byTimeAndVolumeAscending sorts fileset files by their block start times and
+// volume index in ascending order. If the files do not have block start times
+// in their names, the result is undefined.
+type byTimeAndVolumeAscending []string
+
+func (a byTimeAndVolumeAscending) Len() int      { return len(a) }
+func (a byTimeAndVolumeAscending) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a byTimeAndVolumeAscending) Less(i, j int) bool {
+	ti, vi, _ := TimeAndVolumeIndexFromFileSetFilename(a[i])
+	tj, vj, _ := TimeAndVolumeIndexFromFileSetFilename(a[j])
+	if ti.Before(tj) {
+		return true
+	}
+	return ti.Equal(tj) && vi < vj
+}
+
 func componentsAndTimeFromFileName(fname string) ([]string, time.Time, error) {
 	components := strings.Split(filepath.Base(fname), separator)
 	if len(components) < 3 {
@@ -171,22 +190,53 @@ func TimeAndIndexFromFileName(fname string) (time.Time, int, error) {
 	return t, int(index), nil
 }
 
+// TimeAndVolumeIndexFromFileSetFilename extracts the block start and volume
+// index from a fileset file name. Filesets named without a volume index are
+// volume zero.
+func TimeAndVolumeIndexFromFileSetFilename(fname string) (time.Time, int, error) {
+	components, t, err := componentsAndTimeFromFileName(fname)
+	if err != nil {
+		return timeZero, 0, err
+	}
+	if len(components) < 4 {
+		return t, 0, nil
+	}
+	volume, err := strconv.ParseInt(components[2], 10, 64)
+	if err != nil {
+		return timeZero, 0, err
+	}
+	return t, int(volume), nil
+}
+
 type infoFileFn func(fname string, infoData []byte)
 
 func forEachInfoFile(filePathPrefix string, namespace ident.ID, shard uint32, readerBufferSize int, fn infoFileFn) {
-	matched, err := filesetFiles(filePathPrefix, namespace, shard, infoFilePattern)
+	shardDir := ShardDirPath(filePathPrefix, namespace, shard)
+	forEachInfoFileInDir(shardDir, readerBufferSize, fn)
+}
+
+// forEachInfoFileInDir calls fn with the info file of the latest complete
+// volume for each block start in the directory, in ascending block start order.
+func forEachInfoFileInDir(dir string, readerBufferSize int, fn infoFileFn) {
+	matched, err := findFiles(dir, infoFilePattern, func(files []string) sort.Interface {
+		return byTimeAndVolumeAscending(files)
+	})
 	if err != nil {
 		return
 	}
 
-	shardDir := ShardDirPath(filePathPrefix, namespace, shard)
-	digestBuf := digest.NewBuffer()
+	var (
+		digestBuf    = digest.NewBuffer()
+		pendingStart time.Time
+		pendingFname string
+		pendingData  []byte
+	)
 	for i := range matched {
-		t, err := TimeFromFileName(matched[i])
+		t, volume, err := TimeAndVolumeIndexFromFileSetFilename(matched[i])
 		if err != nil {
 			continue
 		}
-		checkpointFilePath := filesetPathFromTime(shardDir, t, checkpointFileSuffix)
+		checkpointFilePath := filesetPathFromTimeAndVolume(dir, t, volume, checkpointFileSuffix)
 		if !FileExists(checkpointFilePath) {
 			continue
 		}
@@ -201,17 +251,27 @@ func forEachInfoFile(filePathPrefix string, namespace ident.ID, shard uint32, re
 			continue
 		}
 		// Read and validate the digest file
-		digestData, err := readAndValidate(shardDir, t, digestFileSuffix, readerBufferSize, expectedDigestOfDigest)
+		digestData, err := readAndValidate(filesetPathFromTimeAndVolume(dir, t, volume, digestFileSuffix),
+			readerBufferSize, expectedDigestOfDigest)
 		if err != nil {
 			continue
 		}
 		// Read and validate the info file
 		expectedInfoDigest := digest.ToBuffer(digestData).ReadDigest()
-		infoData, err := readAndValidate(shardDir, t, infoFileSuffix, readerBufferSize, expectedInfoDigest)
+		infoData, err := readAndValidate(filesetPathFromTimeAndVolume(dir, t, volume, infoFileSuffix),
+			readerBufferSize, expectedInfoDigest)
 		if err != nil {
 			continue
 		}
-		fn(matched[i], infoData)
+		// Files are sorted by volume within a block start so only emit the
+		// pending info file once a later block start is seen.
+		if pendingData != nil && !pendingStart.Equal(t) {
+			fn(pendingFname, pendingData)
+		}
+		pendingStart, pendingFname, pendingData = t, matched[i], infoData
+	}
+	if pendingData != nil {
+		fn(pendingFname, pendingData)
 	}
 }
 
@@ -236,6 +296,49 @@ func ReadInfoFiles(
 	return indexEntries
 }
 
+// ReadIndexInfoFiles reads all the valid reverse index info entries for a namespace.
+func ReadIndexInfoFiles(
+	filePathPrefix string,
+	namespace ident.ID,
+	readerBufferSize int,
+	decodingOpts msgpack.DecodingOptions,
+) []schema.IndexVolumeInfo {
+	var infos []schema.IndexVolumeInfo
+	decoder := msgpack.NewDecoder(decodingOpts)
+	dir := NamespaceIndexDataDirPath(filePathPrefix, namespace)
+	forEachInfoFileInDir(dir, readerBufferSize, func(_ string, data []byte) {
+		decoder.Reset(msgpack.NewDecoderStream(data))
+		info, err := decoder.DecodeIndexVolumeInfo()
+		if err != nil {
+			return
+		}
+		infos = append(infos, info)
+	})
+	return infos
+}
+
+// ReadSnapshotInfoFiles reads all the valid snapshot info entries of a shard.
+func ReadSnapshotInfoFiles(
+	filePathPrefix string,
+	namespace ident.ID,
+	shard uint32,
+	readerBufferSize int,
+	decodingOpts msgpack.DecodingOptions,
+) []schema.IndexInfo {
+	var infos []schema.IndexInfo
+	decoder := msgpack.NewDecoder(decodingOpts)
+	dir := ShardSnapshotsDirPath(filePathPrefix, namespace, shard)
+	forEachInfoFileInDir(dir, readerBufferSize, func(_ string, data []byte) {
+		decoder.Reset(msgpack.NewDecoderStream(data))
+		info, err := decoder.DecodeIndexInfo()
+		if err != nil {
+			return
+		}
+		infos = append(infos, info)
+	})
+	return infos
+}
+
 // FilesetBefore returns all the fileset files whose timestamps are earlier than a given time.
 func FilesetBefore(filePathPrefix string, namespace ident.ID, shard uint32, t time.Time) ([]string, error) {
 	matched, err := filesetFiles(filePathPrefix, namespace, shard, filesetFilePattern)
@@ -245,6 +348,19 @@ func FilesetBefore(filePathPrefix string, namespace ident.ID, shard uint32, t ti
 	return filesBefore(matched, t)
 }
 
+// IndexFilesetBefore returns all the reverse index fileset files for a namespace
+// whose timestamps are earlier than a given time.
+func IndexFilesetBefore(filePathPrefix string, namespace ident.ID, t time.Time) ([]string, error) {
+	dir := NamespaceIndexDataDirPath(filePathPrefix, namespace)
+	matched, err := findFiles(dir, filesetFilePattern, func(files []string) sort.Interface {
+		return byTimeAscending(files)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return filesBefore(matched, t)
+}
+
 // DeleteInactiveDirectories deletes any directories that are not currently active, as defined by the
 // inputed active directories within the parent directory
 func DeleteInactiveDirectories(parentDirectoryPath string, activeDirectories []string) error {
@@ -359,13 +475,10 @@ func filesBefore(files []string, t time.Time) ([]string, error) {
 }
 
 func readAndValidate(
-	prefix string,
-	t time.Time,
-	suffix string,
+	filePath string,
 	readerBufferSize int,
 	expectedDigest uint32,
 ) ([]byte, error) {
-	filePath := filesetPathFromTime(prefix, t, suffix)
 	fd, err := os.Open(filePath)
 	if err != nil {
 		return nil, err
@@ -399,6 +512,18 @@ func NamespaceDirPath(prefix string, namespace ident.ID) string {
 	return path.Join(prefix, dataDirName, namespace.String())
 }
 
+// IndexDataDirPath returns the path to the reverse index data directory
+// belonging to a db
+func IndexDataDirPath(prefix string) string {
+	return path.Join(prefix, indexDirName, dataDirName)
+}
+
+// NamespaceIndexDataDirPath returns the path to the reverse index data
+// directory for a given namespace.
+func NamespaceIndexDataDirPath(prefix string, namespace ident.ID) string {
+	return path.Join(prefix, indexDirName, dataDirName, namespace.String())
+}
+
 // ShardDirPath returns the path to a given shard.
 func ShardDirPath(prefix string, namespace ident.ID, shard uint32) string {
 	namespacePath := NamespaceDirPath(prefix, namespace)
@@ -410,13 +535,247 @@ func CommitLogsDirPath(prefix string) string {
 	return path.Join(prefix, commitLogsDirName)
 }
 
-// FilesetExistsAt determines whether a data file exists for the given namespace, shard, and block start time.
+// SnapshotsDirPath returns the path to the snapshots directory belonging to a db
+func SnapshotsDirPath(prefix string) string {
+	return path.Join(prefix, snapshotsDirName)
+}
+
+// NamespaceSnapshotsDirPath returns the path to the snapshots directory for a given namespace.
+func NamespaceSnapshotsDirPath(prefix string, namespace ident.ID) string {
+	return path.Join(prefix, snapshotsDirName, namespace.String())
+}
+
+// ShardSnapshotsDirPath returns the path to the snapshots directory for a given shard.
+func ShardSnapshotsDirPath(prefix string, namespace ident.ID, shard uint32) string {
+	namespacePath := NamespaceSnapshotsDirPath(prefix, namespace)
+	return path.Join(namespacePath, strconv.Itoa(int(shard)))
+}
+
+// FilesetExistsAt determines whether a complete fileset volume exists for the given namespace, shard, and block start time.
 func FilesetExistsAt(prefix string, namespace ident.ID, shard uint32, blockStart time.Time) bool {
+	_, ok := LatestFilesetVolume(prefix, namespace, shard, blockStart)
+	return ok
+}
+
+// FilesetVolumeExistsAt determines whether the given fileset volume is complete
+// for the given namespace, shard, and block start time.
+func FilesetVolumeExistsAt(prefix string, namespace ident.ID, shard uint32, blockStart time.Time, volume int) bool {
 	shardDir := ShardDirPath(prefix, namespace, shard)
-	checkpointFile := filesetPathFromTime(shardDir, blockStart, checkpointFileSuffix)
+	checkpointFile := filesetPathFromTimeAndVolume(shardDir, blockStart, volume, checkpointFileSuffix)
 	return FileExists(checkpointFile)
 }
 
+// LatestFilesetVolume returns the index of the latest complete fileset volume
+// for the given namespace, shard and block start time, and whether any
+// complete volume exists at all.
+func LatestFilesetVolume(prefix string, namespace ident.ID, shard uint32, blockStart time.Time) (int, bool) {
+	return latestVolumeInDir(ShardDirPath(prefix, namespace, shard), blockStart)
+}
+
+// NextFilesetVolume returns the index of the volume that a new fileset for the
+// given namespace, shard and block start time should be written to.
+func NextFilesetVolume(prefix string, namespace ident.ID, shard uint32, blockStart time.Time) int {
+	volume, ok := LatestFilesetVolume(prefix, namespace, shard, blockStart)
+	if !ok {
+		return 0
+	}
+	return volume + 1
+}
+
+// LatestSnapshotVolume returns the index of the latest complete snapshot volume
+// for the given namespace, shard and block start time, and whether any
+// complete snapshot exists at all.
+func LatestSnapshotVolume(prefix string, namespace ident.ID, shard uint32, blockStart time.Time) (int, bool) {
+	return latestVolumeInDir(ShardSnapshotsDirPath(prefix, namespace, shard), blockStart)
+}
+
+// NextSnapshotVolume returns the index of the volume that a new snapshot for
+// the given namespace, shard and block start time should be written to.
+func NextSnapshotVolume(prefix string, namespace ident.ID, shard uint32, blockStart time.Time) int {
+	volume, ok := LatestSnapshotVolume(prefix, namespace, shard, blockStart)
+	if !ok {
+		return 0
+	}
+	return volume + 1
+}
+
+// DeleteFilesetVolume deletes the files of a single fileset volume for the
+// given namespace, shard and block start time if they exist.
+func DeleteFilesetVolume(prefix string, namespace ident.ID, shard uint32, blockStart time.Time, volume int) error {
+	shardDir := ShardDirPath(prefix, namespace, shard)
+	return DeleteFiles(filesetVolumeFiles(shardDir, blockStart, volume))
+}
+
+// SupersededFilesetFiles returns the files of all fileset volumes in a shard
+// that are older than the latest complete volume for their block start. Newer
+// incomplete volumes are left alone as they may still be being written.
+func SupersededFilesetFiles(prefix string, namespace ident.ID, shard uint32) ([]string, error) {
+	return supersededFilesInDir(ShardDirPath(prefix, namespace, shard))
+}
+
+// SupersededSnapshotFiles returns the files of all snapshot volumes in a shard
+// that are older than the latest complete snapshot for their block start.
+func SupersededSnapshotFiles(prefix string, namespace ident.ID, shard uint32) ([]string, error) {
+	return supersededFilesInDir(ShardSnapshotsDirPath(prefix, namespace, shard))
+}
+
+// ObsoleteSnapshotFiles returns the files of all snapshot volumes in a shard
+// whose block start is earlier than the given time or has since been flushed
+// to a complete fileset, as the snapshot is no longer required to bootstrap.
+func ObsoleteSnapshotFiles(prefix string, namespace ident.ID, shard uint32, earliestToRetain time.Time) ([]string, error) {
+	dir := ShardSnapshotsDirPath(prefix, namespace, shard)
+	matched, err := findFiles(dir, filesetFilePattern, func(files []string) sort.Interface {
+		return byTimeAndVolumeAscending(files)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		obsoletes = make(map[xtime.UnixNano]bool)
+		multiErr  xerrors.MultiError
+		files     []string
+	)
+	for _, fname := range matched {
+		t, _, err := TimeAndVolumeIndexFromFileSetFilename(fname)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		blockStart := xtime.ToUnixNano(t)
+		obsolete, ok := obsoletes[blockStart]
+		if !ok {
+			obsolete = t.Before(earliestToRetain) || FilesetExistsAt(prefix, namespace, shard, t)
+			obsoletes[blockStart] = obsolete
+		}
+		if obsolete {
+			files = append(files, fname)
+		}
+	}
+	return files, multiErr.FinalError()
+}
+
+func supersededFilesInDir(shardDir string) ([]string, error) {
+	matched, err := findFiles(shardDir, filesetFilePattern, func(files []string) sort.Interface {
+		return byTimeAndVolumeAscending(files)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		latest      = make(map[xtime.UnixNano]int)
+		volumes     = make(map[xtime.UnixNano][]int)
+		checkpoint  = checkpointFileSuffix + fileSuffix
+		multiErr    xerrors.MultiError
+		superseded  []string
+		blockStarts []xtime.UnixNano
+	)
+	for _, fname := range matched {
+		t, volume, err := TimeAndVolumeIndexFromFileSetFilename(fname)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		blockStart := xtime.ToUnixNano(t)
+		existing, ok := volumes[blockStart]
+		if !ok {
+			blockStarts = append(blockStarts, blockStart)
+		}
+		if n := len(existing); n == 0 || existing[n-1] != volume {
+			volumes[blockStart] = append(existing, volume)
+		}
+		if strings.HasSuffix(fname, separator+checkpoint) {
+			latest[blockStart] = volume
+		}
+	}
+
+	for _, blockStart := range blockStarts {
+		latestVolume, ok := latest[blockStart]
+		if !ok {
+			continue
+		}
+		for _, volume := range volumes[blockStart] {
+			if volume >= latestVolume {
+				break
+			}
+			superseded = append(superseded,
+				filesetVolumeFiles(shardDir, blockStart.ToTime(), volume)...)
+		}
+	}
+	return superseded, multiErr.FinalError()
+}
+
+// IndexFilesetExistsAt determines whether a complete reverse index fileset
+// volume exists for the given namespace and block start time.
+func IndexFilesetExistsAt(prefix string, namespace ident.ID, blockStart time.Time) bool {
+	_, ok := LatestIndexFilesetVolume(prefix, namespace, blockStart)
+	return ok
+}
+
+// LatestIndexFilesetVolume returns the index of the latest complete reverse
+// index fileset volume for the given namespace and block start time, and
+// whether any complete volume exists at all.
+func LatestIndexFilesetVolume(prefix string, namespace ident.ID, blockStart time.Time) (int, bool) {
+	return latestVolumeInDir(NamespaceIndexDataDirPath(prefix, namespace), blockStart)
+}
+
+// NextIndexFilesetVolume returns the index of the volume that a new reverse
+// index fileset for the given namespace and block start time should be written to.
+func NextIndexFilesetVolume(prefix string, namespace ident.ID, blockStart time.Time) int {
+	volume, ok := LatestIndexFilesetVolume(prefix, namespace, blockStart)
+	if !ok {
+		return 0
+	}
+	return volume + 1
+}
+
+// SupersededIndexFilesetFiles returns the files of all reverse index fileset
+// volumes in a namespace that are older than the latest complete volume for
+// their block start.
+func SupersededIndexFilesetFiles(prefix string, namespace ident.ID) ([]string, error) {
+	return supersededFilesInDir(NamespaceIndexDataDirPath(prefix, namespace))
+}
+
+// LatestFilesetVolumeFiles returns the files of the latest complete fileset
+// volume for the given namespace, shard and block start time, if any.
+func LatestFilesetVolumeFiles(prefix string, namespace ident.ID, shard uint32, blockStart time.Time) []string {
+	shardDir := ShardDirPath(prefix, namespace, shard)
+	volume, ok := latestVolumeInDir(shardDir, blockStart)
+	if !ok {
+		return nil
+	}
+	return filesetVolumeFiles(shardDir, blockStart, volume)
+}
+
+// LatestSnapshotVolumeFiles returns the files of the latest complete snapshot
+// volume for the given namespace, shard and block start time, if any.
+func LatestSnapshotVolumeFiles(prefix string, namespace ident.ID, shard uint32, blockStart time.Time) []string {
+	shardDir := ShardSnapshotsDirPath(prefix, namespace, shard)
+	volume, ok := latestVolumeInDir(shardDir, blockStart)
+	if !ok {
+		return nil
+	}
+	return filesetVolumeFiles(shardDir, blockStart, volume)
+}
+
+// IndexFilesetFiles returns the files of the latest complete reverse index
+// fileset volume for the given namespace and block start time, if any.
+func IndexFilesetFiles(prefix string, namespace ident.ID, blockStart time.Time) []string {
+	dir := NamespaceIndexDataDirPath(prefix, namespace)
+	volume, ok := latestVolumeInDir(dir, blockStart)
+	if !ok {
+		return nil
+	}
+	return filesetVolumeFiles(dir, blockStart, volume)
+}
+
+// IsCheckpointFile returns whether the file is the checkpoint file of a
+// fileset, which marks the fileset complete and so must be written last.
+func IsCheckpointFile(filePath string) bool {
+	return strings.HasSuffix(filePath, separator+checkpointFileSuffix+fileSuffix)
+}
+
 // NextCommitLogsFile returns the next commit logs file.
 func NextCommitLogsFile(prefix string, start time.Time) (string, int) {
 	for i := 0; ; i++ {
@@ -444,3 +803,71 @@ func filesetPathFromTime(prefix string, t time.Time, suffix string) string {
 	name := fmt.Sprintf("%s%s%d%s%s%s", filesetFilePrefix, separator, t.UnixNano(), separator, suffix, fileSuffix)
 	return path.Join(prefix, name)
 }
+
+// filesetPathFromTimeAndVolume returns the path of a fileset volume file, volume
+// zero keeps the original unversioned file name for backwards compatibility.
+func filesetPathFromTimeAndVolume(prefix string, t time.Time, volume int, suffix string) string {
+	if volume == 0 {
+		return filesetPathFromTime(prefix, t, suffix)
+	}
+	name := fmt.Sprintf("%s%s%d%s%d%s%s%s", filesetFilePrefix, separator, t.UnixNano(),
+		separator, volume, separator, suffix, fileSuffix)
+	return path.Join(prefix, name)
+}
+
+// filesetFilesForTime returns the fileset files with the given suffix pattern
+// for a block start across all volumes, sorted by volume in ascending order.
+func filesetFilesForTime(dir string, t time.Time, suffixPattern string) ([]string, error) {
+	pattern := fmt.Sprintf(filesetFileForTimeTemplate, t.UnixNano(), suffixPattern)
+	matched, err := findFiles(dir, pattern, func(files []string) sort.Interface {
+		return byTimeAndVolumeAscending(files)
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The pattern also matches block starts that share the same prefix
+	// digits so filter those out.
+	j := 0
+	for i := range matched {
+		ft, _, err := TimeAndVolumeIndexFromFileSetFilename(matched[i])
+		if err != nil || !ft.Equal(t) {
+			continue
+		}
+		matched[j] = matched[i]
+		j++
+	}
+	return matched[:j], nil
+}
+
+func latestVolumeInDir(dir string, blockStart time.Time) (int, bool) {
+	matched, err := filesetFilesForTime(dir, blockStart, checkpointFileSuffix)
+	if err != nil || len(matched) == 0 {
+		return 0, false
+	}
+	_, volume, err := TimeAndVolumeIndexFromFileSetFilename(matched[len(matched)-1])
+	if err != nil {
+		return 0, false
+	}
+	return volume, true
+}
+
+// filesetVolumeFiles returns the existing files of a fileset volume, with the
+// checkpoint file first so a partially deleted volume is never considered complete.
+func filesetVolumeFiles(dir string, t time.Time, volume int) []string {
+	var filePaths []string
+	for _, suffix := range []string{
+		checkpointFileSuffix,
+		infoFileSuffix,
+		indexFileSuffix,
+		summariesFileSuffix,
+		bloomFilterFileSuffix,
+		dataFileSuffix,
+		digestFileSuffix,
+	} {
+		filePath := filesetPathFromTimeAndVolume(dir, t, volume, suffix)
+		if FileExists(filePath) {
+			filePaths = append(filePaths, filePath)
+		}
+	}
+	return filePaths
+}
@@ -76,6 +76,11 @@ func createDataFile(t *testing.T, shardDir string, blockStart time.Time, suffix
 	createFile(t, filePath, b)
 }
 
+func createVolumeFile(t *testing.T, shardDir string, blockStart time.Time, volume int, suffix string) {
+	filePath := filesetPathFromTimeAndVolume(shardDir, blockStart, volume, suffix)
+	createFile(t, filePath, nil)
+}
+
 func createFile(t *testing.T, filePath string, b []byte) {
 	fd, err := os.Create(filePath)
 	require.NoError(t, err)
@@ -327,6 +332,145 @@ func TestFileExists(t *testing.T) {
 	require.False(t, FileExists(infoFilePath))
 }
 
+func TestLatestFilesetVolume(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+
+	shard := uint32(10)
+	start := time.Unix(0, 1)
+	other := time.Unix(0, 12)
+	shardDir := ShardDirPath(dir, testNs1ID, shard)
+	require.NoError(t, os.MkdirAll(shardDir, defaultNewDirectoryMode))
+
+	_, ok := LatestFilesetVolume(dir, testNs1ID, shard, start)
+	require.False(t, ok)
+	require.Equal(t, 0, NextFilesetVolume(dir, testNs1ID, shard, start))
+
+	// Volume 2 is incomplete and a block start sharing the same leading digits
+	// has a later complete volume
+	for volume := 0; volume < 3; volume++ {
+		createVolumeFile(t, shardDir, start, volume, infoFileSuffix)
+		if volume < 2 {
+			createVolumeFile(t, shardDir, start, volume, checkpointFileSuffix)
+		}
+	}
+	createVolumeFile(t, shardDir, other, 5, checkpointFileSuffix)
+
+	volume, ok := LatestFilesetVolume(dir, testNs1ID, shard, start)
+	require.True(t, ok)
+	require.Equal(t, 1, volume)
+	require.Equal(t, 2, NextFilesetVolume(dir, testNs1ID, shard, start))
+	require.True(t, FilesetVolumeExistsAt(dir, testNs1ID, shard, start, 1))
+	require.False(t, FilesetVolumeExistsAt(dir, testNs1ID, shard, start, 2))
+
+	volume, ok = LatestFilesetVolume(dir, testNs1ID, shard, other)
+	require.True(t, ok)
+	require.Equal(t, 5, volume)
+}
+
+func TestLatestFilesetVolumeFiles(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+
+	shard := uint32(10)
+	start := time.Unix(0, 1)
+	shardDir := ShardDirPath(dir, testNs1ID, shard)
+	require.NoError(t, os.MkdirAll(shardDir, defaultNewDirectoryMode))
+
+	require.Nil(t, LatestFilesetVolumeFiles(dir, testNs1ID, shard, start))
+
+	for volume := 0; volume < 3; volume++ {
+		createVolumeFile(t, shardDir, start, volume, dataFileSuffix)
+		if volume < 2 {
+			createVolumeFile(t, shardDir, start, volume, checkpointFileSuffix)
+		}
+	}
+
+	files := LatestFilesetVolumeFiles(dir, testNs1ID, shard, start)
+	require.Equal(t, []string{
+		filesetPathFromTimeAndVolume(shardDir, start, 1, checkpointFileSuffix),
+		filesetPathFromTimeAndVolume(shardDir, start, 1, dataFileSuffix),
+	}, files)
+	require.True(t, IsCheckpointFile(files[0]))
+	require.False(t, IsCheckpointFile(files[1]))
+}
+
+func TestSupersededFilesetFiles(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+
+	shard := uint32(10)
+	shardDir := ShardDirPath(dir, testNs1ID, shard)
+	require.NoError(t, os.MkdirAll(shardDir, defaultNewDirectoryMode))
+
+	var (
+		single     = time.Unix(0, 1)
+		superseded = time.Unix(0, 2)
+		incomplete = time.Unix(0, 3)
+	)
+	for _, suffix := range []string{dataFileSuffix, checkpointFileSuffix} {
+		createVolumeFile(t, shardDir, single, 0, suffix)
+		createVolumeFile(t, shardDir, superseded, 0, suffix)
+		createVolumeFile(t, shardDir, superseded, 1, suffix)
+		createVolumeFile(t, shardDir, superseded, 2, suffix)
+		createVolumeFile(t, shardDir, incomplete, 0, suffix)
+	}
+	// A volume being written is not complete so must not supersede volume 0
+	createVolumeFile(t, shardDir, incomplete, 1, dataFileSuffix)
+
+	res, err := SupersededFilesetFiles(dir, testNs1ID, shard)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		filesetPathFromTimeAndVolume(shardDir, superseded, 0, checkpointFileSuffix),
+		filesetPathFromTimeAndVolume(shardDir, superseded, 0, dataFileSuffix),
+		filesetPathFromTimeAndVolume(shardDir, superseded, 1, checkpointFileSuffix),
+		filesetPathFromTimeAndVolume(shardDir, superseded, 1, dataFileSuffix),
+	}, res)
+}
+
+func TestObsoleteSnapshotFiles(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+
+	shard := uint32(10)
+	shardDir := ShardDirPath(dir, testNs1ID, shard)
+	snapshotsDir := ShardSnapshotsDirPath(dir, testNs1ID, shard)
+	require.NoError(t, os.MkdirAll(shardDir, defaultNewDirectoryMode))
+	require.NoError(t, os.MkdirAll(snapshotsDir, defaultNewDirectoryMode))
+
+	var (
+		expired   = time.Unix(0, 1)
+		flushed   = time.Unix(0, 2)
+		unflushed = time.Unix(0, 3)
+	)
+	for _, suffix := range []string{dataFileSuffix, checkpointFileSuffix} {
+		createVolumeFile(t, shardDir, flushed, 0, suffix)
+		createVolumeFile(t, snapshotsDir, expired, 0, suffix)
+		createVolumeFile(t, snapshotsDir, flushed, 0, suffix)
+		createVolumeFile(t, snapshotsDir, unflushed, 0, suffix)
+	}
+	// An incomplete fileset does not make a snapshot redundant
+	createVolumeFile(t, shardDir, unflushed, 0, dataFileSuffix)
+
+	res, err := ObsoleteSnapshotFiles(dir, testNs1ID, shard, flushed)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		filesetPathFromTimeAndVolume(snapshotsDir, expired, 0, checkpointFileSuffix),
+		filesetPathFromTimeAndVolume(snapshotsDir, expired, 0, dataFileSuffix),
+		filesetPathFromTimeAndVolume(snapshotsDir, flushed, 0, checkpointFileSuffix),
+		filesetPathFromTimeAndVolume(snapshotsDir, flushed, 0, dataFileSuffix),
+	}, res)
+
+	_, ok := LatestSnapshotVolume(dir, testNs1ID, shard, unflushed)
+	require.True(t, ok)
+	_, ok = LatestFilesetVolume(dir, testNs1ID, shard, unflushed)
+	require.False(t, ok)
+}
+
+func TestShardSnapshotsDirPath(t *testing.T) {
+	require.Equal(t, "foo/bar/snapshots/testNs/12", ShardSnapshotsDirPath("foo/bar", testNs1ID, 12))
+}
+
 func TestShardDirPath(t *testing.T) {
 	require.Equal(t, "foo/bar/data/testNs/12", ShardDirPath("foo/bar", testNs1ID, 12))
 	require.Equal(t, "foo/bar/data/testNs/12", ShardDirPath("foo/bar/", testNs1ID, 12))
@@ -350,6 +494,31 @@ func TestFilePathFromTime(t *testing.T) {
 	}
 }
 
+func TestFilePathFromTimeAndVolume(t *testing.T) {
+	start := time.Unix(1465501321, 123456789)
+	inputs := []struct {
+		volume   int
+		suffix   string
+		expected string
+	}{
+		{0, infoFileSuffix, "foo/bar/fileset-1465501321123456789-info.db"},
+		{1, infoFileSuffix, "foo/bar/fileset-1465501321123456789-1-info.db"},
+		{12, checkpointFileSuffix, "foo/bar/fileset-1465501321123456789-12-checkpoint.db"},
+	}
+	for _, input := range inputs {
+		fname := filesetPathFromTimeAndVolume("foo/bar", start, input.volume, input.suffix)
+		require.Equal(t, input.expected, fname)
+
+		ts, volume, err := TimeAndVolumeIndexFromFileSetFilename(fname)
+		require.NoError(t, err)
+		require.True(t, start.Equal(ts))
+		require.Equal(t, input.volume, volume)
+	}
+
+	_, _, err := TimeAndVolumeIndexFromFileSetFilename("foo/bar/fileset-1465501321123456789-x-info.db")
+	require.Error(t, err)
+}
+
 func TestFilesetFilesBefore(t *testing.T) {
 	shard := uint32(0)
 	dir := createInfoFiles(t, testNs1ID, shard, 20)
@@ -37,4 +37,5 @@ const (
 	filesetFilePattern           = filesetFilePrefix + separator + "[0-9]*" + separator + "[a-z]*" + fileSuffix
 	commitLogFilePattern         = commitLogFilePrefix + separator + "[0-9]*" + separator + "[0-9]*" + fileSuffix
 	commitLogFileForTimeTemplate = commitLogFilePrefix + separator + "%d" + separator + "[0-9]*" + fileSuffix
+	filesetFileForTimeTemplate   = filesetFilePrefix + separator + "%d*" + separator + "%s" + fileSuffix
 )
@@ -150,7 +150,7 @@ func calculateExpectedChecksum(t *testing.T, filePath string) uint32 {
 
 func writeTestSummariesData(w FileSetWriter, writes []generatedWrite) error {
 	for _, write := range writes {
-		err := w.Write(write.id, write.data, write.checksum)
+		err := w.Write(write.id, nil, write.data, write.checksum)
 		if err != nil {
 			return err
 		}
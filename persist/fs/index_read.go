@@ -0,0 +1,163 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"errors"
+	"io"
+	"os"
+	"time"
+
+	"github.com/m3db/m3db/digest"
+	"github.com/m3db/m3db/persist/fs/msgpack"
+	"github.com/m3db/m3x/ident"
+	xtime "github.com/m3db/m3x/time"
+)
+
+var (
+	errIndexReaderNotOpen     = errors.New("index fileset reader is not open")
+	errIndexDigestsIncomplete = errors.New("index fileset digest file is incomplete")
+)
+
+type indexReader struct {
+	filePathPrefix   string
+	readerBufferSize int
+
+	blockStart  time.Time
+	blockSize   time.Duration
+	entries     int
+	entriesRead int
+	decoder     *msgpack.Decoder
+	digestBuf   digest.Buffer
+	open        bool
+}
+
+// NewIndexReader returns a new reverse index fileset reader, the files are
+// read and validated in full on call to Open.
+func NewIndexReader(opts Options) (IndexFileSetReader, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return &indexReader{
+		filePathPrefix:   opts.FilePathPrefix(),
+		readerBufferSize: opts.InfoReaderBufferSize(),
+		decoder:          msgpack.NewDecoder(opts.DecodingOptions()),
+		digestBuf:        digest.NewBuffer(),
+	}, nil
+}
+
+func (r *indexReader) Open(namespace ident.ID, blockStart time.Time) error {
+	dir := NamespaceIndexDataDirPath(r.filePathPrefix, namespace)
+
+	// If there is no complete volume, don't read the data files.
+	volume, ok := latestVolumeInDir(dir, blockStart)
+	if !ok {
+		return ErrCheckpointFileNotFound
+	}
+	checkpointFilePath := filesetPathFromTimeAndVolume(dir, blockStart, volume, checkpointFileSuffix)
+	fd, err := os.Open(checkpointFilePath)
+	if err != nil {
+		return err
+	}
+	expectedDigestOfDigest, err := r.digestBuf.ReadDigestFromFile(fd)
+	fd.Close()
+	if err != nil {
+		return err
+	}
+
+	digestData, err := readAndValidate(filesetPathFromTimeAndVolume(dir, blockStart, volume, digestFileSuffix),
+		r.readerBufferSize, expectedDigestOfDigest)
+	if err != nil {
+		return err
+	}
+	digestLen := len(r.digestBuf)
+	if len(digestData) < 2*digestLen {
+		return errIndexDigestsIncomplete
+	}
+	expectedInfoDigest := digest.ToBuffer(digestData).ReadDigest()
+	expectedDataDigest := digest.ToBuffer(digestData[digestLen:]).ReadDigest()
+
+	infoData, err := readAndValidate(filesetPathFromTimeAndVolume(dir, blockStart, volume, infoFileSuffix),
+		r.readerBufferSize, expectedInfoDigest)
+	if err != nil {
+		return err
+	}
+	r.decoder.Reset(msgpack.NewDecoderStream(infoData))
+	info, err := r.decoder.DecodeIndexVolumeInfo()
+	if err != nil {
+		return err
+	}
+
+	data, err := readAndValidate(filesetPathFromTimeAndVolume(dir, blockStart, volume, dataFileSuffix),
+		r.readerBufferSize, expectedDataDigest)
+	if err != nil {
+		return err
+	}
+	r.decoder.Reset(msgpack.NewDecoderStream(data))
+
+	r.blockStart = xtime.FromNanoseconds(info.BlockStart)
+	r.blockSize = time.Duration(info.BlockSize)
+	r.entries = int(info.Entries)
+	r.entriesRead = 0
+	r.open = true
+	return nil
+}
+
+func (r *indexReader) Read() (ident.ID, ident.Tags, error) {
+	if !r.open {
+		return nil, nil, errIndexReaderNotOpen
+	}
+	if r.entriesRead >= r.entries {
+		return nil, nil, io.EOF
+	}
+
+	doc, err := r.decoder.DecodeIndexDocument()
+	if err != nil {
+		return nil, nil, err
+	}
+	r.entriesRead++
+
+	tags := make(ident.Tags, 0, len(doc.Tags))
+	for _, tag := range doc.Tags {
+		tags = append(tags, ident.Tag{
+			Name:  ident.StringID(string(tag.Name)),
+			Value: ident.StringID(string(tag.Value)),
+		})
+	}
+	return ident.StringID(string(doc.ID)), tags, nil
+}
+
+func (r *indexReader) Range() xtime.Range {
+	return xtime.Range{Start: r.blockStart, End: r.blockStart.Add(r.blockSize)}
+}
+
+func (r *indexReader) Entries() int {
+	return r.entries
+}
+
+func (r *indexReader) Close() error {
+	if !r.open {
+		return errIndexReaderNotOpen
+	}
+	r.open = false
+	r.decoder.Reset(msgpack.NewDecoderStream(nil))
+	return nil
+}
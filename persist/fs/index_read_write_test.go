@@ -0,0 +1,168 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3x/ident"
+	xtime "github.com/m3db/m3x/time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestIndexWriter(t *testing.T, filePathPrefix string) IndexFileSetWriter {
+	writer, err := NewIndexWriter(NewOptions().
+		SetFilePathPrefix(filePathPrefix).
+		SetWriterBufferSize(testWriterBufferSize))
+	require.NoError(t, err)
+	return writer
+}
+
+func newTestIndexReader(t *testing.T, filePathPrefix string) IndexFileSetReader {
+	reader, err := NewIndexReader(NewOptions().
+		SetFilePathPrefix(filePathPrefix))
+	require.NoError(t, err)
+	return reader
+}
+
+func TestIndexSimpleReadWrite(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "")
+	defer os.RemoveAll(dir)
+
+	blockStart := time.Unix(7200, 0)
+	entries := []struct {
+		id   ident.ID
+		tags ident.Tags
+	}{
+		{ident.StringID("foo"), ident.Tags{
+			{Name: ident.StringID("city"), Value: ident.StringID("nyc")},
+			{Name: ident.StringID("host"), Value: ident.StringID("a")},
+		}},
+		{ident.StringID("bar"), ident.Tags{
+			{Name: ident.StringID("city"), Value: ident.StringID("sf")},
+		}},
+		{ident.StringID("baz"), ident.Tags{}},
+	}
+
+	w := newTestIndexWriter(t, filePathPrefix)
+	require.NoError(t, w.Open(testNs1ID, testBlockSize, blockStart))
+	for _, entry := range entries {
+		require.NoError(t, w.Write(entry.id, entry.tags))
+	}
+	require.NoError(t, w.Close())
+
+	require.True(t, IndexFilesetExistsAt(filePathPrefix, testNs1ID, blockStart))
+	require.False(t, IndexFilesetExistsAt(filePathPrefix, testNs1ID, blockStart.Add(testBlockSize)))
+
+	r := newTestIndexReader(t, filePathPrefix)
+	require.NoError(t, r.Open(testNs1ID, blockStart))
+	require.Equal(t, len(entries), r.Entries())
+	require.Equal(t, xtime.Range{
+		Start: blockStart,
+		End:   blockStart.Add(testBlockSize),
+	}, r.Range())
+
+	for _, entry := range entries {
+		id, tags, err := r.Read()
+		require.NoError(t, err)
+		require.Equal(t, entry.id.String(), id.String())
+		require.Equal(t, len(entry.tags), len(tags))
+		for i := range tags {
+			require.Equal(t, entry.tags[i].Name.String(), tags[i].Name.String())
+			require.Equal(t, entry.tags[i].Value.String(), tags[i].Value.String())
+		}
+	}
+	_, _, err := r.Read()
+	require.Equal(t, io.EOF, err)
+	require.NoError(t, r.Close())
+
+	infos := ReadIndexInfoFiles(filePathPrefix, testNs1ID,
+		testReaderBufferSize, NewOptions().DecodingOptions())
+	require.Equal(t, 1, len(infos))
+	require.Equal(t, blockStart.UnixNano(), infos[0].BlockStart)
+	require.Equal(t, int64(len(entries)), infos[0].Entries)
+}
+
+func TestIndexReadWithoutCheckpoint(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "")
+	defer os.RemoveAll(dir)
+
+	blockStart := time.Unix(7200, 0)
+	w := newTestIndexWriter(t, filePathPrefix)
+	require.NoError(t, w.Open(testNs1ID, testBlockSize, blockStart))
+	require.NoError(t, w.Write(ident.StringID("foo"), nil))
+	require.NoError(t, w.Close())
+
+	checkpointPath := filesetPathFromTime(
+		NamespaceIndexDataDirPath(filePathPrefix, testNs1ID),
+		blockStart, checkpointFileSuffix)
+	require.NoError(t, os.Remove(checkpointPath))
+
+	r := newTestIndexReader(t, filePathPrefix)
+	require.Equal(t, ErrCheckpointFileNotFound, r.Open(testNs1ID, blockStart))
+
+	files, err := IndexFilesetBefore(filePathPrefix, testNs1ID, blockStart.Add(time.Nanosecond))
+	require.NoError(t, err)
+	require.Equal(t, 3, len(files))
+}
+
+func TestIndexWriteNewVolumeSupersedes(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "")
+	defer os.RemoveAll(dir)
+
+	blockStart := time.Unix(7200, 0)
+	for _, ids := range [][]string{{"foo"}, {"foo", "bar"}} {
+		w := newTestIndexWriter(t, filePathPrefix)
+		require.NoError(t, w.Open(testNs1ID, testBlockSize, blockStart))
+		for _, id := range ids {
+			require.NoError(t, w.Write(ident.StringID(id), nil))
+		}
+		require.NoError(t, w.Close())
+	}
+
+	volume, ok := LatestIndexFilesetVolume(filePathPrefix, testNs1ID, blockStart)
+	require.True(t, ok)
+	require.Equal(t, 1, volume)
+
+	// The latest volume is read while the earlier one is superseded
+	r := newTestIndexReader(t, filePathPrefix)
+	require.NoError(t, r.Open(testNs1ID, blockStart))
+	require.Equal(t, 2, r.Entries())
+	require.NoError(t, r.Close())
+
+	superseded, err := SupersededIndexFilesetFiles(filePathPrefix, testNs1ID)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(superseded))
+	for _, f := range superseded {
+		_, v, err := TimeAndVolumeIndexFromFileSetFilename(f)
+		require.NoError(t, err)
+		require.Equal(t, 0, v)
+	}
+	require.Equal(t, 4, len(IndexFilesetFiles(filePathPrefix, testNs1ID, blockStart)))
+}
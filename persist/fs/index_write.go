@@ -0,0 +1,229 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/m3db/m3db/digest"
+	"github.com/m3db/m3db/persist/fs/msgpack"
+	"github.com/m3db/m3db/persist/schema"
+	"github.com/m3db/m3x/ident"
+	xtime "github.com/m3db/m3x/time"
+)
+
+var (
+	errIndexWriterNotOpen = errors.New("index fileset writer is not open")
+)
+
+type indexWriter struct {
+	filePathPrefix   string
+	newFileMode      os.FileMode
+	newDirectoryMode os.FileMode
+
+	infoFdWithDigest           digest.FdWithDigestWriter
+	dataFdWithDigest           digest.FdWithDigestWriter
+	digestFdWithDigestContents digest.FdWithDigestContentsWriter
+	checkpointFilePath         string
+
+	blockStart time.Time
+	blockSize  time.Duration
+	entries    int64
+	encoder    *msgpack.Encoder
+	digestBuf  digest.Buffer
+	open       bool
+	err        error
+}
+
+// NewIndexWriter returns a new reverse index fileset writer for a filePathPrefix
+func NewIndexWriter(opts Options) (IndexFileSetWriter, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	bufferSize := opts.WriterBufferSize()
+	return &indexWriter{
+		filePathPrefix:             opts.FilePathPrefix(),
+		newFileMode:                opts.NewFileMode(),
+		newDirectoryMode:           opts.NewDirectoryMode(),
+		infoFdWithDigest:           digest.NewFdWithDigestWriter(bufferSize),
+		dataFdWithDigest:           digest.NewFdWithDigestWriter(bufferSize),
+		digestFdWithDigestContents: digest.NewFdWithDigestContentsWriter(bufferSize),
+		encoder:                    msgpack.NewEncoder(),
+		digestBuf:                  digest.NewBuffer(),
+	}, nil
+}
+
+// Open initializes the internal state for writing the reverse index of the
+// given namespace and block start, creating the namespace index directory
+// if it doesn't exist.
+func (w *indexWriter) Open(
+	namespace ident.ID,
+	blockSize time.Duration,
+	blockStart time.Time,
+) error {
+	dir := NamespaceIndexDataDirPath(w.filePathPrefix, namespace)
+	if err := os.MkdirAll(dir, w.newDirectoryMode); err != nil {
+		return err
+	}
+	// Always write a new volume, it supersedes any earlier volume for the
+	// block start once its checkpoint file is written
+	volume := NextIndexFilesetVolume(w.filePathPrefix, namespace, blockStart)
+	w.blockStart = blockStart
+	w.blockSize = blockSize
+	w.entries = 0
+	w.checkpointFilePath = filesetPathFromTimeAndVolume(dir, blockStart, volume, checkpointFileSuffix)
+	w.err = nil
+
+	var infoFd, dataFd, digestFd *os.File
+	if err := openFiles(
+		w.openWritable,
+		map[string]**os.File{
+			filesetPathFromTimeAndVolume(dir, blockStart, volume, infoFileSuffix):   &infoFd,
+			filesetPathFromTimeAndVolume(dir, blockStart, volume, dataFileSuffix):   &dataFd,
+			filesetPathFromTimeAndVolume(dir, blockStart, volume, digestFileSuffix): &digestFd,
+		},
+	); err != nil {
+		return err
+	}
+
+	w.infoFdWithDigest.Reset(infoFd)
+	w.dataFdWithDigest.Reset(dataFd)
+	w.digestFdWithDigestContents.Reset(digestFd)
+	w.open = true
+
+	return nil
+}
+
+func (w *indexWriter) Write(id ident.ID, tags ident.Tags) error {
+	if !w.open {
+		return errIndexWriterNotOpen
+	}
+	if w.err != nil {
+		return w.err
+	}
+	if err := w.write(id, tags); err != nil {
+		w.err = err
+		return err
+	}
+	return nil
+}
+
+func (w *indexWriter) write(id ident.ID, tags ident.Tags) error {
+	doc := schema.IndexDocument{
+		ID:   id.Data().Get(),
+		Tags: make([]schema.IndexTag, 0, len(tags)),
+	}
+	for _, tag := range tags {
+		doc.Tags = append(doc.Tags, schema.IndexTag{
+			Name:  tag.Name.Data().Get(),
+			Value: tag.Value.Data().Get(),
+		})
+	}
+
+	w.encoder.Reset()
+	if err := w.encoder.EncodeIndexDocument(doc); err != nil {
+		return err
+	}
+	if _, err := w.dataFdWithDigest.Write(w.encoder.Bytes()); err != nil {
+		return err
+	}
+	w.entries++
+	return nil
+}
+
+func (w *indexWriter) Close() error {
+	if !w.open {
+		return errIndexWriterNotOpen
+	}
+	w.open = false
+
+	err := w.close()
+	if w.err != nil {
+		return w.err
+	}
+	if err != nil {
+		w.err = err
+		return err
+	}
+	// NB: only write out the checkpoint file if there are no errors
+	// encountered between calling Open() and Close().
+	if err := w.writeCheckpointFile(); err != nil {
+		w.err = err
+		return err
+	}
+	return nil
+}
+
+func (w *indexWriter) close() error {
+	if err := w.writeInfoFileContents(); err != nil {
+		return err
+	}
+
+	if err := w.digestFdWithDigestContents.WriteDigests(
+		w.infoFdWithDigest.Digest().Sum32(),
+		w.dataFdWithDigest.Digest().Sum32(),
+	); err != nil {
+		return err
+	}
+
+	return closeAll(
+		w.infoFdWithDigest,
+		w.dataFdWithDigest,
+		w.digestFdWithDigestContents,
+	)
+}
+
+func (w *indexWriter) writeInfoFileContents() error {
+	info := schema.IndexVolumeInfo{
+		BlockStart: xtime.ToNanoseconds(w.blockStart),
+		BlockSize:  int64(w.blockSize),
+		Entries:    w.entries,
+	}
+
+	w.encoder.Reset()
+	if err := w.encoder.EncodeIndexVolumeInfo(info); err != nil {
+		return err
+	}
+
+	_, err := w.infoFdWithDigest.Write(w.encoder.Bytes())
+	return err
+}
+
+func (w *indexWriter) writeCheckpointFile() error {
+	fd, err := w.openWritable(w.checkpointFilePath)
+	if err != nil {
+		return err
+	}
+	digestChecksum := w.digestFdWithDigestContents.Digest().Sum32()
+	if err := w.digestBuf.WriteDigestToFile(fd, digestChecksum); err != nil {
+		// NB: intentionally skipping fd.Close() error, as failure
+		// to write takes precedence over failure to close the file
+		fd.Close()
+		return err
+	}
+	return fd.Close()
+}
+
+func (w *indexWriter) openWritable(filePath string) (*os.File, error) {
+	return OpenWritable(filePath, w.newFileMode)
+}
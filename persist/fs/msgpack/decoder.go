@@ -33,13 +33,18 @@ var (
 	emptyIndexInfo              schema.IndexInfo
 	emptyIndexSummariesInfo     schema.IndexSummariesInfo
 	emptyIndexBloomFilterInfo   schema.IndexBloomFilterInfo
+	emptyIndexCodecInfo         schema.IndexCodecInfo
 	emptyIndexEntry             schema.IndexEntry
 	emptyIndexSummary           schema.IndexSummary
 	emptyIndexSummaryToken      IndexSummaryToken
 	emptyLogInfo                schema.LogInfo
+	emptyLogCodecInfo           schema.LogCodecInfo
 	emptyLogEntry               schema.LogEntry
 	emptyLogMetadata            schema.LogMetadata
 	emptyLogEntryRemainingToken DecodeLogEntryRemainingToken
+	emptyIndexVolumeInfo        schema.IndexVolumeInfo
+	emptyIndexDocument          schema.IndexDocument
+	emptyIndexTag               schema.IndexTag
 )
 
 var errorUnableToDetermineNumFieldsToSkip = errors.New("unable to determine num fields to skip")
@@ -214,8 +219,36 @@ func (dec *Decoder) DecodeLogMetadata() (schema.LogMetadata, error) {
 	return logMetadata, nil
 }
 
+// DecodeIndexVolumeInfo decodes reverse index volume info
+func (dec *Decoder) DecodeIndexVolumeInfo() (schema.IndexVolumeInfo, error) {
+	if dec.err != nil {
+		return emptyIndexVolumeInfo, dec.err
+	}
+	numFieldsToSkip := dec.decodeRootObject(indexVolumeInfoVersion, indexVolumeInfoType)
+	info := dec.decodeIndexVolumeInfo()
+	dec.skip(numFieldsToSkip)
+	if dec.err != nil {
+		return emptyIndexVolumeInfo, dec.err
+	}
+	return info, nil
+}
+
+// DecodeIndexDocument decodes a reverse index document
+func (dec *Decoder) DecodeIndexDocument() (schema.IndexDocument, error) {
+	if dec.err != nil {
+		return emptyIndexDocument, dec.err
+	}
+	numFieldsToSkip := dec.decodeRootObject(indexDocumentVersion, indexDocumentType)
+	doc := dec.decodeIndexDocument()
+	dec.skip(numFieldsToSkip)
+	if dec.err != nil {
+		return emptyIndexDocument, dec.err
+	}
+	return doc, nil
+}
+
 func (dec *Decoder) decodeIndexInfo() schema.IndexInfo {
-	numFieldsToSkip, ok := dec.checkNumFieldsFor(indexInfoType)
+	numFields, numFieldsToSkip, ok := dec.checkNumFieldsForWithMinimum(indexInfoType, minNumIndexInfoFields)
 	if !ok {
 		return emptyIndexInfo
 	}
@@ -226,6 +259,12 @@ func (dec *Decoder) decodeIndexInfo() schema.IndexInfo {
 	indexInfo.MajorVersion = dec.decodeVarint()
 	indexInfo.Summaries = dec.decodeIndexSummariesInfo()
 	indexInfo.BloomFilter = dec.decodeIndexBloomFilterInfo()
+	if numFields > minNumIndexInfoFields {
+		indexInfo.SnapshotTime = dec.decodeVarint()
+	}
+	if numFields > minNumIndexInfoFields+1 {
+		indexInfo.Codec = dec.decodeIndexCodecInfo()
+	}
 	dec.skip(numFieldsToSkip)
 	if dec.err != nil {
 		return emptyIndexInfo
@@ -262,8 +301,24 @@ func (dec *Decoder) decodeIndexBloomFilterInfo() schema.IndexBloomFilterInfo {
 	return indexBloomFilterInfo
 }
 
+func (dec *Decoder) decodeIndexCodecInfo() schema.IndexCodecInfo {
+	numFieldsToSkip, ok := dec.checkNumFieldsFor(indexCodecInfoType)
+	if !ok {
+		return emptyIndexCodecInfo
+	}
+	var indexCodecInfo schema.IndexCodecInfo
+	indexCodecInfo.Compression = dec.decodeVarint()
+	indexCodecInfo.Encryption = dec.decodeVarint()
+	indexCodecInfo.EncryptionKeyDigest = dec.decodeVarint()
+	dec.skip(numFieldsToSkip)
+	if dec.err != nil {
+		return emptyIndexCodecInfo
+	}
+	return indexCodecInfo
+}
+
 func (dec *Decoder) decodeIndexEntry() schema.IndexEntry {
-	numFieldsToSkip, ok := dec.checkNumFieldsFor(indexEntryType)
+	numFields, numFieldsToSkip, ok := dec.checkNumFieldsForWithMinimum(indexEntryType, minNumIndexEntryFields)
 	if !ok {
 		return emptyIndexEntry
 	}
@@ -273,6 +328,9 @@ func (dec *Decoder) decodeIndexEntry() schema.IndexEntry {
 	indexEntry.Size = dec.decodeVarint()
 	indexEntry.Offset = dec.decodeVarint()
 	indexEntry.Checksum = dec.decodeVarint()
+	if numFields > minNumIndexEntryFields {
+		indexEntry.EncodedTags, _, _ = dec.decodeBytes()
+	}
 	dec.skip(numFieldsToSkip)
 	if dec.err != nil {
 		return emptyIndexEntry
@@ -308,8 +366,64 @@ func (dec *Decoder) decodeIndexSummary() (schema.IndexSummary, IndexSummaryToken
 	return indexSummary, indexSummaryToken
 }
 
+func (dec *Decoder) decodeIndexVolumeInfo() schema.IndexVolumeInfo {
+	numFieldsToSkip, ok := dec.checkNumFieldsFor(indexVolumeInfoType)
+	if !ok {
+		return emptyIndexVolumeInfo
+	}
+	var info schema.IndexVolumeInfo
+	info.BlockStart = dec.decodeVarint()
+	info.BlockSize = dec.decodeVarint()
+	info.Entries = dec.decodeVarint()
+	info.MajorVersion = dec.decodeVarint()
+	dec.skip(numFieldsToSkip)
+	if dec.err != nil {
+		return emptyIndexVolumeInfo
+	}
+	return info
+}
+
+func (dec *Decoder) decodeIndexDocument() schema.IndexDocument {
+	numFieldsToSkip, ok := dec.checkNumFieldsFor(indexDocumentType)
+	if !ok {
+		return emptyIndexDocument
+	}
+	var doc schema.IndexDocument
+	doc.ID, _, _ = dec.decodeBytes()
+	numTags := dec.decodeArrayLen()
+	if dec.err != nil {
+		return emptyIndexDocument
+	}
+	if numTags > 0 {
+		doc.Tags = make([]schema.IndexTag, 0, numTags)
+	}
+	for i := 0; i < numTags; i++ {
+		doc.Tags = append(doc.Tags, dec.decodeIndexTag())
+	}
+	dec.skip(numFieldsToSkip)
+	if dec.err != nil {
+		return emptyIndexDocument
+	}
+	return doc
+}
+
+func (dec *Decoder) decodeIndexTag() schema.IndexTag {
+	numFieldsToSkip, ok := dec.checkNumFieldsFor(indexTagType)
+	if !ok {
+		return emptyIndexTag
+	}
+	var tag schema.IndexTag
+	tag.Name, _, _ = dec.decodeBytes()
+	tag.Value, _, _ = dec.decodeBytes()
+	dec.skip(numFieldsToSkip)
+	if dec.err != nil {
+		return emptyIndexTag
+	}
+	return tag
+}
+
 func (dec *Decoder) decodeLogInfo() schema.LogInfo {
-	numFieldsToSkip, ok := dec.checkNumFieldsFor(logInfoType)
+	numFields, numFieldsToSkip, ok := dec.checkNumFieldsForWithMinimum(logInfoType, minNumLogInfoFields)
 	if !ok {
 		return emptyLogInfo
 	}
@@ -317,6 +431,9 @@ func (dec *Decoder) decodeLogInfo() schema.LogInfo {
 	logInfo.Start = dec.decodeVarint()
 	logInfo.Duration = dec.decodeVarint()
 	logInfo.Index = dec.decodeVarint()
+	if numFields > minNumLogInfoFields {
+		logInfo.Codec = dec.decodeLogCodecInfo()
+	}
 	dec.skip(numFieldsToSkip)
 	if dec.err != nil {
 		return emptyLogInfo
@@ -324,6 +441,22 @@ func (dec *Decoder) decodeLogInfo() schema.LogInfo {
 	return logInfo
 }
 
+func (dec *Decoder) decodeLogCodecInfo() schema.LogCodecInfo {
+	numFieldsToSkip, ok := dec.checkNumFieldsFor(logCodecInfoType)
+	if !ok {
+		return emptyLogCodecInfo
+	}
+	var logCodecInfo schema.LogCodecInfo
+	logCodecInfo.Compression = dec.decodeVarint()
+	logCodecInfo.Encryption = dec.decodeVarint()
+	logCodecInfo.EncryptionKeyDigest = dec.decodeVarint()
+	dec.skip(numFieldsToSkip)
+	if dec.err != nil {
+		return emptyLogCodecInfo
+	}
+	return logCodecInfo
+}
+
 func (dec *Decoder) decodeLogEntry() schema.LogEntry {
 	numFieldsToSkip, ok := dec.checkNumFieldsFor(logEntryType)
 	if !ok {
@@ -403,6 +536,25 @@ func (dec *Decoder) checkNumFieldsFor(objType objectType) (int, bool) {
 	return actual - expected, true
 }
 
+// checkNumFieldsForWithMinimum is like checkNumFieldsFor but accepts objects
+// missing optional trailing fields, returning the number of fields present
+// along with the number of unknown fields to skip.
+func (dec *Decoder) checkNumFieldsForWithMinimum(objType objectType, minFields int) (int, int, bool) {
+	actual := dec.decodeNumObjectFields()
+	if dec.err != nil {
+		return 0, 0, false
+	}
+	if minFields > actual {
+		dec.err = fmt.Errorf("number of fields mismatch: expected at least %d actual %d", minFields, actual)
+		return 0, 0, false
+	}
+	expected := numFieldsForType(objType)
+	if actual > expected {
+		return actual, actual - expected, true
+	}
+	return actual, 0, true
+}
+
 func (dec *Decoder) skip(numFields int) {
 	if dec.err != nil {
 		return
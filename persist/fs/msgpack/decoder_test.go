@@ -23,6 +23,8 @@ package msgpack
 import (
 	"testing"
 
+	"github.com/m3db/m3db/persist/schema"
+
 	"github.com/stretchr/testify/require"
 )
 
@@ -118,6 +120,62 @@ func TestDecodeIndexInfoMoreFieldsThanExpected(t *testing.T) {
 	require.Equal(t, testIndexInfo, res)
 }
 
+func TestDecodeIndexInfoWithoutSnapshotTime(t *testing.T) {
+	var (
+		enc = testEncoder(t)
+		dec = testDecoder(t, nil)
+	)
+
+	// Intentionally drop the trailing snapshot time and codec fields as written by older versions
+	enc.encodeNumObjectFieldsForFn = testGenEncodeNumObjectFieldsForFn(enc, indexInfoType, -2)
+	require.NoError(t, enc.EncodeIndexInfo(testIndexInfo))
+
+	// Verify the missing optional fields decode as their zero values
+	dec.Reset(NewDecoderStream(enc.Bytes()))
+	res, err := dec.DecodeIndexInfo()
+	require.NoError(t, err)
+
+	expected := testIndexInfo
+	expected.SnapshotTime = 0
+	expected.Codec = schema.IndexCodecInfo{}
+	require.Equal(t, expected, res)
+}
+
+func TestDecodeIndexInfoWithoutCodec(t *testing.T) {
+	var (
+		enc = testEncoder(t)
+		dec = testDecoder(t, nil)
+	)
+
+	// Intentionally drop the trailing codec field as written by older versions
+	enc.encodeNumObjectFieldsForFn = testGenEncodeNumObjectFieldsForFn(enc, indexInfoType, -1)
+	require.NoError(t, enc.EncodeIndexInfo(testIndexInfo))
+
+	// Verify the missing optional field decodes as its zero value
+	dec.Reset(NewDecoderStream(enc.Bytes()))
+	res, err := dec.DecodeIndexInfo()
+	require.NoError(t, err)
+
+	expected := testIndexInfo
+	expected.Codec = schema.IndexCodecInfo{}
+	require.Equal(t, expected, res)
+}
+
+func TestDecodeIndexInfoFewerFieldsThanMinimum(t *testing.T) {
+	var (
+		enc = testEncoder(t)
+		dec = testDecoder(t, nil)
+	)
+
+	// Intentionally drop a required field for the index info object
+	enc.encodeNumObjectFieldsForFn = testGenEncodeNumObjectFieldsForFn(enc, indexInfoType, -3)
+	require.NoError(t, enc.EncodeIndexInfo(testIndexInfo))
+
+	dec.Reset(NewDecoderStream(enc.Bytes()))
+	_, err := dec.DecodeIndexInfo()
+	require.Error(t, err)
+}
+
 func TestDecodeIndexEntryMoreFieldsThanExpected(t *testing.T) {
 	var (
 		enc = testEncoder(t)
@@ -136,6 +194,41 @@ func TestDecodeIndexEntryMoreFieldsThanExpected(t *testing.T) {
 	require.Equal(t, testIndexEntry, res)
 }
 
+func TestDecodeIndexEntryWithoutEncodedTags(t *testing.T) {
+	var (
+		enc = testEncoder(t)
+		dec = testDecoder(t, nil)
+	)
+
+	// Intentionally drop the trailing encoded tags field as written by older versions
+	enc.encodeNumObjectFieldsForFn = testGenEncodeNumObjectFieldsForFn(enc, indexEntryType, -1)
+	require.NoError(t, enc.EncodeIndexEntry(testIndexEntry))
+
+	// Verify the missing optional field decodes as its zero value
+	dec.Reset(NewDecoderStream(enc.Bytes()))
+	res, err := dec.DecodeIndexEntry()
+	require.NoError(t, err)
+
+	expected := testIndexEntry
+	expected.EncodedTags = nil
+	require.Equal(t, expected, res)
+}
+
+func TestDecodeIndexEntryFewerFieldsThanMinimum(t *testing.T) {
+	var (
+		enc = testEncoder(t)
+		dec = testDecoder(t, nil)
+	)
+
+	// Intentionally drop a required field for the index entry object
+	enc.encodeNumObjectFieldsForFn = testGenEncodeNumObjectFieldsForFn(enc, indexEntryType, -2)
+	require.NoError(t, enc.EncodeIndexEntry(testIndexEntry))
+
+	dec.Reset(NewDecoderStream(enc.Bytes()))
+	_, err := dec.DecodeIndexEntry()
+	require.Error(t, err)
+}
+
 func TestDecodeLogInfoMoreFieldsThanExpected(t *testing.T) {
 	var (
 		enc = testEncoder(t)
@@ -154,6 +247,41 @@ func TestDecodeLogInfoMoreFieldsThanExpected(t *testing.T) {
 	require.Equal(t, testLogInfo, res)
 }
 
+func TestDecodeLogInfoWithoutCodec(t *testing.T) {
+	var (
+		enc = testEncoder(t)
+		dec = testDecoder(t, nil)
+	)
+
+	// Intentionally drop the trailing codec field as written by older versions
+	enc.encodeNumObjectFieldsForFn = testGenEncodeNumObjectFieldsForFn(enc, logInfoType, -1)
+	require.NoError(t, enc.EncodeLogInfo(testLogInfo))
+
+	// Verify the missing optional field decodes as its zero value
+	dec.Reset(NewDecoderStream(enc.Bytes()))
+	res, err := dec.DecodeLogInfo()
+	require.NoError(t, err)
+
+	expected := testLogInfo
+	expected.Codec = schema.LogCodecInfo{}
+	require.Equal(t, expected, res)
+}
+
+func TestDecodeLogInfoFewerFieldsThanMinimum(t *testing.T) {
+	var (
+		enc = testEncoder(t)
+		dec = testDecoder(t, nil)
+	)
+
+	// Intentionally drop a required field for the log info object
+	enc.encodeNumObjectFieldsForFn = testGenEncodeNumObjectFieldsForFn(enc, logInfoType, -2)
+	require.NoError(t, enc.EncodeLogInfo(testLogInfo))
+
+	dec.Reset(NewDecoderStream(enc.Bytes()))
+	_, err := dec.DecodeLogInfo()
+	require.Error(t, err)
+}
+
 func TestDecodeLogEntryMoreFieldsThanExpected(t *testing.T) {
 	var (
 		enc = testEncoder(t)
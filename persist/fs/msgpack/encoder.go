@@ -139,6 +139,26 @@ func (enc *Encoder) EncodeLogMetadata(entry schema.LogMetadata) error {
 	return enc.err
 }
 
+// EncodeIndexVolumeInfo encodes reverse index volume info
+func (enc *Encoder) EncodeIndexVolumeInfo(info schema.IndexVolumeInfo) error {
+	if enc.err != nil {
+		return enc.err
+	}
+	enc.encodeRootObject(indexVolumeInfoVersion, indexVolumeInfoType)
+	enc.encodeIndexVolumeInfo(info)
+	return enc.err
+}
+
+// EncodeIndexDocument encodes a reverse index document
+func (enc *Encoder) EncodeIndexDocument(doc schema.IndexDocument) error {
+	if enc.err != nil {
+		return enc.err
+	}
+	enc.encodeRootObject(indexDocumentVersion, indexDocumentType)
+	enc.encodeIndexDocument(doc)
+	return enc.err
+}
+
 func (enc *Encoder) encodeIndexInfo(info schema.IndexInfo) {
 	enc.encodeNumObjectFieldsForFn(indexInfoType)
 	enc.encodeVarintFn(info.Start)
@@ -147,6 +167,8 @@ func (enc *Encoder) encodeIndexInfo(info schema.IndexInfo) {
 	enc.encodeVarintFn(info.MajorVersion)
 	enc.encodeIndexSummariesInfo(info.Summaries)
 	enc.encodeIndexBloomFilterInfo(info.BloomFilter)
+	enc.encodeVarintFn(info.SnapshotTime)
+	enc.encodeIndexCodecInfo(info.Codec)
 }
 
 func (enc *Encoder) encodeIndexSummariesInfo(info schema.IndexSummariesInfo) {
@@ -160,6 +182,13 @@ func (enc *Encoder) encodeIndexBloomFilterInfo(info schema.IndexBloomFilterInfo)
 	enc.encodeVarintFn(info.NumHashesK)
 }
 
+func (enc *Encoder) encodeIndexCodecInfo(info schema.IndexCodecInfo) {
+	enc.encodeNumObjectFieldsForFn(indexCodecInfoType)
+	enc.encodeVarintFn(info.Compression)
+	enc.encodeVarintFn(info.Encryption)
+	enc.encodeVarintFn(info.EncryptionKeyDigest)
+}
+
 func (enc *Encoder) encodeIndexEntry(entry schema.IndexEntry) {
 	enc.encodeNumObjectFieldsForFn(indexEntryType)
 	enc.encodeVarintFn(entry.Index)
@@ -167,6 +196,7 @@ func (enc *Encoder) encodeIndexEntry(entry schema.IndexEntry) {
 	enc.encodeVarintFn(entry.Size)
 	enc.encodeVarintFn(entry.Offset)
 	enc.encodeVarintFn(entry.Checksum)
+	enc.encodeBytesFn(entry.EncodedTags)
 }
 
 func (enc *Encoder) encodeIndexSummary(summary schema.IndexSummary) {
@@ -176,11 +206,42 @@ func (enc *Encoder) encodeIndexSummary(summary schema.IndexSummary) {
 	enc.encodeVarintFn(summary.IndexEntryOffset)
 }
 
+func (enc *Encoder) encodeIndexVolumeInfo(info schema.IndexVolumeInfo) {
+	enc.encodeNumObjectFieldsForFn(indexVolumeInfoType)
+	enc.encodeVarintFn(info.BlockStart)
+	enc.encodeVarintFn(info.BlockSize)
+	enc.encodeVarintFn(info.Entries)
+	enc.encodeVarintFn(info.MajorVersion)
+}
+
+func (enc *Encoder) encodeIndexDocument(doc schema.IndexDocument) {
+	enc.encodeNumObjectFieldsForFn(indexDocumentType)
+	enc.encodeBytesFn(doc.ID)
+	enc.encodeArrayLenFn(len(doc.Tags))
+	for _, tag := range doc.Tags {
+		enc.encodeIndexTag(tag)
+	}
+}
+
+func (enc *Encoder) encodeIndexTag(tag schema.IndexTag) {
+	enc.encodeNumObjectFieldsForFn(indexTagType)
+	enc.encodeBytesFn(tag.Name)
+	enc.encodeBytesFn(tag.Value)
+}
+
 func (enc *Encoder) encodeLogInfo(info schema.LogInfo) {
 	enc.encodeNumObjectFieldsForFn(logInfoType)
 	enc.encodeVarintFn(info.Start)
 	enc.encodeVarintFn(info.Duration)
 	enc.encodeVarintFn(info.Index)
+	enc.encodeLogCodecInfo(info.Codec)
+}
+
+func (enc *Encoder) encodeLogCodecInfo(info schema.LogCodecInfo) {
+	enc.encodeNumObjectFieldsForFn(logCodecInfoType)
+	enc.encodeVarintFn(info.Compression)
+	enc.encodeVarintFn(info.Encryption)
+	enc.encodeVarintFn(info.EncryptionKeyDigest)
 }
 
 func (enc *Encoder) encodeLogEntry(entry schema.LogEntry) {
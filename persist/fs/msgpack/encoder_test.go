@@ -74,6 +74,11 @@ func testExpectedResultForIndexInfo(t *testing.T, indexInfo schema.IndexInfo) []
 		numFieldsForType(indexBloomFilterInfoType),
 		indexInfo.BloomFilter.NumElementsM,
 		indexInfo.BloomFilter.NumHashesK,
+		indexInfo.SnapshotTime,
+		numFieldsForType(indexCodecInfoType),
+		indexInfo.Codec.Compression,
+		indexInfo.Codec.Encryption,
+		indexInfo.Codec.EncryptionKeyDigest,
 	}
 }
 
@@ -88,6 +93,7 @@ func testExpectedResultForIndexEntry(t *testing.T, indexEntry schema.IndexEntry)
 		indexEntry.Size,
 		indexEntry.Offset,
 		indexEntry.Checksum,
+		indexEntry.EncodedTags,
 	}
 }
 
@@ -100,6 +106,10 @@ func testExpectedResultForLogInfo(t *testing.T, logInfo schema.LogInfo) []interf
 		logInfo.Start,
 		logInfo.Duration,
 		logInfo.Index,
+		numFieldsForType(logCodecInfoType),
+		logInfo.Codec.Compression,
+		logInfo.Codec.Encryption,
+		logInfo.Codec.EncryptionKeyDigest,
 	}
 }
 
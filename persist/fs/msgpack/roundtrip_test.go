@@ -42,14 +42,21 @@ var (
 			NumElementsM: 2075674,
 			NumHashesK:   7,
 		},
+		SnapshotTime: time.Now().UnixNano(),
+		Codec: schema.IndexCodecInfo{
+			Compression:         1,
+			Encryption:          1,
+			EncryptionKeyDigest: -8373264418346913419,
+		},
 	}
 
 	testIndexEntry = schema.IndexEntry{
-		Index:    234,
-		ID:       []byte("testIndexEntry"),
-		Size:     5456,
-		Offset:   2390423,
-		Checksum: 134245634534,
+		Index:       234,
+		ID:          []byte("testIndexEntry"),
+		Size:        5456,
+		Offset:      2390423,
+		Checksum:    134245634534,
+		EncodedTags: []byte("testEncodedTags"),
 	}
 
 	testIndexSummary = schema.IndexSummary{
@@ -62,6 +69,11 @@ var (
 		Start:    time.Now().UnixNano(),
 		Duration: int64(2 * time.Hour),
 		Index:    234,
+		Codec: schema.LogCodecInfo{
+			Compression:         1,
+			Encryption:          1,
+			EncryptionKeyDigest: 8230958,
+		},
 	}
 
 	testLogEntry = schema.LogEntry{
@@ -79,6 +91,21 @@ var (
 		Namespace: []byte("testNamespace"),
 		Shard:     123,
 	}
+
+	testIndexVolumeInfo = schema.IndexVolumeInfo{
+		BlockStart:   time.Now().UnixNano(),
+		BlockSize:    int64(2 * time.Hour),
+		Entries:      2000000,
+		MajorVersion: schema.MajorVersion,
+	}
+
+	testIndexDocument = schema.IndexDocument{
+		ID: []byte("testIndexDocument"),
+		Tags: []schema.IndexTag{
+			{Name: []byte("city"), Value: []byte("nyc")},
+			{Name: []byte("app"), Value: []byte("web")},
+		},
+	}
 )
 
 func testEncoder(t *testing.T) *Encoder {
@@ -125,6 +152,30 @@ func TestIndexSummaryRoundtrip(t *testing.T) {
 	require.Equal(t, testIndexSummary, res)
 }
 
+func TestIndexVolumeInfoRoundtrip(t *testing.T) {
+	var (
+		enc = testEncoder(t)
+		dec = testDecoder(t, nil)
+	)
+	require.NoError(t, enc.EncodeIndexVolumeInfo(testIndexVolumeInfo))
+	dec.Reset(NewDecoderStream(enc.Bytes()))
+	res, err := dec.DecodeIndexVolumeInfo()
+	require.NoError(t, err)
+	require.Equal(t, testIndexVolumeInfo, res)
+}
+
+func TestIndexDocumentRoundtrip(t *testing.T) {
+	var (
+		enc = testEncoder(t)
+		dec = testDecoder(t, nil)
+	)
+	require.NoError(t, enc.EncodeIndexDocument(testIndexDocument))
+	dec.Reset(NewDecoderStream(enc.Bytes()))
+	res, err := dec.DecodeIndexDocument()
+	require.NoError(t, err)
+	require.Equal(t, testIndexDocument, res)
+}
+
 func TestLogInfoRoundtrip(t *testing.T) {
 	var (
 		enc = testEncoder(t)
@@ -27,6 +27,9 @@ const (
 	logInfoVersion      = 1
 	logEntryVersion     = 1
 	logMetadataVersion  = 1
+
+	indexVolumeInfoVersion = 1
+	indexDocumentVersion   = 1
 )
 
 type objectType int
@@ -43,6 +46,11 @@ const (
 	logInfoType
 	logEntryType
 	logMetadataType
+	indexVolumeInfoType
+	indexDocumentType
+	indexTagType
+	indexCodecInfoType
+	logCodecInfoType
 
 	// Total number of object types
 	numObjectTypes = iota
@@ -50,14 +58,27 @@ const (
 
 const (
 	numRootObjectFields           = 2
-	numIndexInfoFields            = 6
+	numIndexInfoFields            = 8
 	numIndexSummariesInfoFields   = 1
 	numIndexBloomFilterInfoFields = 2
-	numIndexEntryFields           = 5
+	numIndexEntryFields           = 6
 	numIndexSummaryFields         = 3
-	numLogInfoFields              = 3
+	numLogInfoFields              = 4
 	numLogEntryFields             = 7
 	numLogMetadataFields          = 3
+	numIndexVolumeInfoFields      = 4
+	numIndexDocumentFields        = 2
+	numIndexTagFields             = 2
+	numIndexCodecInfoFields       = 3
+	numLogCodecInfoFields         = 3
+)
+
+// Fields appended to an object after its first release are optional when
+// decoding so that files written by older versions can still be read.
+const (
+	minNumIndexInfoFields  = 6
+	minNumIndexEntryFields = 5
+	minNumLogInfoFields    = 3
 )
 
 var numObjectFields []int
@@ -82,4 +103,9 @@ func init() {
 	setNumFieldsForType(logInfoType, numLogInfoFields)
 	setNumFieldsForType(logEntryType, numLogEntryFields)
 	setNumFieldsForType(logMetadataType, numLogMetadataFields)
+	setNumFieldsForType(indexVolumeInfoType, numIndexVolumeInfoFields)
+	setNumFieldsForType(indexDocumentType, numIndexDocumentFields)
+	setNumFieldsForType(indexTagType, numIndexTagFields)
+	setNumFieldsForType(indexCodecInfoType, numIndexCodecInfoFields)
+	setNumFieldsForType(logCodecInfoType, numLogCodecInfoFields)
 }
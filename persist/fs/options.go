@@ -21,6 +21,7 @@
 package fs
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
@@ -60,6 +61,9 @@ var (
 	defaultFilePathPrefix   = os.TempDir()
 	defaultNewFileMode      = os.FileMode(0666)
 	defaultNewDirectoryMode = os.ModeDir | os.FileMode(0755)
+	defaultBlockCodec       = newNoopBlockCodec()
+
+	errBlockCodecNotSet = errors.New("block codec not set")
 )
 
 type options struct {
@@ -78,6 +82,7 @@ type options struct {
 	seekReaderBufferSize                 int
 	mmapEnableHugePages                  bool
 	mmapHugePagesThreshold               int64
+	blockCodec                           BlockCodec
 }
 
 // NewOptions creates a new set of fs options
@@ -98,6 +103,7 @@ func NewOptions() Options {
 		seekReaderBufferSize:                 defaultSeekReaderBufferSize,
 		mmapEnableHugePages:                  defaultMmapEnableHugePages,
 		mmapHugePagesThreshold:               defaultMmapHugePagesThreshold,
+		blockCodec:                           defaultBlockCodec,
 	}
 }
 
@@ -112,6 +118,9 @@ func (o *options) Validate() error {
 			"invalid index bloom filter false positive percent, must be >= 0 and <= 1: instead %f",
 			o.indexBloomFilterFalsePositivePercent)
 	}
+	if o.blockCodec == nil {
+		return errBlockCodecNotSet
+	}
 	return nil
 }
 
@@ -264,3 +273,13 @@ func (o *options) SetMmapHugeTLBThreshold(value int64) Options {
 func (o *options) MmapHugeTLBThreshold() int64 {
 	return o.mmapHugePagesThreshold
 }
+
+func (o *options) SetBlockCodec(value BlockCodec) Options {
+	opts := *o
+	opts.blockCodec = value
+	return &opts
+}
+
+func (o *options) BlockCodec() BlockCodec {
+	return o.blockCodec
+}
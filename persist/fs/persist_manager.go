@@ -49,7 +49,6 @@ const (
 )
 
 var (
-	errPersistManagerNotIdle                  = errors.New("persist manager cannot start flush, not idle")
 	errPersistManagerNotFlushing              = errors.New("persist manager cannot finish flushing, not flushing")
 	errPersistManagerCannotPrepareNotFlushing = errors.New("persist manager cannot prepare, not flushing")
 )
@@ -66,12 +65,14 @@ type persistManager struct {
 	nowFn          clock.NowFn
 	sleepFn        sleepFn
 	writer         FileSetWriter
+	indexWriter    IndexFileSetWriter
 	// segmentHolder is a two-item slice that's reused to hold pointers to the
 	// head and the tail of each segment so we don't need to allocate memory
 	// and gc it shortly after.
 	segmentHolder []checked.Bytes
 
 	status            persistManagerStatus
+	idle              *sync.Cond
 	currRateLimitOpts ratelimit.Options
 
 	start        time.Time
@@ -105,6 +106,10 @@ func NewPersistManager(opts Options) (persist.Manager, error) {
 	if err != nil {
 		return nil, err
 	}
+	indexWriter, err := NewIndexWriter(opts)
+	if err != nil {
+		return nil, err
+	}
 
 	pm := &persistManager{
 		opts:           opts,
@@ -112,16 +117,19 @@ func NewPersistManager(opts Options) (persist.Manager, error) {
 		nowFn:          opts.ClockOptions().NowFn(),
 		sleepFn:        time.Sleep,
 		writer:         writer,
+		indexWriter:    indexWriter,
 		segmentHolder:  make([]checked.Bytes, 2),
 		status:         persistManagerIdle,
 		metrics:        newPersistManagerMetrics(scope),
 	}
+	pm.idle = sync.NewCond(pm)
 	opts.RuntimeOptionsManager().RegisterListener(pm)
 	return pm, nil
 }
 
 func (pm *persistManager) persist(
 	id ident.ID,
+	tags ident.Tags,
 	segment ts.Segment,
 	checksum uint32,
 ) error {
@@ -153,7 +161,7 @@ func (pm *persistManager) persist(
 
 	pm.segmentHolder[0] = segment.Head
 	pm.segmentHolder[1] = segment.Tail
-	err := pm.writer.WriteAll(id, pm.segmentHolder, checksum)
+	err := pm.writer.WriteAll(id, tags, pm.segmentHolder, checksum)
 	pm.count++
 	pm.bytesWritten += int64(segment.Len())
 
@@ -169,13 +177,23 @@ func (pm *persistManager) close() error {
 	return pm.writer.Close()
 }
 
-// StartFlush is called by the databaseFlushManager to begin the flush process
+func (pm *persistManager) persistIndex(id ident.ID, tags ident.Tags) error {
+	return pm.indexWriter.Write(id, tags)
+}
+
+func (pm *persistManager) closeIndex() error {
+	return pm.indexWriter.Close()
+}
+
+// StartFlush is called by the databaseFlushManager to begin the flush process,
+// the persist manager is shared with the repairer so that all writes are
+// subject to the same rate limit, a flush already in progress is waited on.
 func (pm *persistManager) StartFlush() (persist.Flush, error) {
 	pm.Lock()
 	defer pm.Unlock()
 
-	if pm.status != persistManagerIdle {
-		return nil, errPersistManagerNotIdle
+	for pm.status != persistManagerIdle {
+		pm.idle.Wait()
 	}
 	pm.status = persistManagerFlushing
 
@@ -197,6 +215,7 @@ func (pm *persistManager) Done() error {
 
 	// Reset state
 	pm.reset()
+	pm.idle.Signal()
 
 	return nil
 }
@@ -215,7 +234,23 @@ func (pm *persistManager) Prepare(
 	shard uint32,
 	blockStart time.Time,
 ) (persist.PreparedPersist, error) {
+	return pm.prepare(nsMetadata, shard, blockStart, false)
+}
+
+func (pm *persistManager) PrepareVolume(
+	nsMetadata namespace.Metadata,
+	shard uint32,
+	blockStart time.Time,
+) (persist.PreparedPersist, error) {
+	return pm.prepare(nsMetadata, shard, blockStart, true)
+}
 
+func (pm *persistManager) prepare(
+	nsMetadata namespace.Metadata,
+	shard uint32,
+	blockStart time.Time,
+	newVolume bool,
+) (persist.PreparedPersist, error) {
 	var (
 		nsID     = nsMetadata.ID()
 		prepared persist.PreparedPersist
@@ -232,8 +267,9 @@ func (pm *persistManager) Prepare(
 
 	// NB(xichen): if the checkpoint file for blockStart already exists, bail.
 	// This allows us to retry failed flushing attempts because they wouldn't
-	// have created the checkpoint file.
-	if FilesetExistsAt(pm.filePathPrefix, nsID, shard, blockStart) {
+	// have created the checkpoint file. When writing a new volume the writer
+	// supersedes the existing fileset instead.
+	if !newVolume && FilesetExistsAt(pm.filePathPrefix, nsID, shard, blockStart) {
 		return prepared, nil
 	}
 
@@ -248,6 +284,71 @@ func (pm *persistManager) Prepare(
 	return prepared, nil
 }
 
+func (pm *persistManager) PrepareSnapshot(
+	nsMetadata namespace.Metadata,
+	shard uint32,
+	blockStart time.Time,
+	snapshotTime time.Time,
+) (persist.PreparedPersist, error) {
+	var (
+		nsID     = nsMetadata.ID()
+		prepared persist.PreparedPersist
+	)
+
+	// ensure StartFlush has been called
+	pm.RLock()
+	status := pm.status
+	pm.RUnlock()
+
+	if status != persistManagerFlushing {
+		return prepared, errPersistManagerCannotPrepareNotFlushing
+	}
+
+	// Unlike filesets snapshots are always written since each one captures
+	// more recent data than the last.
+	blockSize := nsMetadata.Options().RetentionOptions().BlockSize()
+	if err := pm.writer.OpenSnapshot(nsID, blockSize, shard, blockStart, snapshotTime); err != nil {
+		return prepared, err
+	}
+
+	prepared.Persist = pm.persist
+	prepared.Close = pm.close
+
+	return prepared, nil
+}
+
+func (pm *persistManager) PrepareIndex(
+	nsMetadata namespace.Metadata,
+	blockStart time.Time,
+) (persist.PreparedIndexPersist, error) {
+	var (
+		nsID     = nsMetadata.ID()
+		prepared persist.PreparedIndexPersist
+	)
+
+	// ensure StartFlush has been called
+	pm.RLock()
+	status := pm.status
+	pm.RUnlock()
+
+	if status != persistManagerFlushing {
+		return prepared, errPersistManagerCannotPrepareNotFlushing
+	}
+
+	// Unlike filesets the index is always written to a new volume since
+	// series may be indexed after the block was first flushed, callers
+	// avoid rewriting blocks that have not changed.
+	blockSize := nsMetadata.Options().RetentionOptions().BlockSize()
+	if err := pm.indexWriter.Open(nsID, blockSize, blockStart); err != nil {
+		return prepared, err
+	}
+
+	prepared.Persist = pm.persistIndex
+	prepared.Close = pm.closeIndex
+
+	return prepared, nil
+}
+
 func (pm *persistManager) SetRuntimeOptions(value runtime.Options) {
 	pm.Lock()
 	pm.currRateLimitOpts = value.PersistRateLimitOptions()
@@ -92,6 +92,75 @@ func TestPersistenceManagerPrepareFileExists(t *testing.T) {
 	require.Nil(t, prepared.Close)
 }
 
+func TestPersistenceManagerPrepareVolumeFileExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pm, writer, _ := testManager(t, ctrl)
+	defer os.RemoveAll(pm.filePathPrefix)
+
+	shard := uint32(0)
+	blockStart := time.Unix(1000, 0)
+	shardDir := createShardDir(t, pm.filePathPrefix, testNs1ID, shard)
+	checkpointFilePath := filesetPathFromTime(shardDir, blockStart, checkpointFileSuffix)
+	f, err := os.Create(checkpointFilePath)
+	require.NoError(t, err)
+	f.Close()
+
+	writer.EXPECT().Open(ident.NewIDMatcher(testNs1ID.String()),
+		testBlockSize, shard, blockStart).Return(nil)
+	writer.EXPECT().Close()
+
+	flush, err := pm.StartFlush()
+	require.NoError(t, err)
+
+	defer func() {
+		assert.NoError(t, flush.Done())
+	}()
+
+	prepared, err := flush.PrepareVolume(testNs1Metadata(t), shard, blockStart)
+	require.NoError(t, err)
+	require.NotNil(t, prepared.Persist)
+	require.NotNil(t, prepared.Close)
+	require.NoError(t, prepared.Close())
+}
+
+func TestPersistenceManagerPrepareSnapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pm, writer, _ := testManager(t, ctrl)
+	defer os.RemoveAll(pm.filePathPrefix)
+
+	shard := uint32(0)
+	blockStart := time.Unix(1000, 0)
+	snapshotTime := blockStart.Add(time.Minute)
+
+	// A snapshot is written even if a fileset already exists
+	shardDir := createShardDir(t, pm.filePathPrefix, testNs1ID, shard)
+	checkpointFilePath := filesetPathFromTime(shardDir, blockStart, checkpointFileSuffix)
+	f, err := os.Create(checkpointFilePath)
+	require.NoError(t, err)
+	f.Close()
+
+	writer.EXPECT().OpenSnapshot(ident.NewIDMatcher(testNs1ID.String()),
+		testBlockSize, shard, blockStart, snapshotTime).Return(nil)
+	writer.EXPECT().Close()
+
+	flush, err := pm.StartFlush()
+	require.NoError(t, err)
+
+	defer func() {
+		assert.NoError(t, flush.Done())
+	}()
+
+	prepared, err := flush.PrepareSnapshot(testNs1Metadata(t), shard, blockStart, snapshotTime)
+	require.NoError(t, err)
+	require.NotNil(t, prepared.Persist)
+	require.NotNil(t, prepared.Close)
+	require.NoError(t, prepared.Close())
+}
+
 func TestPersistenceManagerPrepareOpenError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -134,12 +203,13 @@ func TestPersistenceManagerPrepareSuccess(t *testing.T) {
 
 	var (
 		id       = ident.StringID("foo")
+		tags     = ident.Tags{{Name: ident.StringID("bar"), Value: ident.StringID("baz")}}
 		head     = checked.NewBytes([]byte{0x1, 0x2}, nil)
 		tail     = checked.NewBytes([]byte{0x3, 0x4}, nil)
 		segment  = ts.NewSegment(head, tail, ts.FinalizeNone)
 		checksum = digest.SegmentChecksum(segment)
 	)
-	writer.EXPECT().WriteAll(id, gomock.Any(), checksum).Return(nil)
+	writer.EXPECT().WriteAll(id, tags, gomock.Any(), checksum).Return(nil)
 	writer.EXPECT().Close()
 
 	flush, err := pm.StartFlush()
@@ -159,7 +229,7 @@ func TestPersistenceManagerPrepareSuccess(t *testing.T) {
 
 	require.Nil(t, err)
 
-	require.Nil(t, prepared.Persist(id, segment, checksum))
+	require.Nil(t, prepared.Persist(id, tags, segment, checksum))
 
 	require.True(t, pm.start.Equal(now))
 	require.Equal(t, 124, pm.count)
@@ -177,6 +247,34 @@ func TestPersistenceManagerClose(t *testing.T) {
 	pm.close()
 }
 
+func TestPersistenceManagerStartFlushWaitsForDone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pm, _, _ := testManager(t, ctrl)
+	defer os.RemoveAll(pm.filePathPrefix)
+
+	flush, err := pm.StartFlush()
+	require.NoError(t, err)
+
+	started := make(chan struct{})
+	go func() {
+		next, err := pm.StartFlush()
+		require.NoError(t, err)
+		close(started)
+		require.NoError(t, next.Done())
+	}()
+
+	select {
+	case <-started:
+		require.FailNow(t, "flush started while another flush was in progress")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	require.NoError(t, flush.Done())
+	<-started
+}
+
 func TestPersistenceManagerNoRateLimit(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -202,7 +300,7 @@ func TestPersistenceManagerNoRateLimit(t *testing.T) {
 	pm.nowFn = func() time.Time { return now }
 	pm.sleepFn = func(d time.Duration) { slept += d }
 
-	writer.EXPECT().WriteAll(id, pm.segmentHolder, checksum).Return(nil).Times(2)
+	writer.EXPECT().WriteAll(id, gomock.Any(), pm.segmentHolder, checksum).Return(nil).Times(2)
 
 	flush, err := pm.StartFlush()
 	require.NoError(t, err)
@@ -217,11 +315,11 @@ func TestPersistenceManagerNoRateLimit(t *testing.T) {
 
 	// Start persistence
 	now = time.Now()
-	require.NoError(t, prepared.Persist(id, segment, checksum))
+	require.NoError(t, prepared.Persist(id, nil, segment, checksum))
 
 	// Advance time and write again
 	now = now.Add(time.Millisecond)
-	require.NoError(t, prepared.Persist(id, segment, checksum))
+	require.NoError(t, prepared.Persist(id, nil, segment, checksum))
 
 	// Check there is no rate limiting
 	require.Equal(t, time.Duration(0), slept)
@@ -254,7 +352,7 @@ func TestPersistenceManagerWithRateLimit(t *testing.T) {
 
 	writer.EXPECT().Open(ident.NewIDMatcher(testNs1ID.String()),
 		testBlockSize, shard, blockStart).Return(nil).Times(iter)
-	writer.EXPECT().WriteAll(id, pm.segmentHolder, checksum).Return(nil).AnyTimes()
+	writer.EXPECT().WriteAll(id, gomock.Any(), pm.segmentHolder, checksum).Return(nil).AnyTimes()
 	writer.EXPECT().Close().Times(iter)
 
 	// Enable rate limiting
@@ -288,21 +386,21 @@ func TestPersistenceManagerWithRateLimit(t *testing.T) {
 
 		// Start persistence
 		now = time.Now()
-		require.NoError(t, prepared.Persist(id, segment, checksum))
+		require.NoError(t, prepared.Persist(id, nil, segment, checksum))
 
 		// Assert we don't rate limit if the count is not enough yet
-		require.NoError(t, prepared.Persist(id, segment, checksum))
+		require.NoError(t, prepared.Persist(id, nil, segment, checksum))
 		require.Equal(t, time.Duration(0), slept)
 
 		// Advance time and check we rate limit if the disk throughput exceeds the limit
 		now = now.Add(time.Microsecond)
-		require.NoError(t, prepared.Persist(id, segment, checksum))
+		require.NoError(t, prepared.Persist(id, nil, segment, checksum))
 		require.Equal(t, time.Duration(1861), slept)
 
 		// Advance time and check we don't rate limit if the disk throughput is below the limit
-		require.NoError(t, prepared.Persist(id, segment, checksum))
+		require.NoError(t, prepared.Persist(id, nil, segment, checksum))
 		now = now.Add(time.Second - time.Microsecond)
-		require.NoError(t, prepared.Persist(id, segment, checksum))
+		require.NoError(t, prepared.Persist(id, nil, segment, checksum))
 		require.Equal(t, time.Duration(1861), slept)
 
 		require.Equal(t, int64(15), pm.bytesWritten)
@@ -33,6 +33,7 @@ import (
 	"github.com/m3db/m3db/persist/fs/msgpack"
 	"github.com/m3db/m3db/persist/schema"
 	"github.com/m3db/m3db/x/mmap"
+	"github.com/m3db/m3db/x/serialize"
 	"github.com/m3db/m3x/checked"
 	xerrors "github.com/m3db/m3x/errors"
 	"github.com/m3db/m3x/ident"
@@ -56,6 +57,7 @@ type reader struct {
 	namespace      ident.ID
 
 	start     time.Time
+	volume    int
 	blockSize time.Duration
 
 	infoFdWithDigest           digest.FdWithDigestReader
@@ -75,6 +77,9 @@ type reader struct {
 
 	entries         int
 	bloomFilterInfo schema.IndexBloomFilterInfo
+	codecInfo       schema.IndexCodecInfo
+	decodeBlocks    bool
+	blockBuf        []byte
 	entriesRead     int
 	metadataRead    int
 	decoder         *msgpack.Decoder
@@ -121,19 +126,41 @@ func NewReader(
 }
 
 func (r *reader) Open(namespace ident.ID, shard uint32, blockStart time.Time) error {
-	var err error
-
-	// If there is no checkpoint file, don't read the data files.
+	// If there is no complete volume, don't read the data files.
+	volume, ok := LatestFilesetVolume(r.filePathPrefix, namespace, shard, blockStart)
+	if !ok {
+		return ErrCheckpointFileNotFound
+	}
 	shardDir := ShardDirPath(r.filePathPrefix, namespace, shard)
-	if err := r.readCheckpointFile(shardDir, blockStart); err != nil {
+	return r.openVolume(namespace, shard, shardDir, blockStart, volume)
+}
+
+func (r *reader) OpenSnapshot(namespace ident.ID, shard uint32, blockStart time.Time) error {
+	volume, ok := LatestSnapshotVolume(r.filePathPrefix, namespace, shard, blockStart)
+	if !ok {
+		return ErrCheckpointFileNotFound
+	}
+	shardDir := ShardSnapshotsDirPath(r.filePathPrefix, namespace, shard)
+	return r.openVolume(namespace, shard, shardDir, blockStart, volume)
+}
+
+func (r *reader) openVolume(
+	namespace ident.ID,
+	shard uint32,
+	shardDir string,
+	blockStart time.Time,
+	volume int,
+) error {
+	var err error
+	if err := r.readCheckpointFile(shardDir, blockStart, volume); err != nil {
 		return err
 	}
 
 	var infoFd, digestFd *os.File
 	if err := openFiles(os.Open, map[string]**os.File{
-		filesetPathFromTime(shardDir, blockStart, infoFileSuffix):        &infoFd,
-		filesetPathFromTime(shardDir, blockStart, digestFileSuffix):      &digestFd,
-		filesetPathFromTime(shardDir, blockStart, bloomFilterFileSuffix): &r.bloomFilterFd,
+		filesetPathFromTimeAndVolume(shardDir, blockStart, volume, infoFileSuffix):        &infoFd,
+		filesetPathFromTimeAndVolume(shardDir, blockStart, volume, digestFileSuffix):      &digestFd,
+		filesetPathFromTimeAndVolume(shardDir, blockStart, volume, bloomFilterFileSuffix): &r.bloomFilterFd,
 	}); err != nil {
 		return err
 	}
@@ -148,12 +175,12 @@ func (r *reader) Open(namespace ident.ID, shard uint32, blockStart time.Time) er
 	}()
 
 	result, err := mmap.Files(os.Open, map[string]mmap.FileDesc{
-		filesetPathFromTime(shardDir, blockStart, indexFileSuffix): mmap.FileDesc{
+		filesetPathFromTimeAndVolume(shardDir, blockStart, volume, indexFileSuffix): mmap.FileDesc{
 			File:    &r.indexFd,
 			Bytes:   &r.indexMmap,
 			Options: mmap.Options{Read: true, HugeTLB: r.hugePagesOpts},
 		},
-		filesetPathFromTime(shardDir, blockStart, dataFileSuffix): mmap.FileDesc{
+		filesetPathFromTimeAndVolume(shardDir, blockStart, volume, dataFileSuffix): mmap.FileDesc{
 			File:    &r.dataFd,
 			Bytes:   &r.dataMmap,
 			Options: mmap.Options{Read: true, HugeTLB: r.hugePagesOpts},
@@ -193,6 +220,7 @@ func (r *reader) Open(namespace ident.ID, shard uint32, blockStart time.Time) er
 	r.open = true
 	r.namespace = namespace
 	r.shard = shard
+	r.volume = volume
 
 	return nil
 }
@@ -203,11 +231,12 @@ func (r *reader) Status() FileSetReaderStatus {
 		Namespace:  r.namespace,
 		Shard:      r.shard,
 		BlockStart: r.start,
+		Volume:     r.volume,
 	}
 }
 
-func (r *reader) readCheckpointFile(shardDir string, blockStart time.Time) error {
-	filePath := filesetPathFromTime(shardDir, blockStart, checkpointFileSuffix)
+func (r *reader) readCheckpointFile(shardDir string, blockStart time.Time, volume int) error {
+	filePath := filesetPathFromTimeAndVolume(shardDir, blockStart, volume, checkpointFileSuffix)
 	if !FileExists(filePath) {
 		return ErrCheckpointFileNotFound
 	}
@@ -262,6 +291,8 @@ func (r *reader) readInfo(size int) error {
 	r.entriesRead = 0
 	r.metadataRead = 0
 	r.bloomFilterInfo = info.BloomFilter
+	r.codecInfo = info.Codec
+	r.decodeBlocks = blockCodecInfoEncodes(info.Codec)
 	return nil
 }
 
@@ -280,57 +311,110 @@ func (r *reader) readIndexAndSortByOffsetAsc() error {
 	return nil
 }
 
-func (r *reader) Read() (ident.ID, checked.Bytes, uint32, error) {
+func (r *reader) Read() (ident.ID, ident.Tags, checked.Bytes, uint32, error) {
 	var none ident.ID
 	if r.entries > 0 && len(r.indexEntriesByOffsetAsc) < r.entries {
 		// Have not read the index yet, this is required when reading
 		// data as we need each index entry in order by by the offset ascending
 		if err := r.readIndexAndSortByOffsetAsc(); err != nil {
-			return none, nil, 0, err
+			return none, nil, nil, 0, err
 		}
 	}
 
 	if r.entriesRead >= r.entries {
-		return none, nil, 0, io.EOF
+		return none, nil, nil, 0, io.EOF
 	}
 
 	entry := r.indexEntriesByOffsetAsc[r.entriesRead]
 
+	tags, err := serialize.DecodeTags(entry.EncodedTags)
+	if err != nil {
+		return none, nil, nil, 0, err
+	}
+
+	var (
+		size    = int(entry.Size)
+		decoded []byte
+	)
+	if r.decodeBlocks {
+		decoded, err = r.readEncodedBlock(entry.Offset)
+		if err != nil {
+			return none, nil, nil, 0, err
+		}
+		if len(decoded) != size {
+			return none, nil, nil, 0, errReadNotExpectedSize
+		}
+	}
+
 	var data checked.Bytes
 	if r.bytesPool != nil {
-		data = r.bytesPool.Get(int(entry.Size))
+		data = r.bytesPool.Get(size)
 		data.IncRef()
 		defer data.DecRef()
-		data.Resize(int(entry.Size))
+		data.Resize(size)
 	} else {
-		data = checked.NewBytes(make([]byte, entry.Size), nil)
+		data = checked.NewBytes(make([]byte, size), nil)
 		data.IncRef()
 		defer data.DecRef()
 	}
 
-	n, err := r.dataReader.Read(data.Get())
-	if err != nil {
-		return none, nil, 0, err
-	}
-	if n != int(entry.Size) {
-		return none, nil, 0, errReadNotExpectedSize
+	if r.decodeBlocks {
+		copy(data.Get(), decoded)
+	} else if err := r.readData(data.Get()); err != nil {
+		return none, nil, nil, 0, err
 	}
 
 	r.entriesRead++
 
-	return r.entryID(entry.ID), data, uint32(entry.Checksum), nil
+	return r.entryID(entry.ID), tags, data, uint32(entry.Checksum), nil
+}
+
+// readEncodedBlock reads the encoded block at the offset through the data
+// reader so the data file digest still covers every byte, then decodes it.
+func (r *reader) readEncodedBlock(offset int64) ([]byte, error) {
+	block, total, err := encodedBlock(r.dataMmap, offset)
+	if err != nil {
+		return nil, err
+	}
+	if cap(r.blockBuf) < total {
+		r.blockBuf = make([]byte, total)
+	}
+	buf := r.blockBuf[:total]
+	if err := r.readData(buf); err != nil {
+		return nil, err
+	}
+	decoded, err := r.opts.BlockCodec().Decode(r.codecInfo, buf[total-len(block):])
+	if err != nil {
+		return nil, fmt.Errorf("could not decode block: %v", err)
+	}
+	return decoded, nil
+}
+
+func (r *reader) readData(buf []byte) error {
+	n, err := r.dataReader.Read(buf)
+	if err != nil {
+		return err
+	}
+	if n != len(buf) {
+		return errReadNotExpectedSize
+	}
+	return nil
 }
 
-func (r *reader) ReadMetadata() (id ident.ID, length int, checksum uint32, err error) {
+func (r *reader) ReadMetadata() (id ident.ID, tags ident.Tags, length int, checksum uint32, err error) {
 	var none ident.ID
 	if r.metadataRead >= r.entries {
-		return none, 0, 0, io.EOF
+		return none, nil, 0, 0, io.EOF
 	}
 
 	entry := r.indexEntriesByOffsetAsc[r.metadataRead]
+	tags, err = serialize.DecodeTags(entry.EncodedTags)
+	if err != nil {
+		return none, nil, 0, 0, err
+	}
 
 	r.metadataRead++
-	return r.entryID(entry.ID), int(entry.Size), uint32(entry.Checksum), nil
+	return r.entryID(entry.ID), tags, int(entry.Size), uint32(entry.Checksum), nil
 }
 
 func (r *reader) ReadBloomFilter() (*ManagedConcurrentBloomFilter, error) {
@@ -433,6 +517,7 @@ func (r *reader) Close() error {
 	digestBuf := r.digestBuf
 	bytesPool := r.bytesPool
 	indexEntriesByOffsetAsc := r.indexEntriesByOffsetAsc
+	blockBuf := r.blockBuf
 
 	// Reset struct
 	*r = reader{}
@@ -446,6 +531,7 @@ func (r *reader) Close() error {
 	r.bloomFilterWithDigest = bloomFilterWithDigest
 	r.indexDecoderStream = indexDecoderStream
 	r.dataReader = dataReader
+	r.blockBuf = blockBuf
 	r.decoder = decoder
 	r.digestBuf = digestBuf
 	r.bytesPool = bytesPool
@@ -120,7 +120,7 @@ func TestReadEmptyIndexUnreadData(t *testing.T) {
 	err = r.Open(testNs1ID, 0, testWriterStart)
 	assert.NoError(t, err)
 
-	_, _, _, err = r.Read()
+	_, _, _, _, err = r.Read()
 	assert.Error(t, err)
 
 	assert.NoError(t, r.Close())
@@ -142,6 +142,7 @@ func TestReadDataError(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, w.Write(
 		ident.StringID("foo"),
+		nil,
 		bytesRefd([]byte{1, 2, 3}),
 		digest.Checksum([]byte{1, 2, 3})))
 	require.NoError(t, w.Close())
@@ -159,7 +160,7 @@ func TestReadDataError(t *testing.T) {
 	mockReader.EXPECT().Read(gomock.Any()).Return(0, fmt.Errorf("an error"))
 	reader.dataReader = mockReader
 
-	_, _, _, err = r.Read()
+	_, _, _, _, err = r.Read()
 	assert.Error(t, err)
 
 	// Cleanly close
@@ -182,6 +183,7 @@ func TestReadDataUnexpectedSize(t *testing.T) {
 
 	assert.NoError(t, w.Write(
 		ident.StringID("foo"),
+		nil,
 		bytesRefd([]byte{1, 2, 3}),
 		digest.Checksum([]byte{1, 2, 3})))
 	assert.NoError(t, w.Close())
@@ -193,7 +195,7 @@ func TestReadDataUnexpectedSize(t *testing.T) {
 	err = r.Open(testNs1ID, 0, testWriterStart)
 	assert.NoError(t, err)
 
-	_, _, _, err = r.Read()
+	_, _, _, _, err = r.Read()
 	assert.Error(t, err)
 	assert.Equal(t, errReadNotExpectedSize, err)
 
@@ -233,6 +235,7 @@ func testReadOpen(t *testing.T, fileData map[string][]byte) {
 
 	assert.NoError(t, w.Write(
 		ident.StringID("foo"),
+		nil,
 		bytesRefd([]byte{0x1}),
 		digest.Checksum([]byte{0x1})))
 	assert.NoError(t, w.Close())
@@ -311,13 +314,14 @@ func TestReadValidate(t *testing.T) {
 
 	assert.NoError(t, w.Write(
 		ident.StringID("foo"),
+		nil,
 		bytesRefd([]byte{0x1}),
 		digest.Checksum([]byte{0x1})))
 	require.NoError(t, w.Close())
 
 	r := newTestReader(t, filePathPrefix)
 	require.NoError(t, r.Open(testNs1ID, shard, start))
-	_, _, _, err := r.Read()
+	_, _, _, _, err := r.Read()
 	require.NoError(t, err)
 
 	// Mutate expected data checksum to simulate data corruption
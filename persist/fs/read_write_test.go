@@ -58,6 +58,7 @@ func writeTestData(t *testing.T, w FileSetWriter, shard uint32, timestamp time.T
 	for i := range entries {
 		assert.NoError(t, w.Write(
 			ident.StringID(entries[i].id),
+			nil,
 			bytesRefd(entries[i].data),
 			digest.Checksum(entries[i].data)))
 	}
@@ -106,12 +107,13 @@ func readTestData(t *testing.T, r FileSetReader, shard uint32, timestamp time.Ti
 		for i := 0; i < r.Entries(); i++ {
 			switch underTest {
 			case readTestTypeData:
-				id, data, checksum, err := r.Read()
+				id, tags, data, checksum, err := r.Read()
 				require.NoError(t, err)
 
 				data.IncRef()
 
 				assert.Equal(t, entries[i].id, id.String())
+				assert.Equal(t, 0, len(tags))
 				assert.True(t, bytes.Equal(entries[i].data, data.Get()))
 				assert.Equal(t, digest.Checksum(entries[i].data), checksum)
 
@@ -125,10 +127,11 @@ func readTestData(t *testing.T, r FileSetReader, shard uint32, timestamp time.Ti
 				data.DecRef()
 				data.Finalize()
 			case readTestTypeMetadata:
-				id, length, checksum, err := r.ReadMetadata()
+				id, tags, length, checksum, err := r.ReadMetadata()
 				require.NoError(t, err)
 
 				assert.True(t, id.Equal(id))
+				assert.Equal(t, 0, len(tags))
 				assert.Equal(t, digest.Checksum(entries[i].data), checksum)
 				assert.Equal(t, len(entries[i].data), length)
 
@@ -166,6 +169,59 @@ func TestSimpleReadWrite(t *testing.T) {
 	readTestData(t, r, 0, testWriterStart, entries)
 }
 
+func TestReadWriteTags(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "")
+	defer os.RemoveAll(dir)
+
+	var (
+		shard = uint32(0)
+		data  = []byte{1, 2, 3}
+		tags  = map[string]ident.Tags{
+			"foo": {
+				{Name: ident.StringID("city"), Value: ident.StringID("nyc")},
+				{Name: ident.StringID("host"), Value: ident.StringID("a")},
+			},
+			"bar": {
+				{Name: ident.StringID("city"), Value: ident.StringID("sf")},
+			},
+			"baz": nil,
+		}
+	)
+	w := newTestWriter(t, filePathPrefix)
+	require.NoError(t, w.Open(testNs1ID, testBlockSize, shard, testWriterStart))
+	for id, seriesTags := range tags {
+		require.NoError(t, w.Write(ident.StringID(id), seriesTags,
+			bytesRefd(data), digest.Checksum(data)))
+	}
+	require.NoError(t, w.Close())
+
+	r := newTestReader(t, filePathPrefix)
+	require.NoError(t, r.Open(testNs1ID, shard, testWriterStart))
+	for i := 0; i < len(tags); i++ {
+		id, seriesTags, _, _, err := r.Read()
+		require.NoError(t, err)
+		requireTagsEqual(t, tags[id.String()], seriesTags)
+	}
+	require.NoError(t, r.Close())
+
+	require.NoError(t, r.Open(testNs1ID, shard, testWriterStart))
+	for i := 0; i < len(tags); i++ {
+		id, seriesTags, _, _, err := r.ReadMetadata()
+		require.NoError(t, err)
+		requireTagsEqual(t, tags[id.String()], seriesTags)
+	}
+	require.NoError(t, r.Close())
+}
+
+func requireTagsEqual(t *testing.T, expected, actual ident.Tags) {
+	require.Equal(t, len(expected), len(actual))
+	for i := range expected {
+		require.Equal(t, expected[i].Name.String(), actual[i].Name.String())
+		require.Equal(t, expected[i].Value.String(), actual[i].Value.String())
+	}
+}
+
 func TestReadWithReusedReader(t *testing.T) {
 	dir := createTempDir(t)
 	filePathPrefix := filepath.Join(dir, "")
@@ -254,6 +310,7 @@ func TestReusingWriterAfterWriteError(t *testing.T) {
 
 	require.NoError(t, w.Write(
 		ident.StringID(entries[0].id),
+		nil,
 		bytesRefd(entries[0].data),
 		digest.Checksum(entries[0].data)))
 
@@ -261,6 +318,7 @@ func TestReusingWriterAfterWriteError(t *testing.T) {
 	w.(*writer).err = errors.New("foo")
 	require.Equal(t, "foo", w.Write(
 		ident.StringID(entries[1].id),
+		nil,
 		bytesRefd(entries[1].data),
 		digest.Checksum(entries[1].data)).Error())
 	w.Close()
@@ -273,6 +331,92 @@ func TestReusingWriterAfterWriteError(t *testing.T) {
 	readTestData(t, r, shard, testWriterStart, entries)
 }
 
+func TestWriterWritesNewVolume(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "")
+	defer os.RemoveAll(dir)
+
+	shard := uint32(0)
+	w := newTestWriter(t, filePathPrefix)
+	r := newTestReader(t, filePathPrefix)
+
+	first := []testEntry{
+		{"foo", []byte{1, 2, 3}},
+	}
+	writeTestData(t, w, shard, testWriterStart, first)
+	readTestData(t, r, shard, testWriterStart, first)
+
+	// Writing the same block start again supersedes the first volume
+	second := []testEntry{
+		{"foo", []byte{1, 2, 3}},
+		{"bar", []byte{4, 5, 6}},
+	}
+	writeTestData(t, w, shard, testWriterStart, second)
+	readTestData(t, r, shard, testWriterStart, second)
+
+	require.NoError(t, r.Open(testNs1ID, shard, testWriterStart))
+	require.Equal(t, 1, r.Status().Volume)
+	require.NoError(t, r.Close())
+
+	// An incomplete newer volume is ignored by readers and overwritten by
+	// the next writer
+	require.NoError(t, w.Open(testNs1ID, testBlockSize, shard, testWriterStart))
+	w.(*writer).err = errors.New("foo")
+	w.Close()
+	readTestData(t, r, shard, testWriterStart, second)
+
+	writeTestData(t, w, shard, testWriterStart, first)
+	readTestData(t, r, shard, testWriterStart, first)
+	require.NoError(t, r.Open(testNs1ID, shard, testWriterStart))
+	require.Equal(t, 2, r.Status().Volume)
+	require.NoError(t, r.Close())
+
+	// Only the info file of the latest volume is read
+	infoFiles := ReadInfoFiles(filePathPrefix, testNs1ID, shard, 16, nil)
+	require.Equal(t, 1, len(infoFiles))
+	require.Equal(t, int64(len(first)), infoFiles[0].Entries)
+}
+
+func TestSnapshotReadWrite(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "")
+	defer os.RemoveAll(dir)
+
+	var (
+		shard        = uint32(0)
+		snapshotTime = testWriterStart.Add(10 * time.Minute)
+		w            = newTestWriter(t, filePathPrefix)
+		r            = newTestReader(t, filePathPrefix)
+		entries      = []testEntry{
+			{"foo", []byte{1, 2, 3}},
+			{"bar", []byte{4, 5, 6}},
+		}
+	)
+	require.NoError(t, w.OpenSnapshot(testNs1ID, testBlockSize, shard, testWriterStart, snapshotTime))
+	for i := range entries {
+		require.NoError(t, w.Write(
+			ident.StringID(entries[i].id),
+			nil,
+			bytesRefd(entries[i].data),
+			digest.Checksum(entries[i].data)))
+	}
+	require.NoError(t, w.Close())
+
+	// Snapshots are not visible as filesets
+	require.False(t, FilesetExistsAt(filePathPrefix, testNs1ID, shard, testWriterStart))
+	require.Equal(t, ErrCheckpointFileNotFound, r.Open(testNs1ID, shard, testWriterStart))
+
+	require.NoError(t, r.OpenSnapshot(testNs1ID, shard, testWriterStart))
+	require.Equal(t, len(entries), r.Entries())
+	require.NoError(t, r.Validate())
+	require.NoError(t, r.Close())
+
+	infoFiles := ReadSnapshotInfoFiles(filePathPrefix, testNs1ID, shard, 16, nil)
+	require.Equal(t, 1, len(infoFiles))
+	require.Equal(t, snapshotTime.UnixNano(), infoFiles[0].SnapshotTime)
+	require.Equal(t, 0, len(ReadInfoFiles(filePathPrefix, testNs1ID, shard, 16, nil)))
+}
+
 func TestWriterOnlyWritesNonNilBytes(t *testing.T) {
 	dir := createTempDir(t)
 	filePathPrefix := filepath.Join(dir, "")
@@ -288,7 +432,7 @@ func TestWriterOnlyWritesNonNilBytes(t *testing.T) {
 	err := w.Open(testNs1ID, testBlockSize, 0, testWriterStart)
 	assert.NoError(t, err)
 
-	w.WriteAll(ident.StringID("foo"), []checked.Bytes{
+	w.WriteAll(ident.StringID("foo"), nil, []checked.Bytes{
 		checkedBytes([]byte{1, 2, 3}),
 		nil,
 		checkedBytes([]byte{4, 5, 6}),
@@ -173,7 +173,7 @@ func testBlockRetrieverHighConcurrentSeeks(t *testing.T, shouldCacheShardIndices
 				}
 				shardData[shard][id.Hash()][xtime.ToUnixNano(blockStart)] = data
 
-				err := w.Write(id, data, digest.Checksum(data.Get()))
+				err := w.Write(id, nil, data, digest.Checksum(data.Get()))
 				require.NoError(t, err)
 			}
 			closer()
@@ -279,7 +279,7 @@ func TestBlockRetrieverIDDoesNotExist(t *testing.T) {
 	data := checked.NewBytes([]byte("Hello world!"), nil)
 	data.IncRef()
 	defer data.DecRef()
-	err = w.Write(ident.StringID("exists"), data, digest.Checksum(data.Get()))
+	err = w.Write(ident.StringID("exists"), nil, data, digest.Checksum(data.Get()))
 	assert.NoError(t, err)
 	closer()
 
@@ -51,6 +51,9 @@ var (
 	// errNotEnoughBytes returned when the data file doesn't have enough bytes to satisfy a read
 	errNotEnoughBytes = errors.New("invalid data file, not enough bytes to satisfy read")
 
+	// errDecodedBlockSizeMismatch returned when the size of a decoded block does not match the index entry size
+	errDecodedBlockSizeMismatch = errors.New("decoded block size does not match expected size")
+
 	// errClonesShouldNotBeOpened returned when Open() is called on a clone
 	errClonesShouldNotBeOpened = errors.New("clone should not be opened")
 )
@@ -65,6 +68,8 @@ type seeker struct {
 	entries         int
 	bloomFilterInfo schema.IndexBloomFilterInfo
 	summariesInfo   schema.IndexSummariesInfo
+	codecInfo       schema.IndexCodecInfo
+	decodeBlocks    bool
 
 	// Readers for each file that will also verify the digest
 	infoFdWithDigest           digest.FdWithDigestReader
@@ -99,11 +104,13 @@ type seeker struct {
 }
 
 // IndexEntry is an entry from the index file which can be passed to
-// SeekUsingIndexEntry to seek to the data for that entry
+// SeekUsingIndexEntry to seek to the data for that entry, the encoded
+// tags of the series can be decoded with serialize.DecodeTags
 type IndexEntry struct {
-	Size     uint32
-	Checksum uint32
-	Offset   int64
+	Size        uint32
+	Checksum    uint32
+	Offset      int64
+	EncodedTags []byte
 }
 
 // NewSeeker returns a new seeker.
@@ -178,17 +185,31 @@ func (s *seeker) Open(namespace ident.ID, shard uint32, blockStart time.Time) er
 		return errClonesShouldNotBeOpened
 	}
 
+	volume, ok := LatestFilesetVolume(s.filePathPrefix, namespace, shard, blockStart)
+	if !ok {
+		return ErrCheckpointFileNotFound
+	}
+	return s.openVolume(namespace, shard, blockStart, volume)
+}
+
+// openVolume opens the files of a specific fileset volume, callers are
+// expected to have already checked the volume is complete.
+func (s *seeker) openVolume(namespace ident.ID, shard uint32, blockStart time.Time, volume int) error {
+	if s.isClone {
+		return errClonesShouldNotBeOpened
+	}
+
 	shardDir := ShardDirPath(s.filePathPrefix, namespace, shard)
 	var infoFd, indexFd, dataFd, digestFd, bloomFilterFd, summariesFd *os.File
 
 	// Open necessary files
 	if err := openFiles(os.Open, map[string]**os.File{
-		filesetPathFromTime(shardDir, blockStart, infoFileSuffix):        &infoFd,
-		filesetPathFromTime(shardDir, blockStart, indexFileSuffix):       &indexFd,
-		filesetPathFromTime(shardDir, blockStart, dataFileSuffix):        &dataFd,
-		filesetPathFromTime(shardDir, blockStart, digestFileSuffix):      &digestFd,
-		filesetPathFromTime(shardDir, blockStart, bloomFilterFileSuffix): &bloomFilterFd,
-		filesetPathFromTime(shardDir, blockStart, summariesFileSuffix):   &summariesFd,
+		filesetPathFromTimeAndVolume(shardDir, blockStart, volume, infoFileSuffix):        &infoFd,
+		filesetPathFromTimeAndVolume(shardDir, blockStart, volume, indexFileSuffix):       &indexFd,
+		filesetPathFromTimeAndVolume(shardDir, blockStart, volume, dataFileSuffix):        &dataFd,
+		filesetPathFromTimeAndVolume(shardDir, blockStart, volume, digestFileSuffix):      &digestFd,
+		filesetPathFromTimeAndVolume(shardDir, blockStart, volume, bloomFilterFileSuffix): &bloomFilterFd,
+		filesetPathFromTimeAndVolume(shardDir, blockStart, volume, summariesFileSuffix):   &summariesFd,
 	}); err != nil {
 		return err
 	}
@@ -217,12 +238,12 @@ func (s *seeker) Open(namespace ident.ID, shard uint32, blockStart time.Time) er
 		},
 	}
 	mmapResult, err := mmap.Files(os.Open, map[string]mmap.FileDesc{
-		filesetPathFromTime(shardDir, blockStart, indexFileSuffix): mmap.FileDesc{
+		filesetPathFromTimeAndVolume(shardDir, blockStart, volume, indexFileSuffix): mmap.FileDesc{
 			File:    &indexFd,
 			Bytes:   &s.indexMmap,
 			Options: mmapOptions,
 		},
-		filesetPathFromTime(shardDir, blockStart, dataFileSuffix): mmap.FileDesc{
+		filesetPathFromTimeAndVolume(shardDir, blockStart, volume, dataFileSuffix): mmap.FileDesc{
 			File:    &dataFd,
 			Bytes:   &s.dataMmap,
 			Options: mmapOptions,
@@ -257,7 +278,7 @@ func (s *seeker) Open(namespace ident.ID, shard uint32, blockStart time.Time) er
 		s.Close()
 		return fmt.Errorf(
 			"index file digest for file: %s does not match the expected digest",
-			filesetPathFromTime(shardDir, blockStart, indexFileSuffix),
+			filesetPathFromTimeAndVolume(shardDir, blockStart, volume, indexFileSuffix),
 		)
 	}
 
@@ -342,6 +363,8 @@ func (s *seeker) readInfo(size int) error {
 	s.entries = int(info.Entries)
 	s.bloomFilterInfo = info.BloomFilter
 	s.summariesInfo = info.Summaries
+	s.codecInfo = info.Codec
+	s.decodeBlocks = blockCodecInfoEncodes(info.Codec)
 
 	return nil
 }
@@ -367,31 +390,47 @@ func (s *seeker) SeekByIndexEntry(entry IndexEntry) (checked.Bytes, error) {
 		return nil, errInvalidDataFileOffset
 	}
 
-	// We'll treat "data" similar to a reader interface, I.E after every read we'll
-	// reslice it such that the first byte is the next byte we want to read.
-	data := s.dataMmap[entry.Offset:]
+	var data []byte
+	if s.decodeBlocks {
+		block, _, err := encodedBlock(s.dataMmap, entry.Offset)
+		if err != nil {
+			return nil, err
+		}
+		data, err = s.opts.BlockCodec().Decode(s.codecInfo, block)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode block: %v", err)
+		}
+		if len(data) != int(entry.Size) {
+			return nil, errDecodedBlockSizeMismatch
+		}
+	} else {
+		// We'll treat "data" similar to a reader interface, I.E after every read we'll
+		// reslice it such that the first byte is the next byte we want to read.
+		data = s.dataMmap[entry.Offset:]
 
-	// Should never happen, but prevents panics in the case of malformed data
-	if len(data) < int(entry.Size) {
-		return nil, errNotEnoughBytes
+		// Should never happen, but prevents panics in the case of malformed data
+		if len(data) < int(entry.Size) {
+			return nil, errNotEnoughBytes
+		}
+		data = data[:entry.Size]
 	}
 
 	// Obtain an appropriately sized buffer
 	var buffer checked.Bytes
 	if s.bytesPool != nil {
-		buffer = s.bytesPool.Get(int(entry.Size))
+		buffer = s.bytesPool.Get(len(data))
 		buffer.IncRef()
 		defer buffer.DecRef()
-		buffer.Resize(int(entry.Size))
+		buffer.Resize(len(data))
 	} else {
-		buffer = checked.NewBytes(make([]byte, entry.Size), nil)
+		buffer = checked.NewBytes(make([]byte, len(data)), nil)
 		buffer.IncRef()
 		defer buffer.DecRef()
 	}
 
 	// Copy the actual data into the underlying buffer
 	underlyingBuf := buffer.Get()
-	copy(underlyingBuf, data[:entry.Size])
+	copy(underlyingBuf, data)
 
 	// NB(r): _must_ check the checksum against known checksum as the data
 	// file might not have been verified if we haven't read through the file yet.
@@ -424,10 +463,17 @@ func (s *seeker) SeekIndexEntry(id ident.ID) (IndexEntry, error) {
 		}
 		comparison := bytes.Compare(entry.ID, idBytes)
 		if comparison == 0 {
+			// NB: The encoded tags reference the index file mmap which is
+			// unmapped on close, so they must be copied to be returned.
+			var encodedTags []byte
+			if len(entry.EncodedTags) > 0 {
+				encodedTags = append([]byte(nil), entry.EncodedTags...)
+			}
 			return IndexEntry{
-				Size:     uint32(entry.Size),
-				Checksum: uint32(entry.Checksum),
-				Offset:   entry.Offset,
+				Size:        uint32(entry.Size),
+				Checksum:    uint32(entry.Checksum),
+				Offset:      entry.Offset,
+				EncodedTags: encodedTags,
 			}, nil
 		}
 
@@ -487,9 +533,11 @@ func (s *seeker) ConcurrentClone() (ConcurrentFileSetSeeker, error) {
 
 	return &seeker{
 		// Bare-minimum required fields for a clone to function properly
-		bytesPool: s.bytesPool,
-		decoder:   msgpack.NewDecoder(s.decodingOpts),
-		opts:      s.opts,
+		bytesPool:    s.bytesPool,
+		decoder:      msgpack.NewDecoder(s.decodingOpts),
+		opts:         s.opts,
+		codecInfo:    s.codecInfo,
+		decodeBlocks: s.decodeBlocks,
 		// Mmaps are read-only so they're concurrency safe
 		dataMmap:  s.dataMmap,
 		indexMmap: s.indexMmap,
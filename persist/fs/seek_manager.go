@@ -51,7 +51,7 @@ type openAnyUnopenSeekersFn func(*seekersByTime) error
 type newOpenSeekerFn func(
 	shard uint32,
 	blockStart time.Time,
-) (FileSetSeeker, error)
+) (FileSetSeeker, int, error)
 
 type seekerManagerStatus int
 
@@ -89,11 +89,13 @@ type seekerUnreadBuf struct {
 
 // seekersAndBloom contains a slice of seekers for a given shard/blockStart. One of the seeker will be the original,
 // and the others will be clones. The bloomFilter field is a reference to the underlying bloom filter that the
-// original seeker and all of its clones share.
+// original seeker and all of its clones share. The volume field is the fileset volume the seekers were opened
+// against so they can be replaced once a newer volume is written.
 type seekersAndBloom struct {
 	wg          *sync.WaitGroup
 	seekers     []borrowableSeeker
 	bloomFilter *ManagedConcurrentBloomFilter
+	volume      int
 }
 
 // borrowableSeeker is just a seeker with an additional field for keeping track of whether or not it has been borrowed.
@@ -297,7 +299,7 @@ func (m *seekerManager) getOrOpenSeekersWithLock(start xtime.UnixNano, byTime *s
 	byTime.Unlock()
 	// Open first one - Do this outside the context of the lock because opening
 	// a seeker can be an expensive operation (validating index files)
-	seeker, err := m.newOpenSeekerFn(byTime.shard, start.ToTime())
+	seeker, volume, err := m.newOpenSeekerFn(byTime.shard, start.ToTime())
 	// Immediately re-lock once the seeker is open regardless of errors because
 	// thats the contract of this function
 	byTime.Lock()
@@ -331,6 +333,7 @@ func (m *seekerManager) getOrOpenSeekersWithLock(start xtime.UnixNano, byTime *s
 
 	seekers.wg = nil
 	seekers.seekers = borrowableSeekers
+	seekers.volume = volume
 	// Doesn't matter which seeker we pick to grab the bloom filter from, they all share the same underlying one.
 	// Use index 0 because its guaranteed to be there.
 	seekers.bloomFilter = borrowableSeekers[0].seeker.ConcurrentIDBloomFilter()
@@ -359,9 +362,10 @@ func (m *seekerManager) openAnyUnopenSeekers(byTime *seekersByTime) error {
 func (m *seekerManager) newOpenSeeker(
 	shard uint32,
 	blockStart time.Time,
-) (FileSetSeeker, error) {
-	if !FilesetExistsAt(m.filePathPrefix, m.namespace, shard, blockStart) {
-		return nil, errSeekerManagerFileSetNotFound
+) (FileSetSeeker, int, error) {
+	volume, ok := LatestFilesetVolume(m.filePathPrefix, m.namespace, shard, blockStart)
+	if !ok {
+		return nil, 0, errSeekerManagerFileSetNotFound
 	}
 
 	// NB(r): Use a lock on the unread buffer to avoid multiple
@@ -385,8 +389,8 @@ func (m *seekerManager) newOpenSeeker(
 	// Set the unread buffer to reuse it amongst all seekers.
 	seeker.setUnreadBuffer(m.unreadBuf.value)
 
-	if err := seeker.Open(m.namespace, shard, blockStart); err != nil {
-		return nil, err
+	if err := seeker.openVolume(m.namespace, shard, blockStart, volume); err != nil {
+		return nil, 0, err
 	}
 
 	// Retrieve the buffer, it may have changed due to
@@ -394,7 +398,7 @@ func (m *seekerManager) newOpenSeeker(
 	m.unreadBuf.value = seeker.unreadBuffer()
 	seeker.setUnreadBuffer(nil)
 
-	return seeker, nil
+	return seeker, volume, nil
 }
 
 func (m *seekerManager) seekersByTime(shard uint32) *seekersByTime {
@@ -483,6 +487,23 @@ func (m *seekerManager) latestSeekableBlockStart() time.Time {
 	return now.Truncate(ropts.BlockSize())
 }
 
+// newerVolumeExists returns whether a fileset volume newer than the one the
+// seekers were opened against has been completed, in which case the seekers
+// are closed once returned so the next borrow opens the latest volume. Volumes
+// are always written after the latest complete volume so only the next volume
+// needs to be checked.
+func (m *seekerManager) newerVolumeExists(
+	shard uint32,
+	blockStart time.Time,
+	seekers seekersAndBloom,
+) bool {
+	if seekers.wg != nil || len(seekers.seekers) == 0 {
+		// Still being opened
+		return false
+	}
+	return FilesetVolumeExistsAt(m.filePathPrefix, m.namespace, shard, blockStart, seekers.volume+1)
+}
+
 func (m *seekerManager) openCloseLoop() {
 	var (
 		shouldTryOpen []*seekersByTime
@@ -533,9 +554,10 @@ func (m *seekerManager) openCloseLoop() {
 		m.RLock()
 		for shard, byTime := range m.seekersByShardIdx {
 			byTime.RLock()
-			for blockStartNano := range byTime.seekers {
+			for blockStartNano, seekers := range byTime.seekers {
 				blockStart := blockStartNano.ToTime()
-				if blockStart.Before(earliestSeekableBlockStart) {
+				if blockStart.Before(earliestSeekableBlockStart) ||
+					m.newerVolumeExists(uint32(shard), blockStart, seekers) {
 					shouldClose = append(shouldClose, seekerManagerPendingClose{
 						shard:      uint32(shard),
 						blockStart: blockStart,
@@ -21,6 +21,7 @@
 package fs
 
 import (
+	"os"
 	"sync"
 	"testing"
 	"time"
@@ -79,7 +80,7 @@ func TestSeekerManagerBorrowOpenSeekersLazy(t *testing.T) {
 	m.newOpenSeekerFn = func(
 		shard uint32,
 		blockStart time.Time,
-	) (FileSetSeeker, error) {
+	) (FileSetSeeker, int, error) {
 		mock := NewMockFileSetSeeker(ctrl)
 		mock.EXPECT().Open(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 		mock.EXPECT().ConcurrentClone().Return(mock, nil)
@@ -87,7 +88,7 @@ func TestSeekerManagerBorrowOpenSeekersLazy(t *testing.T) {
 			mock.EXPECT().Close().Return(nil)
 			mock.EXPECT().ConcurrentIDBloomFilter().Return(nil)
 		}
-		return mock, nil
+		return mock, 0, nil
 	}
 
 	metadata := testNs1Metadata(t)
@@ -272,3 +273,38 @@ func TestSeekerManagerOpenCloseLoop(t *testing.T) {
 	// to prevent the test itself from interfering with the goroutine leak test
 	close(cleanupCh)
 }
+
+// TestSeekerManagerNewerVolume tests that seekers are opened against the latest
+// complete volume and detected as stale once a newer volume is complete.
+func TestSeekerManagerNewerVolume(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+
+	shard := uint32(0)
+	w := newTestWriter(t, dir)
+	entries := []testEntry{{"foo", []byte{1, 2, 3}}}
+	writeTestData(t, w, shard, testWriterStart, entries)
+	writeTestData(t, w, shard, testWriterStart, entries)
+
+	opts := NewOptions().SetFilePathPrefix(dir)
+	m := NewSeekerManager(nil, opts, NewBlockRetrieverOptions().FetchConcurrency()).(*seekerManager)
+	m.namespace = testNs1ID
+
+	seeker, volume, err := m.newOpenSeekerFn(shard, testWriterStart)
+	require.NoError(t, err)
+	require.Equal(t, 1, volume)
+	require.NoError(t, seeker.Close())
+
+	seekers := seekersAndBloom{
+		seekers: []borrowableSeeker{{}},
+		volume:  volume,
+	}
+	require.False(t, m.newerVolumeExists(shard, testWriterStart, seekers))
+
+	writeTestData(t, w, shard, testWriterStart, entries)
+	require.True(t, m.newerVolumeExists(shard, testWriterStart, seekers))
+
+	// Seekers still being opened are never considered stale
+	seekers.wg = &sync.WaitGroup{}
+	require.False(t, m.newerVolumeExists(shard, testWriterStart, seekers))
+}
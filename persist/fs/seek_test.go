@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3db/digest"
+	"github.com/m3db/m3db/x/serialize"
 	"github.com/m3db/m3x/ident"
 	"github.com/m3db/m3x/pool"
 
@@ -85,6 +86,7 @@ func TestSeekDataUnexpectedSize(t *testing.T) {
 
 	assert.NoError(t, w.Write(
 		ident.StringID("foo"),
+		nil,
 		bytesRefd([]byte{1, 2, 3}),
 		digest.Checksum([]byte{1, 2, 3})))
 	assert.NoError(t, w.Close())
@@ -118,6 +120,7 @@ func TestSeekBadChecksum(t *testing.T) {
 	// Write data with wrong checksum
 	assert.NoError(t, w.Write(
 		ident.StringID("foo"),
+		nil,
 		bytesRefd([]byte{1, 2, 3}),
 		digest.Checksum([]byte{1, 2, 4})))
 	assert.NoError(t, w.Close())
@@ -148,14 +151,17 @@ func TestSeek(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NoError(t, w.Write(
 		ident.StringID("foo1"),
+		nil,
 		bytesRefd([]byte{1, 2, 1}),
 		digest.Checksum([]byte{1, 2, 1})))
 	assert.NoError(t, w.Write(
 		ident.StringID("foo2"),
+		nil,
 		bytesRefd([]byte{1, 2, 2}),
 		digest.Checksum([]byte{1, 2, 2})))
 	assert.NoError(t, w.Write(
 		ident.StringID("foo3"),
+		nil,
 		bytesRefd([]byte{1, 2, 3}),
 		digest.Checksum([]byte{1, 2, 3})))
 	assert.NoError(t, w.Close())
@@ -192,6 +198,49 @@ func TestSeek(t *testing.T) {
 	assert.NoError(t, s.Close())
 }
 
+func TestSeekIndexEntryTags(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	filePathPrefix := filepath.Join(dir, "")
+	defer os.RemoveAll(dir)
+
+	tags := ident.Tags{
+		{Name: ident.StringID("city"), Value: ident.StringID("nyc")},
+	}
+	w := newTestWriter(t, filePathPrefix)
+	err = w.Open(testNs1ID, testBlockSize, 0, testWriterStart)
+	assert.NoError(t, err)
+	assert.NoError(t, w.Write(
+		ident.StringID("foo1"),
+		tags,
+		bytesRefd([]byte{1, 2, 1}),
+		digest.Checksum([]byte{1, 2, 1})))
+	assert.NoError(t, w.Write(
+		ident.StringID("foo2"),
+		nil,
+		bytesRefd([]byte{1, 2, 2}),
+		digest.Checksum([]byte{1, 2, 2})))
+	assert.NoError(t, w.Close())
+
+	s := newTestSeeker(filePathPrefix)
+	err = s.Open(testNs1ID, 0, testWriterStart)
+	assert.NoError(t, err)
+
+	entry, err := s.SeekIndexEntry(ident.StringID("foo1"))
+	require.NoError(t, err)
+	decoded, err := serialize.DecodeTags(entry.EncodedTags)
+	require.NoError(t, err)
+	requireTagsEqual(t, tags, decoded)
+
+	entry, err = s.SeekIndexEntry(ident.StringID("foo2"))
+	require.NoError(t, err)
+	require.Nil(t, entry.EncodedTags)
+
+	assert.NoError(t, s.Close())
+}
+
 // TestSeekIDNotExists is similar to TestSeek, but it covers more edge cases
 // around IDs not existing.
 func TestSeekIDNotExists(t *testing.T) {
@@ -207,14 +256,17 @@ func TestSeekIDNotExists(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NoError(t, w.Write(
 		ident.StringID("foo10"),
+		nil,
 		bytesRefd([]byte{1, 2, 1}),
 		digest.Checksum([]byte{1, 2, 1})))
 	assert.NoError(t, w.Write(
 		ident.StringID("foo20"),
+		nil,
 		bytesRefd([]byte{1, 2, 2}),
 		digest.Checksum([]byte{1, 2, 2})))
 	assert.NoError(t, w.Write(
 		ident.StringID("foo30"),
+		nil,
 		bytesRefd([]byte{1, 2, 3}),
 		digest.Checksum([]byte{1, 2, 3})))
 	assert.NoError(t, w.Close())
@@ -252,6 +304,7 @@ func TestReuseSeeker(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NoError(t, w.Write(
 		ident.StringID("foo"),
+		nil,
 		bytesRefd([]byte{1, 2, 1}),
 		digest.Checksum([]byte{1, 2, 1})))
 	assert.NoError(t, w.Close())
@@ -260,6 +313,7 @@ func TestReuseSeeker(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NoError(t, w.Write(
 		ident.StringID("foo"),
+		nil,
 		bytesRefd([]byte{1, 2, 3}),
 		digest.Checksum([]byte{1, 2, 3})))
 	assert.NoError(t, w.Close())
@@ -300,6 +354,7 @@ func TestCloneSeeker(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NoError(t, w.Write(
 		ident.StringID("foo"),
+		nil,
 		bytesRefd([]byte{1, 2, 1}),
 		digest.Checksum([]byte{1, 2, 1})))
 	assert.NoError(t, w.Close())
@@ -308,6 +363,7 @@ func TestCloneSeeker(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NoError(t, w.Write(
 		ident.StringID("foo"),
+		nil,
 		bytesRefd([]byte{1, 2, 3}),
 		digest.Checksum([]byte{1, 2, 3})))
 	assert.NoError(t, w.Close())
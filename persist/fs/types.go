@@ -27,6 +27,7 @@ import (
 
 	"github.com/m3db/m3db/clock"
 	"github.com/m3db/m3db/persist/fs/msgpack"
+	"github.com/m3db/m3db/persist/schema"
 	"github.com/m3db/m3db/runtime"
 	"github.com/m3db/m3db/storage/block"
 	"github.com/m3db/m3db/storage/namespace"
@@ -42,20 +43,54 @@ import (
 type FileSetWriter interface {
 	io.Closer
 
-	// Open opens the files for writing data to the given shard in the given namespace
+	// Open opens the files for writing data to the given shard in the given namespace,
+	// the data is written to a new volume that supersedes any existing complete volume
 	Open(namespace ident.ID, blockSize time.Duration, shard uint32, start time.Time) error
 
-	// Write will write the id and data pair and returns an error on a write error
-	Write(id ident.ID, data checked.Bytes, checksum uint32) error
+	// OpenSnapshot opens the files for writing a snapshot of the given shard in the given
+	// namespace to the snapshots directory, recording the time the snapshot was taken at
+	OpenSnapshot(namespace ident.ID, blockSize time.Duration, shard uint32, start time.Time, snapshotTime time.Time) error
 
-	// WriteAll will write the id and all byte slices and returns an error on a write error
-	WriteAll(id ident.ID, data []checked.Bytes, checksum uint32) error
+	// Write will write the id, tags and data and returns an error on a write error
+	Write(id ident.ID, tags ident.Tags, data checked.Bytes, checksum uint32) error
+
+	// WriteAll will write the id, tags and all byte slices and returns an error on a write error
+	WriteAll(id ident.ID, tags ident.Tags, data []checked.Bytes, checksum uint32) error
+}
+
+// IndexFileSetWriter provides an unsynchronized writer for a reverse index file set
+type IndexFileSetWriter interface {
+	io.Closer
+
+	// Open opens the files for writing the reverse index of the given namespace and block start
+	Open(namespace ident.ID, blockSize time.Duration, blockStart time.Time) error
+
+	// Write will write the id and tags of a series and returns an error on a write error
+	Write(id ident.ID, tags ident.Tags) error
+}
+
+// IndexFileSetReader provides an unsynchronized reader for a reverse index file set
+type IndexFileSetReader interface {
+	io.Closer
+
+	// Open opens the files for the reverse index of the given namespace and block start
+	Open(namespace ident.ID, blockStart time.Time) error
+
+	// Read returns the next id and tags or error, will return io.EOF at end of volume
+	Read() (id ident.ID, tags ident.Tags, err error)
+
+	// Range returns the time range associated with the volume
+	Range() xtime.Range
+
+	// Entries returns the count of entries in the volume
+	Entries() int
 }
 
 // FileSetReaderStatus describes the status of a file set reader
 type FileSetReaderStatus struct {
 	Namespace  ident.ID
 	BlockStart time.Time
+	Volume     int
 
 	Shard uint32
 	Open  bool
@@ -65,19 +100,22 @@ type FileSetReaderStatus struct {
 type FileSetReader interface {
 	io.Closer
 
-	// Open opens the files for the given shard and version for reading
+	// Open opens the files of the latest complete volume for the given shard and block start for reading
 	Open(namespace ident.ID, shard uint32, start time.Time) error
 
+	// OpenSnapshot opens the files of the latest complete snapshot for the given shard and block start for reading
+	OpenSnapshot(namespace ident.ID, shard uint32, start time.Time) error
+
 	// Status returns the status of the reader
 	Status() FileSetReaderStatus
 
-	// Read returns the next id, data, checksum tuple or error, will return io.EOF at end of volume.
+	// Read returns the next id, tags, data, checksum tuple or error, will return io.EOF at end of volume.
 	// Use either Read or ReadMetadata to progress through a volume, but not both.
-	Read() (id ident.ID, data checked.Bytes, checksum uint32, err error)
+	Read() (id ident.ID, tags ident.Tags, data checked.Bytes, checksum uint32, err error)
 
-	// ReadMetadata returns the next id and metadata or error, will return io.EOF at end of volume.
+	// ReadMetadata returns the next id, tags and metadata or error, will return io.EOF at end of volume.
 	// Use either Read or ReadMetadata to progress through a volume, but not both.
-	ReadMetadata() (id ident.ID, length int, checksum uint32, err error)
+	ReadMetadata() (id ident.ID, tags ident.Tags, length int, checksum uint32, err error)
 
 	// ReadBloomFilter returns the bloom filter stored on disk in a container object that is safe
 	// for concurrent use and has a Close() method for releasing resources when done.
@@ -109,7 +147,7 @@ type FileSetReader interface {
 type FileSetSeeker interface {
 	io.Closer
 
-	// Open opens the files for the given shard and version for reading
+	// Open opens the files of the latest complete volume for the given shard and block start for reading
 	Open(namespace ident.ID, shard uint32, start time.Time) error
 
 	// SeekByID returns the data for specified ID provided the index was loaded upon open. An
@@ -201,6 +239,21 @@ type RetrievableBlockSegmentReader interface {
 	xio.SegmentReader
 }
 
+// BlockCodec encodes and decodes the blocks of series data written to
+// fileset data files, implementations must be safe for concurrent use
+type BlockCodec interface {
+	// Info returns the codec info recorded in the info file of filesets
+	// written with the codec
+	Info() schema.IndexCodecInfo
+
+	// Encode encodes a block to be written to a data file
+	Encode(block []byte) ([]byte, error)
+
+	// Decode decodes a block read from the data file of a fileset written
+	// with the codec described by the codec info
+	Decode(info schema.IndexCodecInfo, block []byte) ([]byte, error)
+}
+
 // Options represents the options for filesystem persistence
 type Options interface {
 	// Validate will validate the options and return an error if not valid
@@ -297,6 +350,14 @@ type Options interface {
 
 	// MmapHugeTLBThreshold returns the threshold when to use mmap huge pages for mmap'd files on linux
 	MmapHugeTLBThreshold() int64
+
+	// SetBlockCodec sets the codec used to encode blocks written to data files
+	// and to decode blocks read from data files
+	SetBlockCodec(value BlockCodec) Options
+
+	// BlockCodec returns the codec used to encode blocks written to data files
+	// and to decode blocks read from data files
+	BlockCodec() BlockCodec
 }
 
 // BlockRetrieverOptions represents the options for block retrieval
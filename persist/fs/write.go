@@ -22,6 +22,7 @@ package fs
 
 import (
 	"bytes"
+	"encoding/binary"
 	"math"
 	"os"
 	"sort"
@@ -31,6 +32,7 @@ import (
 	"github.com/m3db/m3db/digest"
 	"github.com/m3db/m3db/persist/fs/msgpack"
 	"github.com/m3db/m3db/persist/schema"
+	"github.com/m3db/m3db/x/serialize"
 	"github.com/m3db/m3x/checked"
 	"github.com/m3db/m3x/ident"
 	xtime "github.com/m3db/m3x/time"
@@ -44,6 +46,8 @@ type writer struct {
 
 	summariesPercent                float64
 	bloomFilterFalsePositivePercent float64
+	blockCodec                      BlockCodec
+	encodeBlocks                    bool
 
 	infoFdWithDigest           digest.FdWithDigestWriter
 	indexFdWithDigest          digest.FdWithDigestWriter
@@ -54,17 +58,21 @@ type writer struct {
 	checkpointFilePath         string
 	indexEntries               indexEntries
 
-	start      time.Time
-	currIdx    int64
-	currOffset int64
-	encoder    *msgpack.Encoder
-	digestBuf  digest.Buffer
-	err        error
+	start        time.Time
+	snapshotTime time.Time
+	currIdx      int64
+	currOffset   int64
+	encoder      *msgpack.Encoder
+	digestBuf    digest.Buffer
+	blockBuf     []byte
+	lengthBuf    [binary.MaxVarintLen64]byte
+	err          error
 }
 
 type indexEntry struct {
 	index           int64
 	id              ident.ID
+	encodedTags     []byte
 	dataFileOffset  int64
 	indexFileOffset int64
 	size            uint32
@@ -103,6 +111,8 @@ func NewWriter(opts Options) (FileSetWriter, error) {
 		newDirectoryMode:                opts.NewDirectoryMode(),
 		summariesPercent:                opts.IndexSummariesPercent(),
 		bloomFilterFalsePositivePercent: opts.IndexBloomFilterFalsePositivePercent(),
+		blockCodec:                      opts.BlockCodec(),
+		encodeBlocks:                    blockCodecInfoEncodes(opts.BlockCodec().Info()),
 		infoFdWithDigest:                digest.NewFdWithDigestWriter(bufferSize),
 		indexFdWithDigest:               digest.NewFdWithDigestWriter(bufferSize),
 		summariesFdWithDigest:           digest.NewFdWithDigestWriter(bufferSize),
@@ -123,27 +133,57 @@ func (w *writer) Open(
 	shard uint32,
 	blockStart time.Time,
 ) error {
+	// NB: Each open writes a new volume after the latest complete one, the
+	// volume only becomes visible to readers once its checkpoint file is
+	// written on close so an existing fileset is superseded atomically.
 	shardDir := ShardDirPath(w.filePathPrefix, namespace, shard)
+	volume := NextFilesetVolume(w.filePathPrefix, namespace, shard, blockStart)
+	return w.open(shardDir, volume, blockSize, blockStart, time.Time{})
+}
+
+// OpenSnapshot initializes the internal state for writing a snapshot of the
+// given shard taken at the snapshot time, the snapshot is written to the shard
+// snapshots directory as a new volume superseding any previous snapshot.
+func (w *writer) OpenSnapshot(
+	namespace ident.ID,
+	blockSize time.Duration,
+	shard uint32,
+	blockStart time.Time,
+	snapshotTime time.Time,
+) error {
+	shardDir := ShardSnapshotsDirPath(w.filePathPrefix, namespace, shard)
+	volume := NextSnapshotVolume(w.filePathPrefix, namespace, shard, blockStart)
+	return w.open(shardDir, volume, blockSize, blockStart, snapshotTime)
+}
+
+func (w *writer) open(
+	shardDir string,
+	volume int,
+	blockSize time.Duration,
+	blockStart time.Time,
+	snapshotTime time.Time,
+) error {
 	if err := os.MkdirAll(shardDir, w.newDirectoryMode); err != nil {
 		return err
 	}
 	w.blockSize = blockSize
 	w.start = blockStart
+	w.snapshotTime = snapshotTime
 	w.currIdx = 0
 	w.currOffset = 0
-	w.checkpointFilePath = filesetPathFromTime(shardDir, blockStart, checkpointFileSuffix)
+	w.checkpointFilePath = filesetPathFromTimeAndVolume(shardDir, blockStart, volume, checkpointFileSuffix)
 	w.err = nil
 
 	var infoFd, indexFd, summariesFd, bloomFilterFd, dataFd, digestFd *os.File
 	if err := openFiles(
 		w.openWritable,
 		map[string]**os.File{
-			filesetPathFromTime(shardDir, blockStart, infoFileSuffix):        &infoFd,
-			filesetPathFromTime(shardDir, blockStart, indexFileSuffix):       &indexFd,
-			filesetPathFromTime(shardDir, blockStart, summariesFileSuffix):   &summariesFd,
-			filesetPathFromTime(shardDir, blockStart, bloomFilterFileSuffix): &bloomFilterFd,
-			filesetPathFromTime(shardDir, blockStart, dataFileSuffix):        &dataFd,
-			filesetPathFromTime(shardDir, blockStart, digestFileSuffix):      &digestFd,
+			filesetPathFromTimeAndVolume(shardDir, blockStart, volume, infoFileSuffix):        &infoFd,
+			filesetPathFromTimeAndVolume(shardDir, blockStart, volume, indexFileSuffix):       &indexFd,
+			filesetPathFromTimeAndVolume(shardDir, blockStart, volume, summariesFileSuffix):   &summariesFd,
+			filesetPathFromTimeAndVolume(shardDir, blockStart, volume, bloomFilterFileSuffix): &bloomFilterFd,
+			filesetPathFromTimeAndVolume(shardDir, blockStart, volume, dataFileSuffix):        &dataFd,
+			filesetPathFromTimeAndVolume(shardDir, blockStart, volume, digestFileSuffix):      &digestFd,
 		},
 	); err != nil {
 		return err
@@ -173,14 +213,16 @@ func (w *writer) writeData(data []byte) error {
 
 func (w *writer) Write(
 	id ident.ID,
+	tags ident.Tags,
 	data checked.Bytes,
 	checksum uint32,
 ) error {
-	return w.WriteAll(id, []checked.Bytes{data}, checksum)
+	return w.WriteAll(id, tags, []checked.Bytes{data}, checksum)
 }
 
 func (w *writer) WriteAll(
 	id ident.ID,
+	tags ident.Tags,
 	data []checked.Bytes,
 	checksum uint32,
 ) error {
@@ -188,7 +230,7 @@ func (w *writer) WriteAll(
 		return w.err
 	}
 
-	if err := w.writeAll(id, data, checksum); err != nil {
+	if err := w.writeAll(id, tags, data, checksum); err != nil {
 		w.err = err
 		return err
 	}
@@ -197,6 +239,7 @@ func (w *writer) WriteAll(
 
 func (w *writer) writeAll(
 	id ident.ID,
+	tags ident.Tags,
 	data []checked.Bytes,
 	checksum uint32,
 ) error {
@@ -211,20 +254,42 @@ func (w *writer) writeAll(
 		return nil
 	}
 
+	encodedTags, err := serialize.EncodeTags(tags)
+	if err != nil {
+		return err
+	}
+
 	entry := indexEntry{
 		index:          w.currIdx,
 		id:             id,
+		encodedTags:    encodedTags,
 		dataFileOffset: w.currOffset,
 		size:           uint32(size),
 		checksum:       checksum,
 	}
-	for _, d := range data {
-		if d == nil {
-			continue
+	if w.encodeBlocks {
+		// NB: Encoded blocks are prefixed with their encoded length so that
+		// the index entry size and checksum remain those of the block as is.
+		encoded, err := w.encodeBlock(data)
+		if err != nil {
+			return err
 		}
-		if err := w.writeData(d.Get()); err != nil {
+		n := binary.PutUvarint(w.lengthBuf[:], uint64(len(encoded)))
+		if err := w.writeData(w.lengthBuf[:n]); err != nil {
 			return err
 		}
+		if err := w.writeData(encoded); err != nil {
+			return err
+		}
+	} else {
+		for _, d := range data {
+			if d == nil {
+				continue
+			}
+			if err := w.writeData(d.Get()); err != nil {
+				return err
+			}
+		}
 	}
 
 	w.indexEntries = append(w.indexEntries, entry)
@@ -233,6 +298,17 @@ func (w *writer) writeAll(
 	return nil
 }
 
+func (w *writer) encodeBlock(data []checked.Bytes) ([]byte, error) {
+	w.blockBuf = w.blockBuf[:0]
+	for _, d := range data {
+		if d == nil {
+			continue
+		}
+		w.blockBuf = append(w.blockBuf, d.Get()...)
+	}
+	return w.blockCodec.Encode(w.blockBuf)
+}
+
 func (w *writer) Close() error {
 	err := w.close()
 	if w.err != nil {
@@ -350,11 +426,12 @@ func (w *writer) writeIndexFileContents(
 		id := w.indexEntries[i].id.Data().Get()
 
 		entry := schema.IndexEntry{
-			Index:    w.indexEntries[i].index,
-			ID:       id,
-			Size:     int64(w.indexEntries[i].size),
-			Offset:   w.indexEntries[i].dataFileOffset,
-			Checksum: int64(w.indexEntries[i].checksum),
+			Index:       w.indexEntries[i].index,
+			ID:          id,
+			Size:        int64(w.indexEntries[i].size),
+			Offset:      w.indexEntries[i].dataFileOffset,
+			Checksum:    int64(w.indexEntries[i].checksum),
+			EncodedTags: w.indexEntries[i].encodedTags,
 		}
 
 		w.encoder.Reset()
@@ -438,6 +515,12 @@ func (w *writer) writeInfoFileContents(
 			NumHashesK:   int64(bloomFilter.K()),
 		},
 	}
+	if !w.snapshotTime.IsZero() {
+		info.SnapshotTime = xtime.ToNanoseconds(w.snapshotTime)
+	}
+	if w.encodeBlocks {
+		info.Codec = w.blockCodec.Info()
+	}
 
 	w.encoder.Reset()
 	if err := w.encoder.EncodeIndexInfo(info); err != nil {
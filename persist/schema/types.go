@@ -33,6 +33,8 @@ type IndexInfo struct {
 	MajorVersion int64
 	Summaries    IndexSummariesInfo
 	BloomFilter  IndexBloomFilterInfo
+	SnapshotTime int64
+	Codec        IndexCodecInfo
 }
 
 // IndexSummariesInfo stores metadata about the summaries
@@ -46,13 +48,22 @@ type IndexBloomFilterInfo struct {
 	NumHashesK   int64
 }
 
+// IndexCodecInfo stores metadata about the codec used to encode the blocks
+// of the data file, the zero value denotes blocks written as is
+type IndexCodecInfo struct {
+	Compression         int64
+	Encryption          int64
+	EncryptionKeyDigest int64
+}
+
 // IndexEntry stores entry-level data indexing
 type IndexEntry struct {
-	Index    int64
-	ID       []byte
-	Size     int64
-	Offset   int64
-	Checksum int64
+	Index       int64
+	ID          []byte
+	Size        int64
+	Offset      int64
+	Checksum    int64
+	EncodedTags []byte
 }
 
 // IndexSummary stores a summary of an index entry to lookup
@@ -62,11 +73,40 @@ type IndexSummary struct {
 	IndexEntryOffset int64
 }
 
+// IndexVolumeInfo stores metadata information about reverse index filesets
+type IndexVolumeInfo struct {
+	BlockStart   int64
+	BlockSize    int64
+	Entries      int64
+	MajorVersion int64
+}
+
+// IndexDocument stores a series ID and its tags in a reverse index fileset
+type IndexDocument struct {
+	ID   []byte
+	Tags []IndexTag
+}
+
+// IndexTag stores a single tag name and value pair of an index document
+type IndexTag struct {
+	Name  []byte
+	Value []byte
+}
+
 // LogInfo stores summary information about a commit log
 type LogInfo struct {
 	Start    int64
 	Duration int64
 	Index    int64
+	Codec    LogCodecInfo
+}
+
+// LogCodecInfo stores metadata about the codec used to encode the chunks
+// of a commit log, the zero value denotes chunks written as is
+type LogCodecInfo struct {
+	Compression         int64
+	Encryption          int64
+	EncryptionKeyDigest int64
 }
 
 // LogEntry stores per-entry data in a commit log
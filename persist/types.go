@@ -28,8 +28,8 @@ import (
 	"github.com/m3db/m3x/ident"
 )
 
-// Fn is a function that persists a m3db segment for a given ID.
-type Fn func(id ident.ID, segment ts.Segment, checksum uint32) error
+// Fn is a function that persists a m3db segment for a given ID and tags.
+type Fn func(id ident.ID, tags ident.Tags, segment ts.Segment, checksum uint32) error
 
 // Closer is a function that performs cleanup after persisting the data
 // blocks for a (shard, blockStart) combination.
@@ -41,9 +41,20 @@ type PreparedPersist struct {
 	Close   Closer
 }
 
+// IndexFn is a function that persists the reverse index entry of a series.
+type IndexFn func(id ident.ID, tags ident.Tags) error
+
+// PreparedIndexPersist is an object that wraps holds an index persist
+// function and a closer.
+type PreparedIndexPersist struct {
+	Persist IndexFn
+	Close   Closer
+}
+
 // Manager manages the internals of persisting data onto storage layer.
 type Manager interface {
-	// StartFlush begins a flush for a set of shards.
+	// StartFlush begins a flush for a set of shards, waiting for any flush
+	// already in progress to be done.
 	StartFlush() (Flush, error)
 }
 
@@ -55,6 +66,23 @@ type Flush interface {
 	// preparation if any.
 	Prepare(ns namespace.Metadata, shard uint32, blockStart time.Time) (PreparedPersist, error)
 
+	// PrepareVolume prepares writing data for a given (shard, blockStart)
+	// combination to a new fileset volume that supersedes any existing
+	// fileset once complete, this is used to rewrite already flushed data.
+	PrepareVolume(ns namespace.Metadata, shard uint32, blockStart time.Time) (PreparedPersist, error)
+
+	// PrepareSnapshot prepares writing a snapshot of the unflushed data for a
+	// given (shard, blockStart) combination taken at the snapshot time, the
+	// snapshot supersedes any previous snapshot for the same combination.
+	PrepareSnapshot(ns namespace.Metadata, shard uint32, blockStart time.Time, snapshotTime time.Time) (PreparedPersist, error)
+
+	// PrepareIndex prepares writing the reverse index for a given
+	// (namespace, blockStart) combination to a new volume, returning a
+	// PreparedIndexPersist object and any error encountered during
+	// preparation if any. The new volume supersedes any previous volume
+	// for the same combination.
+	PrepareIndex(ns namespace.Metadata, blockStart time.Time) (PreparedIndexPersist, error)
+
 	// Done marks the flush as complete.
 	Done() error
 }
@@ -0,0 +1,497 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package promql
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/m3db/m3db/encoding"
+	"github.com/m3db/m3db/storage/index"
+	"github.com/m3db/m3ninx/index/segment"
+	"github.com/m3db/m3x/ident"
+)
+
+const (
+	// lookbackDelta is how far back an instant vector selector looks
+	// for the latest point of a series at each step.
+	lookbackDelta = 5 * time.Minute
+
+	// maxPointsPerSeries is the most steps a range query may evaluate.
+	maxPointsPerSeries = 11000
+)
+
+var (
+	errStepNotPositive     = errors.New("step must be positive")
+	errEndBeforeStart      = errors.New("end must not be before start")
+	errTooManyPoints       = fmt.Errorf("exceeded maximum resolution of %d points per series", maxPointsPerSeries)
+	errRangeQueryNotVector = errors.New("range query must evaluate to an instant vector")
+)
+
+// Fetcher fetches the series matching index queries.
+type Fetcher interface {
+	// FetchTagged resolves the provided query to known IDs, and fetches the data for them.
+	FetchTagged(q index.Query, opts index.QueryOptions) (encoding.SeriesIterators, bool, error)
+
+	// FetchTaggedIDs resolves the provided query to known IDs.
+	FetchTaggedIDs(q index.Query, opts index.QueryOptions) (index.QueryResults, error)
+}
+
+// Engine evaluates queries against the series returned by a fetcher.
+type Engine interface {
+	// QueryRange evaluates the query at each step from start to end inclusive.
+	QueryRange(query string, start, end time.Time, step time.Duration) (Matrix, error)
+
+	// Series returns the labels of the series matching any of the selectors
+	// with data between start and end.
+	Series(selectors []string, start, end time.Time) ([]Labels, error)
+}
+
+type engine struct {
+	fetcher Fetcher
+}
+
+// NewEngine creates a new query engine.
+func NewEngine(fetcher Fetcher) Engine {
+	return &engine{fetcher: fetcher}
+}
+
+// ValidateRange returns an error if a range query from start to end at the
+// step would be rejected, the step must be positive, end must not be before
+// start and the query must not evaluate too many steps.
+func ValidateRange(start, end time.Time, step time.Duration) error {
+	if step <= 0 {
+		return errStepNotPositive
+	}
+	if end.Before(start) {
+		return errEndBeforeStart
+	}
+	if end.Sub(start)/step >= maxPointsPerSeries {
+		return errTooManyPoints
+	}
+	return nil
+}
+
+func (e *engine) QueryRange(
+	query string,
+	start, end time.Time,
+	step time.Duration,
+) (Matrix, error) {
+	if err := ValidateRange(start, end, step); err != nil {
+		return nil, err
+	}
+
+	expr, err := ParseExpr(query)
+	if err != nil {
+		return nil, err
+	}
+	if expr.valueType() != valueTypeVector {
+		return nil, errRangeQueryNotVector
+	}
+
+	ev := &evaluator{
+		fetcher: e.fetcher,
+		start:   start,
+		end:     end,
+		step:    step,
+		steps:   int(end.Sub(start)/step) + 1,
+	}
+	return ev.eval(expr)
+}
+
+func (e *engine) Series(selectors []string, start, end time.Time) ([]Labels, error) {
+	var (
+		seen   = make(map[string]struct{})
+		result []Labels
+	)
+	for _, selector := range selectors {
+		matchers, err := ParseMatchers(selector)
+		if err != nil {
+			return nil, err
+		}
+		compiled, err := CompileMatchers(matchers)
+		if err != nil {
+			return nil, err
+		}
+
+		results, err := e.fetcher.FetchTaggedIDs(ToIndexQuery(matchers), index.QueryOptions{
+			StartInclusive: start,
+			EndExclusive:   end.Add(time.Nanosecond),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		iter := results.Iter
+		for iter.Next() {
+			_, _, tags := iter.Current()
+			labels, err := toLabels(tags)
+			if err != nil {
+				return nil, err
+			}
+			if !compiled.Matches(labels) {
+				continue
+			}
+			key := labels.key()
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			result = append(result, labels)
+		}
+		if err := iter.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].key() < result[j].key()
+	})
+	return result, nil
+}
+
+type evaluator struct {
+	fetcher Fetcher
+	start   time.Time
+	end     time.Time
+	step    time.Duration
+	steps   int
+}
+
+func (ev *evaluator) stepTime(i int) time.Time {
+	return ev.start.Add(time.Duration(i) * ev.step)
+}
+
+func (ev *evaluator) eval(expr Expr) (Matrix, error) {
+	switch e := expr.(type) {
+	case *VectorSelector:
+		return ev.evalVectorSelector(e)
+	case *Call:
+		return ev.evalCall(e)
+	case *AggregateExpr:
+		return ev.evalAggregateExpr(e)
+	}
+	return nil, fmt.Errorf("unsupported expression %s", expr)
+}
+
+// evalVectorSelector evaluates each series at each step as the latest
+// point within the lookback delta of the step.
+func (ev *evaluator) evalVectorSelector(selector *VectorSelector) (Matrix, error) {
+	series, err := ev.fetch(selector, ev.start.Add(-lookbackDelta))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(Matrix, 0, len(series))
+	for _, s := range series {
+		var (
+			points []Point
+			idx    = -1
+		)
+		for i := 0; i < ev.steps; i++ {
+			t := ev.stepTime(i)
+			for idx+1 < len(s.Points) && !s.Points[idx+1].T.After(t) {
+				idx++
+			}
+			if idx < 0 || !s.Points[idx].T.After(t.Add(-lookbackDelta)) {
+				continue
+			}
+			points = append(points, Point{T: t, V: s.Points[idx].V})
+		}
+		if len(points) > 0 {
+			result = append(result, Series{Labels: s.Labels, Points: points})
+		}
+	}
+	return result, nil
+}
+
+// evalCall evaluates the function at each step over the points of each
+// series within the range preceding the step.
+func (ev *evaluator) evalCall(call *Call) (Matrix, error) {
+	selector, ok := call.Arg.(*VectorSelector)
+	if !ok || selector.Range <= 0 {
+		return nil, fmt.Errorf("expected range vector selector in call to function %q", call.Func.Name)
+	}
+
+	series, err := ev.fetch(selector, ev.start.Add(-selector.Range))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(Matrix, 0, len(series))
+	for _, s := range series {
+		var (
+			points   []Point
+			from, to int
+		)
+		for i := 0; i < ev.steps; i++ {
+			t := ev.stepTime(i)
+			rangeStart := t.Add(-selector.Range)
+			for to < len(s.Points) && !s.Points[to].T.After(t) {
+				to++
+			}
+			for from < to && !s.Points[from].T.After(rangeStart) {
+				from++
+			}
+			v, ok := call.Func.fn(s.Points[from:to], rangeStart, t)
+			if !ok {
+				continue
+			}
+			points = append(points, Point{T: t, V: v})
+		}
+		if len(points) > 0 {
+			result = append(result, Series{
+				Labels: s.Labels.without(MetricNameLabel),
+				Points: points,
+			})
+		}
+	}
+	return result, nil
+}
+
+type aggregateGroup struct {
+	labels Labels
+	steps  []aggregateStep
+}
+
+type aggregateStep struct {
+	count int
+	value float64
+}
+
+// evalAggregateExpr aggregates the series of the expression at each step,
+// grouped by the labels of the aggregation.
+func (ev *evaluator) evalAggregateExpr(agg *AggregateExpr) (Matrix, error) {
+	inner, err := ev.eval(agg.Expr)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		groupsByKey = make(map[string]*aggregateGroup)
+		groups      []*aggregateGroup
+		without     = append(append([]string(nil), agg.Grouping...), MetricNameLabel)
+	)
+	for _, s := range inner {
+		var labels Labels
+		if agg.Without {
+			labels = s.Labels.without(without...)
+		} else {
+			labels = s.Labels.only(agg.Grouping...)
+		}
+
+		key := labels.key()
+		group, ok := groupsByKey[key]
+		if !ok {
+			group = &aggregateGroup{
+				labels: labels,
+				steps:  make([]aggregateStep, ev.steps),
+			}
+			groupsByKey[key] = group
+			groups = append(groups, group)
+		}
+
+		for _, p := range s.Points {
+			step := &group.steps[int(p.T.Sub(ev.start)/ev.step)]
+			aggregate(agg.Op, step, p.V)
+		}
+	}
+
+	result := make(Matrix, 0, len(groups))
+	for _, group := range groups {
+		var points []Point
+		for i, step := range group.steps {
+			if step.count == 0 {
+				continue
+			}
+			v := step.value
+			switch agg.Op {
+			case AggregateAvg:
+				v = v / float64(step.count)
+			case AggregateCount:
+				v = float64(step.count)
+			}
+			points = append(points, Point{T: ev.stepTime(i), V: v})
+		}
+		result = append(result, Series{Labels: group.labels, Points: points})
+	}
+	return result, nil
+}
+
+func aggregate(op AggregateOp, step *aggregateStep, v float64) {
+	step.count++
+	if step.count == 1 {
+		step.value = v
+		return
+	}
+	switch op {
+	case AggregateSum, AggregateAvg:
+		step.value += v
+	case AggregateMin:
+		if v < step.value {
+			step.value = v
+		}
+	case AggregateMax:
+		if v > step.value {
+			step.value = v
+		}
+	}
+}
+
+// fetch fetches the points of the series matching the selector from the
+// given start to the end of the evaluation.
+func (ev *evaluator) fetch(selector *VectorSelector, start time.Time) ([]Series, error) {
+	matchers, err := CompileMatchers(selector.Matchers)
+	if err != nil {
+		return nil, err
+	}
+
+	iters, _, err := ev.fetcher.FetchTagged(ToIndexQuery(selector.Matchers), index.QueryOptions{
+		StartInclusive: start,
+		EndExclusive:   ev.end.Add(time.Nanosecond),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer iters.Close()
+
+	var result []Series
+	for _, iter := range iters.Iters() {
+		labels, err := toLabels(iter.Tags())
+		if err != nil {
+			return nil, err
+		}
+		if !matchers.Matches(labels) {
+			continue
+		}
+
+		var points []Point
+		for iter.Next() {
+			dp, _, _ := iter.Current()
+			points = append(points, Point{T: dp.Timestamp, V: dp.Value})
+		}
+		if err := iter.Err(); err != nil {
+			return nil, err
+		}
+		result = append(result, Series{Labels: labels, Points: points})
+	}
+	return result, nil
+}
+
+func toLabels(tags ident.TagIterator) (Labels, error) {
+	var labels Labels
+	for tags.Next() {
+		tag := tags.Current()
+		labels = append(labels, Label{
+			Name:  tag.Name.String(),
+			Value: tag.Value.String(),
+		})
+	}
+	if err := tags.Err(); err != nil {
+		return nil, err
+	}
+	return NewLabels(labels...), nil
+}
+
+// ToIndexQuery converts the matchers that can be resolved by the index to
+// an index query, matchers that match empty values are only applied once
+// the series are fetched. The matchers must have been validated with
+// CompileMatchers beforehand.
+func ToIndexQuery(matchers []LabelMatcher) index.Query {
+	query := segment.Query{Conjunction: segment.AndConjunction}
+	for _, m := range matchers {
+		if m.Value == "" {
+			continue
+		}
+		filter := segment.Filter{
+			FieldName:        []byte(m.Name),
+			FieldValueFilter: []byte(m.Value),
+			Negate:           m.Type == MatchNotEqual || m.Type == MatchNotRegexp,
+			Regexp:           m.Type == MatchRegexp || m.Type == MatchNotRegexp,
+		}
+		if filter.Regexp {
+			re := regexp.MustCompile(anchorRegexp(m.Value))
+			if re.MatchString("") {
+				continue
+			}
+			filter.FieldValueFilter = []byte(anchorRegexp(m.Value))
+		}
+		query.Filters = append(query.Filters, filter)
+	}
+	return index.Query{Query: query}
+}
+
+func anchorRegexp(re string) string {
+	return "^(?:" + re + ")$"
+}
+
+type compiledMatcher struct {
+	LabelMatcher
+
+	re *regexp.Regexp
+}
+
+// CompiledMatchers are label matchers with their regular expressions compiled.
+type CompiledMatchers []compiledMatcher
+
+// CompileMatchers compiles the regular expressions of the matchers.
+func CompileMatchers(matchers []LabelMatcher) (CompiledMatchers, error) {
+	compiled := make(CompiledMatchers, 0, len(matchers))
+	for _, m := range matchers {
+		c := compiledMatcher{LabelMatcher: m}
+		if m.Type == MatchRegexp || m.Type == MatchNotRegexp {
+			re, err := regexp.Compile(anchorRegexp(m.Value))
+			if err != nil {
+				return nil, err
+			}
+			c.re = re
+		}
+		compiled = append(compiled, c)
+	}
+	return compiled, nil
+}
+
+// Matches returns whether the labels match all matchers, with missing
+// labels matching as empty values.
+func (m CompiledMatchers) Matches(labels Labels) bool {
+	values := labels.Map()
+	for _, matcher := range m {
+		value := values[matcher.Name]
+		var matched bool
+		switch matcher.Type {
+		case MatchEqual:
+			matched = value == matcher.Value
+		case MatchNotEqual:
+			matched = value != matcher.Value
+		case MatchRegexp:
+			matched = matcher.re.MatchString(value)
+		case MatchNotRegexp:
+			matched = !matcher.re.MatchString(value)
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
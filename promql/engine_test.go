@@ -0,0 +1,368 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package promql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3db/encoding"
+	"github.com/m3db/m3db/storage/index"
+	"github.com/m3db/m3db/ts"
+	"github.com/m3db/m3ninx/index/segment"
+	"github.com/m3db/m3x/ident"
+	xtime "github.com/m3db/m3x/time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testSeries struct {
+	tags   ident.Tags
+	points []Point
+}
+
+type testFetcher struct {
+	series  []testSeries
+	queries []index.Query
+}
+
+func (f *testFetcher) FetchTagged(
+	q index.Query,
+	opts index.QueryOptions,
+) (encoding.SeriesIterators, bool, error) {
+	f.queries = append(f.queries, q)
+	iters := make([]encoding.SeriesIterator, 0, len(f.series))
+	for _, s := range f.series {
+		var points []Point
+		for _, p := range s.points {
+			if !p.T.Before(opts.StartInclusive) && p.T.Before(opts.EndExclusive) {
+				points = append(points, p)
+			}
+		}
+		iters = append(iters, &testSeriesIterator{tags: s.tags, points: points, idx: -1})
+	}
+	return encoding.NewSeriesIterators(iters, nil), true, nil
+}
+
+func (f *testFetcher) FetchTaggedIDs(
+	q index.Query,
+	opts index.QueryOptions,
+) (index.QueryResults, error) {
+	f.queries = append(f.queries, q)
+	results := index.NewResults(ident.StringID("testns"))
+	for i, s := range f.series {
+		results.Add(ident.StringID(string(rune('a'+i))), s.tags)
+	}
+	return index.QueryResults{Iter: results.Iter(), Exhaustive: true}, nil
+}
+
+type testSeriesIterator struct {
+	encoding.SeriesIterator
+
+	tags   ident.Tags
+	points []Point
+	idx    int
+}
+
+func (it *testSeriesIterator) Next() bool {
+	it.idx++
+	return it.idx < len(it.points)
+}
+
+func (it *testSeriesIterator) Current() (ts.Datapoint, xtime.Unit, ts.Annotation) {
+	p := it.points[it.idx]
+	return ts.Datapoint{Timestamp: p.T, Value: p.V}, xtime.Second, nil
+}
+
+func (it *testSeriesIterator) Err() error              { return nil }
+func (it *testSeriesIterator) Close()                  {}
+func (it *testSeriesIterator) Tags() ident.TagIterator { return ident.NewTagSliceIterator(it.tags) }
+
+func testTags(nameValues ...string) ident.Tags {
+	var tags ident.Tags
+	for i := 0; i < len(nameValues); i += 2 {
+		tags = append(tags, ident.Tag{
+			Name:  ident.StringID(nameValues[i]),
+			Value: ident.StringID(nameValues[i+1]),
+		})
+	}
+	return tags
+}
+
+// testCounter returns points every ten seconds from start to end increasing
+// by the given rate per second.
+func testCounter(start, end time.Time, rate float64) []Point {
+	var points []Point
+	for t := start; !t.After(end); t = t.Add(10 * time.Second) {
+		points = append(points, Point{T: t, V: 1000 + rate*t.Sub(start).Seconds()})
+	}
+	return points
+}
+
+func newTestEngine() (Engine, *testFetcher, time.Time) {
+	start := time.Unix(1500000000, 0)
+	fetcher := &testFetcher{
+		series: []testSeries{
+			{
+				tags:   testTags(MetricNameLabel, "requests", "host", "a", "dc", "east"),
+				points: testCounter(start.Add(-10*time.Minute), start.Add(10*time.Minute), 1),
+			},
+			{
+				tags:   testTags(MetricNameLabel, "requests", "host", "b", "dc", "east"),
+				points: testCounter(start.Add(-10*time.Minute), start.Add(10*time.Minute), 2),
+			},
+			{
+				tags:   testTags(MetricNameLabel, "requests", "host", "c", "dc", "west"),
+				points: testCounter(start.Add(-10*time.Minute), start.Add(10*time.Minute), 4),
+			},
+		},
+	}
+	return NewEngine(fetcher), fetcher, start
+}
+
+type testResult struct {
+	labels map[string]string
+	values []float64
+}
+
+func requireMatrix(t *testing.T, expected []testResult, actual Matrix) {
+	require.Equal(t, len(expected), len(actual))
+	for i, series := range actual {
+		assert.Equal(t, expected[i].labels, series.Labels.Map())
+		require.Equal(t, len(expected[i].values), len(series.Points))
+		for j, p := range series.Points {
+			assert.InDelta(t, expected[i].values[j], p.V, 1e-9)
+		}
+	}
+}
+
+func TestQueryRangeSelector(t *testing.T) {
+	engine, fetcher, start := newTestEngine()
+
+	matrix, err := engine.QueryRange(`requests{host=~"a|b",dc="east"}`,
+		start, start.Add(2*time.Minute), time.Minute)
+	require.NoError(t, err)
+	requireMatrix(t, []testResult{
+		{
+			labels: map[string]string{MetricNameLabel: "requests", "host": "a", "dc": "east"},
+			values: []float64{1600, 1660, 1720},
+		},
+		{
+			labels: map[string]string{MetricNameLabel: "requests", "host": "b", "dc": "east"},
+			values: []float64{2200, 2320, 2440},
+		},
+	}, matrix)
+	for i, p := range matrix[0].Points {
+		assert.True(t, start.Add(time.Duration(i)*time.Minute).Equal(p.T))
+	}
+
+	require.Equal(t, 1, len(fetcher.queries))
+	assert.Equal(t, segment.AndConjunction, fetcher.queries[0].Conjunction)
+	assert.Equal(t, []segment.Filter{
+		{FieldName: []byte(MetricNameLabel), FieldValueFilter: []byte("requests")},
+		{FieldName: []byte("host"), FieldValueFilter: []byte("^(?:a|b)$"), Regexp: true},
+		{FieldName: []byte("dc"), FieldValueFilter: []byte("east")},
+	}, fetcher.queries[0].Filters)
+}
+
+func TestQueryRangeSelectorLookback(t *testing.T) {
+	engine, _, start := newTestEngine()
+
+	// The series end ten minutes after start so steps more than five
+	// minutes later have no point within the lookback
+	matrix, err := engine.QueryRange(`requests{host="a"}`,
+		start.Add(10*time.Minute), start.Add(20*time.Minute), 4*time.Minute)
+	require.NoError(t, err)
+	requireMatrix(t, []testResult{
+		{
+			labels: map[string]string{MetricNameLabel: "requests", "host": "a", "dc": "east"},
+			values: []float64{2200, 2200},
+		},
+	}, matrix)
+}
+
+func TestQueryRangeFunctions(t *testing.T) {
+	engine, _, start := newTestEngine()
+
+	for _, fn := range []string{"rate", "irate"} {
+		matrix, err := engine.QueryRange(fn+`(requests{dc="east"}[1m])`,
+			start, start.Add(time.Minute), time.Minute)
+		require.NoError(t, err)
+		requireMatrix(t, []testResult{
+			{
+				labels: map[string]string{"host": "a", "dc": "east"},
+				values: []float64{1, 1},
+			},
+			{
+				labels: map[string]string{"host": "b", "dc": "east"},
+				values: []float64{2, 2},
+			},
+		}, matrix)
+	}
+
+	matrix, err := engine.QueryRange(`increase(requests{host="a"}[1m])`,
+		start, start, time.Minute)
+	require.NoError(t, err)
+	requireMatrix(t, []testResult{
+		{labels: map[string]string{"host": "a", "dc": "east"}, values: []float64{60}},
+	}, matrix)
+
+	matrix, err = engine.QueryRange(`count_over_time(requests{host="a"}[1m])`,
+		start, start, time.Minute)
+	require.NoError(t, err)
+	requireMatrix(t, []testResult{
+		{labels: map[string]string{"host": "a", "dc": "east"}, values: []float64{6}},
+	}, matrix)
+
+	matrix, err = engine.QueryRange(`max_over_time(requests{host="a"}[1m])`,
+		start, start, time.Minute)
+	require.NoError(t, err)
+	requireMatrix(t, []testResult{
+		{labels: map[string]string{"host": "a", "dc": "east"}, values: []float64{1600}},
+	}, matrix)
+}
+
+func TestQueryRangeCounterReset(t *testing.T) {
+	start := time.Unix(1500000000, 0)
+	fetcher := &testFetcher{
+		series: []testSeries{
+			{
+				tags: testTags(MetricNameLabel, "requests"),
+				points: []Point{
+					{T: start.Add(-30 * time.Second), V: 10},
+					{T: start.Add(-20 * time.Second), V: 20},
+					{T: start.Add(-10 * time.Second), V: 5},
+					{T: start, V: 15},
+				},
+			},
+		},
+	}
+	engine := NewEngine(fetcher)
+
+	matrix, err := engine.QueryRange(`irate(requests[1m])`, start, start, time.Minute)
+	require.NoError(t, err)
+	requireMatrix(t, []testResult{
+		{labels: map[string]string{}, values: []float64{1}},
+	}, matrix)
+
+	matrix, err = engine.QueryRange(`irate(requests[15s])`, start.Add(-10*time.Second),
+		start.Add(-10*time.Second), time.Minute)
+	require.NoError(t, err)
+	requireMatrix(t, []testResult{
+		{labels: map[string]string{}, values: []float64{0.5}},
+	}, matrix)
+}
+
+func TestQueryRangeAggregations(t *testing.T) {
+	engine, _, start := newTestEngine()
+
+	tests := []struct {
+		query    string
+		expected []testResult
+	}{
+		{
+			query: `sum(rate(requests[1m]))`,
+			expected: []testResult{
+				{labels: map[string]string{}, values: []float64{7, 7}},
+			},
+		},
+		{
+			query: `sum by (dc) (rate(requests[1m]))`,
+			expected: []testResult{
+				{labels: map[string]string{"dc": "east"}, values: []float64{3, 3}},
+				{labels: map[string]string{"dc": "west"}, values: []float64{4, 4}},
+			},
+		},
+		{
+			query: `avg(irate(requests[1m])) by (dc)`,
+			expected: []testResult{
+				{labels: map[string]string{"dc": "east"}, values: []float64{1.5, 1.5}},
+				{labels: map[string]string{"dc": "west"}, values: []float64{4, 4}},
+			},
+		},
+		{
+			query: `max without (host) (rate(requests[1m]))`,
+			expected: []testResult{
+				{labels: map[string]string{"dc": "east"}, values: []float64{2, 2}},
+				{labels: map[string]string{"dc": "west"}, values: []float64{4, 4}},
+			},
+		},
+		{
+			query: `min(requests)`,
+			expected: []testResult{
+				{labels: map[string]string{}, values: []float64{1600, 1660}},
+			},
+		},
+		{
+			query: `count without (host) (requests)`,
+			expected: []testResult{
+				{labels: map[string]string{"dc": "east"}, values: []float64{2, 2}},
+				{labels: map[string]string{"dc": "west"}, values: []float64{1, 1}},
+			},
+		},
+	}
+	for _, test := range tests {
+		matrix, err := engine.QueryRange(test.query, start, start.Add(time.Minute), time.Minute)
+		require.NoError(t, err, test.query)
+		requireMatrix(t, test.expected, matrix)
+	}
+}
+
+func TestQueryRangeErrors(t *testing.T) {
+	engine, _, start := newTestEngine()
+
+	_, err := engine.QueryRange(`requests`, start, start.Add(time.Minute), 0)
+	assert.Equal(t, errStepNotPositive, err)
+
+	_, err = engine.QueryRange(`requests`, start, start.Add(-time.Minute), time.Minute)
+	assert.Equal(t, errEndBeforeStart, err)
+
+	_, err = engine.QueryRange(`requests`, start, start.Add(24*time.Hour), time.Second)
+	assert.Equal(t, errTooManyPoints, err)
+
+	_, err = engine.QueryRange(`requests[1m]`, start, start.Add(time.Minute), time.Minute)
+	assert.Equal(t, errRangeQueryNotVector, err)
+
+	_, err = engine.QueryRange(`rate(requests)`, start, start.Add(time.Minute), time.Minute)
+	assert.Error(t, err)
+}
+
+func TestSeries(t *testing.T) {
+	engine, fetcher, start := newTestEngine()
+
+	series, err := engine.Series([]string{
+		`requests{dc="west"}`,
+		`{host=~"a|c"}`,
+	}, start, start.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(series))
+	assert.Equal(t, map[string]string{
+		MetricNameLabel: "requests", "host": "a", "dc": "east",
+	}, series[0].Map())
+	assert.Equal(t, map[string]string{
+		MetricNameLabel: "requests", "host": "c", "dc": "west",
+	}, series[1].Map())
+	assert.Equal(t, 2, len(fetcher.queries))
+
+	_, err = engine.Series([]string{`rate(requests[1m])`}, start, start.Add(time.Hour))
+	assert.Error(t, err)
+}
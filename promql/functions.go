@@ -0,0 +1,204 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package promql
+
+import (
+	"math"
+	"time"
+)
+
+// rangeFn computes a value from the points of a series within a range,
+// returning false if no value can be computed.
+type rangeFn func(points []Point, rangeStart, rangeEnd time.Time) (float64, bool)
+
+// Function is a function evaluated over the points of range vectors.
+type Function struct {
+	Name string
+	fn   rangeFn
+}
+
+var functions = map[string]*Function{
+	"rate": {
+		Name: "rate",
+		fn: func(points []Point, rangeStart, rangeEnd time.Time) (float64, bool) {
+			return extrapolatedRate(points, rangeStart, rangeEnd, true, true)
+		},
+	},
+	"increase": {
+		Name: "increase",
+		fn: func(points []Point, rangeStart, rangeEnd time.Time) (float64, bool) {
+			return extrapolatedRate(points, rangeStart, rangeEnd, true, false)
+		},
+	},
+	"delta": {
+		Name: "delta",
+		fn: func(points []Point, rangeStart, rangeEnd time.Time) (float64, bool) {
+			return extrapolatedRate(points, rangeStart, rangeEnd, false, false)
+		},
+	},
+	"irate": {
+		Name: "irate",
+		fn:   instantRate,
+	},
+	"avg_over_time": {
+		Name: "avg_over_time",
+		fn: aggregateOverTime(func(points []Point) float64 {
+			var sum float64
+			for _, p := range points {
+				sum += p.V
+			}
+			return sum / float64(len(points))
+		}),
+	},
+	"min_over_time": {
+		Name: "min_over_time",
+		fn: aggregateOverTime(func(points []Point) float64 {
+			min := points[0].V
+			for _, p := range points[1:] {
+				if p.V < min || math.IsNaN(min) {
+					min = p.V
+				}
+			}
+			return min
+		}),
+	},
+	"max_over_time": {
+		Name: "max_over_time",
+		fn: aggregateOverTime(func(points []Point) float64 {
+			max := points[0].V
+			for _, p := range points[1:] {
+				if p.V > max || math.IsNaN(max) {
+					max = p.V
+				}
+			}
+			return max
+		}),
+	},
+	"sum_over_time": {
+		Name: "sum_over_time",
+		fn: aggregateOverTime(func(points []Point) float64 {
+			var sum float64
+			for _, p := range points {
+				sum += p.V
+			}
+			return sum
+		}),
+	},
+	"count_over_time": {
+		Name: "count_over_time",
+		fn: aggregateOverTime(func(points []Point) float64 {
+			return float64(len(points))
+		}),
+	},
+}
+
+func aggregateOverTime(fn func(points []Point) float64) rangeFn {
+	return func(points []Point, _, _ time.Time) (float64, bool) {
+		if len(points) == 0 {
+			return 0, false
+		}
+		return fn(points), true
+	}
+}
+
+// extrapolatedRate computes the rate or delta of the points over the range,
+// extrapolating to the range boundaries the same way Prometheus does and
+// accounting for counter resets if the points are of a counter.
+func extrapolatedRate(
+	points []Point,
+	rangeStart, rangeEnd time.Time,
+	isCounter, isRate bool,
+) (float64, bool) {
+	if len(points) < 2 {
+		return 0, false
+	}
+
+	var (
+		first  = points[0]
+		last   = points[len(points)-1]
+		result = last.V - first.V
+	)
+	if isCounter {
+		var prev float64
+		for _, p := range points {
+			if p.V < prev {
+				result += prev
+			}
+			prev = p.V
+		}
+	}
+
+	var (
+		durationToStart      = first.T.Sub(rangeStart).Seconds()
+		durationToEnd        = rangeEnd.Sub(last.T).Seconds()
+		sampledInterval      = last.T.Sub(first.T).Seconds()
+		avgDurationBetween   = sampledInterval / float64(len(points)-1)
+		extrapolateThreshold = avgDurationBetween * 1.1
+		extrapolateInterval  = sampledInterval
+	)
+	if isCounter && result > 0 && first.V >= 0 {
+		// Counters cannot be negative, so do not extrapolate past zero
+		durationToZero := sampledInterval * (first.V / result)
+		if durationToZero < durationToStart {
+			durationToStart = durationToZero
+		}
+	}
+
+	if durationToStart < extrapolateThreshold {
+		extrapolateInterval += durationToStart
+	} else {
+		extrapolateInterval += avgDurationBetween / 2
+	}
+	if durationToEnd < extrapolateThreshold {
+		extrapolateInterval += durationToEnd
+	} else {
+		extrapolateInterval += avgDurationBetween / 2
+	}
+
+	result = result * (extrapolateInterval / sampledInterval)
+	if isRate {
+		result = result / rangeEnd.Sub(rangeStart).Seconds()
+	}
+	return result, true
+}
+
+// instantRate computes the per-second rate of the last two points.
+func instantRate(points []Point, _, _ time.Time) (float64, bool) {
+	if len(points) < 2 {
+		return 0, false
+	}
+
+	var (
+		last     = points[len(points)-1]
+		previous = points[len(points)-2]
+		result   = last.V - previous.V
+	)
+	if last.V < previous.V {
+		// Counter reset
+		result = last.V
+	}
+
+	interval := last.T.Sub(previous.T).Seconds()
+	if interval == 0 {
+		return 0, false
+	}
+	return result / interval, true
+}
@@ -0,0 +1,456 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package promql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+type tokenType int
+
+const (
+	tokenEOF tokenType = iota
+	tokenIdentifier
+	tokenString
+	tokenDuration
+	tokenLeftParen
+	tokenRightParen
+	tokenLeftBrace
+	tokenRightBrace
+	tokenComma
+	tokenEqual
+	tokenNotEqual
+	tokenRegexp
+	tokenNotRegexp
+)
+
+type token struct {
+	typ   tokenType
+	value string
+	pos   int
+}
+
+// lex splits the input into tokens.
+func lex(input string) ([]token, error) {
+	var (
+		tokens []token
+		pos    int
+	)
+	for pos < len(input) {
+		c := input[pos]
+		switch {
+		case unicode.IsSpace(rune(c)):
+			pos++
+		case c == '(':
+			tokens = append(tokens, token{typ: tokenLeftParen, value: "(", pos: pos})
+			pos++
+		case c == ')':
+			tokens = append(tokens, token{typ: tokenRightParen, value: ")", pos: pos})
+			pos++
+		case c == '{':
+			tokens = append(tokens, token{typ: tokenLeftBrace, value: "{", pos: pos})
+			pos++
+		case c == '}':
+			tokens = append(tokens, token{typ: tokenRightBrace, value: "}", pos: pos})
+			pos++
+		case c == ',':
+			tokens = append(tokens, token{typ: tokenComma, value: ",", pos: pos})
+			pos++
+		case c == '=':
+			if pos+1 < len(input) && input[pos+1] == '~' {
+				tokens = append(tokens, token{typ: tokenRegexp, value: "=~", pos: pos})
+				pos += 2
+				continue
+			}
+			tokens = append(tokens, token{typ: tokenEqual, value: "=", pos: pos})
+			pos++
+		case c == '!':
+			if pos+1 < len(input) && input[pos+1] == '=' {
+				tokens = append(tokens, token{typ: tokenNotEqual, value: "!=", pos: pos})
+				pos += 2
+				continue
+			}
+			if pos+1 < len(input) && input[pos+1] == '~' {
+				tokens = append(tokens, token{typ: tokenNotRegexp, value: "!~", pos: pos})
+				pos += 2
+				continue
+			}
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, pos)
+		case c == '[':
+			end := strings.IndexByte(input[pos:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed range at position %d", pos)
+			}
+			value := strings.TrimSpace(input[pos+1 : pos+end])
+			tokens = append(tokens, token{typ: tokenDuration, value: value, pos: pos})
+			pos += end + 1
+		case c == '"' || c == '\'' || c == '`':
+			value, n, err := lexString(input[pos:])
+			if err != nil {
+				return nil, fmt.Errorf("invalid string at position %d: %v", pos, err)
+			}
+			tokens = append(tokens, token{typ: tokenString, value: value, pos: pos})
+			pos += n
+		case isIdentifierChar(c, true):
+			start := pos
+			for pos < len(input) && isIdentifierChar(input[pos], false) {
+				pos++
+			}
+			tokens = append(tokens, token{typ: tokenIdentifier, value: input[start:pos], pos: start})
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, pos)
+		}
+	}
+	return append(tokens, token{typ: tokenEOF, pos: pos}), nil
+}
+
+// lexString returns the unquoted value of the string at the start of the
+// input and the length of the quoted string.
+func lexString(input string) (string, int, error) {
+	quote := input[0]
+	for i := 1; i < len(input); i++ {
+		switch input[i] {
+		case '\\':
+			if quote != '`' {
+				i++
+			}
+		case quote:
+			quoted := input[:i+1]
+			if quote == '\'' {
+				// Convert to a double quoted string to unquote
+				inner := strings.Replace(quoted[1:i], `\'`, `'`, -1)
+				quoted = `"` + strings.Replace(inner, `"`, `\"`, -1) + `"`
+			}
+			value, err := strconv.Unquote(quoted)
+			if err != nil {
+				return "", 0, err
+			}
+			return value, i + 1, nil
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string")
+}
+
+func isIdentifierChar(c byte, first bool) bool {
+	if c == '_' || c == ':' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') {
+		return true
+	}
+	return !first && c >= '0' && c <= '9'
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// ParseExpr parses a query expression.
+func ParseExpr(input string) (Expr, error) {
+	tokens, err := lex(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	expr, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.next(); t.typ != tokenEOF {
+		return nil, p.unexpected(t, "end of input")
+	}
+	return expr, nil
+}
+
+// ParseMatchers parses a series selector into its label matchers.
+func ParseMatchers(input string) ([]LabelMatcher, error) {
+	expr, err := ParseExpr(input)
+	if err != nil {
+		return nil, err
+	}
+	selector, ok := expr.(*VectorSelector)
+	if !ok || selector.Range > 0 {
+		return nil, fmt.Errorf("expected a series selector, got %s", expr)
+	}
+	return selector.Matchers, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.typ != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) expect(typ tokenType, context string) (token, error) {
+	t := p.next()
+	if t.typ != typ {
+		return t, p.unexpected(t, context)
+	}
+	return t, nil
+}
+
+func (p *parser) unexpected(t token, context string) error {
+	if t.typ == tokenEOF {
+		return fmt.Errorf("unexpected end of input, expected %s", context)
+	}
+	return fmt.Errorf("unexpected %q at position %d, expected %s", t.value, t.pos, context)
+}
+
+func (p *parser) parseExpr() (Expr, error) {
+	t := p.peek()
+	switch t.typ {
+	case tokenLeftParen:
+		p.next()
+		expr, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRightParen, ")"); err != nil {
+			return nil, err
+		}
+		return expr, nil
+	case tokenLeftBrace:
+		return p.parseVectorSelector("")
+	case tokenIdentifier:
+		p.next()
+		if op, ok := aggregateOps[t.value]; ok && p.isAggregateStart() {
+			return p.parseAggregateExpr(op)
+		}
+		if p.peek().typ == tokenLeftParen {
+			return p.parseCall(t)
+		}
+		return p.parseVectorSelector(t.value)
+	}
+	return nil, p.unexpected(t, "expression")
+}
+
+func (p *parser) isAggregateStart() bool {
+	t := p.peek()
+	return t.typ == tokenLeftParen ||
+		(t.typ == tokenIdentifier && (t.value == "by" || t.value == "without"))
+}
+
+func (p *parser) parseAggregateExpr(op AggregateOp) (Expr, error) {
+	agg := &AggregateExpr{Op: op}
+	parsedGrouping := false
+	if p.peek().typ == tokenIdentifier {
+		if err := p.parseGrouping(agg); err != nil {
+			return nil, err
+		}
+		parsedGrouping = true
+	}
+
+	if _, err := p.expect(tokenLeftParen, "("); err != nil {
+		return nil, err
+	}
+	expr, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokenRightParen, ")"); err != nil {
+		return nil, err
+	}
+	if expr.valueType() != valueTypeVector {
+		return nil, fmt.Errorf("expected instant vector in aggregation %s, got range vector", op)
+	}
+	agg.Expr = expr
+
+	if t := p.peek(); !parsedGrouping && t.typ == tokenIdentifier &&
+		(t.value == "by" || t.value == "without") {
+		if err := p.parseGrouping(agg); err != nil {
+			return nil, err
+		}
+	}
+	return agg, nil
+}
+
+func (p *parser) parseGrouping(agg *AggregateExpr) error {
+	t := p.next()
+	switch t.value {
+	case "by":
+	case "without":
+		agg.Without = true
+	default:
+		return p.unexpected(t, "by or without")
+	}
+
+	if _, err := p.expect(tokenLeftParen, "("); err != nil {
+		return err
+	}
+	for p.peek().typ != tokenRightParen {
+		label, err := p.expect(tokenIdentifier, "label name")
+		if err != nil {
+			return err
+		}
+		agg.Grouping = append(agg.Grouping, label.value)
+		if p.peek().typ != tokenComma {
+			break
+		}
+		p.next()
+	}
+	_, err := p.expect(tokenRightParen, ")")
+	return err
+}
+
+func (p *parser) parseCall(name token) (Expr, error) {
+	fn, ok := functions[name.value]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q", name.value)
+	}
+
+	p.next()
+	arg, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokenRightParen, ")"); err != nil {
+		return nil, err
+	}
+	if arg.valueType() != valueTypeMatrix {
+		return nil, fmt.Errorf("expected range vector in call to function %q", fn.Name)
+	}
+	return &Call{Func: fn, Arg: arg}, nil
+}
+
+func (p *parser) parseVectorSelector(name string) (Expr, error) {
+	selector := &VectorSelector{}
+	if name != "" {
+		selector.Matchers = append(selector.Matchers, LabelMatcher{
+			Type:  MatchEqual,
+			Name:  MetricNameLabel,
+			Value: name,
+		})
+	}
+
+	if p.peek().typ == tokenLeftBrace {
+		p.next()
+		for p.peek().typ != tokenRightBrace {
+			matcher, err := p.parseLabelMatcher()
+			if err != nil {
+				return nil, err
+			}
+			selector.Matchers = append(selector.Matchers, matcher)
+			if p.peek().typ != tokenComma {
+				break
+			}
+			p.next()
+		}
+		if _, err := p.expect(tokenRightBrace, "}"); err != nil {
+			return nil, err
+		}
+	}
+
+	notEmpty := false
+	for _, m := range selector.Matchers {
+		if m.Type == MatchEqual || m.Type == MatchRegexp {
+			if m.Value != "" {
+				notEmpty = true
+			}
+		}
+	}
+	if !notEmpty {
+		return nil, fmt.Errorf("vector selector must contain at least one non-empty matcher")
+	}
+
+	if p.peek().typ == tokenDuration {
+		t := p.next()
+		d, err := ParseDuration(t.value)
+		if err != nil {
+			return nil, err
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("range must be positive, got %q", t.value)
+		}
+		selector.Range = d
+	}
+	return selector, nil
+}
+
+func (p *parser) parseLabelMatcher() (LabelMatcher, error) {
+	name, err := p.expect(tokenIdentifier, "label name")
+	if err != nil {
+		return LabelMatcher{}, err
+	}
+
+	var matchType MatchType
+	switch t := p.next(); t.typ {
+	case tokenEqual:
+		matchType = MatchEqual
+	case tokenNotEqual:
+		matchType = MatchNotEqual
+	case tokenRegexp:
+		matchType = MatchRegexp
+	case tokenNotRegexp:
+		matchType = MatchNotRegexp
+	default:
+		return LabelMatcher{}, p.unexpected(t, "label matching operator")
+	}
+
+	value, err := p.expect(tokenString, "label value string")
+	if err != nil {
+		return LabelMatcher{}, err
+	}
+	if matchType == MatchRegexp || matchType == MatchNotRegexp {
+		if _, err := regexp.Compile(value.value); err != nil {
+			return LabelMatcher{}, fmt.Errorf("invalid regular expression %q: %v", value.value, err)
+		}
+	}
+	return LabelMatcher{Type: matchType, Name: name.value, Value: value.value}, nil
+}
+
+var durationUnits = map[string]time.Duration{
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+	"d":  24 * time.Hour,
+	"w":  7 * 24 * time.Hour,
+	"y":  365 * 24 * time.Hour,
+}
+
+// ParseDuration parses a duration such as "5m" in the query language format.
+func ParseDuration(s string) (time.Duration, error) {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	if i == 0 || i == len(s) {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	n, err := strconv.ParseInt(s[:i], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: %v", s, err)
+	}
+	unit, ok := durationUnits[s[i:]]
+	if !ok {
+		return 0, fmt.Errorf("invalid duration unit in %q", s)
+	}
+	return time.Duration(n) * unit, nil
+}
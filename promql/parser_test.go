@@ -0,0 +1,112 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package promql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExpr(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`requests`, `{__name__="requests"}`},
+		{`requests{host="a", dc!~'we.*'}`, `{__name__="requests",host="a",dc!~"we.*"}`},
+		{`{job=~"api|web",env!=""}`, `{job=~"api|web",env!=""}`},
+		{`rate(requests[5m])`, `rate({__name__="requests"}[5m0s])`},
+		{`irate(requests{host="a"}[30s])`, `irate({__name__="requests",host="a"}[30s])`},
+		{`sum by (dc) (rate(requests[1m]))`, `sum by (dc)(rate({__name__="requests"}[1m0s]))`},
+		{`max(avg_over_time(requests[1h])) without (host, dc)`, `max without (host, dc)(avg_over_time({__name__="requests"}[1h0m0s]))`},
+		{`avg((requests))`, `avg({__name__="requests"})`},
+	}
+	for _, test := range tests {
+		expr, err := ParseExpr(test.input)
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.expected, expr.String(), test.input)
+	}
+}
+
+func TestParseExprErrors(t *testing.T) {
+	inputs := []string{
+		``,
+		`rate(requests)`,
+		`sum(requests[1m])`,
+		`unknown(requests[1m])`,
+		`{host=""}`,
+		`{host!="a"}`,
+		`requests{host=~"("}`,
+		`requests{host="a"`,
+		`requests[1m`,
+		`requests[1x]`,
+		`requests[0s]`,
+		`sum by (dc (requests)`,
+		`requests requests`,
+	}
+	for _, input := range inputs {
+		_, err := ParseExpr(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestParseMatchers(t *testing.T) {
+	matchers, err := ParseMatchers(`requests{host!~"a.*"}`)
+	require.NoError(t, err)
+	assert.Equal(t, []LabelMatcher{
+		{Type: MatchEqual, Name: MetricNameLabel, Value: "requests"},
+		{Type: MatchNotRegexp, Name: "host", Value: "a.*"},
+	}, matchers)
+
+	_, err = ParseMatchers(`requests[1m]`)
+	assert.Error(t, err)
+
+	_, err = ParseMatchers(`rate(requests[1m])`)
+	assert.Error(t, err)
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Duration
+	}{
+		{"500ms", 500 * time.Millisecond},
+		{"30s", 30 * time.Second},
+		{"5m", 5 * time.Minute},
+		{"2h", 2 * time.Hour},
+		{"1d", 24 * time.Hour},
+		{"1w", 7 * 24 * time.Hour},
+		{"1y", 365 * 24 * time.Hour},
+	}
+	for _, test := range tests {
+		d, err := ParseDuration(test.input)
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.expected, d, test.input)
+	}
+
+	for _, input := range []string{"", "5", "m", "5x", "1.5m"} {
+		_, err := ParseDuration(input)
+		assert.Error(t, err, input)
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package promql implements a subset of the Prometheus query language,
+// evaluating queries against series fetched by tags from the database.
+package promql
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// MetricNameLabel is the label holding the name of a metric.
+const MetricNameLabel = "__name__"
+
+// Expr is a parsed query expression.
+type Expr interface {
+	fmt.Stringer
+
+	valueType() valueType
+}
+
+type valueType int
+
+const (
+	valueTypeVector valueType = iota
+	valueTypeMatrix
+)
+
+// MatchType is the type of a label matcher.
+type MatchType int
+
+// List of label matcher types.
+const (
+	MatchEqual MatchType = iota
+	MatchNotEqual
+	MatchRegexp
+	MatchNotRegexp
+)
+
+func (t MatchType) String() string {
+	switch t {
+	case MatchEqual:
+		return "="
+	case MatchNotEqual:
+		return "!="
+	case MatchRegexp:
+		return "=~"
+	case MatchNotRegexp:
+		return "!~"
+	}
+	return "unknown"
+}
+
+// LabelMatcher matches the value of a label.
+type LabelMatcher struct {
+	Type  MatchType
+	Name  string
+	Value string
+}
+
+func (m LabelMatcher) String() string {
+	return fmt.Sprintf("%s%s%q", m.Name, m.Type, m.Value)
+}
+
+// VectorSelector selects the series matching a set of label matchers,
+// over a range if the range is set.
+type VectorSelector struct {
+	Matchers []LabelMatcher
+	Range    time.Duration
+}
+
+func (s *VectorSelector) valueType() valueType {
+	if s.Range > 0 {
+		return valueTypeMatrix
+	}
+	return valueTypeVector
+}
+
+func (s *VectorSelector) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("{")
+	for i, m := range s.Matchers {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		buf.WriteString(m.String())
+	}
+	buf.WriteString("}")
+	if s.Range > 0 {
+		fmt.Fprintf(&buf, "[%s]", s.Range)
+	}
+	return buf.String()
+}
+
+// Call is a call of a function over a range vector.
+type Call struct {
+	Func *Function
+	Arg  Expr
+}
+
+func (c *Call) valueType() valueType {
+	return valueTypeVector
+}
+
+func (c *Call) String() string {
+	return fmt.Sprintf("%s(%s)", c.Func.Name, c.Arg)
+}
+
+// AggregateOp is an aggregation operator.
+type AggregateOp int
+
+// List of aggregation operators.
+const (
+	AggregateSum AggregateOp = iota
+	AggregateAvg
+	AggregateMin
+	AggregateMax
+	AggregateCount
+)
+
+var aggregateOps = map[string]AggregateOp{
+	"sum":   AggregateSum,
+	"avg":   AggregateAvg,
+	"min":   AggregateMin,
+	"max":   AggregateMax,
+	"count": AggregateCount,
+}
+
+func (op AggregateOp) String() string {
+	for name, value := range aggregateOps {
+		if value == op {
+			return name
+		}
+	}
+	return "unknown"
+}
+
+// AggregateExpr aggregates the series of a vector, grouped by labels.
+type AggregateExpr struct {
+	Op       AggregateOp
+	Expr     Expr
+	Grouping []string
+	Without  bool
+}
+
+func (a *AggregateExpr) valueType() valueType {
+	return valueTypeVector
+}
+
+func (a *AggregateExpr) String() string {
+	var grouping string
+	if len(a.Grouping) > 0 || a.Without {
+		keyword := "by"
+		if a.Without {
+			keyword = "without"
+		}
+		grouping = fmt.Sprintf(" %s (%s)", keyword, joinStrings(a.Grouping))
+	}
+	return fmt.Sprintf("%s%s(%s)", a.Op, grouping, a.Expr)
+}
+
+func joinStrings(values []string) string {
+	var buf bytes.Buffer
+	for i, v := range values {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(v)
+	}
+	return buf.String()
+}
+
+// Label is a label name and value pair.
+type Label struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Labels is a set of labels sorted by name.
+type Labels []Label
+
+func (l Labels) Len() int           { return len(l) }
+func (l Labels) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+func (l Labels) Less(i, j int) bool { return l[i].Name < l[j].Name }
+
+// Map returns the labels as a map of name to value.
+func (l Labels) Map() map[string]string {
+	m := make(map[string]string, len(l))
+	for _, label := range l {
+		m[label.Name] = label.Value
+	}
+	return m
+}
+
+// String returns the labels in the Prometheus text format, e.g.
+// {__name__="up",job="node"}, which uniquely identifies a sorted set of labels.
+func (l Labels) String() string {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, label := range l {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(label.Name)
+		buf.WriteByte('=')
+		buf.WriteString(strconv.Quote(label.Value))
+	}
+	buf.WriteByte('}')
+	return buf.String()
+}
+
+// key returns a string uniquely identifying the labels.
+func (l Labels) key() string {
+	var buf bytes.Buffer
+	for _, label := range l {
+		buf.WriteString(label.Name)
+		buf.WriteByte(0xff)
+		buf.WriteString(label.Value)
+		buf.WriteByte(0xff)
+	}
+	return buf.String()
+}
+
+// without returns the labels without the given label names.
+func (l Labels) without(names ...string) Labels {
+	result := make(Labels, 0, len(l))
+	for _, label := range l {
+		if !containsString(names, label.Name) {
+			result = append(result, label)
+		}
+	}
+	return result
+}
+
+// only returns the labels with the given label names.
+func (l Labels) only(names ...string) Labels {
+	result := make(Labels, 0, len(names))
+	for _, label := range l {
+		if containsString(names, label.Name) {
+			result = append(result, label)
+		}
+	}
+	return result
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// NewLabels returns the labels sorted by name.
+func NewLabels(labels ...Label) Labels {
+	l := Labels(labels)
+	sort.Sort(l)
+	return l
+}
+
+// Point is a value at a time.
+type Point struct {
+	T time.Time
+	V float64
+}
+
+// Series is a set of points for a set of labels.
+type Series struct {
+	Labels Labels
+	Points []Point
+}
+
+// Matrix is a set of series.
+type Matrix []Series
@@ -0,0 +1,308 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package runtime
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3cluster/kv"
+	rtproto "github.com/m3db/m3db/generated/proto/runtimeoptions"
+	xlog "github.com/m3db/m3x/log"
+
+	"github.com/uber-go/tally"
+)
+
+var (
+	errKVOptionsSourceAlreadyStarted = errors.New("kv options source already started")
+	errKVOptionsSourceClosed         = errors.New("kv options source is closed")
+)
+
+type kvOptionsSource struct {
+	sync.Mutex
+
+	manager        OptionsManager
+	opts           KVOptionsSourceOptions
+	logger         xlog.Logger
+	metrics        kvOptionsSourceMetrics
+	watch          kv.ValueWatch
+	baseline       Options
+	value          *rtproto.RuntimeOptionsValue
+	appliedVersion int
+	started        bool
+	closed         bool
+	doneCh         chan struct{}
+}
+
+type kvOptionsSourceMetrics struct {
+	updates        tally.Counter
+	invalidUpdates tally.Counter
+	appliedVersion tally.Gauge
+}
+
+func newKVOptionsSourceMetrics(scope tally.Scope) kvOptionsSourceMetrics {
+	return kvOptionsSourceMetrics{
+		updates:        scope.Counter("update"),
+		invalidUpdates: scope.Counter("invalid-update"),
+		appliedVersion: scope.Gauge("applied-version"),
+	}
+}
+
+// NewKVOptionsSource creates a new source of runtime options backed by a KV
+// key. The key holds default values for all hosts and optional per host
+// overrides. The runtime options held by the manager when the source is
+// created are the baseline, each update is resolved as the baseline with the
+// defaults and then the host override applied on top so that fields removed
+// from the key revert to the baseline. Options updated directly on the
+// manager are replaced by the next update, use UpdateBaseline instead.
+// Invalid updates are skipped so that the last valid runtime options remain
+// in effect.
+func NewKVOptionsSource(
+	manager OptionsManager,
+	opts KVOptionsSourceOptions,
+) (KVOptionsSource, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	iopts := opts.InstrumentOptions()
+	scope := iopts.MetricsScope().SubScope("runtime-options-source")
+	return &kvOptionsSource{
+		manager:  manager,
+		opts:     opts,
+		logger:   iopts.Logger().WithFields(xlog.NewField("key", opts.Key())),
+		metrics:  newKVOptionsSourceMetrics(scope),
+		baseline: manager.Get(),
+		doneCh:   make(chan struct{}),
+	}, nil
+}
+
+func (s *kvOptionsSource) Start() error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.closed {
+		return errKVOptionsSourceClosed
+	}
+	if s.started {
+		return errKVOptionsSourceAlreadyStarted
+	}
+
+	store := s.opts.KVStore()
+	value, err := store.Get(s.opts.Key())
+	if err == nil {
+		s.updateWithLock(value)
+	} else if err != kv.ErrNotFound {
+		return err
+	}
+
+	watch, err := store.Watch(s.opts.Key())
+	if err != nil {
+		return err
+	}
+
+	s.watch = watch
+	s.started = true
+	go s.run()
+	go s.reportMetrics()
+	return nil
+}
+
+func (s *kvOptionsSource) run() {
+	for range s.watch.C() {
+		s.Lock()
+		if s.closed {
+			s.Unlock()
+			return
+		}
+		s.updateWithLock(s.watch.Get())
+		s.Unlock()
+	}
+}
+
+func (s *kvOptionsSource) reportMetrics() {
+	ticker := time.NewTicker(s.opts.InstrumentOptions().ReportInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.doneCh:
+			return
+		case <-ticker.C:
+			s.Lock()
+			version := s.appliedVersion
+			s.Unlock()
+			s.metrics.appliedVersion.Update(float64(version))
+		}
+	}
+}
+
+func (s *kvOptionsSource) updateWithLock(value kv.Value) {
+	if value == nil {
+		s.logger.Warnf("runtime options key was deleted, reverting to baseline runtime options")
+		// Versions start over once the key is recreated
+		s.appliedVersion = 0
+		s.value = nil
+		if err := s.resolveWithLock(s.baseline, nil); err != nil {
+			s.metrics.invalidUpdates.Inc(1)
+			s.logger.Errorf("could not revert to baseline runtime options: %v", err)
+		}
+		return
+	}
+
+	// NB: Compare by inequality as the version goes backwards when the key
+	// is deleted and recreated, and the watch may only deliver the latest
+	// value so the deletion is not always seen
+	if value.Version() == s.appliedVersion {
+		// Already applied
+		return
+	}
+
+	if err := s.applyWithLock(value); err != nil {
+		s.metrics.invalidUpdates.Inc(1)
+		s.logger.WithFields(
+			xlog.NewField("version", value.Version()),
+		).Errorf("skipping invalid runtime options update: %v", err)
+		return
+	}
+
+	s.appliedVersion = value.Version()
+	s.metrics.updates.Inc(1)
+	s.metrics.appliedVersion.Update(float64(value.Version()))
+	s.logger.WithFields(
+		xlog.NewField("version", value.Version()),
+	).Infof("applied runtime options update")
+}
+
+func (s *kvOptionsSource) applyWithLock(value kv.Value) error {
+	var protoValue rtproto.RuntimeOptionsValue
+	if err := value.Unmarshal(&protoValue); err != nil {
+		return fmt.Errorf("could not unmarshal value: %v", err)
+	}
+
+	if err := s.resolveWithLock(s.baseline, &protoValue); err != nil {
+		return err
+	}
+	s.value = &protoValue
+	return nil
+}
+
+// resolveWithLock applies the defaults and then the host override of the
+// value on top of the baseline and updates the manager with the result.
+func (s *kvOptionsSource) resolveWithLock(
+	baseline Options,
+	value *rtproto.RuntimeOptionsValue,
+) error {
+	opts := baseline
+	if value != nil {
+		opts = applyRuntimeOptionsProto(opts, value.Defaults)
+		if hostID := s.opts.HostID(); hostID != "" {
+			if override, ok := value.HostOverrides[hostID]; ok {
+				opts = applyRuntimeOptionsProto(opts, override)
+			}
+		}
+	}
+
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+	return s.manager.Update(opts)
+}
+
+func (s *kvOptionsSource) Baseline() Options {
+	s.Lock()
+	baseline := s.baseline
+	s.Unlock()
+	return baseline
+}
+
+func (s *kvOptionsSource) UpdateBaseline(fn func(Options) Options) error {
+	s.Lock()
+	defer s.Unlock()
+
+	baseline := fn(s.baseline)
+	if err := s.resolveWithLock(baseline, s.value); err != nil {
+		return err
+	}
+	s.baseline = baseline
+	return nil
+}
+
+func (s *kvOptionsSource) Close() {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.closed = true
+	if s.watch != nil {
+		s.watch.Close()
+	}
+	close(s.doneCh)
+}
+
+// applyRuntimeOptionsProto returns the runtime options with each field set
+// in the proto message applied.
+func applyRuntimeOptionsProto(opts Options, p *rtproto.RuntimeOptions) Options {
+	if p == nil {
+		return opts
+	}
+
+	rateLimitOpts := opts.PersistRateLimitOptions()
+	if v := p.PersistRateLimitEnabled; v != nil {
+		rateLimitOpts = rateLimitOpts.SetLimitEnabled(v.Value)
+	}
+	if v := p.PersistRateLimitMbps; v != nil {
+		rateLimitOpts = rateLimitOpts.SetLimitMbps(v.Value)
+	}
+	if v := p.PersistRateLimitCheckEvery; v != nil {
+		rateLimitOpts = rateLimitOpts.SetLimitCheckEvery(int(v.Value))
+	}
+	opts = opts.SetPersistRateLimitOptions(rateLimitOpts)
+
+	if v := p.WriteNewSeriesAsync; v != nil {
+		opts = opts.SetWriteNewSeriesAsync(v.Value)
+	}
+	if v := p.WriteNewSeriesBackoffDurationNanos; v != nil {
+		opts = opts.SetWriteNewSeriesBackoffDuration(time.Duration(v.Value))
+	}
+	if v := p.WriteNewSeriesLimitPerShardPerSecond; v != nil {
+		opts = opts.SetWriteNewSeriesLimitPerShardPerSecond(int(v.Value))
+	}
+	if v := p.MaxSeriesPerShard; v != nil {
+		opts = opts.SetMaxSeriesPerShard(int(v.Value))
+	}
+	if v := p.MaxSeriesPerNamespace; v != nil {
+		opts = opts.SetMaxSeriesPerNamespace(int(v.Value))
+	}
+	if v := p.TickSeriesBatchSize; v != nil {
+		opts = opts.SetTickSeriesBatchSize(int(v.Value))
+	}
+	if v := p.TickPerSeriesSleepDurationNanos; v != nil {
+		opts = opts.SetTickPerSeriesSleepDuration(time.Duration(v.Value))
+	}
+	if v := p.TickMinimumIntervalNanos; v != nil {
+		opts = opts.SetTickMinimumInterval(time.Duration(v.Value))
+	}
+	return opts
+}
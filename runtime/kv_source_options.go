@@ -0,0 +1,97 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package runtime
+
+import (
+	"errors"
+
+	"github.com/m3db/m3cluster/kv"
+	"github.com/m3db/m3x/instrument"
+)
+
+var (
+	errKVOptionsSourceStoreNotSet = errors.New("kv options source store not set")
+	errKVOptionsSourceKeyEmpty    = errors.New("kv options source key must not be empty")
+)
+
+type kvOptionsSourceOptions struct {
+	iopts  instrument.Options
+	store  kv.Store
+	key    string
+	hostID string
+}
+
+// NewKVOptionsSourceOptions creates a new set of KV options source options.
+func NewKVOptionsSourceOptions() KVOptionsSourceOptions {
+	return &kvOptionsSourceOptions{
+		iopts: instrument.NewOptions(),
+	}
+}
+
+func (o *kvOptionsSourceOptions) Validate() error {
+	if o.store == nil {
+		return errKVOptionsSourceStoreNotSet
+	}
+	if o.key == "" {
+		return errKVOptionsSourceKeyEmpty
+	}
+	return nil
+}
+
+func (o *kvOptionsSourceOptions) SetInstrumentOptions(value instrument.Options) KVOptionsSourceOptions {
+	opts := *o
+	opts.iopts = value
+	return &opts
+}
+
+func (o *kvOptionsSourceOptions) InstrumentOptions() instrument.Options {
+	return o.iopts
+}
+
+func (o *kvOptionsSourceOptions) SetKVStore(value kv.Store) KVOptionsSourceOptions {
+	opts := *o
+	opts.store = value
+	return &opts
+}
+
+func (o *kvOptionsSourceOptions) KVStore() kv.Store {
+	return o.store
+}
+
+func (o *kvOptionsSourceOptions) SetKey(value string) KVOptionsSourceOptions {
+	opts := *o
+	opts.key = value
+	return &opts
+}
+
+func (o *kvOptionsSourceOptions) Key() string {
+	return o.key
+}
+
+func (o *kvOptionsSourceOptions) SetHostID(value string) KVOptionsSourceOptions {
+	opts := *o
+	opts.hostID = value
+	return &opts
+}
+
+func (o *kvOptionsSourceOptions) HostID() string {
+	return o.hostID
+}
@@ -0,0 +1,292 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package runtime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3cluster/kv"
+	"github.com/m3db/m3cluster/kv/mem"
+	rtproto "github.com/m3db/m3db/generated/proto/runtimeoptions"
+	"github.com/m3db/m3x/instrument"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+const testKVOptionsSourceKey = "runtime-options"
+
+func newTestKVOptionsSource(
+	t *testing.T,
+	hostID string,
+) (KVOptionsSource, OptionsManager, kv.Store, tally.TestScope) {
+	store := mem.NewStore()
+	scope := tally.NewTestScope("", nil)
+	opts := NewKVOptionsSourceOptions().
+		SetInstrumentOptions(instrument.NewOptions().SetMetricsScope(scope)).
+		SetKVStore(store).
+		SetKey(testKVOptionsSourceKey).
+		SetHostID(hostID)
+	manager := NewOptionsManager()
+	source, err := NewKVOptionsSource(manager, opts)
+	require.NoError(t, err)
+	return source, manager, store, scope
+}
+
+func waitUntil(t *testing.T, fn func() bool) {
+	deadline := time.Now().Add(5 * time.Second)
+	for !fn() {
+		require.True(t, time.Now().Before(deadline), "timed out waiting")
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func invalidUpdates(scope tally.TestScope) int64 {
+	counter, ok := scope.Snapshot().Counters()["runtime-options-source.invalid-update+"]
+	if !ok {
+		return 0
+	}
+	return counter.Value()
+}
+
+func TestKVOptionsSourceOptionsValidate(t *testing.T) {
+	require.Error(t, NewKVOptionsSourceOptions().Validate())
+	require.Error(t, NewKVOptionsSourceOptions().
+		SetKVStore(mem.NewStore()).
+		SetKey("").
+		Validate())
+	require.NoError(t, NewKVOptionsSourceOptions().
+		SetKVStore(mem.NewStore()).
+		SetKey(testKVOptionsSourceKey).
+		Validate())
+}
+
+func TestKVOptionsSourceAppliesDefaultsAndHostOverrides(t *testing.T) {
+	source, manager, store, _ := newTestKVOptionsSource(t, "host1")
+
+	// Value set before start is applied on start
+	_, err := store.Set(testKVOptionsSourceKey, &rtproto.RuntimeOptionsValue{
+		Defaults: &rtproto.RuntimeOptions{
+			WriteNewSeriesAsync: &rtproto.BoolValue{Value: true},
+			TickSeriesBatchSize: &rtproto.Int64Value{Value: 128},
+		},
+		HostOverrides: map[string]*rtproto.RuntimeOptions{
+			"host1": {
+				TickSeriesBatchSize: &rtproto.Int64Value{Value: 64},
+			},
+			"host2": {
+				MaxSeriesPerShard: &rtproto.Int64Value{Value: 1000},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, source.Start())
+	defer source.Close()
+
+	opts := manager.Get()
+	require.True(t, opts.WriteNewSeriesAsync())
+	require.Equal(t, 64, opts.TickSeriesBatchSize())
+	require.Equal(t, 0, opts.MaxSeriesPerShard())
+
+	// Fields not set keep their baseline value
+	require.Equal(t, NewOptions().TickPerSeriesSleepDuration(),
+		opts.TickPerSeriesSleepDuration())
+
+	// Updates are applied as they are watched
+	_, err = store.Set(testKVOptionsSourceKey, &rtproto.RuntimeOptionsValue{
+		Defaults: &rtproto.RuntimeOptions{
+			PersistRateLimitEnabled:  &rtproto.BoolValue{Value: true},
+			PersistRateLimitMbps:     &rtproto.DoubleValue{Value: 32},
+			TickMinimumIntervalNanos: &rtproto.Int64Value{Value: int64(time.Second)},
+		},
+	})
+	require.NoError(t, err)
+
+	waitUntil(t, func() bool {
+		return manager.Get().TickMinimumInterval() == time.Second
+	})
+	opts = manager.Get()
+	require.True(t, opts.PersistRateLimitOptions().LimitEnabled())
+	require.Equal(t, 32.0, opts.PersistRateLimitOptions().LimitMbps())
+
+	// Fields removed from the key and the removed host override revert
+	// to their baseline values
+	require.False(t, opts.WriteNewSeriesAsync())
+	require.Equal(t, NewOptions().TickSeriesBatchSize(), opts.TickSeriesBatchSize())
+}
+
+func TestKVOptionsSourceRevertsToBaselineWhenKeyDeleted(t *testing.T) {
+	source, manager, store, _ := newTestKVOptionsSource(t, "host1")
+	require.NoError(t, source.Start())
+	defer source.Close()
+
+	_, err := store.Set(testKVOptionsSourceKey, &rtproto.RuntimeOptionsValue{
+		Defaults: &rtproto.RuntimeOptions{
+			MaxSeriesPerShard: &rtproto.Int64Value{Value: 1000},
+		},
+	})
+	require.NoError(t, err)
+	waitUntil(t, func() bool {
+		return manager.Get().MaxSeriesPerShard() == 1000
+	})
+
+	_, err = store.Delete(testKVOptionsSourceKey)
+	require.NoError(t, err)
+	waitUntil(t, func() bool {
+		return manager.Get().MaxSeriesPerShard() == 0
+	})
+}
+
+func TestKVOptionsSourceUpdateBaselinePrecedence(t *testing.T) {
+	source, manager, store, _ := newTestKVOptionsSource(t, "host1")
+	require.NoError(t, source.Start())
+	defer source.Close()
+
+	// Baseline updates apply when the key is not set
+	require.NoError(t, source.UpdateBaseline(func(opts Options) Options {
+		return opts.SetWriteNewSeriesLimitPerShardPerSecond(100)
+	}))
+	require.Equal(t, 100, manager.Get().WriteNewSeriesLimitPerShardPerSecond())
+	require.Equal(t, 100, source.Baseline().WriteNewSeriesLimitPerShardPerSecond())
+
+	// Fields set by the key take precedence over the baseline
+	_, err := store.Set(testKVOptionsSourceKey, &rtproto.RuntimeOptionsValue{
+		Defaults: &rtproto.RuntimeOptions{
+			WriteNewSeriesLimitPerShardPerSecond: &rtproto.Int64Value{Value: 200},
+		},
+		HostOverrides: map[string]*rtproto.RuntimeOptions{
+			"host1": {
+				MaxSeriesPerShard: &rtproto.Int64Value{Value: 1000},
+			},
+		},
+	})
+	require.NoError(t, err)
+	waitUntil(t, func() bool {
+		return manager.Get().WriteNewSeriesLimitPerShardPerSecond() == 200
+	})
+
+	require.NoError(t, source.UpdateBaseline(func(opts Options) Options {
+		return opts.
+			SetWriteNewSeriesLimitPerShardPerSecond(300).
+			SetTickSeriesBatchSize(128)
+	}))
+	opts := manager.Get()
+	require.Equal(t, 200, opts.WriteNewSeriesLimitPerShardPerSecond())
+	require.Equal(t, 1000, opts.MaxSeriesPerShard())
+	require.Equal(t, 128, opts.TickSeriesBatchSize())
+
+	// The updated baseline applies once the key no longer sets the field
+	_, err = store.Set(testKVOptionsSourceKey, &rtproto.RuntimeOptionsValue{
+		Defaults: &rtproto.RuntimeOptions{
+			MaxSeriesPerShard: &rtproto.Int64Value{Value: 2000},
+		},
+	})
+	require.NoError(t, err)
+	waitUntil(t, func() bool {
+		return manager.Get().MaxSeriesPerShard() == 2000
+	})
+	require.Equal(t, 300, manager.Get().WriteNewSeriesLimitPerShardPerSecond())
+
+	// Invalid baseline updates are rejected and leave the baseline unchanged
+	require.Error(t, source.UpdateBaseline(func(opts Options) Options {
+		return opts.SetTickSeriesBatchSize(0)
+	}))
+	require.Equal(t, 128, source.Baseline().TickSeriesBatchSize())
+	require.Equal(t, 128, manager.Get().TickSeriesBatchSize())
+}
+
+func TestKVOptionsSourceSkipsInvalidUpdates(t *testing.T) {
+	source, manager, store, scope := newTestKVOptionsSource(t, "host1")
+	require.NoError(t, source.Start())
+	defer source.Close()
+
+	_, err := store.Set(testKVOptionsSourceKey, &rtproto.RuntimeOptionsValue{
+		Defaults: &rtproto.RuntimeOptions{
+			TickSeriesBatchSize: &rtproto.Int64Value{Value: 128},
+		},
+	})
+	require.NoError(t, err)
+	waitUntil(t, func() bool {
+		return manager.Get().TickSeriesBatchSize() == 128
+	})
+
+	// A batch size of zero is invalid so the last valid value is kept
+	_, err = store.Set(testKVOptionsSourceKey, &rtproto.RuntimeOptionsValue{
+		Defaults: &rtproto.RuntimeOptions{
+			TickSeriesBatchSize: &rtproto.Int64Value{Value: 0},
+			MaxSeriesPerShard:   &rtproto.Int64Value{Value: 1000},
+		},
+	})
+	require.NoError(t, err)
+	waitUntil(t, func() bool {
+		return invalidUpdates(scope) == 1
+	})
+	require.Equal(t, 128, manager.Get().TickSeriesBatchSize())
+	require.Equal(t, 0, manager.Get().MaxSeriesPerShard())
+
+	// A later valid update is still applied
+	_, err = store.Set(testKVOptionsSourceKey, &rtproto.RuntimeOptionsValue{
+		Defaults: &rtproto.RuntimeOptions{
+			MaxSeriesPerShard: &rtproto.Int64Value{Value: 1000},
+		},
+	})
+	require.NoError(t, err)
+	waitUntil(t, func() bool {
+		return manager.Get().MaxSeriesPerShard() == 1000
+	})
+
+	gauge, ok := scope.Snapshot().Gauges()["runtime-options-source.applied-version+"]
+	require.True(t, ok)
+	require.Equal(t, 3.0, gauge.Value())
+}
+
+func TestKVOptionsSourceAppliesUpdatesAfterKeyRecreated(t *testing.T) {
+	source, manager, store, _ := newTestKVOptionsSource(t, "host1")
+	require.NoError(t, source.Start())
+	defer source.Close()
+
+	for _, batchSize := range []int64{128, 256} {
+		_, err := store.Set(testKVOptionsSourceKey, &rtproto.RuntimeOptionsValue{
+			Defaults: &rtproto.RuntimeOptions{
+				TickSeriesBatchSize: &rtproto.Int64Value{Value: batchSize},
+			},
+		})
+		require.NoError(t, err)
+		waitUntil(t, func() bool {
+			return manager.Get().TickSeriesBatchSize() == int(batchSize)
+		})
+	}
+
+	// The recreated key starts over at a lower version but is still applied
+	_, err := store.Delete(testKVOptionsSourceKey)
+	require.NoError(t, err)
+	_, err = store.Set(testKVOptionsSourceKey, &rtproto.RuntimeOptionsValue{
+		Defaults: &rtproto.RuntimeOptions{
+			TickSeriesBatchSize: &rtproto.Int64Value{Value: 64},
+		},
+	})
+	require.NoError(t, err)
+	waitUntil(t, func() bool {
+		return manager.Get().TickSeriesBatchSize() == 64
+	})
+}
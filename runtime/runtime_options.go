@@ -31,6 +31,8 @@ const (
 	defaultWriteNewSeriesAsync                  = false
 	defaultWriteNewSeriesBackoffDuration        = time.Duration(0)
 	defaultWriteNewSeriesLimitPerShardPerSecond = 0
+	defaultMaxSeriesPerShard                    = 0
+	defaultMaxSeriesPerNamespace                = 0
 	defaultTickSeriesBatchSize                  = 512
 	defaultTickPerSeriesSleepDuration           = 100 * time.Microsecond
 	defaultTickMinimumInterval                  = time.Minute
@@ -41,6 +43,10 @@ var (
 		"write new series backoff duration cannot be negative")
 	errWriteNewSeriesLimitPerShardPerSecondIsNegative = errors.New(
 		"write new series limit per shard per cannot be negative")
+	errMaxSeriesPerShardIsNegative = errors.New(
+		"max series per shard cannot be negative")
+	errMaxSeriesPerNamespaceIsNegative = errors.New(
+		"max series per namespace cannot be negative")
 	errTickSeriesBatchSizeMustBePositive = errors.New(
 		"tick series batch size must be positive")
 	errTickPerSeriesSleepDurationMustBePositive = errors.New(
@@ -52,6 +58,8 @@ type options struct {
 	writeNewSeriesAsync                  bool
 	writeNewSeriesBackoffDuration        time.Duration
 	writeNewSeriesLimitPerShardPerSecond int
+	maxSeriesPerShard                    int
+	maxSeriesPerNamespace                int
 	tickSeriesBatchSize                  int
 	tickPerSeriesSleepDuration           time.Duration
 	tickMinimumInterval                  time.Duration
@@ -64,6 +72,8 @@ func NewOptions() Options {
 		writeNewSeriesAsync:                  defaultWriteNewSeriesAsync,
 		writeNewSeriesBackoffDuration:        defaultWriteNewSeriesBackoffDuration,
 		writeNewSeriesLimitPerShardPerSecond: defaultWriteNewSeriesLimitPerShardPerSecond,
+		maxSeriesPerShard:                    defaultMaxSeriesPerShard,
+		maxSeriesPerNamespace:                defaultMaxSeriesPerNamespace,
 		tickSeriesBatchSize:                  defaultTickSeriesBatchSize,
 		tickPerSeriesSleepDuration:           defaultTickPerSeriesSleepDuration,
 		tickMinimumInterval:                  defaultTickMinimumInterval,
@@ -82,6 +92,15 @@ func (o *options) Validate() error {
 		return errWriteNewSeriesLimitPerShardPerSecondIsNegative
 	}
 
+	// maxSeriesPerShard and maxSeriesPerNamespace can be zero to specify
+	// that no limit should be enforced
+	if o.maxSeriesPerShard < 0 {
+		return errMaxSeriesPerShardIsNegative
+	}
+	if o.maxSeriesPerNamespace < 0 {
+		return errMaxSeriesPerNamespaceIsNegative
+	}
+
 	if !(o.tickSeriesBatchSize > 0) {
 		return errTickSeriesBatchSizeMustBePositive
 	}
@@ -135,6 +154,26 @@ func (o *options) WriteNewSeriesLimitPerShardPerSecond() int {
 	return o.writeNewSeriesLimitPerShardPerSecond
 }
 
+func (o *options) SetMaxSeriesPerShard(value int) Options {
+	opts := *o
+	opts.maxSeriesPerShard = value
+	return &opts
+}
+
+func (o *options) MaxSeriesPerShard() int {
+	return o.maxSeriesPerShard
+}
+
+func (o *options) SetMaxSeriesPerNamespace(value int) Options {
+	opts := *o
+	opts.maxSeriesPerNamespace = value
+	return &opts
+}
+
+func (o *options) MaxSeriesPerNamespace() int {
+	return o.maxSeriesPerNamespace
+}
+
 func (o *options) SetTickSeriesBatchSize(value int) Options {
 	opts := *o
 	opts.tickSeriesBatchSize = value
@@ -30,3 +30,13 @@ func TestRuntimeOptionsDefaultsIsValid(t *testing.T) {
 	v := NewOptions()
 	assert.NoError(t, v.Validate())
 }
+
+func TestRuntimeOptionsMaxSeriesValidation(t *testing.T) {
+	v := NewOptions().SetMaxSeriesPerShard(1000).SetMaxSeriesPerNamespace(100000)
+	assert.NoError(t, v.Validate())
+	assert.Equal(t, 1000, v.MaxSeriesPerShard())
+	assert.Equal(t, 100000, v.MaxSeriesPerNamespace())
+
+	assert.Error(t, NewOptions().SetMaxSeriesPerShard(-1).Validate())
+	assert.Error(t, NewOptions().SetMaxSeriesPerNamespace(-1).Validate())
+}
@@ -23,8 +23,10 @@ package runtime
 import (
 	"time"
 
+	"github.com/m3db/m3cluster/kv"
 	"github.com/m3db/m3db/ratelimit"
 	xclose "github.com/m3db/m3x/close"
+	"github.com/m3db/m3x/instrument"
 )
 
 // Options is a set of runtime options.
@@ -76,6 +78,30 @@ type Options interface {
 	// time series being inserted.
 	WriteNewSeriesLimitPerShardPerSecond() int
 
+	// SetMaxSeriesPerShard sets the hard limit on the number of live series
+	// held by a shard, setting to zero disables the limit. Once the limit is
+	// reached writes for new series are rejected while writes for existing
+	// series are still accepted.
+	SetMaxSeriesPerShard(value int) Options
+
+	// MaxSeriesPerShard returns the hard limit on the number of live series
+	// held by a shard, setting to zero disables the limit. Once the limit is
+	// reached writes for new series are rejected while writes for existing
+	// series are still accepted.
+	MaxSeriesPerShard() int
+
+	// SetMaxSeriesPerNamespace sets the hard limit on the number of live series
+	// held by a namespace across all its shards on this node, setting to zero
+	// disables the limit. Once the limit is reached writes for new series are
+	// rejected while writes for existing series are still accepted.
+	SetMaxSeriesPerNamespace(value int) Options
+
+	// MaxSeriesPerNamespace returns the hard limit on the number of live series
+	// held by a namespace across all its shards on this node, setting to zero
+	// disables the limit. Once the limit is reached writes for new series are
+	// rejected while writes for existing series are still accepted.
+	MaxSeriesPerNamespace() int
+
 	// SetTickSeriesBatchSize sets the batch size to process series together
 	// during a tick before yielding and sleeping the per series duration
 	// multiplied by the batch size.
@@ -140,3 +166,53 @@ type OptionsListener interface {
 	// and when any updates occurred passing the new runtime options.
 	SetRuntimeOptions(value Options)
 }
+
+// KVOptionsSource watches a KV key holding runtime options and applies
+// each valid update to an options manager.
+type KVOptionsSource interface {
+	// Start applies the current value of the key if set and starts
+	// watching the key for updates.
+	Start() error
+
+	// Baseline returns the runtime options that values of the key are
+	// applied on top of.
+	Baseline() Options
+
+	// UpdateBaseline updates the runtime options that values of the key are
+	// applied on top of, fields set by the current value of the key take
+	// precedence over the updated baseline.
+	UpdateBaseline(fn func(Options) Options) error
+
+	// Close stops watching the key for updates.
+	Close()
+}
+
+// KVOptionsSourceOptions is a set of options for a KV options source.
+type KVOptionsSourceOptions interface {
+	// Validate validates the options.
+	Validate() error
+
+	// SetInstrumentOptions sets the instrumentation options.
+	SetInstrumentOptions(value instrument.Options) KVOptionsSourceOptions
+
+	// InstrumentOptions returns the instrumentation options.
+	InstrumentOptions() instrument.Options
+
+	// SetKVStore sets the KV store to watch.
+	SetKVStore(value kv.Store) KVOptionsSourceOptions
+
+	// KVStore returns the KV store to watch.
+	KVStore() kv.Store
+
+	// SetKey sets the key holding the runtime options.
+	SetKey(value string) KVOptionsSourceOptions
+
+	// Key returns the key holding the runtime options.
+	Key() string
+
+	// SetHostID sets the host ID used to select per host overrides.
+	SetHostID(value string) KVOptionsSourceOptions
+
+	// HostID returns the host ID used to select per host overrides.
+	HostID() string
+}
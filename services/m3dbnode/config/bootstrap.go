@@ -21,14 +21,17 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"runtime"
 
 	"github.com/m3db/m3db/client"
+	fsbackup "github.com/m3db/m3db/persist/fs/backup"
 	"github.com/m3db/m3db/storage"
 	"github.com/m3db/m3db/storage/bootstrap"
 	"github.com/m3db/m3db/storage/bootstrap/bootstrapper"
+	"github.com/m3db/m3db/storage/bootstrap/bootstrapper/backup"
 	"github.com/m3db/m3db/storage/bootstrap/bootstrapper/commitlog"
 	"github.com/m3db/m3db/storage/bootstrap/bootstrapper/fs"
 	"github.com/m3db/m3db/storage/bootstrap/bootstrapper/peers"
@@ -38,6 +41,9 @@ import (
 var (
 	// defaultNumProcessorsPerCPU is the default number of processors per CPU.
 	defaultNumProcessorsPerCPU = 0.5
+
+	errBackupConfigurationNotSet = errors.New("backup bootstrapper requires backup configuration")
+	errRemoteConfigurationNotSet = errors.New("remote bootstrapper requires remote configuration")
 )
 
 // BootstrapConfiguration specifies the config for bootstrappers.
@@ -51,6 +57,12 @@ type BootstrapConfiguration struct {
 
 	// Peers bootstrapper configuration.
 	Peers *BootstrapPeersConfiguration `yaml:"peers"`
+
+	// Backup bootstrapper configuration.
+	Backup *BootstrapBackupConfiguration `yaml:"backup"`
+
+	// Remote fileset store bootstrapper configuration.
+	Remote *BootstrapRemoteConfiguration `yaml:"remote"`
 }
 
 func (bsc BootstrapConfiguration) fsNumProcessors() int {
@@ -83,6 +95,22 @@ type BootstrapPeersConfiguration struct {
 	FetchBlocksMetadataEndpointVersion client.FetchBlocksMetadataEndpointVersion `yaml:"fetchBlocksMetadataEndpointVersion"`
 }
 
+// BootstrapBackupConfiguration specifies config for the backup bootstrapper.
+type BootstrapBackupConfiguration struct {
+	// Directory is the directory backups are kept in.
+	Directory string `yaml:"directory" validate:"nonzero"`
+
+	// BackupID is the ID of the backup to restore from.
+	BackupID string `yaml:"backupID" validate:"nonzero"`
+}
+
+// BootstrapRemoteConfiguration specifies config for the remote fileset store
+// bootstrapper.
+type BootstrapRemoteConfiguration struct {
+	// Directory is the directory of the fileset store shared between nodes.
+	Directory string `yaml:"directory" validate:"nonzero"`
+}
+
 // New creates a bootstrap process based on the bootstrap configuration.
 func (bsc BootstrapConfiguration) New(
 	opts storage.Options,
@@ -114,6 +142,51 @@ func (bsc BootstrapConfiguration) New(
 				SetNumProcessors(bsc.fsNumProcessors()).
 				SetDatabaseBlockRetrieverManager(opts.DatabaseBlockRetrieverManager())
 			bs = fs.NewFileSystemBootstrapper(filePathPrefix, fsbopts, bs)
+		case backup.BackupBootstrapperName:
+			if bsc.Backup == nil {
+				return nil, errBackupConfigurationNotSet
+			}
+			fsopts := opts.CommitLogOptions().FilesystemOptions()
+			manager, err := fsbackup.NewManager(fsbackup.NewOptions().
+				SetFilesystemOptions(fsopts).
+				SetBlobStore(fsbackup.NewLocalBlobStore(bsc.Backup.Directory)))
+			if err != nil {
+				return nil, err
+			}
+			bopts := backup.NewOptions().
+				SetResultOptions(rsopts).
+				SetFilesystemBootstrapperOptions(fs.NewOptions().
+					SetFilesystemOptions(fsopts).
+					SetNumProcessors(bsc.fsNumProcessors()).
+					SetDatabaseBlockRetrieverManager(opts.DatabaseBlockRetrieverManager())).
+				SetBackupManager(manager).
+				SetBackupID(bsc.Backup.BackupID)
+			bs, err = backup.NewBackupBootstrapper(bopts, bs)
+			if err != nil {
+				return nil, err
+			}
+		case backup.RemoteBootstrapperName:
+			if bsc.Remote == nil {
+				return nil, errRemoteConfigurationNotSet
+			}
+			fsopts := opts.CommitLogOptions().FilesystemOptions()
+			manager, err := fsbackup.NewManager(fsbackup.NewOptions().
+				SetFilesystemOptions(fsopts).
+				SetBlobStore(fsbackup.NewLocalBlobStore(bsc.Remote.Directory)))
+			if err != nil {
+				return nil, err
+			}
+			bopts := backup.NewOptions().
+				SetResultOptions(rsopts).
+				SetFilesystemBootstrapperOptions(fs.NewOptions().
+					SetFilesystemOptions(fsopts).
+					SetNumProcessors(bsc.fsNumProcessors()).
+					SetDatabaseBlockRetrieverManager(opts.DatabaseBlockRetrieverManager())).
+				SetBackupManager(manager)
+			bs, err = backup.NewRemoteBootstrapper(bopts, bs)
+			if err != nil {
+				return nil, err
+			}
 		case commitlog.CommitLogBootstrapperName:
 			copts := commitlog.NewOptions().
 				SetResultOptions(rsopts).
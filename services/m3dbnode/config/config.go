@@ -25,6 +25,8 @@ import (
 
 	"github.com/m3db/m3db/client"
 	"github.com/m3db/m3db/environment"
+	grpcserver "github.com/m3db/m3db/network/server/grpc"
+	"github.com/m3db/m3db/persist/fs"
 	"github.com/m3db/m3x/config/hostid"
 	"github.com/m3db/m3x/instrument"
 	xlog "github.com/m3db/m3x/log"
@@ -50,6 +52,16 @@ type Configuration struct {
 	// The HTTP host and port on which to listen for the cluster service.
 	HTTPClusterListenAddress string `yaml:"httpClusterListenAddress" validate:"nonzero"`
 
+	// The gRPC host and port on which to listen for the node service.
+	GRPCNodeListenAddress string `yaml:"grpcNodeListenAddress"`
+
+	// The gRPC host and port on which to listen for the cluster service.
+	GRPCClusterListenAddress string `yaml:"grpcClusterListenAddress"`
+
+	// The gRPC server configuration, applies to both the node and cluster
+	// gRPC services.
+	GRPC *grpcserver.Configuration `yaml:"grpc"`
+
 	// The host and port on which to listen for debug endpoints.
 	DebugListenAddress string `yaml:"debugListenAddress"`
 
@@ -68,6 +80,16 @@ type Configuration struct {
 	// Write new series backoff between batches of new series insertions.
 	WriteNewSeriesBackoffDuration time.Duration `yaml:"writeNewSeriesBackoffDuration"`
 
+	// Max live series per shard, writes for new series are rejected once
+	// reached. Zero disables the limit. Overridden by the runtime options KV
+	// key when set there.
+	MaxSeriesPerShard int `yaml:"maxSeriesPerShard" validate:"min=0"`
+
+	// Max live series per namespace on the node, writes for new series are
+	// rejected once reached. Zero disables the limit. Overridden by the
+	// runtime options KV key when set there.
+	MaxSeriesPerNamespace int `yaml:"maxSeriesPerNamespace" validate:"min=0"`
+
 	// The tick configuration, omit this to use default settings.
 	Tick *TickConfiguration `yaml:"tick"`
 
@@ -143,6 +165,17 @@ type CommitLogPolicy struct {
 
 	// The commit log block size.
 	BlockSize time.Duration `yaml:"blockSize" validate:"nonzero"`
+
+	// The codec used to compress and encrypt commit log chunks.
+	Codec *BlockCodecConfiguration `yaml:"codec"`
+}
+
+// NewChunkCodec returns the commit log chunk codec specified.
+func (p CommitLogPolicy) NewChunkCodec() (fs.BlockCodec, error) {
+	if p.Codec == nil {
+		return fs.NewOptions().BlockCodec(), nil
+	}
+	return p.Codec.NewBlockCodec()
 }
 
 // CalculationType is a type of configuration parameter.
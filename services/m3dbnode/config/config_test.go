@@ -23,8 +23,11 @@ package config
 import (
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/m3db/m3db/persist/fs"
+	"github.com/m3db/m3db/persist/schema"
 	xtest "github.com/m3db/m3db/x/test"
 	xconfig "github.com/m3db/m3x/config"
 
@@ -282,6 +285,9 @@ listenAddress: 0.0.0.0:9000
 clusterListenAddress: 0.0.0.0:9001
 httpNodeListenAddress: 0.0.0.0:9002
 httpClusterListenAddress: 0.0.0.0:9003
+grpcNodeListenAddress: ""
+grpcClusterListenAddress: ""
+grpc: null
 debugListenAddress: 0.0.0.0:9004
 hostID:
   resolver: hostname
@@ -315,9 +321,15 @@ client:
   backgroundHealthCheckFailThrottleFactor: 0.5
   hashing:
     seed: 42
+  hintedHandoff: null
+  readRepair: null
+  transport: null
+  grpc: null
 gcPercentage: 100
 writeNewSeriesLimitPerSecond: 1048576
 writeNewSeriesBackoffDuration: 2ms
+maxSeriesPerShard: 0
+maxSeriesPerNamespace: 0
 tick: null
 bootstrap:
   bootstrappers:
@@ -327,6 +339,8 @@ bootstrap:
   fs:
     numProcessorsPerCPU: 0.125
   peers: null
+  backup: null
+  remote: null
 blockRetrieve: null
 cache:
   series: null
@@ -341,6 +355,7 @@ fs:
   newFileMode: null
   newDirectoryMode: null
   mmap: null
+  codec: null
 commitlog:
   flushMaxBytes: 524288
   flushEvery: 1s
@@ -349,6 +364,7 @@ commitlog:
     size: 2097152
   retentionPeriod: 24h0m0s
   blockSize: 10m0s
+  codec: null
 repair:
   enabled: false
   interval: 2h0m0s
@@ -487,3 +503,32 @@ writeNewSeriesAsync: true
 		require.FailNow(t, "reverse config did not match:\n"+diff)
 	}
 }
+
+func TestCommitLogPolicyNewChunkCodec(t *testing.T) {
+	dir, err := ioutil.TempDir("", "commitlog-config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	keyFile := filepath.Join(dir, "key")
+	key := "000102030405060708090a0b0c0d0e0f"
+	require.NoError(t, ioutil.WriteFile(keyFile, []byte(key), 0600))
+
+	codec, err := CommitLogPolicy{}.NewChunkCodec()
+	require.NoError(t, err)
+	assert.Equal(t, schema.IndexCodecInfo{}, codec.Info())
+
+	codec, err = CommitLogPolicy{
+		Codec: &BlockCodecConfiguration{
+			Compression: "snappy",
+			Encryption:  &EncryptionConfiguration{KeyFile: keyFile},
+		},
+	}.NewChunkCodec()
+	require.NoError(t, err)
+	assert.Equal(t, int64(fs.CompressionSnappy), codec.Info().Compression)
+	assert.Equal(t, int64(fs.EncryptionAESGCM), codec.Info().Encryption)
+
+	_, err = CommitLogPolicy{
+		Codec: &BlockCodecConfiguration{Compression: "lz4"},
+	}.NewChunkCodec()
+	assert.Error(t, err)
+}
@@ -23,6 +23,8 @@ package config
 import (
 	"fmt"
 	"os"
+
+	"github.com/m3db/m3db/persist/fs"
 )
 
 const (
@@ -75,6 +77,30 @@ type FilesystemConfiguration struct {
 
 	// Mmap is the mmap options which features are primarily platform dependent
 	Mmap *MmapConfiguration `yaml:"mmap"`
+
+	// Codec is the codec used to compress and encrypt blocks of data files
+	Codec *BlockCodecConfiguration `yaml:"codec"`
+}
+
+// BlockCodecConfiguration is the block codec configuration of data files
+// and commit logs.
+type BlockCodecConfiguration struct {
+	// Compression is the compression type, one of none, snappy or zstd
+	Compression string `yaml:"compression"`
+
+	// Encryption is the encryption configuration, if set blocks are
+	// encrypted with AES-GCM
+	Encryption *EncryptionConfiguration `yaml:"encryption"`
+}
+
+// EncryptionConfiguration is the data file and commit log encryption configuration.
+type EncryptionConfiguration struct {
+	// KeyFile is the path of the file holding the hex encoded AES key
+	KeyFile string `yaml:"keyFile" validate:"nonzero"`
+
+	// DecryptOnly if true only uses the key to read encrypted data files
+	// without encrypting new data files, to allow migrating off encryption
+	DecryptOnly bool `yaml:"decryptOnly"`
 }
 
 // MmapConfiguration is the mmap configuration.
@@ -148,3 +174,40 @@ func (p FilesystemConfiguration) MmapConfiguration() MmapConfiguration {
 	}
 	return *p.Mmap
 }
+
+// NewBlockCodec returns the data file block codec specified.
+func (p FilesystemConfiguration) NewBlockCodec() (fs.BlockCodec, error) {
+	if p.Codec == nil {
+		return fs.NewOptions().BlockCodec(), nil
+	}
+	return p.Codec.NewBlockCodec()
+}
+
+// NewBlockCodec returns the block codec specified.
+func (c BlockCodecConfiguration) NewBlockCodec() (fs.BlockCodec, error) {
+	compression := fs.CompressionNone
+	if str := c.Compression; str != "" {
+		var err error
+		compression, err = fs.ParseCompressionType(str)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		encryption = fs.EncryptionNone
+		key        []byte
+	)
+	if cfg := c.Encryption; cfg != nil {
+		var err error
+		key, err = fs.ReadEncryptionKeyFile(cfg.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		if !cfg.DecryptOnly {
+			encryption = fs.EncryptionAESGCM
+		}
+	}
+
+	return fs.NewBlockCodec(compression, encryption, key)
+}
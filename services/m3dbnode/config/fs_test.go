@@ -21,9 +21,14 @@
 package config
 
 import (
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/m3db/m3db/persist/fs"
+	"github.com/m3db/m3db/persist/schema"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -47,3 +52,40 @@ func TestFilesystemConfigurationParseNewDirectoryMode(t *testing.T) {
 
 	assert.Equal(t, os.FileMode(0775)|os.ModeDir, v)
 }
+
+func TestFilesystemConfigurationNewBlockCodec(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fs-config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	keyFile := filepath.Join(dir, "key")
+	key := "000102030405060708090a0b0c0d0e0f"
+	require.NoError(t, ioutil.WriteFile(keyFile, []byte(key), 0600))
+
+	codec, err := FilesystemConfiguration{}.NewBlockCodec()
+	require.NoError(t, err)
+	assert.Equal(t, schema.IndexCodecInfo{}, codec.Info())
+
+	codec, err = FilesystemConfiguration{
+		Codec: &BlockCodecConfiguration{
+			Compression: "zstd",
+			Encryption:  &EncryptionConfiguration{KeyFile: keyFile},
+		},
+	}.NewBlockCodec()
+	require.NoError(t, err)
+	assert.Equal(t, int64(fs.CompressionZstd), codec.Info().Compression)
+	assert.Equal(t, int64(fs.EncryptionAESGCM), codec.Info().Encryption)
+
+	codec, err = FilesystemConfiguration{
+		Codec: &BlockCodecConfiguration{
+			Encryption: &EncryptionConfiguration{KeyFile: keyFile, DecryptOnly: true},
+		},
+	}.NewBlockCodec()
+	require.NoError(t, err)
+	assert.Equal(t, schema.IndexCodecInfo{}, codec.Info())
+
+	_, err = FilesystemConfiguration{
+		Codec: &BlockCodecConfiguration{Compression: "lz4"},
+	}.NewBlockCodec()
+	assert.Error(t, err)
+}
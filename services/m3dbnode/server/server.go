@@ -40,6 +40,9 @@ import (
 	"github.com/m3db/m3db/encoding/m3tsz"
 	"github.com/m3db/m3db/environment"
 	"github.com/m3db/m3db/kvconfig"
+	grpcserver "github.com/m3db/m3db/network/server/grpc"
+	grpccluster "github.com/m3db/m3db/network/server/grpc/cluster"
+	grpcnode "github.com/m3db/m3db/network/server/grpc/node"
 	hjcluster "github.com/m3db/m3db/network/server/httpjson/cluster"
 	hjnode "github.com/m3db/m3db/network/server/httpjson/node"
 	"github.com/m3db/m3db/network/server/tchannelthrift"
@@ -131,7 +134,9 @@ func Run(runOpts RunOptions) {
 			SetLimitMbps(cfg.Filesystem.ThroughputLimitMbps).
 			SetLimitCheckEvery(cfg.Filesystem.ThroughputCheckEvery)).
 		SetWriteNewSeriesAsync(cfg.WriteNewSeriesAsync).
-		SetWriteNewSeriesBackoffDuration(cfg.WriteNewSeriesBackoffDuration)
+		SetWriteNewSeriesBackoffDuration(cfg.WriteNewSeriesBackoffDuration).
+		SetMaxSeriesPerShard(cfg.MaxSeriesPerShard).
+		SetMaxSeriesPerNamespace(cfg.MaxSeriesPerNamespace)
 
 	if tick := cfg.Tick; tick != nil {
 		runtimeOpts = runtimeOpts.
@@ -172,6 +177,11 @@ func Run(runOpts RunOptions) {
 		}
 	}
 
+	blockCodec, err := cfg.Filesystem.NewBlockCodec()
+	if err != nil {
+		logger.Fatalf("could not create data file block codec: %v", err)
+	}
+
 	fsopts := fs.NewOptions().
 		SetClockOptions(opts.ClockOptions()).
 		SetInstrumentOptions(opts.InstrumentOptions().
@@ -185,7 +195,13 @@ func Run(runOpts RunOptions) {
 		SetSeekReaderBufferSize(cfg.Filesystem.SeekReadBufferSize).
 		SetMmapEnableHugeTLB(shouldUseHugeTLB).
 		SetMmapHugeTLBThreshold(mmapCfg.HugeTLB.Threshold).
-		SetRuntimeOptionsManager(runtimeOptsMgr)
+		SetRuntimeOptionsManager(runtimeOptsMgr).
+		SetBlockCodec(blockCodec)
+
+	chunkCodec, err := cfg.CommitLog.NewChunkCodec()
+	if err != nil {
+		logger.Fatalf("could not create commit log chunk codec: %v", err)
+	}
 
 	var commitLogQueueSize int
 	specified := cfg.CommitLog.Queue.Size
@@ -207,15 +223,28 @@ func Run(runOpts RunOptions) {
 		SetFlushInterval(cfg.CommitLog.FlushEvery).
 		SetBacklogQueueSize(commitLogQueueSize).
 		SetRetentionPeriod(cfg.CommitLog.RetentionPeriod).
-		SetBlockSize(cfg.CommitLog.BlockSize))
+		SetBlockSize(cfg.CommitLog.BlockSize).
+		SetChunkCodec(chunkCodec))
 
 	// Set the series cache policy
-	seriesCachePolicy := cfg.Cache.SeriesConfiguration().Policy
+	seriesCacheCfg := cfg.Cache.SeriesConfiguration()
+	seriesCachePolicy := seriesCacheCfg.Policy
 	opts = opts.SetSeriesCachePolicy(seriesCachePolicy)
 
 	// Apply pooling options
 	opts = withEncodingAndPoolingOptions(logger, opts, cfg.PoolingPolicy)
 
+	if seriesCachePolicy == series.CacheLRU {
+		maxBlocks := block.DefaultWiredListCapacity
+		if lruCfg := seriesCacheCfg.LRU; lruCfg != nil && lruCfg.MaxBlocks > 0 {
+			maxBlocks = lruCfg.MaxBlocks
+		}
+		wiredList := block.NewWiredList(maxBlocks, iopts)
+		defer wiredList.Close()
+		opts = opts.SetDatabaseBlockOptions(opts.DatabaseBlockOptions().
+			SetWiredList(wiredList))
+	}
+
 	// Setup the block retriever
 	switch seriesCachePolicy {
 	case series.CacheAll:
@@ -295,7 +324,8 @@ func Run(runOpts RunOptions) {
 		client.ConfigurationParameters{
 			InstrumentOptions: iopts.
 				SetMetricsScope(iopts.MetricsScope().SubScope("m3dbclient")),
-			TopologyInitializer: envCfg.TopologyInitializer,
+			TopologyInitializer:  envCfg.TopologyInitializer,
+			NamespaceInitializer: envCfg.NamespaceInitializer,
 		},
 		func(opts client.AdminOptions) client.AdminOptions {
 			return opts.SetContextPool(opts.ContextPool()).(client.AdminOptions)
@@ -315,6 +345,20 @@ func Run(runOpts RunOptions) {
 
 	opts = opts.SetBootstrapProcess(bs)
 
+	runtimeOptsSource, err := m3dbruntime.NewKVOptionsSource(runtimeOptsMgr,
+		m3dbruntime.NewKVOptionsSourceOptions().
+			SetInstrumentOptions(iopts).
+			SetKVStore(envCfg.KVStore).
+			SetKey(kvconfig.RuntimeOptionsKey).
+			SetHostID(hostID))
+	if err != nil {
+		logger.Fatalf("could not create runtime options source: %v", err)
+	}
+	if err := runtimeOptsSource.Start(); err != nil {
+		logger.Errorf("could not start runtime options source: %v", err)
+	}
+	defer runtimeOptsSource.Close()
+
 	timeout := bootstrapConfigInitTimeout
 	kvWatchBootstrappers(envCfg.KVStore, logger, timeout, cfg.Bootstrap.Bootstrappers,
 		func(bootstrappers []string) {
@@ -416,6 +460,36 @@ func Run(runOpts RunOptions) {
 	defer httpjsonClusterClose()
 	logger.Infof("cluster httpjson: listening on %v", cfg.HTTPClusterListenAddress)
 
+	var grpcOpts grpcserver.ServerOptions
+	if cfg.GRPC != nil {
+		grpcOpts, err = cfg.GRPC.NewServerOptions()
+		if err != nil {
+			logger.Fatalf("could not create grpc server options: %v", err)
+		}
+	}
+
+	if cfg.GRPCNodeListenAddress != "" {
+		grpcNodeClose, err := grpcnode.NewServer(db,
+			cfg.GRPCNodeListenAddress, contextPool, grpcOpts, ttopts).ListenAndServe()
+		if err != nil {
+			logger.Fatalf("could not open grpc interface on %s: %v",
+				cfg.GRPCNodeListenAddress, err)
+		}
+		defer grpcNodeClose()
+		logger.Infof("node grpc: listening on %v", cfg.GRPCNodeListenAddress)
+	}
+
+	if cfg.GRPCClusterListenAddress != "" {
+		grpcClusterClose, err := grpccluster.NewServer(m3dbClient,
+			cfg.GRPCClusterListenAddress, contextPool, grpcOpts).ListenAndServe()
+		if err != nil {
+			logger.Fatalf("could not open grpc interface on %s: %v",
+				cfg.GRPCClusterListenAddress, err)
+		}
+		defer grpcClusterClose()
+		logger.Infof("cluster grpc: listening on %v", cfg.GRPCClusterListenAddress)
+	}
+
 	if cfg.DebugListenAddress != "" {
 		go func() {
 			if err := http.ListenAndServe(cfg.DebugListenAddress, nil); err != nil {
@@ -440,7 +514,7 @@ func Run(runOpts RunOptions) {
 
 		// Only set the write new series limit after bootstrapping
 		kvWatchNewSeriesLimitPerShard(envCfg.KVStore, logger, topo,
-			runtimeOptsMgr, cfg.WriteNewSeriesLimitPerSecond)
+			runtimeOptsSource, cfg.WriteNewSeriesLimitPerSecond)
 	}()
 
 	// Handle interrupt
@@ -486,11 +560,15 @@ func interrupt() <-chan os.Signal {
 	return c
 }
 
+// kvWatchNewSeriesLimitPerShard resolves and watches the cluster new series
+// insert limit, the limit is applied to the baseline of the runtime options
+// source so that a write new series limit set by the runtime options key
+// takes precedence.
 func kvWatchNewSeriesLimitPerShard(
 	store kv.Store,
 	logger xlog.Logger,
 	topo topology.Topology,
-	runtimeOptsMgr m3dbruntime.OptionsManager,
+	runtimeOptsSource m3dbruntime.KVOptionsSource,
 	defaultClusterNewSeriesLimit int,
 ) {
 	var initClusterLimit int
@@ -511,7 +589,7 @@ func kvWatchNewSeriesLimitPerShard(
 		initClusterLimit = defaultClusterNewSeriesLimit
 	}
 
-	err = setNewSeriesLimitPerShardOnChange(topo, runtimeOptsMgr, initClusterLimit)
+	err = setNewSeriesLimitPerShardOnChange(topo, runtimeOptsSource, initClusterLimit)
 	if err != nil {
 		logger.Warnf("unable to set cluster new series insert limit: %v", err)
 	}
@@ -532,7 +610,7 @@ func kvWatchNewSeriesLimitPerShard(
 			}
 
 			value := int(protoValue.Value)
-			err = setNewSeriesLimitPerShardOnChange(topo, runtimeOptsMgr, value)
+			err = setNewSeriesLimitPerShardOnChange(topo, runtimeOptsSource, value)
 			if err != nil {
 				logger.Warnf("unable to set cluster new series insert limit: %v", err)
 				continue
@@ -543,19 +621,19 @@ func kvWatchNewSeriesLimitPerShard(
 
 func setNewSeriesLimitPerShardOnChange(
 	topo topology.Topology,
-	runtimeOptsMgr m3dbruntime.OptionsManager,
+	runtimeOptsSource m3dbruntime.KVOptionsSource,
 	clusterLimit int,
 ) error {
 	perPlacedShardLimit := clusterLimitToPlacedShardLimit(topo, clusterLimit)
-	runtimeOpts := runtimeOptsMgr.Get()
-	if runtimeOpts.WriteNewSeriesLimitPerShardPerSecond() == perPlacedShardLimit {
+	baseline := runtimeOptsSource.Baseline()
+	if baseline.WriteNewSeriesLimitPerShardPerSecond() == perPlacedShardLimit {
 		// Not changed, no need to set the value and trigger a runtime options update
 		return nil
 	}
 
-	newRuntimeOpts := runtimeOpts.
-		SetWriteNewSeriesLimitPerShardPerSecond(perPlacedShardLimit)
-	return runtimeOptsMgr.Update(newRuntimeOpts)
+	return runtimeOptsSource.UpdateBaseline(func(opts m3dbruntime.Options) m3dbruntime.Options {
+		return opts.SetWriteNewSeriesLimitPerShardPerSecond(perPlacedShardLimit)
+	})
 }
 
 func clusterLimitToPlacedShardLimit(topo topology.Topology, clusterLimit int) int {
@@ -121,8 +121,12 @@ func (s *fetchBlockMetadataResults) Close() {
 }
 
 // NewFetchBlocksMetadataResult creates new database blocks metadata
-func NewFetchBlocksMetadataResult(id ident.ID, blocks FetchBlockMetadataResults) FetchBlocksMetadataResult {
-	return FetchBlocksMetadataResult{ID: id, Blocks: blocks}
+func NewFetchBlocksMetadataResult(
+	id ident.ID,
+	tags ident.Tags,
+	blocks FetchBlockMetadataResults,
+) FetchBlocksMetadataResult {
+	return FetchBlocksMetadataResult{ID: id, Tags: tags, Blocks: blocks}
 }
 
 type fetchBlocksMetadataResults struct {
@@ -70,11 +70,11 @@ func TestFilteredBlocksMetadataIter(t *testing.T) {
 	checksums := []uint32{6, 7, 8}
 	lastRead := now.Add(-100 * time.Millisecond)
 	inputs := []FetchBlocksMetadataResult{
-		NewFetchBlocksMetadataResult(ident.StringID("foo"), newPooledFetchBlockMetadataResults(
+		NewFetchBlocksMetadataResult(ident.StringID("foo"), nil, newPooledFetchBlockMetadataResults(
 			[]FetchBlockMetadataResult{
 				NewFetchBlockMetadataResult(now.Add(-time.Second), sizes[0], &checksums[0], lastRead, nil),
 			}, nil)),
-		NewFetchBlocksMetadataResult(ident.StringID("bar"), newPooledFetchBlockMetadataResults(
+		NewFetchBlocksMetadataResult(ident.StringID("bar"), nil, newPooledFetchBlockMetadataResults(
 			[]FetchBlockMetadataResult{
 				NewFetchBlockMetadataResult(now, sizes[1], &checksums[1], lastRead, nil),
 				NewFetchBlockMetadataResult(now.Add(time.Second), sizes[2], &checksums[2], lastRead, errors.New("foo")),
@@ -113,6 +113,7 @@ type FetchBlockMetadataResults interface {
 // FetchBlocksMetadataResult captures the fetch results for multiple blocks.
 type FetchBlocksMetadataResult struct {
 	ID     ident.ID
+	Tags   ident.Tags
 	Blocks FetchBlockMetadataResults
 }
 
@@ -389,4 +390,12 @@ type Options interface {
 
 	// BytesPool returns the bytesPool
 	BytesPool() pool.CheckedBytesPool
+
+	// SetWiredList sets the wired list used to hold retrieved blocks
+	// when using the LRU series cache policy
+	SetWiredList(value *WiredList) Options
+
+	// WiredList returns the wired list used to hold retrieved blocks
+	// when using the LRU series cache policy
+	WiredList() *WiredList
 }
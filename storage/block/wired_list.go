@@ -0,0 +1,231 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package block
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/m3db/m3x/instrument"
+
+	"github.com/uber-go/tally"
+)
+
+const (
+	// DefaultWiredListCapacity is the default max number of blocks held
+	// in a wired list.
+	DefaultWiredListCapacity = 65536
+
+	// wiredListEvictionQueueSize is the max number of evicted blocks waiting
+	// for their owners to be notified.
+	wiredListEvictionQueueSize = 4096
+)
+
+// OnEvictedFromWiredList is implemented by the owner of a block held in a
+// wired list, it is called when the block is evicted from the wired list so
+// the owner can remove and free the block.
+type OnEvictedFromWiredList interface {
+	// OnEvictedFromWiredList is called when the block is evicted.
+	OnEvictedFromWiredList(b DatabaseBlock)
+}
+
+// WiredList is a fixed capacity LRU of blocks retrieved from disk that spans
+// all shards of a database. Blocks are moved to the front of the list as they
+// are read and the least recently used blocks are evicted once the list grows
+// beyond its capacity.
+//
+// Evictions are handed to the block owners by a single eviction worker so
+// that owners can safely update the list while holding their own locks. By
+// the time an owner is notified the block may have been closed and reused, so
+// owners must check under their own lock that the block is still theirs and
+// is no longer held by the list before closing it.
+//
+// The queue of evictions is bounded, when it is full the least recently used
+// blocks are kept in the list beyond its capacity until a later update finds
+// room in the queue rather than blocking the caller.
+type WiredList struct {
+	sync.Mutex
+
+	capacity int
+	list     *list.List
+	elems    map[DatabaseBlock]*list.Element
+	evictCh  chan *wiredListEntry
+	closed   bool
+	doneCh   chan struct{}
+	metrics  wiredListMetrics
+}
+
+type wiredListEntry struct {
+	block DatabaseBlock
+	owner OnEvictedFromWiredList
+}
+
+type wiredListMetrics struct {
+	hits              tally.Counter
+	misses            tally.Counter
+	evictions         tally.Counter
+	evictionsDeferred tally.Counter
+}
+
+func newWiredListMetrics(scope tally.Scope) wiredListMetrics {
+	return wiredListMetrics{
+		hits:              scope.Counter("hits"),
+		misses:            scope.Counter("misses"),
+		evictions:         scope.Counter("evictions"),
+		evictionsDeferred: scope.Counter("evictions-deferred"),
+	}
+}
+
+// NewWiredList returns a new wired list holding at most capacity blocks, the
+// eviction worker runs until the list is closed.
+func NewWiredList(capacity int, iopts instrument.Options) *WiredList {
+	return newWiredList(capacity, wiredListEvictionQueueSize, iopts)
+}
+
+func newWiredList(capacity, queueSize int, iopts instrument.Options) *WiredList {
+	if capacity <= 0 {
+		capacity = DefaultWiredListCapacity
+	}
+	scope := iopts.MetricsScope().SubScope("wired-list")
+	l := &WiredList{
+		capacity: capacity,
+		list:     list.New(),
+		elems:    make(map[DatabaseBlock]*list.Element),
+		evictCh:  make(chan *wiredListEntry, queueSize),
+		doneCh:   make(chan struct{}),
+		metrics:  newWiredListMetrics(scope),
+	}
+	go l.evictLoop()
+	return l
+}
+
+func (l *WiredList) evictLoop() {
+	for entry := range l.evictCh {
+		entry.owner.OnEvictedFromWiredList(entry.block)
+	}
+	close(l.doneCh)
+}
+
+// Update marks the block as most recently used, inserting it into the list if
+// it is not already held. Inserting a block may evict the least recently used
+// blocks, their owners are notified by the eviction worker.
+func (l *WiredList) Update(b DatabaseBlock, owner OnEvictedFromWiredList) {
+	l.Lock()
+	if elem, ok := l.elems[b]; ok {
+		elem.Value.(*wiredListEntry).owner = owner
+		l.list.MoveToFront(elem)
+		l.Unlock()
+		return
+	}
+
+	l.elems[b] = l.list.PushFront(&wiredListEntry{block: b, owner: owner})
+
+	var evicted, deferred int64
+	for !l.closed && l.list.Len() > l.capacity {
+		back := l.list.Back()
+		entry := back.Value.(*wiredListEntry)
+		select {
+		case l.evictCh <- entry:
+			l.list.Remove(back)
+			delete(l.elems, entry.block)
+			evicted++
+		default:
+			// NB: Never block on a full queue as the caller may hold the lock
+			// of an owner the eviction worker is waiting on
+			deferred = int64(l.list.Len() - l.capacity)
+		}
+		if deferred > 0 {
+			break
+		}
+	}
+	l.Unlock()
+
+	if evicted > 0 {
+		l.metrics.evictions.Inc(evicted)
+	}
+	if deferred > 0 {
+		l.metrics.evictionsDeferred.Inc(deferred)
+	}
+}
+
+// MarkRead marks a block already held by the list as most recently used,
+// returning false if the list does not hold the block.
+func (l *WiredList) MarkRead(b DatabaseBlock) bool {
+	l.Lock()
+	elem, ok := l.elems[b]
+	if ok {
+		l.list.MoveToFront(elem)
+	}
+	l.Unlock()
+	if ok {
+		l.metrics.hits.Inc(1)
+	}
+	return ok
+}
+
+// MarkMissed records a read of a block that was not held in memory and is
+// being retrieved from disk.
+func (l *WiredList) MarkMissed() {
+	l.metrics.misses.Inc(1)
+}
+
+// Contains returns whether the list holds the block.
+func (l *WiredList) Contains(b DatabaseBlock) bool {
+	l.Lock()
+	_, ok := l.elems[b]
+	l.Unlock()
+	return ok
+}
+
+// Remove removes the block from the list without notifying its owner, this
+// must be called by owners before closing a block held by the list.
+func (l *WiredList) Remove(b DatabaseBlock) {
+	l.Lock()
+	if elem, ok := l.elems[b]; ok {
+		l.list.Remove(elem)
+		delete(l.elems, b)
+	}
+	l.Unlock()
+}
+
+// Close stops the eviction worker once the evictions already queued have been
+// handed to their owners, blocks are no longer evicted after the list is
+// closed.
+func (l *WiredList) Close() {
+	l.Lock()
+	if l.closed {
+		l.Unlock()
+		return
+	}
+	l.closed = true
+	close(l.evictCh)
+	l.Unlock()
+
+	<-l.doneCh
+}
+
+// Len returns the number of blocks held by the list.
+func (l *WiredList) Len() int {
+	l.Lock()
+	n := l.list.Len()
+	l.Unlock()
+	return n
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package block
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3db/ts"
+	"github.com/m3db/m3x/instrument"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+type testWiredListOwner struct {
+	evicted chan DatabaseBlock
+}
+
+func newTestWiredListOwner() *testWiredListOwner {
+	return &testWiredListOwner{evicted: make(chan DatabaseBlock, 16)}
+}
+
+func (o *testWiredListOwner) OnEvictedFromWiredList(b DatabaseBlock) {
+	o.evicted <- b
+}
+
+func newTestWiredList(capacity, queueSize int) (*WiredList, tally.TestScope) {
+	scope := tally.NewTestScope("", nil)
+	iopts := instrument.NewOptions().SetMetricsScope(scope)
+	return newWiredList(capacity, queueSize, iopts), scope
+}
+
+func requireEvicted(t *testing.T, owner *testWiredListOwner, b DatabaseBlock) {
+	select {
+	case evicted := <-owner.evicted:
+		require.True(t, evicted == b)
+	case <-time.After(time.Minute):
+		require.FailNow(t, "timed out waiting for eviction")
+	}
+}
+
+func newTestWiredListBlocks(n int) []DatabaseBlock {
+	opts := NewOptions()
+	start := time.Now().Truncate(time.Hour)
+	blocks := make([]DatabaseBlock, 0, n)
+	for i := 0; i < n; i++ {
+		blocks = append(blocks, NewDatabaseBlock(start.Add(time.Duration(i)*time.Hour),
+			ts.Segment{}, opts))
+	}
+	return blocks
+}
+
+func TestWiredListEvictsLeastRecentlyUsed(t *testing.T) {
+	l, scope := newTestWiredList(2, wiredListEvictionQueueSize)
+	defer l.Close()
+	owner := newTestWiredListOwner()
+	blocks := newTestWiredListBlocks(3)
+
+	// Each block is missed and retrieved before being inserted
+	for _, b := range blocks[:2] {
+		l.MarkMissed()
+		l.Update(b, owner)
+	}
+	require.True(t, l.MarkRead(blocks[0]))
+	l.MarkMissed()
+	l.Update(blocks[2], owner)
+
+	requireEvicted(t, owner, blocks[1])
+	require.Equal(t, 2, l.Len())
+	require.False(t, l.MarkRead(blocks[1]))
+	require.False(t, l.Contains(blocks[1]))
+	require.True(t, l.Contains(blocks[2]))
+
+	counters := scope.Snapshot().Counters()
+	require.Equal(t, int64(3), counters["wired-list.misses+"].Value())
+	require.Equal(t, int64(1), counters["wired-list.hits+"].Value())
+	require.Equal(t, int64(1), counters["wired-list.evictions+"].Value())
+}
+
+func TestWiredListRemove(t *testing.T) {
+	l, _ := newTestWiredList(1, wiredListEvictionQueueSize)
+	defer l.Close()
+	owner := newTestWiredListOwner()
+	blocks := newTestWiredListBlocks(2)
+
+	l.Update(blocks[0], owner)
+	l.Remove(blocks[0])
+	require.Equal(t, 0, l.Len())
+
+	// Removed blocks are never handed back to their owner
+	l.Update(blocks[1], owner)
+	require.Equal(t, 1, l.Len())
+	require.Equal(t, 0, len(owner.evicted))
+}
+
+func TestWiredListDefersEvictionsWhenQueueFull(t *testing.T) {
+	l, scope := newTestWiredList(1, 1)
+	defer l.Close()
+	owner := &testWiredListOwner{evicted: make(chan DatabaseBlock)}
+	blocks := newTestWiredListBlocks(5)
+
+	// The worker blocks handing the first eviction to the owner
+	l.Update(blocks[0], owner)
+	l.Update(blocks[1], owner)
+	for len(l.evictCh) != 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// The second eviction fills the queue and the third is deferred
+	l.Update(blocks[2], owner)
+	l.Update(blocks[3], owner)
+	require.Equal(t, 2, l.Len())
+	require.True(t, l.Contains(blocks[2]))
+
+	counters := scope.Snapshot().Counters()
+	require.Equal(t, int64(2), counters["wired-list.evictions+"].Value())
+	require.Equal(t, int64(1), counters["wired-list.evictions-deferred+"].Value())
+
+	requireEvicted(t, owner, blocks[0])
+	requireEvicted(t, owner, blocks[1])
+
+	// The deferred block is evicted once the queue has room
+	l.Update(blocks[4], owner)
+	requireEvicted(t, owner, blocks[2])
+	require.False(t, l.Contains(blocks[2]))
+}
+
+func TestWiredListClose(t *testing.T) {
+	l, _ := newTestWiredList(1, wiredListEvictionQueueSize)
+	owner := newTestWiredListOwner()
+	blocks := newTestWiredListBlocks(3)
+
+	l.Update(blocks[0], owner)
+	l.Update(blocks[1], owner)
+
+	// Queued evictions are handed to their owners before close returns
+	l.Close()
+	require.Equal(t, 1, len(owner.evicted))
+
+	// Blocks are no longer evicted once closed
+	l.Update(blocks[2], owner)
+	require.Equal(t, 2, l.Len())
+	l.Close()
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/m3db/m3db/storage/bootstrap"
+	"github.com/m3db/m3db/storage/bootstrap/bootstrapper"
+)
+
+const (
+	// BackupBootstrapperName is the name of the backup bootstrapper.
+	BackupBootstrapperName = "backup"
+
+	// RemoteBootstrapperName is the name of the remote fileset store
+	// bootstrapper, it restores the latest filesets of all backups held by
+	// a store shared between nodes.
+	RemoteBootstrapperName = "remote"
+)
+
+var (
+	errBackupIDNotSet = errors.New("backup ID not set")
+)
+
+type backupBootstrapper struct {
+	bootstrap.Bootstrapper
+
+	name string
+}
+
+// NewBackupBootstrapper creates a new bootstrapper to bootstrap from a backup,
+// filesets held by the backup are restored to disk before being read.
+func NewBackupBootstrapper(
+	opts Options,
+	next bootstrap.Bootstrapper,
+) (bootstrap.Bootstrapper, error) {
+	if opts.BackupID() == "" {
+		return nil, fmt.Errorf("unable to validate backup options: %v", errBackupIDNotSet)
+	}
+	return newBackupBootstrapper(BackupBootstrapperName, opts, next)
+}
+
+// NewRemoteBootstrapper creates a new bootstrapper to bootstrap from the
+// latest filesets held by a fileset store shared between nodes, any backup
+// ID set on the options is ignored.
+func NewRemoteBootstrapper(
+	opts Options,
+	next bootstrap.Bootstrapper,
+) (bootstrap.Bootstrapper, error) {
+	return newBackupBootstrapper(RemoteBootstrapperName, opts.SetBackupID(""), next)
+}
+
+func newBackupBootstrapper(
+	name string,
+	opts Options,
+	next bootstrap.Bootstrapper,
+) (bootstrap.Bootstrapper, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("unable to validate backup options: %v", err)
+	}
+
+	src := newBackupSource(opts)
+	b := &backupBootstrapper{name: name}
+	b.Bootstrapper = bootstrapper.NewBaseBootstrapper(b.String(),
+		src, opts.ResultOptions(), next)
+	return b, nil
+}
+
+func (b *backupBootstrapper) String() string {
+	return b.name
+}
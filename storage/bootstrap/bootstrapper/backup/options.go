@@ -0,0 +1,95 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"errors"
+
+	fsbackup "github.com/m3db/m3db/persist/fs/backup"
+	"github.com/m3db/m3db/storage/bootstrap/bootstrapper/fs"
+	"github.com/m3db/m3db/storage/bootstrap/result"
+)
+
+var (
+	errBackupManagerNotSet = errors.New("backup manager not set")
+)
+
+type options struct {
+	resultOpts      result.Options
+	fsBootstrapOpts fs.Options
+	backupManager   fsbackup.Manager
+	backupID        string
+}
+
+// NewOptions creates new bootstrap options
+func NewOptions() Options {
+	return &options{
+		resultOpts:      result.NewOptions(),
+		fsBootstrapOpts: fs.NewOptions(),
+	}
+}
+
+func (o *options) Validate() error {
+	if o.backupManager == nil {
+		return errBackupManagerNotSet
+	}
+	return nil
+}
+
+func (o *options) SetResultOptions(value result.Options) Options {
+	opts := *o
+	opts.resultOpts = value
+	return &opts
+}
+
+func (o *options) ResultOptions() result.Options {
+	return o.resultOpts
+}
+
+func (o *options) SetFilesystemBootstrapperOptions(value fs.Options) Options {
+	opts := *o
+	opts.fsBootstrapOpts = value
+	return &opts
+}
+
+func (o *options) FilesystemBootstrapperOptions() fs.Options {
+	return o.fsBootstrapOpts
+}
+
+func (o *options) SetBackupManager(value fsbackup.Manager) Options {
+	opts := *o
+	opts.backupManager = value
+	return &opts
+}
+
+func (o *options) BackupManager() fsbackup.Manager {
+	return o.backupManager
+}
+
+func (o *options) SetBackupID(value string) Options {
+	opts := *o
+	opts.backupID = value
+	return &opts
+}
+
+func (o *options) BackupID() string {
+	return o.backupID
+}
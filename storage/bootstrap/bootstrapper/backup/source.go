@@ -0,0 +1,155 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	fsbackup "github.com/m3db/m3db/persist/fs/backup"
+	"github.com/m3db/m3db/storage/bootstrap"
+	"github.com/m3db/m3db/storage/bootstrap/bootstrapper/fs"
+	"github.com/m3db/m3db/storage/bootstrap/result"
+	"github.com/m3db/m3db/storage/namespace"
+	xlog "github.com/m3db/m3x/log"
+	xtime "github.com/m3db/m3x/time"
+)
+
+type backupSource struct {
+	opts     Options
+	log      xlog.Logger
+	manager  fsbackup.Manager
+	backupID string
+	fsSource bootstrap.Source
+}
+
+func newBackupSource(opts Options) bootstrap.Source {
+	fsOpts := opts.FilesystemBootstrapperOptions().
+		SetResultOptions(opts.ResultOptions())
+	prefix := fsOpts.FilesystemOptions().FilePathPrefix()
+	return &backupSource{
+		opts:     opts,
+		log:      opts.ResultOptions().InstrumentOptions().Logger(),
+		manager:  opts.BackupManager(),
+		backupID: opts.BackupID(),
+		fsSource: fs.NewFileSystemSource(prefix, fsOpts),
+	}
+}
+
+func (s *backupSource) Can(strategy bootstrap.Strategy) bool {
+	switch strategy {
+	case bootstrap.BootstrapSequential:
+		return true
+	}
+	return false
+}
+
+func (s *backupSource) Available(
+	md namespace.Metadata,
+	shardsTimeRanges result.ShardTimeRanges,
+) result.ShardTimeRanges {
+	result := make(map[uint32]xtime.Ranges)
+	manifest, ok := s.manifest()
+	if !ok {
+		return result
+	}
+	for shard, ranges := range shardsTimeRanges {
+		var tr xtime.Ranges
+		entries := manifest.ShardEntries(fsbackup.DataFileSetType, md.ID(), shard)
+		for _, entry := range entries {
+			if currRange := entry.Range(); ranges.Overlaps(currRange) {
+				tr = tr.AddRange(currRange)
+			}
+		}
+		result[shard] = tr
+	}
+	return result
+}
+
+func (s *backupSource) Read(
+	md namespace.Metadata,
+	shardsTimeRanges result.ShardTimeRanges,
+	opts bootstrap.RunOptions,
+) (result.BootstrapResult, error) {
+	if shardsTimeRanges.IsEmpty() {
+		return nil, nil
+	}
+
+	manifest, ok := s.manifest()
+	if !ok {
+		bootstrapResult := result.NewBootstrapResult()
+		bootstrapResult.SetUnfulfilled(shardsTimeRanges)
+		return bootstrapResult, nil
+	}
+
+	// Restore the filesets overlapping the requested ranges to disk, those
+	// that fail to be restored are left unfulfilled by the filesystem source
+	var all xtime.Ranges
+	for shard, ranges := range shardsTimeRanges {
+		all = all.AddRanges(ranges)
+		for _, fileSetType := range []fsbackup.FileSetType{
+			fsbackup.DataFileSetType,
+			fsbackup.SnapshotFileSetType,
+		} {
+			s.restore(manifest.ShardEntries(fileSetType, md.ID(), shard), ranges)
+		}
+	}
+	s.restore(manifest.ShardEntries(fsbackup.IndexFileSetType, md.ID(), 0), all)
+
+	return s.fsSource.Read(md, shardsTimeRanges, opts)
+}
+
+func (s *backupSource) manifest() (fsbackup.Manifest, bool) {
+	var (
+		manifest fsbackup.Manifest
+		err      error
+	)
+	if s.backupID == "" {
+		// Without a backup ID restore the latest filesets of all backups,
+		// as when a store is shared between nodes
+		manifest, err = s.manager.LatestManifest()
+	} else {
+		manifest, err = s.manager.Manifest(s.backupID)
+	}
+	if err != nil {
+		s.log.WithFields(
+			xlog.NewField("backupID", s.backupID),
+			xlog.NewField("error", err.Error()),
+		).Error("unable to read backup manifest")
+		return fsbackup.Manifest{}, false
+	}
+	return manifest, true
+}
+
+func (s *backupSource) restore(entries []fsbackup.ManifestEntry, ranges xtime.Ranges) {
+	for _, entry := range entries {
+		if !ranges.Overlaps(entry.Range()) {
+			continue
+		}
+		if err := s.manager.Restore(entry); err != nil {
+			s.log.WithFields(
+				xlog.NewField("backupID", entry.BackupID),
+				xlog.NewField("type", string(entry.Type)),
+				xlog.NewField("namespace", entry.Namespace),
+				xlog.NewField("shard", entry.Shard),
+				xlog.NewField("blockStart", entry.BlockStart.String()),
+				xlog.NewField("error", err.Error()),
+			).Error("unable to restore fileset from backup")
+		}
+	}
+}
@@ -0,0 +1,302 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3db/digest"
+	"github.com/m3db/m3db/persist/fs"
+	fsbackup "github.com/m3db/m3db/persist/fs/backup"
+	"github.com/m3db/m3db/retention"
+	"github.com/m3db/m3db/storage/bootstrap"
+	bfs "github.com/m3db/m3db/storage/bootstrap/bootstrapper/fs"
+	"github.com/m3db/m3db/storage/bootstrap/result"
+	"github.com/m3db/m3db/storage/namespace"
+	"github.com/m3db/m3db/storage/series"
+	"github.com/m3db/m3x/checked"
+	"github.com/m3db/m3x/clock"
+	"github.com/m3db/m3x/context"
+	"github.com/m3db/m3x/ident"
+	xtime "github.com/m3db/m3x/time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testBackupID      = "backup-1"
+	testNewerBackupID = "backup-2"
+)
+
+var (
+	testShard             = uint32(0)
+	testNs1ID             = ident.StringID("testNs")
+	testBlockSize         = 2 * time.Hour
+	testStart             = time.Now().Truncate(testBlockSize).Add(-10 * testBlockSize)
+	testDefaultRunOpts    = bootstrap.NewRunOptions().SetIncremental(false)
+	testDefaultResultOpts = result.NewOptions().SetSeriesCachePolicy(series.CacheAll)
+)
+
+func testNsMetadata(t *testing.T) namespace.Metadata {
+	ropts := retention.NewOptions().SetBlockSize(testBlockSize)
+	md, err := namespace.NewMetadata(testNs1ID, namespace.NewOptions().SetRetentionOptions(ropts))
+	require.NoError(t, err)
+	return md
+}
+
+func writeTSDBFiles(t *testing.T, dir string, start time.Time, id string, data []byte) {
+	w, err := fs.NewWriter(fs.NewOptions().SetFilePathPrefix(dir))
+	require.NoError(t, err)
+	require.NoError(t, w.Open(testNs1ID, testBlockSize, testShard, start))
+
+	bytes := checked.NewBytes(data, nil)
+	bytes.IncRef()
+	require.NoError(t, w.Write(ident.StringID(id), nil, bytes, digest.Checksum(bytes.Get())))
+	require.NoError(t, w.Close())
+}
+
+func validateTimeRanges(t *testing.T, tr xtime.Ranges, expected []xtime.Range) {
+	require.Equal(t, len(expected), tr.Len())
+	it := tr.Iter()
+	idx := 0
+	for it.Next() {
+		require.True(t, expected[idx].Equal(it.Value()))
+		idx++
+	}
+}
+
+func newTestManager(t *testing.T, prefix, storeDir string, nowFn clock.NowFn) fsbackup.Manager {
+	m, err := fsbackup.NewManager(fsbackup.NewOptions().
+		SetFilesystemOptions(fs.NewOptions().
+			SetFilePathPrefix(prefix).
+			SetClockOptions(clock.NewOptions().SetNowFn(nowFn))).
+		SetBlobStore(fsbackup.NewLocalBlobStore(storeDir)))
+	require.NoError(t, err)
+	return m
+}
+
+// newTestSource backs up filesets written to a source directory twice,
+// rewriting a block in between, and returns a source that restores from the
+// given backup, or the latest filesets if empty, to an empty destination
+// directory.
+func newTestSource(t *testing.T, dir, backupID string) (bootstrap.Source, string) {
+	var (
+		srcPrefix  = filepath.Join(dir, "src")
+		destPrefix = filepath.Join(dir, "dest")
+		backupDir  = filepath.Join(dir, "backups")
+		now        = time.Now()
+		nowFn      = func() time.Time { return now }
+	)
+	src := newTestManager(t, srcPrefix, backupDir, nowFn)
+	writeTSDBFiles(t, srcPrefix, testStart, "foo", []byte{1, 2, 3})
+	writeTSDBFiles(t, srcPrefix, testStart.Add(4*time.Hour), "bar", []byte{4, 5, 6})
+	_, err := src.Backup(testBackupID, []ident.ID{testNs1ID})
+	require.NoError(t, err)
+
+	now = now.Add(time.Minute)
+	require.NoError(t, os.RemoveAll(srcPrefix))
+	writeTSDBFiles(t, srcPrefix, testStart, "foo", []byte{7, 8, 9})
+	_, err = src.Backup(testNewerBackupID, []ident.ID{testNs1ID})
+	require.NoError(t, err)
+
+	destFsOpts := fs.NewOptions().SetFilePathPrefix(destPrefix)
+	opts := NewOptions().
+		SetResultOptions(testDefaultResultOpts).
+		SetFilesystemBootstrapperOptions(bfs.NewOptions().SetFilesystemOptions(destFsOpts)).
+		SetBackupManager(newTestManager(t, destPrefix, backupDir, time.Now)).
+		SetBackupID(backupID)
+	require.NoError(t, opts.Validate())
+	return newBackupSource(opts), destPrefix
+}
+
+func requireBlockData(t *testing.T, s result.DatabaseSeriesBlocks, start time.Time, expected []byte) {
+	block, ok := s.Blocks.BlockAt(start)
+	require.True(t, ok)
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+	stream, err := block.Stream(ctx)
+	require.NoError(t, err)
+	var b [100]byte
+	n, err := stream.Read(b[:])
+	require.NoError(t, err)
+	require.Equal(t, expected, b[:n])
+}
+
+func TestBackupSourceAvailable(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	src, _ := newTestSource(t, dir, testBackupID)
+	ranges := xtime.Ranges{}.AddRange(xtime.Range{
+		Start: testStart.Add(time.Hour),
+		End:   testStart.Add(3 * time.Hour),
+	})
+	res := src.Available(testNsMetadata(t), result.ShardTimeRanges{
+		testShard:     ranges,
+		testShard + 1: ranges,
+	})
+
+	validateTimeRanges(t, res[testShard], []xtime.Range{
+		{Start: testStart, End: testStart.Add(testBlockSize)},
+	})
+	require.True(t, res[testShard+1].IsEmpty())
+}
+
+func TestBackupSourceRead(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	src, destPrefix := newTestSource(t, dir, testBackupID)
+	ranges := xtime.Ranges{}.AddRange(xtime.Range{
+		Start: testStart,
+		End:   testStart.Add(4 * time.Hour),
+	})
+	res, err := src.Read(testNsMetadata(t), result.ShardTimeRanges{
+		testShard: ranges,
+	}, testDefaultRunOpts)
+	require.NoError(t, err)
+
+	// Only the fileset overlapping the requested ranges is restored
+	require.True(t, fs.FilesetExistsAt(destPrefix, testNs1ID, testShard, testStart))
+	require.False(t, fs.FilesetExistsAt(destPrefix, testNs1ID, testShard, testStart.Add(4*time.Hour)))
+
+	validateTimeRanges(t, res.Unfulfilled()[testShard], []xtime.Range{
+		{Start: testStart.Add(testBlockSize), End: testStart.Add(4 * time.Hour)},
+	})
+
+	allSeries := res.ShardResults()[testShard].AllSeries()
+	require.Equal(t, 1, len(allSeries))
+	foo, ok := allSeries[ident.StringID("foo").Hash()]
+	require.True(t, ok)
+	requireBlockData(t, foo, testStart, []byte{1, 2, 3})
+}
+
+func TestBackupSourceAvailableLatest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	src, _ := newTestSource(t, dir, "")
+	ranges := xtime.Ranges{}.AddRange(xtime.Range{
+		Start: testStart,
+		End:   testStart.Add(6 * time.Hour),
+	})
+	res := src.Available(testNsMetadata(t), result.ShardTimeRanges{
+		testShard:     ranges,
+		testShard + 1: ranges,
+	})
+
+	validateTimeRanges(t, res[testShard], []xtime.Range{
+		{Start: testStart, End: testStart.Add(testBlockSize)},
+		{Start: testStart.Add(4 * time.Hour), End: testStart.Add(6 * time.Hour)},
+	})
+	require.True(t, res[testShard+1].IsEmpty())
+}
+
+func TestBackupSourceReadLatest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	src, destPrefix := newTestSource(t, dir, "")
+	ranges := xtime.Ranges{}.AddRange(xtime.Range{
+		Start: testStart,
+		End:   testStart.Add(6 * time.Hour),
+	})
+	res, err := src.Read(testNsMetadata(t), result.ShardTimeRanges{
+		testShard: ranges,
+	}, testDefaultRunOpts)
+	require.NoError(t, err)
+
+	require.True(t, fs.FilesetExistsAt(destPrefix, testNs1ID, testShard, testStart))
+	require.True(t, fs.FilesetExistsAt(destPrefix, testNs1ID, testShard, testStart.Add(4*time.Hour)))
+
+	// The gap not held by any backup is left for the next bootstrapper
+	validateTimeRanges(t, res.Unfulfilled()[testShard], []xtime.Range{
+		{Start: testStart.Add(testBlockSize), End: testStart.Add(4 * time.Hour)},
+	})
+
+	// The rewritten block is restored from the newer backup
+	allSeries := res.ShardResults()[testShard].AllSeries()
+	require.Equal(t, 2, len(allSeries))
+	foo, ok := allSeries[ident.StringID("foo").Hash()]
+	require.True(t, ok)
+	requireBlockData(t, foo, testStart, []byte{7, 8, 9})
+	bar, ok := allSeries[ident.StringID("bar").Hash()]
+	require.True(t, ok)
+	requireBlockData(t, bar, testStart.Add(4*time.Hour), []byte{4, 5, 6})
+}
+
+func TestBackupSourceMissingBackup(t *testing.T) {
+	// An empty backup ID restores the latest filesets of an empty store
+	for _, backupID := range []string{"missing", ""} {
+		dir, err := ioutil.TempDir("", "backup")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		src := newBackupSource(NewOptions().
+			SetResultOptions(testDefaultResultOpts).
+			SetFilesystemBootstrapperOptions(bfs.NewOptions().
+				SetFilesystemOptions(fs.NewOptions().SetFilePathPrefix(dir))).
+			SetBackupManager(newTestManager(t, dir, filepath.Join(dir, "backups"), time.Now)).
+			SetBackupID(backupID))
+
+		shardsTimeRanges := result.ShardTimeRanges{
+			testShard: xtime.Ranges{}.AddRange(xtime.Range{
+				Start: testStart,
+				End:   testStart.Add(testBlockSize),
+			}),
+		}
+		require.True(t, src.Available(testNsMetadata(t), shardsTimeRanges).IsEmpty())
+
+		res, err := src.Read(testNsMetadata(t), shardsTimeRanges, testDefaultRunOpts)
+		require.NoError(t, err)
+		validateTimeRanges(t, res.Unfulfilled()[testShard], []xtime.Range{
+			{Start: testStart, End: testStart.Add(testBlockSize)},
+		})
+	}
+}
+
+func TestNewBackupBootstrapperValidatesOptions(t *testing.T) {
+	_, err := NewBackupBootstrapper(NewOptions(), nil)
+	require.Error(t, err)
+
+	// The backup ID is required by the backup bootstrapper only
+	manager := newTestManager(t, "", "", time.Now)
+	_, err = NewBackupBootstrapper(NewOptions().SetBackupManager(manager), nil)
+	require.Error(t, err)
+
+	_, err = NewRemoteBootstrapper(NewOptions(), nil)
+	require.Error(t, err)
+
+	b, err := NewRemoteBootstrapper(NewOptions().
+		SetBackupManager(manager).
+		SetBackupID(testBackupID), nil)
+	require.NoError(t, err)
+	require.Equal(t, RemoteBootstrapperName, b.String())
+}
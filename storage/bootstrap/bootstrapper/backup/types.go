@@ -0,0 +1,62 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	fsbackup "github.com/m3db/m3db/persist/fs/backup"
+	"github.com/m3db/m3db/storage/bootstrap/bootstrapper/fs"
+	"github.com/m3db/m3db/storage/bootstrap/result"
+)
+
+// Options represents the options for bootstrapping from a backup.
+type Options interface {
+	// Validate validates the options.
+	Validate() error
+
+	// SetResultOptions sets the result options.
+	SetResultOptions(value result.Options) Options
+
+	// ResultOptions returns the result options.
+	ResultOptions() result.Options
+
+	// SetFilesystemBootstrapperOptions sets the options used to read the
+	// restored filesets, filesets are restored to their file path prefix.
+	SetFilesystemBootstrapperOptions(value fs.Options) Options
+
+	// FilesystemBootstrapperOptions returns the options used to read the
+	// restored filesets, filesets are restored to their file path prefix.
+	FilesystemBootstrapperOptions() fs.Options
+
+	// SetBackupManager sets the backup manager, it must restore filesets
+	// to the same file path prefix they are read from.
+	SetBackupManager(value fsbackup.Manager) Options
+
+	// BackupManager returns the backup manager.
+	BackupManager() fsbackup.Manager
+
+	// SetBackupID sets the ID of the backup to restore from, if empty the
+	// latest filesets across all backups are restored.
+	SetBackupID(value string) Options
+
+	// BackupID returns the ID of the backup to restore from, if empty the
+	// latest filesets across all backups are restored.
+	BackupID() string
+}
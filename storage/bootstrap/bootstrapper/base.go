@@ -110,6 +110,11 @@ func (b *baseBootstrapper) Bootstrap(
 	nowFn := b.opts.ClockOptions().NowFn()
 	begin := nowFn()
 
+	reporter := opts.ProgressReporter()
+	if !available.IsEmpty() {
+		reporter.ReportSourceStarted(b.name, available)
+	}
+
 	currResult, currErr = b.src.Read(ns, available, opts)
 
 	logFields = append(logFields, xlog.NewField("took", nowFn().Sub(begin).String()))
@@ -123,6 +128,10 @@ func (b *baseBootstrapper) Bootstrap(
 		}
 		logFields = append(logFields, xlog.NewField("numSeries", numSeries))
 		b.log.WithFields(logFields...).Infof("bootstrapping from source completed successfully")
+
+		if currResult != nil {
+			b.reportFetched(reporter, currResult.ShardResults())
+		}
 	}
 
 	wg.Wait()
@@ -142,6 +151,8 @@ func (b *baseBootstrapper) Bootstrap(
 	if nextResult != nil {
 		// Union the results
 		mergedResult.ShardResults().AddResults(nextResult.ShardResults())
+		mergedResult.IndexResults().AddResults(nextResult.IndexResults())
+		mergedResult.ColdWriteResults().AddResults(nextResult.ColdWriteResults())
 		// Save the first next unfulfilled time ranges
 		firstNextUnfulfilled = nextResult.Unfulfilled()
 	} else {
@@ -160,6 +171,8 @@ func (b *baseBootstrapper) Bootstrap(
 		if nextResult != nil {
 			// Union the results
 			mergedResult.ShardResults().AddResults(nextResult.ShardResults())
+			mergedResult.IndexResults().AddResults(nextResult.IndexResults())
+			mergedResult.ColdWriteResults().AddResults(nextResult.ColdWriteResults())
 
 			// Set the unfulfilled ranges and don't use a union considering the
 			// next bootstrapper was asked to fulfill all outstanding ranges of
@@ -178,6 +191,27 @@ func (b *baseBootstrapper) Bootstrap(
 	return mergedResult, nil
 }
 
+// reportFetched reports the series and bytes of data held by the shard results
+// read by the source.
+func (b *baseBootstrapper) reportFetched(
+	reporter bootstrap.ProgressReporter,
+	shardResults result.ShardResults,
+) {
+	for shard, shardResult := range shardResults {
+		if shardResult == nil {
+			continue
+		}
+		var numSeries, numBytes int64
+		for _, series := range shardResult.AllSeries() {
+			numSeries++
+			for _, block := range series.Blocks.AllBlocks() {
+				numBytes += int64(block.Len())
+			}
+		}
+		reporter.ReportFetched(b.name, shard, numSeries, numBytes)
+	}
+}
+
 // String returns the name of the bootstrapper.
 func (b *baseBootstrapper) String() string {
 	return baseBootstrapperName
@@ -80,7 +80,7 @@ func shardResult(entries ...testBlockEntry) result.ShardResult {
 	for _, entry := range entries {
 		block := opts.DatabaseBlockOptions().DatabaseBlockPool().Get()
 		block.Reset(entry.t, ts.Segment{})
-		res.AddBlock(ident.StringID(entry.id), block)
+		res.AddBlock(ident.StringID(entry.id), nil, block)
 	}
 	return res
 }
@@ -245,6 +245,38 @@ func TestBaseBootstrapperCurrentNoUnfulfilled(t *testing.T) {
 	validateResult(t, result, res)
 }
 
+func TestBaseBootstrapperReportsProgress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	source, _, base := testBaseBootstrapper(t, ctrl)
+	testNs := testNsMetadata(t)
+
+	targetRanges := testShardTimeRanges()
+	result := testResult(map[uint32]testShardResult{
+		testShard: {result: shardResult(
+			testBlockEntry{"foo", testTargetStart},
+			testBlockEntry{"bar", testTargetStart},
+		)},
+	})
+
+	reporter := bootstrap.NewMockProgressReporter(ctrl)
+	runOpts := testDefaultRunOpts.SetProgressReporter(reporter)
+	gomock.InOrder(
+		source.EXPECT().
+			Available(testNs, targetRanges).
+			Return(targetRanges),
+		reporter.EXPECT().ReportSourceStarted("mock", targetRanges),
+		source.EXPECT().
+			Read(testNs, targetRanges, runOpts).
+			Return(result, nil),
+		reporter.EXPECT().ReportFetched("mock", testShard, int64(2), int64(0)),
+	)
+
+	res, err := base.Bootstrap(testNs, targetRanges, runOpts)
+	require.NoError(t, err)
+	validateResult(t, result, res)
+}
+
 func TestBaseBootstrapperCurrentSomeUnfulfilled(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -27,7 +27,9 @@ import (
 	"time"
 
 	"github.com/m3db/m3db/encoding"
+	"github.com/m3db/m3db/persist/fs"
 	"github.com/m3db/m3db/persist/fs/commitlog"
+	"github.com/m3db/m3db/retention"
 	"github.com/m3db/m3db/storage/block"
 	"github.com/m3db/m3db/storage/bootstrap"
 	"github.com/m3db/m3db/storage/bootstrap/result"
@@ -36,6 +38,7 @@ import (
 	"github.com/m3db/m3db/x/xio"
 	"github.com/m3db/m3x/ident"
 	xlog "github.com/m3db/m3x/log"
+	"github.com/m3db/m3x/pool"
 	xsync "github.com/m3db/m3x/sync"
 	xtime "github.com/m3db/m3x/time"
 )
@@ -44,10 +47,16 @@ const encoderChanBufSize = 1000
 
 type newIteratorFn func(opts commitlog.IteratorOpts) (commitlog.Iterator, error)
 
+type newFileSetReaderFn func(
+	bytesPool pool.CheckedBytesPool,
+	opts fs.Options,
+) (fs.FileSetReader, error)
+
 type commitLogSource struct {
 	opts          Options
 	log           xlog.Logger
 	newIteratorFn newIteratorFn
+	newReaderFn   newFileSetReaderFn
 }
 
 type encoder struct {
@@ -60,8 +69,10 @@ func newCommitLogSource(opts Options) bootstrap.Source {
 		opts:          opts,
 		log:           opts.ResultOptions().InstrumentOptions().Logger(),
 		newIteratorFn: commitlog.NewIterator,
+		newReaderFn:   fs.NewReader,
 	}
 }
+
 func (s *commitLogSource) Can(strategy bootstrap.Strategy) bool {
 	switch strategy {
 	case bootstrap.BootstrapSequential:
@@ -89,7 +100,11 @@ func (s *commitLogSource) Read(
 		return nil, nil
 	}
 
-	readCommitLogPredicate := newReadCommitLogPredicate(ns, shardsTimeRanges, s.opts)
+	// Load the latest snapshots first so that only the commit logs written
+	// after them need to be replayed
+	snapshots := s.readSnapshots(ns, shardsTimeRanges)
+
+	readCommitLogPredicate := newReadCommitLogPredicate(ns, shardsTimeRanges, s.opts, snapshots.cutoff)
 	readSeriesPredicate := newReadSeriesPredicate(ns)
 	iterOpts := commitlog.IteratorOpts{
 		CommitLogOptions:      s.opts.CommitLogOptions(),
@@ -110,9 +125,14 @@ func (s *commitLogSource) Read(
 		numConc     = s.opts.EncodingConcurrency()
 		bopts       = s.opts.ResultOptions()
 		blopts      = bopts.DatabaseBlockOptions()
-		blockSize   = ns.Options().RetentionOptions().BlockSize()
+		ropts       = ns.Options().RetentionOptions()
+		blockSize   = ropts.BlockSize()
 		encoderPool = bopts.DatabaseBlockOptions().EncoderPool()
 		workerErrs  = make([]int, numConc)
+		coldWrites  = ns.Options().ColdWritesEnabled()
+		now         = bopts.ClockOptions().NowFn()()
+		earliest    = retention.FlushTimeStart(ropts, now)
+		bufferPast  = ropts.BufferPast()
 	)
 
 	unmerged := make([]encodersAndRanges, numShards)
@@ -121,6 +141,9 @@ func (s *commitLogSource) Read(
 			encodersBySeries: make(map[uint64]encodersByTime),
 			ranges:           shardsTimeRanges[shard],
 		}
+		if coldWrites {
+			unmerged[shard].coldEncodersBySeries = make(map[uint64]encodersByTime)
+		}
 	}
 
 	encoderChans := make([]chan encoderArg, numConc)
@@ -147,7 +170,20 @@ func (s *commitLogSource) Read(
 
 	for iter.Next() {
 		series, dp, unit, annotation := iter.Current()
+		writtenAt := iter.CurrentWrittenAt()
+		cold := false
 		if !s.shouldEncodeSeries(unmerged, blockSize, series, dp) {
+			if !coldWrites || !s.shouldEncodeColdSeries(unmerged, blockSize, bufferPast,
+				earliest, now, series, dp, writtenAt) {
+				continue
+			}
+			// Datapoint was a cold write for a block outside of the ranges
+			// being bootstrapped which may have already been flushed, it is
+			// returned as a cold write to be merged with the fileset
+			cold = true
+		} else if coldWrites && !snapshots.cutoff.IsZero() && !writtenAt.After(snapshots.cutoff) {
+			// All commitlogs are read when cold writes are enabled, writes
+			// made before the snapshot cutoff are already held by the snapshots
 			continue
 		}
 
@@ -165,6 +201,7 @@ func (s *commitLogSource) Read(
 			unit:       unit,
 			annotation: annotation,
 			blockStart: dp.Timestamp.Truncate(blockSize),
+			cold:       cold,
 		}
 	}
 
@@ -176,7 +213,179 @@ func (s *commitLogSource) Read(
 	wg.Wait()
 	s.logEncodingOutcome(workerErrs, iter)
 
-	return s.mergeShards(int(numShards), bopts, blopts, encoderPool, unmerged), nil
+	bootstrapResult := s.mergeShards(int(numShards), bopts, blopts, encoderPool, unmerged)
+	mergeSnapshotResults(bootstrapResult, snapshots.shardResults)
+	return bootstrapResult, nil
+}
+
+// readSnapshots loads the latest snapshot of the unflushed blocks of each shard
+// being bootstrapped.
+func (s *commitLogSource) readSnapshots(
+	ns namespace.Metadata,
+	shardsTimeRanges result.ShardTimeRanges,
+) snapshotResults {
+	results := snapshotResults{shardResults: make(result.ShardResults)}
+	if !ns.Options().SnapshotEnabled() {
+		return results
+	}
+
+	var (
+		fsOpts    = s.opts.CommitLogOptions().FilesystemOptions()
+		bytesPool = s.opts.ResultOptions().DatabaseBlockOptions().BytesPool()
+		covered   = true
+	)
+	reader, err := s.newReaderFn(bytesPool, fsOpts)
+	if err != nil {
+		s.log.Errorf("unable to create snapshot reader: %v", err)
+		return results
+	}
+
+	for shard, ranges := range shardsTimeRanges {
+		if ranges.IsEmpty() {
+			continue
+		}
+		shardResult, snapshotTime, ok := s.readShardSnapshots(reader, ns, shard, ranges)
+		if !ok {
+			covered = false
+			continue
+		}
+		if !shardResult.IsEmpty() {
+			results.shardResults[shard] = shardResult
+		}
+		if results.cutoff.IsZero() || snapshotTime.Before(results.cutoff) {
+			results.cutoff = snapshotTime
+		}
+	}
+
+	// NB: Data of the shards covered by snapshots is still used as the
+	// commit logs it was written to may have been removed since.
+	if !covered {
+		results.cutoff = time.Time{}
+	}
+	return results
+}
+
+// readShardSnapshots loads the latest snapshot of each block of a shard within
+// the ranges being bootstrapped. It returns the time up to which the snapshots
+// hold all writes made to the shard, and false if a block that could have been
+// written to before then has no readable snapshot.
+func (s *commitLogSource) readShardSnapshots(
+	reader fs.FileSetReader,
+	ns namespace.Metadata,
+	shard uint32,
+	ranges xtime.Ranges,
+) (result.ShardResult, time.Time, bool) {
+	var (
+		fsOpts       = s.opts.CommitLogOptions().FilesystemOptions()
+		ropts        = ns.Options().RetentionOptions()
+		blockSize    = ropts.BlockSize()
+		snapshotTime time.Time
+		blockStarts  []time.Time
+		snapshotted  = make(map[xtime.UnixNano]struct{})
+	)
+	infos := fs.ReadSnapshotInfoFiles(fsOpts.FilePathPrefix(), ns.ID(), shard,
+		fsOpts.InfoReaderBufferSize(), fsOpts.DecodingOptions())
+	for _, info := range infos {
+		blockStart := xtime.FromNanoseconds(info.Start)
+		blockRange := xtime.Range{Start: blockStart, End: blockStart.Add(blockSize)}
+		if info.SnapshotTime == 0 || !ranges.Overlaps(blockRange) {
+			continue
+		}
+		t := xtime.FromNanoseconds(info.SnapshotTime)
+		if snapshotTime.IsZero() || t.Before(snapshotTime) {
+			snapshotTime = t
+		}
+		blockStarts = append(blockStarts, blockStart)
+		snapshotted[xtime.ToUnixNano(blockStart)] = struct{}{}
+	}
+	if len(blockStarts) == 0 {
+		return nil, time.Time{}, false
+	}
+
+	// Blocks without a snapshot are only covered if they could not have
+	// received any writes before the snapshot was taken
+	bufferFuture := ropts.BufferFuture()
+	iter := ranges.Iter()
+	for iter.Next() {
+		curr := iter.Value()
+		for t := curr.Start.Truncate(blockSize); t.Before(curr.End); t = t.Add(blockSize) {
+			if _, ok := snapshotted[xtime.ToUnixNano(t)]; ok {
+				continue
+			}
+			if t.Add(-bufferFuture).Before(snapshotTime) {
+				return nil, time.Time{}, false
+			}
+		}
+	}
+
+	shardResult := result.NewShardResult(0, s.opts.ResultOptions())
+	for _, blockStart := range blockStarts {
+		if err := s.readSnapshotBlock(reader, ns.ID(), shard, blockStart, shardResult); err != nil {
+			s.log.WithFields(
+				xlog.NewField("shard", shard),
+				xlog.NewField("blockStart", blockStart.String()),
+				xlog.NewField("error", err.Error()),
+			).Error("unable to read snapshot")
+			shardResult.Close()
+			return nil, time.Time{}, false
+		}
+	}
+	return shardResult, snapshotTime, true
+}
+
+func (s *commitLogSource) readSnapshotBlock(
+	reader fs.FileSetReader,
+	namespace ident.ID,
+	shard uint32,
+	blockStart time.Time,
+	shardResult result.ShardResult,
+) error {
+	if err := reader.OpenSnapshot(namespace, shard, blockStart); err != nil {
+		return err
+	}
+
+	blocksPool := s.opts.ResultOptions().DatabaseBlockOptions().DatabaseBlockPool()
+	for i := 0; i < reader.Entries(); i++ {
+		id, tags, data, _, err := reader.Read()
+		if err != nil {
+			reader.Close()
+			return err
+		}
+		seriesBlock := blocksPool.Get()
+		seriesBlock.Reset(blockStart, ts.NewSegment(data, nil, ts.FinalizeHead))
+		shardResult.AddBlock(id, tags, seriesBlock)
+	}
+
+	if err := reader.Validate(); err != nil {
+		reader.Close()
+		return err
+	}
+	return reader.Close()
+}
+
+// mergeSnapshotResults merges snapshot data into the data replayed from the
+// commit logs, both may hold writes for the same block.
+func mergeSnapshotResults(
+	bootstrapResult result.BootstrapResult,
+	snapshotResults result.ShardResults,
+) {
+	shardResults := bootstrapResult.ShardResults()
+	for shard, snapshotResult := range snapshotResults {
+		shardResult, ok := shardResults[shard]
+		if !ok {
+			bootstrapResult.Add(shard, snapshotResult, xtime.Ranges{})
+			continue
+		}
+		for _, series := range snapshotResult.AllSeries() {
+			for _, b := range series.Blocks.AllBlocks() {
+				if existing, ok := shardResult.BlockAt(series.ID, b.StartTime()); ok {
+					existing.Merge(b)
+					continue
+				}
+				shardResult.AddBlock(series.ID, series.Tags, b)
+			}
+		}
+	}
 }
 
 func (s *commitLogSource) startM3TSZEncodingWorker(
@@ -198,6 +407,9 @@ func (s *commitLogSource) startM3TSZEncodingWorker(
 		)
 
 		unmergedShard := unmerged[series.Shard].encodersBySeries
+		if arg.cold {
+			unmergedShard = unmerged[series.Shard].coldEncodersBySeries
+		}
 		unmergedSeries, ok := unmergedShard[series.UniqueIndex]
 		if !ok {
 			unmergedSeries = encodersByTime{
@@ -270,6 +482,34 @@ func (s *commitLogSource) shouldEncodeSeries(
 	return ranges.Overlaps(blockRange)
 }
 
+func (s *commitLogSource) shouldEncodeColdSeries(
+	unmerged []encodersAndRanges,
+	blockSize time.Duration,
+	bufferPast time.Duration,
+	earliest time.Time,
+	now time.Time,
+	series commitlog.Series,
+	dp ts.Datapoint,
+	writtenAt time.Time,
+) bool {
+	if series.Shard > uint32(len(unmerged)-1) {
+		return false
+	}
+	if unmerged[series.Shard].coldEncodersBySeries == nil {
+		return false
+	}
+
+	// Only datapoints that were cold writes when written are replayed, the
+	// rest are already held by the flushed filesets. This mirrors the
+	// classification made by the series buffer when accepting the write.
+	if writtenAt.Add(-bufferPast).Before(dp.Timestamp) {
+		return false
+	}
+
+	blockStart := dp.Timestamp.Truncate(blockSize)
+	return !blockStart.Before(earliest) && blockStart.Before(now)
+}
+
 func (s *commitLogSource) mergeShards(
 	numShards int,
 	bopts result.Options,
@@ -300,7 +540,7 @@ func (s *commitLogSource) mergeShards(
 		mergeShardFunc := func() {
 			var shardResult result.ShardResult
 			shardResult, shardEmptyErrs[shard], shardErrs[shard] = s.mergeShard(
-				unmergedShard, blocksPool, multiReaderIteratorPool, encoderPool, blopts)
+				unmergedShard.encodersBySeries, blocksPool, multiReaderIteratorPool, encoderPool, blopts)
 			if shardResult != nil && len(shardResult.AllSeries()) > 0 {
 				// Prevent race conditions while updating bootstrapResult from multiple go-routines
 				bootstrapResultLock.Lock()
@@ -308,6 +548,20 @@ func (s *commitLogSource) mergeShards(
 				bootstrapResult.Add(uint32(shard), shardResult, xtime.Ranges{})
 				bootstrapResultLock.Unlock()
 			}
+
+			if len(unmergedShard.coldEncodersBySeries) > 0 {
+				coldResult, numEmptyErrs, numErrs := s.mergeShard(
+					unmergedShard.coldEncodersBySeries, blocksPool, multiReaderIteratorPool, encoderPool, blopts)
+				shardEmptyErrs[shard] += numEmptyErrs
+				shardErrs[shard] += numErrs
+				if coldResult != nil && len(coldResult.AllSeries()) > 0 {
+					bootstrapResultLock.Lock()
+					bootstrapResult.ColdWriteResults().AddResults(result.ShardResults{
+						uint32(shard): coldResult,
+					})
+					bootstrapResultLock.Unlock()
+				}
+			}
 			wg.Done()
 		}
 		workerPool.Go(mergeShardFunc)
@@ -320,7 +574,7 @@ func (s *commitLogSource) mergeShards(
 }
 
 func (s *commitLogSource) mergeShard(
-	unmergedShard encodersAndRanges,
+	encodersBySeries map[uint64]encodersByTime,
 	blocksPool block.DatabaseBlockPool,
 	multiReaderIteratorPool encoding.MultiReaderIteratorPool,
 	encoderPool encoding.EncoderPool,
@@ -330,7 +584,7 @@ func (s *commitLogSource) mergeShard(
 	var numShardEmptyErrs int
 	var numErrs int
 
-	for _, unmergedBlocks := range unmergedShard.encodersBySeries {
+	for _, unmergedBlocks := range encodersBySeries {
 		seriesBlocks, numSeriesEmptyErrs, numSeriesErrs := s.mergeSeries(
 			unmergedBlocks,
 			blocksPool,
@@ -341,9 +595,9 @@ func (s *commitLogSource) mergeShard(
 
 		if seriesBlocks != nil && seriesBlocks.Len() > 0 {
 			if shardResult == nil {
-				shardResult = result.NewShardResult(len(unmergedShard.encodersBySeries), s.opts.ResultOptions())
+				shardResult = result.NewShardResult(len(encodersBySeries), s.opts.ResultOptions())
 			}
-			shardResult.AddSeries(unmergedBlocks.id, seriesBlocks)
+			shardResult.AddSeries(unmergedBlocks.id, nil, seriesBlocks)
 		}
 
 		numShardEmptyErrs += numSeriesEmptyErrs
@@ -470,6 +724,7 @@ func newReadCommitLogPredicate(
 	ns namespace.Metadata,
 	shardsTimeRanges result.ShardTimeRanges,
 	opts Options,
+	snapshotCutoff time.Time,
 ) commitlog.FileFilterPredicate {
 	// Minimum and maximum times for which we want to bootstrap
 	shardMin, shardMax := shardsTimeRanges.MinMax()
@@ -483,7 +738,20 @@ func newReadCommitLogPredicate(
 	bufferPast := ns.Options().RetentionOptions().BufferPast()
 	bufferFuture := ns.Options().RetentionOptions().BufferFuture()
 
+	// Cold writes for any block within retention may be held by any
+	// commitlog so all of them must be read, the snapshot cutoff is then
+	// applied to each of the other writes as they are read
+	coldWritesEnabled := ns.Options().ColdWritesEnabled()
+
 	return func(entryTime time.Time, entryDuration time.Duration) bool {
+		if coldWritesEnabled {
+			return true
+		}
+		// Writes held by commitlogs that ended before the snapshot cutoff
+		// are already held by the snapshots
+		if !snapshotCutoff.IsZero() && !entryTime.Add(entryDuration).After(snapshotCutoff) {
+			return false
+		}
 		// If there is any amount of overlap between the commitlog range and the
 		// shardRange then we need to read the commitlog file
 		return xtime.Range{
@@ -500,9 +768,17 @@ func newReadSeriesPredicate(ns namespace.Metadata) commitlog.SeriesFilterPredica
 	}
 }
 
+type snapshotResults struct {
+	shardResults result.ShardResults
+	// cutoff is the time before which all writes to the shards being
+	// bootstrapped are held by snapshots, zero if any shard is not covered.
+	cutoff time.Time
+}
+
 type encodersAndRanges struct {
-	encodersBySeries map[uint64]encodersByTime
-	ranges           xtime.Ranges
+	encodersBySeries     map[uint64]encodersByTime
+	coldEncodersBySeries map[uint64]encodersByTime
+	ranges               xtime.Ranges
 }
 
 type encodersByTime struct {
@@ -520,6 +796,7 @@ type encoderArg struct {
 	unit       xtime.Unit
 	annotation ts.Annotation
 	blockStart time.Time
+	cold       bool
 }
 
 type encoders []encoder
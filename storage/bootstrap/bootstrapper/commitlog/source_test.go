@@ -24,19 +24,24 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"os"
 	"reflect"
 	"sort"
 	"testing"
 	"time"
 
+	"github.com/m3db/m3db/digest"
 	"github.com/m3db/m3db/encoding"
 	"github.com/m3db/m3db/encoding/m3tsz"
+	"github.com/m3db/m3db/persist/fs"
 	"github.com/m3db/m3db/persist/fs/commitlog"
 	"github.com/m3db/m3db/storage/block"
 	"github.com/m3db/m3db/storage/bootstrap"
 	"github.com/m3db/m3db/storage/bootstrap/result"
 	"github.com/m3db/m3db/storage/namespace"
 	"github.com/m3db/m3db/ts"
+	"github.com/m3db/m3x/checked"
 	"github.com/m3db/m3x/ident"
 	xtime "github.com/m3db/m3x/time"
 
@@ -286,6 +291,193 @@ func TestReadTrimsToRanges(t *testing.T) {
 	require.NoError(t, verifyShardResultsAreCorrect(values[1:3], res.ShardResults(), opts))
 }
 
+func TestReadColdWrites(t *testing.T) {
+	opts := testOptions()
+	md, err := namespace.NewMetadata(testNamespaceID,
+		namespace.NewOptions().SetColdWritesEnabled(true))
+	require.NoError(t, err)
+	src := newCommitLogSource(opts).(*commitLogSource)
+
+	blockSize := md.Options().RetentionOptions().BlockSize()
+	now := time.Now()
+	start := now.Truncate(blockSize).Add(-blockSize)
+	end := now
+
+	ranges := xtime.Ranges{}
+	ranges = ranges.AddRange(xtime.Range{
+		Start: start,
+		End:   end,
+	})
+
+	foo := commitlog.Series{Namespace: testNamespaceID, Shard: 0, ID: ident.StringID("foo")}
+	bar := commitlog.Series{Namespace: testNamespaceID, Shard: 1, ID: ident.StringID("bar")}
+
+	values := []testValue{
+		{foo, start.Add(-2 * blockSize), 1.0, xtime.Second, nil},
+		{bar, start.Add(-1 * time.Minute), 2.0, xtime.Second, nil},
+		{foo, start.Add(1 * time.Minute), 3.0, xtime.Second, nil},
+		// Beyond retention so should not be returned as a cold write
+		{foo, start.Add(-1 * md.Options().RetentionOptions().RetentionPeriod()).Add(-blockSize),
+			4.0, xtime.Second, nil},
+		// Written as it occurred so already held by the flushed fileset
+		{bar, start.Add(-blockSize).Add(time.Minute), 5.0, xtime.Second, nil},
+	}
+	// All but the in range and flushed warm writes were written cold
+	writtenAt := []time.Time{now, now, time.Time{}, now, time.Time{}}
+	src.newIteratorFn = func(_ commitlog.IteratorOpts) (commitlog.Iterator, error) {
+		iter := newTestCommitLogIterator(values, nil)
+		iter.writtenAt = writtenAt
+		return iter, nil
+	}
+
+	targetRanges := result.ShardTimeRanges{0: ranges, 1: ranges}
+	res, err := src.Read(md, targetRanges, testDefaultRunOpts)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, 1, len(res.ShardResults()))
+	require.NoError(t, verifyShardResultsAreCorrect(values[2:3], res.ShardResults(), opts))
+	require.Equal(t, 2, len(res.ColdWriteResults()))
+	require.NoError(t, verifyShardResultsAreCorrect(values[:2], res.ColdWriteResults(), opts))
+}
+
+func TestReadSnapshots(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testdir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	opts := testOptions()
+	fsOpts := opts.CommitLogOptions().FilesystemOptions().SetFilePathPrefix(dir)
+	opts = opts.SetCommitLogOptions(opts.CommitLogOptions().SetFilesystemOptions(fsOpts))
+	md, err := namespace.NewMetadata(testNamespaceID,
+		namespace.NewOptions().SetSnapshotEnabled(true))
+	require.NoError(t, err)
+	src := newCommitLogSource(opts).(*commitLogSource)
+
+	blockSize := md.Options().RetentionOptions().BlockSize()
+	now := time.Now()
+	start := now.Truncate(blockSize).Add(-blockSize)
+	snapshotTime := start.Add(30 * time.Minute)
+
+	ranges := xtime.Ranges{}
+	ranges = ranges.AddRange(xtime.Range{
+		Start: start,
+		End:   now,
+	})
+
+	foo := commitlog.Series{Namespace: testNamespaceID, Shard: 0, ID: ident.StringID("foo")}
+	bar := commitlog.Series{Namespace: testNamespaceID, Shard: 0, ID: ident.StringID("bar")}
+
+	snapshotted := []testValue{
+		{foo, start.Add(1 * time.Minute), 1.0, xtime.Second, nil},
+		{bar, start.Add(2 * time.Minute), 2.0, xtime.Second, nil},
+	}
+	values := []testValue{
+		{foo, start.Add(40 * time.Minute), 3.0, xtime.Second, nil},
+		{foo, start.Add(blockSize).Add(time.Minute), 4.0, xtime.Second, nil},
+	}
+
+	// Snapshot both blocks being bootstrapped, the latter before any writes
+	writeTestSnapshot(t, opts, fsOpts, blockSize, start, snapshotTime, snapshotted)
+	writeTestSnapshot(t, opts, fsOpts, blockSize, start.Add(blockSize), snapshotTime, nil)
+
+	var iterOpts commitlog.IteratorOpts
+	src.newIteratorFn = func(o commitlog.IteratorOpts) (commitlog.Iterator, error) {
+		iterOpts = o
+		return newTestCommitLogIterator(values, nil), nil
+	}
+
+	targetRanges := result.ShardTimeRanges{0: ranges}
+	res, err := src.Read(md, targetRanges, testDefaultRunOpts)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, 1, len(res.ShardResults()))
+	require.NoError(t, verifyShardResultsAreCorrect(append(snapshotted, values...), res.ShardResults(), opts))
+
+	// Only commit logs written after the snapshot are replayed
+	require.False(t, iterOpts.FileFilterPredicate(start.Add(10*time.Minute), 20*time.Minute))
+	require.True(t, iterOpts.FileFilterPredicate(start.Add(20*time.Minute), 20*time.Minute))
+}
+
+func TestReadSnapshotsColdWritesEnabled(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testdir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	opts := testOptions()
+	fsOpts := opts.CommitLogOptions().FilesystemOptions().SetFilePathPrefix(dir)
+	opts = opts.SetCommitLogOptions(opts.CommitLogOptions().SetFilesystemOptions(fsOpts))
+	md, err := namespace.NewMetadata(testNamespaceID,
+		namespace.NewOptions().SetSnapshotEnabled(true).SetColdWritesEnabled(true))
+	require.NoError(t, err)
+	src := newCommitLogSource(opts).(*commitLogSource)
+
+	blockSize := md.Options().RetentionOptions().BlockSize()
+	now := time.Now()
+	start := now.Truncate(blockSize).Add(-blockSize)
+	snapshotTime := start.Add(30 * time.Minute)
+
+	ranges := xtime.Ranges{}
+	ranges = ranges.AddRange(xtime.Range{
+		Start: start,
+		End:   now,
+	})
+
+	foo := commitlog.Series{Namespace: testNamespaceID, Shard: 0, ID: ident.StringID("foo")}
+
+	snapshotted := []testValue{
+		{foo, start.Add(1 * time.Minute), 1.0, xtime.Second, nil},
+	}
+	values := []testValue{
+		// Written before the snapshot so should not be replayed
+		{foo, start.Add(2 * time.Minute), 2.0, xtime.Second, nil},
+		{foo, start.Add(40 * time.Minute), 3.0, xtime.Second, nil},
+	}
+
+	writeTestSnapshot(t, opts, fsOpts, blockSize, start, snapshotTime, snapshotted)
+	writeTestSnapshot(t, opts, fsOpts, blockSize, start.Add(blockSize), snapshotTime, nil)
+
+	var iterOpts commitlog.IteratorOpts
+	src.newIteratorFn = func(o commitlog.IteratorOpts) (commitlog.Iterator, error) {
+		iterOpts = o
+		return newTestCommitLogIterator(values, nil), nil
+	}
+
+	targetRanges := result.ShardTimeRanges{0: ranges}
+	res, err := src.Read(md, targetRanges, testDefaultRunOpts)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, 1, len(res.ShardResults()))
+	require.NoError(t, verifyShardResultsAreCorrect(append(snapshotted, values[1:]...), res.ShardResults(), opts))
+	require.Equal(t, 0, len(res.ColdWriteResults()))
+
+	// All commit logs are read as they may hold cold writes
+	require.True(t, iterOpts.FileFilterPredicate(start.Add(10*time.Minute), 20*time.Minute))
+}
+
+func writeTestSnapshot(
+	t *testing.T,
+	opts Options,
+	fsOpts fs.Options,
+	blockSize time.Duration,
+	blockStart time.Time,
+	snapshotTime time.Time,
+	values []testValue,
+) {
+	writer, err := fs.NewWriter(fsOpts)
+	require.NoError(t, err)
+	require.NoError(t, writer.OpenSnapshot(testNamespaceID, blockSize, 0, blockStart, snapshotTime))
+	encoderPool := opts.ResultOptions().DatabaseBlockOptions().EncoderPool()
+	for _, v := range values {
+		enc := encoderPool.Get()
+		enc.Reset(blockStart, 0)
+		require.NoError(t, enc.Encode(ts.Datapoint{Timestamp: v.t, Value: v.v}, v.u, v.a))
+		data, err := ioutil.ReadAll(enc.Stream())
+		require.NoError(t, err)
+		require.NoError(t, writer.Write(v.s.ID, nil, checked.NewBytes(data, nil), digest.Checksum(data)))
+	}
+	require.NoError(t, writer.Close())
+}
+
 func TestNewReadCommitLogPredicate(t *testing.T) {
 	testCases := []struct {
 		title                    string
@@ -294,6 +486,7 @@ func TestNewReadCommitLogPredicate(t *testing.T) {
 		bufferPast               time.Duration
 		bufferFuture             time.Duration
 		blockSize                time.Duration
+		snapshotCutoff           time.Time
 		expectedPredicateResults []bool
 	}{
 		{
@@ -360,6 +553,24 @@ func TestNewReadCommitLogPredicate(t *testing.T) {
 			blockSize:                time.Hour,
 			expectedPredicateResults: []bool{true},
 		},
+		{
+			title: "Test ended before snapshot cutoff",
+			commitLogTimes: []time.Time{
+				time.Time{},
+				time.Time{}.Add(time.Hour),
+			},
+			shardTimeRanges: []xtime.Range{
+				xtime.Range{
+					Start: time.Time{},
+					End:   time.Time{}.Add(2 * time.Hour),
+				},
+			},
+			bufferPast:               5 * time.Minute,
+			bufferFuture:             10 * time.Minute,
+			blockSize:                time.Hour,
+			snapshotCutoff:           time.Time{}.Add(90 * time.Minute),
+			expectedPredicateResults: []bool{false, true},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -386,7 +597,7 @@ func TestNewReadCommitLogPredicate(t *testing.T) {
 			}
 
 			// Instantiate and test predicate
-			predicate := newReadCommitLogPredicate(ns, shardTimeRanges, opts)
+			predicate := newReadCommitLogPredicate(ns, shardTimeRanges, opts, tc.snapshotCutoff)
 			for i, commitLogTime := range tc.commitLogTimes {
 				predicateResult := predicate(commitLogTime, tc.blockSize)
 				require.Equal(t, tc.expectedPredicateResults[i], predicateResult)
@@ -458,7 +669,7 @@ func createExpectedShardResult(
 		if !ok {
 			shardResult = result.NewShardResult(0, bopts)
 			// Trigger blocks to be created for series
-			shardResult.AddSeries(v.s.ID, nil)
+			shardResult.AddSeries(v.s.ID, nil, nil)
 			expected[v.s.Shard] = shardResult
 		}
 
@@ -631,10 +842,11 @@ func verifyBlocksAreEqual(opts Options, expectedAllBlocks, actualAllBlocks map[x
 }
 
 type testCommitLogIterator struct {
-	values []testValue
-	idx    int
-	err    error
-	closed bool
+	values    []testValue
+	writtenAt []time.Time
+	idx       int
+	err       error
+	closed    bool
 }
 
 type testValuesByTime []testValue
@@ -663,6 +875,18 @@ func (i *testCommitLogIterator) Current() (commitlog.Series, ts.Datapoint, xtime
 	return v.s, ts.Datapoint{Timestamp: v.t, Value: v.v}, v.u, v.a
 }
 
+func (i *testCommitLogIterator) CurrentWrittenAt() time.Time {
+	idx := i.idx
+	if idx == -1 {
+		idx = 0
+	}
+	// Values are written as they occur unless stated otherwise
+	if idx < len(i.writtenAt) && !i.writtenAt[idx].IsZero() {
+		return i.writtenAt[idx]
+	}
+	return i.values[idx].t
+}
+
 func (i *testCommitLogIterator) Err() error {
 	return i.err
 }
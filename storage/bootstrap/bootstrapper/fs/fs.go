@@ -47,6 +47,12 @@ func NewFileSystemBootstrapper(
 	return b
 }
 
+// NewFileSystemSource creates a new bootstrap source that reads on-disk files,
+// for use by bootstrappers that first materialize filesets on disk.
+func NewFileSystemSource(prefix string, opts Options) bootstrap.Source {
+	return newFileSystemSource(prefix, opts)
+}
+
 func (fsb *fileSystemBootstrapper) String() string {
 	return FileSystemBootstrapperName
 }
@@ -22,6 +22,7 @@ package fs
 
 import (
 	"fmt"
+	"io"
 	"sync"
 	"time"
 
@@ -29,6 +30,7 @@ import (
 	"github.com/m3db/m3db/storage/block"
 	"github.com/m3db/m3db/storage/bootstrap"
 	"github.com/m3db/m3db/storage/bootstrap/result"
+	"github.com/m3db/m3db/storage/index"
 	"github.com/m3db/m3db/storage/namespace"
 	"github.com/m3db/m3db/storage/series"
 	"github.com/m3db/m3db/ts"
@@ -45,23 +47,27 @@ type newFileSetReaderFn func(
 	opts fs.Options,
 ) (fs.FileSetReader, error)
 
+type newIndexFileSetReaderFn func(opts fs.Options) (fs.IndexFileSetReader, error)
+
 type fileSystemSource struct {
-	opts        Options
-	fsopts      fs.Options
-	log         xlog.Logger
-	newReaderFn newFileSetReaderFn
-	processors  xsync.WorkerPool
+	opts             Options
+	fsopts           fs.Options
+	log              xlog.Logger
+	newReaderFn      newFileSetReaderFn
+	newIndexReaderFn newIndexFileSetReaderFn
+	processors       xsync.WorkerPool
 }
 
 func newFileSystemSource(prefix string, opts Options) bootstrap.Source {
 	processors := xsync.NewWorkerPool(opts.NumProcessors())
 	processors.Init()
 	return &fileSystemSource{
-		opts:        opts,
-		fsopts:      opts.FilesystemOptions().SetFilePathPrefix(prefix),
-		log:         opts.ResultOptions().InstrumentOptions().Logger(),
-		newReaderFn: fs.NewReader,
-		processors:  processors,
+		opts:             opts,
+		fsopts:           opts.FilesystemOptions().SetFilePathPrefix(prefix),
+		log:              opts.ResultOptions().InstrumentOptions().Logger(),
+		newReaderFn:      fs.NewReader,
+		newIndexReaderFn: fs.NewIndexReader,
+		processors:       processors,
 	}
 }
 
@@ -311,6 +317,7 @@ func (s *fileSystemSource) loadShardReadersDataIntoShardResult(
 			var (
 				seriesBlock = blockPool.Get()
 				id          ident.ID
+				tags        ident.Tags
 				data        checked.Bytes
 				length      int
 				checksum    uint32
@@ -318,9 +325,9 @@ func (s *fileSystemSource) loadShardReadersDataIntoShardResult(
 			)
 			switch seriesCachePolicy {
 			case series.CacheAll:
-				id, data, checksum, err = r.Read()
+				id, tags, data, checksum, err = r.Read()
 			case series.CacheAllMetadata:
-				id, length, checksum, err = r.ReadMetadata()
+				id, tags, length, checksum, err = r.ReadMetadata()
 			default:
 				s.log.WithFields(
 					xlog.NewField("shard", shard),
@@ -378,10 +385,10 @@ func (s *fileSystemSource) loadShardReadersDataIntoShardResult(
 			}
 
 			resultLock.Lock()
-			if exists {
+			if exists && len(tags) == 0 {
 				entry.Blocks.AddBlock(seriesBlock)
 			} else {
-				shardResult.AddBlock(id, seriesBlock)
+				shardResult.AddBlock(id, tags, seriesBlock)
 			}
 			resultLock.Unlock()
 		}
@@ -490,6 +497,7 @@ func (s *fileSystemSource) Read(
 			bootstrapResult.Add(shard, nil, remaining)
 		}
 		bootstrapResult.SetUnfulfilled(unfulfilled)
+		s.loadIndexResults(md, shardsTimeRanges, bootstrapResult)
 		return bootstrapResult, nil
 	}
 
@@ -508,7 +516,84 @@ func (s *fileSystemSource) Read(
 	})
 	readersCh := make(chan shardReaders)
 	go s.enqueueReaders(nsID, shardsTimeRanges, readerPool, readersCh)
-	return s.bootstrapFromReaders(readerPool, blockRetriever, readersCh), nil
+	bootstrapResult := s.bootstrapFromReaders(readerPool, blockRetriever, readersCh)
+	s.loadIndexResults(md, shardsTimeRanges, bootstrapResult)
+	return bootstrapResult, nil
+}
+
+// loadIndexResults loads the reverse index filesets overlapping with the
+// requested ranges of any shard into the bootstrap result, filesets that
+// fail to load are skipped since the index can be rebuilt by new writes.
+func (s *fileSystemSource) loadIndexResults(
+	md namespace.Metadata,
+	shardsTimeRanges result.ShardTimeRanges,
+	bootstrapResult result.BootstrapResult,
+) {
+	var tr xtime.Ranges
+	for _, ranges := range shardsTimeRanges {
+		tr = tr.AddRanges(ranges)
+	}
+	if tr.IsEmpty() {
+		return
+	}
+
+	infos := fs.ReadIndexInfoFiles(s.fsopts.FilePathPrefix(), md.ID(),
+		s.fsopts.InfoReaderBufferSize(), s.fsopts.DecodingOptions())
+	if len(infos) == 0 {
+		return
+	}
+
+	r, err := s.newIndexReaderFn(s.fsopts)
+	if err != nil {
+		s.log.Errorf("unable to create index fileset reader: %v", err)
+		return
+	}
+
+	indexResults := bootstrapResult.IndexResults()
+	for _, info := range infos {
+		t := xtime.FromNanoseconds(info.BlockStart)
+		blockSize := time.Duration(info.BlockSize)
+		if !tr.Overlaps(xtime.Range{Start: t, End: t.Add(blockSize)}) {
+			continue
+		}
+
+		b, err := s.readIndexBlock(r, md.ID(), t, blockSize)
+		if err != nil {
+			s.log.WithFields(
+				xlog.NewField("namespace", md.ID().String()),
+				xlog.NewField("time", t.String()),
+				xlog.NewField("error", err.Error()),
+			).Error("unable to read index fileset files")
+			continue
+		}
+		indexResults.AddBlock(b)
+	}
+}
+
+func (s *fileSystemSource) readIndexBlock(
+	r fs.IndexFileSetReader,
+	namespace ident.ID,
+	blockStart time.Time,
+	blockSize time.Duration,
+) (index.Block, error) {
+	if err := r.Open(namespace, blockStart); err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	b := index.NewBlock(blockStart, blockSize)
+	for {
+		id, tags, err := r.Read()
+		if err == io.EOF {
+			return b, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := b.Write(id, ident.NewTagSliceIterator(tags)); err != nil {
+			return nil, err
+		}
+	}
 }
 
 type shardReaders struct {
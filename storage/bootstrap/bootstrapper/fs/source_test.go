@@ -139,7 +139,7 @@ func writeTSDBFiles(t *testing.T, dir string, namespace ident.ID, shard uint32,
 
 	bytes := checked.NewBytes(data, nil)
 	bytes.IncRef()
-	require.NoError(t, w.Write(ident.StringID(id), bytes, digest.Checksum(bytes.Get())))
+	require.NoError(t, w.Write(ident.StringID(id), nil, bytes, digest.Checksum(bytes.Get())))
 	require.NoError(t, w.Close())
 }
 
@@ -536,3 +536,35 @@ func TestReadDeleteOnError(t *testing.T) {
 	}
 	validateTimeRanges(t, res.Unfulfilled()[testShard], expected)
 }
+
+func writeIndexFiles(t *testing.T, dir string, namespace ident.ID, start time.Time, ids ...string) {
+	w, err := fs.NewIndexWriter(newTestFsOptions(dir))
+	require.NoError(t, err)
+	require.NoError(t, w.Open(namespace, testBlockSize, start))
+	for _, id := range ids {
+		require.NoError(t, w.Write(ident.StringID(id), ident.Tags{
+			{Name: ident.StringID("name"), Value: ident.StringID(id)},
+		}))
+	}
+	require.NoError(t, w.Close())
+}
+
+func TestReadIndexFilesets(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+
+	writeGoodFiles(t, dir, testNs1ID, testShard)
+	writeIndexFiles(t, dir, testNs1ID, testStart, "foo", "bar")
+	writeIndexFiles(t, dir, testNs1ID, testStart.Add(20*time.Hour), "baz")
+
+	src := newFileSystemSource(dir, testDefaultOpts)
+	res, err := src.Read(testNsMetadata(t), testShardTimeRanges(), testDefaultRunOpts)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	indexResults := res.IndexResults()
+	require.Equal(t, 1, len(indexResults))
+	block, ok := indexResults[xtime.ToUnixNano(testStart)]
+	require.True(t, ok)
+	require.Equal(t, 2, block.NumSeries())
+}
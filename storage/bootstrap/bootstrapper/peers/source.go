@@ -94,7 +94,7 @@ func (s *peersSource) Read(
 		incremental       = false
 		seriesCachePolicy = s.opts.ResultOptions().SeriesCachePolicy()
 	)
-	if opts.Incremental() && seriesCachePolicy != series.CacheAll {
+	if opts.Incremental() {
 		retrieverMgr := s.opts.DatabaseBlockRetrieverManager()
 		persistManager := s.opts.PersistManager()
 
@@ -102,17 +102,26 @@ func (s *peersSource) Read(
 		if seriesCachePolicy != series.CacheAll && retrieverMgr == nil {
 			s.log.Fatal("tried to perform incremental flush without retriever manager")
 		}
-		if seriesCachePolicy != series.CacheAll && persistManager == nil {
+		if persistManager == nil {
 			s.log.Fatal("tried to perform incremental flush without persist manager")
 		}
 
-		s.log.WithFields(
-			xlog.NewField("namespace", namespace.String()),
-		).Infof("peers bootstrapper resolving block retriever")
+		// NB: Blocks are kept in memory with the CacheAll policy so there is
+		// no need for a retriever, the blocks are still persisted as they are
+		// received so that a bootstrap interrupted by a crash can resume from
+		// the filesets persisted so far rather than starting over.
+		if seriesCachePolicy != series.CacheAll {
+			s.log.WithFields(
+				xlog.NewField("namespace", namespace.String()),
+			).Infof("peers bootstrapper resolving block retriever")
 
-		r, err := retrieverMgr.Retriever(nsMetadata)
-		if err != nil {
-			return nil, err
+			r, err := retrieverMgr.Retriever(nsMetadata)
+			if err != nil {
+				return nil, err
+			}
+
+			blockRetriever = r
+			shardRetrieverMgr = block.NewDatabaseShardBlockRetrieverManager(r)
 		}
 
 		flush, err := persistManager.StartFlush()
@@ -123,8 +132,6 @@ func (s *peersSource) Read(
 		defer flush.Done()
 
 		incremental = true
-		blockRetriever = r
-		shardRetrieverMgr = block.NewDatabaseShardBlockRetrieverManager(r)
 		persistFlush = flush
 	}
 
@@ -157,8 +164,9 @@ func (s *peersSource) Read(
 		xlog.NewField("incremental", incremental),
 	).Infof("peers bootstrapper bootstrapping shards for ranges")
 	if incremental {
-		go s.startIncrementalQueueWorkerLoop(
-			incrementalWorkerDoneCh, incrementalQueue, persistFlush, result, &resultLock)
+		progress := newIncrementalProgress(shardsTimeRanges, blockSize)
+		go s.startIncrementalQueueWorkerLoop(incrementalWorkerDoneCh, incrementalQueue,
+			persistFlush, progress, opts.ProgressReporter(), result, &resultLock)
 	}
 
 	workers := xsync.NewWorkerPool(concurrency)
@@ -180,7 +188,7 @@ func (s *peersSource) Read(
 		<-incrementalWorkerDoneCh
 	}
 
-	if incremental {
+	if incremental && blockRetriever != nil {
 		// Now cache the incremental results
 		err := s.cacheShardIndices(shardsTimeRanges, blockRetriever)
 		if err != nil {
@@ -200,19 +208,23 @@ func (s *peersSource) startIncrementalQueueWorkerLoop(
 	doneCh chan struct{},
 	incrementalQueue chan incrementalFlush,
 	persistFlush persist.Flush,
+	progress incrementalProgress,
+	reporter bootstrap.ProgressReporter,
 	bootstrapResult result.BootstrapResult,
 	lock *sync.Mutex,
 ) {
 	// If performing an incremental bootstrap then flush one
 	// at a time as shard results are gathered
 	for flush := range incrementalQueue {
-		err := s.incrementalFlush(persistFlush, flush.nsMetadata, flush.shard,
+		err := s.incrementalFlush(persistFlush, reporter, flush.nsMetadata, flush.shard,
 			flush.shardRetrieverMgr, flush.shardResult, flush.timeRange)
 		if err == nil {
 			// Safe to add to the shared bootstrap result now
 			lock.Lock()
 			bootstrapResult.Add(flush.shard, flush.shardResult, xtime.Ranges{})
 			lock.Unlock()
+
+			s.reportIncrementalProgress(flush, progress, reporter)
 			continue
 		}
 
@@ -261,9 +273,12 @@ func (s *peersSource) fetchBootstrapBlocksFromPeers(
 			s.logFetchBootstrapBlocksFromPeersOutcome(shard, shardResult, err)
 
 			if err != nil {
-				// Do not add result at all to the bootstrap result
+				// Do not add result at all to the bootstrap result, only the
+				// block that failed is unfulfilled as the other blocks of the
+				// range are fetched and persisted independently
 				lock.Lock()
-				bootstrapResult.Add(shard, nil, xtime.Ranges{}.AddRange(currRange))
+				bootstrapResult.Add(shard, nil,
+					xtime.Ranges{}.AddRange(xtime.Range{Start: blockStart, End: blockEnd}))
 				lock.Unlock()
 				continue
 			}
@@ -287,6 +302,60 @@ func (s *peersSource) fetchBootstrapBlocksFromPeers(
 	}
 }
 
+// incrementalProgress tracks the number of blocks persisted out of the
+// number of blocks to bootstrap for each shard, it is only accessed by the
+// incremental queue worker.
+type incrementalProgress map[uint32]*shardProgress
+
+type shardProgress struct {
+	persisted int
+	total     int
+}
+
+func newIncrementalProgress(
+	shardsTimeRanges result.ShardTimeRanges,
+	blockSize time.Duration,
+) incrementalProgress {
+	progress := make(incrementalProgress, len(shardsTimeRanges))
+	for shard, ranges := range shardsTimeRanges {
+		total := 0
+		it := ranges.Iter()
+		for it.Next() {
+			currRange := it.Value()
+			for blockStart := currRange.Start; blockStart.Before(currRange.End); blockStart = blockStart.Add(blockSize) {
+				total++
+			}
+		}
+		progress[shard] = &shardProgress{total: total}
+	}
+	return progress
+}
+
+// reportIncrementalProgress records and reports that a block has been
+// persisted, once persisted a block is picked up by the filesystem
+// bootstrapper after a restart so that only the remaining blocks are
+// fetched from peers again.
+func (s *peersSource) reportIncrementalProgress(
+	flush incrementalFlush,
+	progress incrementalProgress,
+	reporter bootstrap.ProgressReporter,
+) {
+	shardProgress, ok := progress[flush.shard]
+	if !ok {
+		return
+	}
+	shardProgress.persisted++
+	reporter.ReportPersisted(PeersBootstrapperName, flush.shard,
+		shardProgress.persisted, shardProgress.total)
+	s.log.WithFields(
+		xlog.NewField("namespace", flush.nsMetadata.ID().String()),
+		xlog.NewField("shard", flush.shard),
+		xlog.NewField("blockStart", flush.timeRange.Start.String()),
+		xlog.NewField("persistedBlocks", shardProgress.persisted),
+		xlog.NewField("totalBlocks", shardProgress.total),
+	).Info("peers bootstrapper persisted block")
+}
+
 func (s *peersSource) logFetchBootstrapBlocksFromPeersOutcome(
 	shard uint32,
 	shardResult result.ShardResult,
@@ -332,9 +401,11 @@ func (s *peersSource) logFetchBootstrapBlocksFromPeersOutcome(
 // at the end we remove all the series objects from the shard result as well
 // (since all their corresponding blocks have been removed anyways) to prevent
 // a huge memory spike caused by adding lots of unused series to the Shard
-// object and then immediately evicting them in the next tick.
+// object and then immediately evicting them in the next tick. As the removed
+// series are no longer part of the result they are reported as fetched here.
 func (s *peersSource) incrementalFlush(
 	flush persist.Flush,
+	reporter bootstrap.ProgressReporter,
 	nsMetadata namespace.Metadata,
 	shard uint32,
 	shardRetrieverMgr block.DatabaseShardBlockRetrieverManager,
@@ -344,10 +415,15 @@ func (s *peersSource) incrementalFlush(
 	var (
 		ropts             = nsMetadata.Options().RetentionOptions()
 		blockSize         = ropts.BlockSize()
-		shardRetriever    = shardRetrieverMgr.ShardRetriever(shard)
 		tmpCtx            = context.NewContext()
 		seriesCachePolicy = s.opts.ResultOptions().SeriesCachePolicy()
+		shardRetriever    block.DatabaseShardBlockRetriever
+		numRemovedBytes   int64
 	)
+	// The retriever manager is only set when blocks are made retrievable
+	if shardRetrieverMgr != nil {
+		shardRetriever = shardRetrieverMgr.ShardRetriever(shard)
+	}
 	if seriesCachePolicy == series.CacheAllMetadata && shardRetriever == nil {
 		return fmt.Errorf("shard retriever missing for shard: %d", shard)
 	}
@@ -380,7 +456,7 @@ func (s *peersSource) incrementalFlush(
 				break
 			}
 
-			err = prepared.Persist(s.ID, segment, bl.Checksum())
+			err = prepared.Persist(s.ID, s.Tags, segment, bl.Checksum())
 			tmpCtx.BlockingClose()
 			if err != nil {
 				blockErr = err // Need to call prepared.Close, avoid return
@@ -407,6 +483,7 @@ func (s *peersSource) incrementalFlush(
 				// Not caching the series or metadata in memory so finalize the block,
 				// better to do this as we loop through to make blocks return to the
 				// pool earlier than at the end of this flush cycle
+				numRemovedBytes += int64(bl.Len())
 				s.Blocks.RemoveBlockAt(start)
 				bl.Close()
 			}
@@ -442,6 +519,7 @@ func (s *peersSource) incrementalFlush(
 		// then we don't want to keep these series in the shard result. If we leave them in, then
 		// they will all get loaded into the shard object, and then immediately evicted on the next
 		// tick which causes unnecessary memory pressure.
+		var numRemovedSeries int64
 		numSeriesTriedToRemoveWithRemainingBlocks := 0
 		for _, series := range shardResult.AllSeries() {
 			numBlocksRemaining := len(series.Blocks.AllBlocks())
@@ -453,6 +531,7 @@ func (s *peersSource) incrementalFlush(
 			}
 
 			shardResult.RemoveSeries(series.ID)
+			numRemovedSeries++
 			series.Blocks.Close()
 			// Safe to finalize these IDs because the prepared object was the only other thing
 			// using them, and it has been closed.
@@ -465,6 +544,8 @@ func (s *peersSource) incrementalFlush(
 				xlog.NewField("numTimes", numSeriesTriedToRemoveWithRemainingBlocks),
 			).Error("error tried to remove series that still has blocks")
 		}
+
+		reporter.ReportFetched(PeersBootstrapperName, shard, numRemovedSeries, numRemovedBytes)
 	}
 
 	return nil
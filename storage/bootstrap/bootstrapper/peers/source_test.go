@@ -22,13 +22,17 @@ package peers
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
 	"testing"
 	"time"
 
 	"github.com/m3db/m3db/client"
 	"github.com/m3db/m3db/persist"
+	"github.com/m3db/m3db/persist/fs"
 	"github.com/m3db/m3db/storage/block"
 	"github.com/m3db/m3db/storage/bootstrap"
+	bfs "github.com/m3db/m3db/storage/bootstrap/bootstrapper/fs"
 	"github.com/m3db/m3db/storage/bootstrap/result"
 	"github.com/m3db/m3db/storage/namespace"
 	"github.com/m3db/m3db/storage/series"
@@ -119,7 +123,7 @@ func TestPeersSourceReturnsFulfilledAndUnfulfilled(t *testing.T) {
 
 	goodResult := result.NewShardResult(0, opts.ResultOptions())
 	fooBlock := block.NewDatabaseBlock(start, ts.Segment{}, testBlockOpts)
-	goodResult.AddBlock(ident.StringID("foo"), fooBlock)
+	goodResult.AddBlock(ident.StringID("foo"), nil, fooBlock)
 	badErr := fmt.Errorf("an error")
 
 	mockAdminSession := client.NewMockAdminSession(ctrl)
@@ -166,6 +170,7 @@ func TestPeersSourceReturnsFulfilledAndUnfulfilled(t *testing.T) {
 
 func TestPeersSourceIncrementalRun(t *testing.T) {
 	for _, cachePolicy := range []series.CachePolicy{
+		series.CacheAll,
 		series.CacheAllMetadata,
 		series.CacheRecentlyRead,
 	} {
@@ -189,15 +194,15 @@ func TestPeersSourceIncrementalRun(t *testing.T) {
 		barBlock := block.NewDatabaseBlock(start.Add(ropts.BlockSize()),
 			ts.NewSegment(checked.NewBytes([]byte{4, 5, 6}, nil), nil, ts.FinalizeNone),
 			testBlockOpts)
-		shard0ResultBlock1.AddBlock(ident.StringID("foo"), fooBlock)
-		shard0ResultBlock2.AddBlock(ident.StringID("bar"), barBlock)
+		shard0ResultBlock1.AddBlock(ident.StringID("foo"), nil, fooBlock)
+		shard0ResultBlock2.AddBlock(ident.StringID("bar"), nil, barBlock)
 
 		shard1ResultBlock1 := result.NewShardResult(0, opts.ResultOptions())
 		shard1ResultBlock2 := result.NewShardResult(0, opts.ResultOptions())
 		bazBlock := block.NewDatabaseBlock(start,
 			ts.NewSegment(checked.NewBytes([]byte{7, 8, 9}, nil), nil, ts.FinalizeNone),
 			testBlockOpts)
-		shard1ResultBlock1.AddBlock(ident.StringID("baz"), bazBlock)
+		shard1ResultBlock1.AddBlock(ident.StringID("baz"), nil, bazBlock)
 
 		mockAdminSession := client.NewMockAdminSession(ctrl)
 		mockAdminSession.EXPECT().
@@ -222,16 +227,19 @@ func TestPeersSourceIncrementalRun(t *testing.T) {
 
 		opts = opts.SetAdminClient(mockAdminClient)
 
-		mockRetriever := block.NewMockDatabaseBlockRetriever(ctrl)
-		// The shard indices are computed from iterating over a map, they can
-		// come in any order
-		mockRetriever.EXPECT().CacheShardIndices([]uint32{0, 1}).AnyTimes()
-		mockRetriever.EXPECT().CacheShardIndices([]uint32{1, 0}).AnyTimes()
-
+		// No retriever is needed when keeping all blocks in memory
 		mockRetrieverMgr := block.NewMockDatabaseBlockRetrieverManager(ctrl)
-		mockRetrieverMgr.EXPECT().
-			Retriever(namespace.NewMetadataMatcher(testNsMd)).
-			Return(mockRetriever, nil)
+		if cachePolicy != series.CacheAll {
+			mockRetriever := block.NewMockDatabaseBlockRetriever(ctrl)
+			// The shard indices are computed from iterating over a map, they can
+			// come in any order
+			mockRetriever.EXPECT().CacheShardIndices([]uint32{0, 1}).AnyTimes()
+			mockRetriever.EXPECT().CacheShardIndices([]uint32{1, 0}).AnyTimes()
+
+			mockRetrieverMgr.EXPECT().
+				Retriever(namespace.NewMetadataMatcher(testNsMd)).
+				Return(mockRetriever, nil)
+		}
 
 		opts = opts.SetDatabaseBlockRetrieverManager(mockRetrieverMgr)
 
@@ -242,7 +250,7 @@ func TestPeersSourceIncrementalRun(t *testing.T) {
 		mockFlush.EXPECT().
 			Prepare(namespace.NewMetadataMatcher(testNsMd), uint32(0), start).
 			Return(persist.PreparedPersist{
-				Persist: func(id ident.ID, segment ts.Segment, checksum uint32) error {
+				Persist: func(id ident.ID, tags ident.Tags, segment ts.Segment, checksum uint32) error {
 					persists["foo"]++
 					assert.Equal(t, "foo", id.String())
 					assert.Equal(t, []byte{1, 2, 3}, segment.Head.Get())
@@ -257,7 +265,7 @@ func TestPeersSourceIncrementalRun(t *testing.T) {
 		mockFlush.EXPECT().
 			Prepare(namespace.NewMetadataMatcher(testNsMd), uint32(0), start.Add(ropts.BlockSize())).
 			Return(persist.PreparedPersist{
-				Persist: func(id ident.ID, segment ts.Segment, checksum uint32) error {
+				Persist: func(id ident.ID, tags ident.Tags, segment ts.Segment, checksum uint32) error {
 					persists["bar"]++
 					assert.Equal(t, "bar", id.String())
 					assert.Equal(t, []byte{4, 5, 6}, segment.Head.Get())
@@ -272,7 +280,7 @@ func TestPeersSourceIncrementalRun(t *testing.T) {
 		mockFlush.EXPECT().
 			Prepare(namespace.NewMetadataMatcher(testNsMd), uint32(1), start).
 			Return(persist.PreparedPersist{
-				Persist: func(id ident.ID, segment ts.Segment, checksum uint32) error {
+				Persist: func(id ident.ID, tags ident.Tags, segment ts.Segment, checksum uint32) error {
 					persists["baz"]++
 					assert.Equal(t, "baz", id.String())
 					assert.Equal(t, []byte{7, 8, 9}, segment.Head.Get())
@@ -287,7 +295,7 @@ func TestPeersSourceIncrementalRun(t *testing.T) {
 		mockFlush.EXPECT().
 			Prepare(namespace.NewMetadataMatcher(testNsMd), uint32(1), start.Add(ropts.BlockSize())).
 			Return(persist.PreparedPersist{
-				Persist: func(id ident.ID, segment ts.Segment, checksum uint32) error {
+				Persist: func(id ident.ID, tags ident.Tags, segment ts.Segment, checksum uint32) error {
 					assert.Fail(t, "no expected shard 1 second block")
 					return nil
 				},
@@ -315,7 +323,10 @@ func TestPeersSourceIncrementalRun(t *testing.T) {
 		require.True(t, r.Unfulfilled()[0].IsEmpty())
 		require.True(t, r.Unfulfilled()[1].IsEmpty())
 
-		if cachePolicy == series.CacheAllMetadata {
+		if cachePolicy == series.CacheAll || cachePolicy == series.CacheAllMetadata {
+			// Blocks are only left retrievable from disk with the CacheAllMetadata policy
+			retrieved := cachePolicy == series.CacheAll
+
 			assert.Equal(t, 2, len(r.ShardResults()))
 			require.NotNil(t, r.ShardResults()[0])
 			require.NotNil(t, r.ShardResults()[1])
@@ -323,17 +334,17 @@ func TestPeersSourceIncrementalRun(t *testing.T) {
 			block, ok := r.ShardResults()[0].BlockAt(ident.StringID("foo"), start)
 			require.True(t, ok)
 			assert.Equal(t, fooBlock.Checksum(), block.Checksum())
-			assert.False(t, block.IsRetrieved())
+			assert.Equal(t, retrieved, block.IsRetrieved())
 
 			block, ok = r.ShardResults()[0].BlockAt(ident.StringID("bar"), start.Add(ropts.BlockSize()))
 			require.True(t, ok)
 			assert.Equal(t, barBlock.Checksum(), block.Checksum())
-			assert.False(t, block.IsRetrieved())
+			assert.Equal(t, retrieved, block.IsRetrieved())
 
 			block, ok = r.ShardResults()[1].BlockAt(ident.StringID("baz"), start)
 			require.True(t, ok)
 			assert.Equal(t, bazBlock.Checksum(), block.Checksum())
-			assert.False(t, block.IsRetrieved())
+			assert.Equal(t, retrieved, block.IsRetrieved())
 		} else {
 			assert.Equal(t, 0, len(r.ShardResults()))
 			require.Nil(t, r.ShardResults()[0])
@@ -375,7 +386,7 @@ func TestPeersSourceMarksUnfulfilledOnIncrementalFlushErrors(t *testing.T) {
 	results := make(map[resultsKey]result.ShardResult)
 	addResult := func(shard uint32, id string, b block.DatabaseBlock) {
 		r := result.NewShardResult(0, opts.ResultOptions())
-		r.AddBlock(ident.StringID(id), b)
+		r.AddBlock(ident.StringID(id), nil, b)
 		start := b.StartTime()
 		end := start.Add(ropts.BlockSize())
 		results[resultsKey{shard, start.UnixNano(), end.UnixNano()}] = r
@@ -467,7 +478,7 @@ func TestPeersSourceMarksUnfulfilledOnIncrementalFlushErrors(t *testing.T) {
 	mockFlush.EXPECT().
 		Prepare(namespace.NewMetadataMatcher(testNsMd), uint32(0), start).
 		Return(persist.PreparedPersist{
-			Persist: func(id ident.ID, segment ts.Segment, checksum uint32) error {
+			Persist: func(id ident.ID, tags ident.Tags, segment ts.Segment, checksum uint32) error {
 				assert.Fail(t, "not expecting to flush shard 0 at start")
 				return nil
 			},
@@ -479,7 +490,7 @@ func TestPeersSourceMarksUnfulfilledOnIncrementalFlushErrors(t *testing.T) {
 	mockFlush.EXPECT().
 		Prepare(namespace.NewMetadataMatcher(testNsMd), uint32(0), midway).
 		Return(persist.PreparedPersist{
-			Persist: func(id ident.ID, segment ts.Segment, checksum uint32) error {
+			Persist: func(id ident.ID, tags ident.Tags, segment ts.Segment, checksum uint32) error {
 				persists["foo"]++
 				return nil
 			},
@@ -493,7 +504,7 @@ func TestPeersSourceMarksUnfulfilledOnIncrementalFlushErrors(t *testing.T) {
 	mockFlush.EXPECT().
 		Prepare(namespace.NewMetadataMatcher(testNsMd), uint32(1), start).
 		Return(persist.PreparedPersist{
-			Persist: func(id ident.ID, segment ts.Segment, checksum uint32) error {
+			Persist: func(id ident.ID, tags ident.Tags, segment ts.Segment, checksum uint32) error {
 				assert.Fail(t, "not expecting to flush shard 0 at start + block size")
 				return nil
 			},
@@ -505,7 +516,7 @@ func TestPeersSourceMarksUnfulfilledOnIncrementalFlushErrors(t *testing.T) {
 	mockFlush.EXPECT().
 		Prepare(namespace.NewMetadataMatcher(testNsMd), uint32(1), midway).
 		Return(persist.PreparedPersist{
-			Persist: func(id ident.ID, segment ts.Segment, checksum uint32) error {
+			Persist: func(id ident.ID, tags ident.Tags, segment ts.Segment, checksum uint32) error {
 				persists["bar"]++
 				return nil
 			},
@@ -519,7 +530,7 @@ func TestPeersSourceMarksUnfulfilledOnIncrementalFlushErrors(t *testing.T) {
 	mockFlush.EXPECT().
 		Prepare(namespace.NewMetadataMatcher(testNsMd), uint32(2), start).
 		Return(persist.PreparedPersist{
-			Persist: func(id ident.ID, segment ts.Segment, checksum uint32) error {
+			Persist: func(id ident.ID, tags ident.Tags, segment ts.Segment, checksum uint32) error {
 				persists["baz"]++
 				return fmt.Errorf("a persist error")
 			},
@@ -531,7 +542,7 @@ func TestPeersSourceMarksUnfulfilledOnIncrementalFlushErrors(t *testing.T) {
 	mockFlush.EXPECT().
 		Prepare(namespace.NewMetadataMatcher(testNsMd), uint32(2), midway).
 		Return(persist.PreparedPersist{
-			Persist: func(id ident.ID, segment ts.Segment, checksum uint32) error {
+			Persist: func(id ident.ID, tags ident.Tags, segment ts.Segment, checksum uint32) error {
 				persists["baz"]++
 				return nil
 			},
@@ -545,7 +556,7 @@ func TestPeersSourceMarksUnfulfilledOnIncrementalFlushErrors(t *testing.T) {
 	mockFlush.EXPECT().
 		Prepare(namespace.NewMetadataMatcher(testNsMd), uint32(3), start).
 		Return(persist.PreparedPersist{
-			Persist: func(id ident.ID, segment ts.Segment, checksum uint32) error {
+			Persist: func(id ident.ID, tags ident.Tags, segment ts.Segment, checksum uint32) error {
 				persists["qux"]++
 				return nil
 			},
@@ -557,7 +568,7 @@ func TestPeersSourceMarksUnfulfilledOnIncrementalFlushErrors(t *testing.T) {
 	mockFlush.EXPECT().
 		Prepare(namespace.NewMetadataMatcher(testNsMd), uint32(3), midway).
 		Return(persist.PreparedPersist{
-			Persist: func(id ident.ID, segment ts.Segment, checksum uint32) error {
+			Persist: func(id ident.ID, tags ident.Tags, segment ts.Segment, checksum uint32) error {
 				persists["qux"]++
 				return nil
 			},
@@ -609,3 +620,110 @@ func TestPeersSourceMarksUnfulfilledOnIncrementalFlushErrors(t *testing.T) {
 		"foo": 2, "bar": 2, "baz": 2, "qux": 2,
 	}, closes)
 }
+
+func TestPeersSourceIncrementalRestartFetchesRemainingBlocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir, err := ioutil.TempDir("", "peers-bootstrap")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		testNsMd  = testNamespaceMetadata(t)
+		ropts     = testNsMd.Options().RetentionOptions()
+		blockSize = ropts.BlockSize()
+		start     = time.Now().Add(-ropts.RetentionPeriod()).Truncate(blockSize)
+		midway    = start.Add(blockSize)
+		end       = start.Add(2 * blockSize)
+		fsOpts    = fs.NewOptions().SetFilePathPrefix(dir)
+		target    = result.ShardTimeRanges{
+			0: xtime.Ranges{}.AddRange(xtime.Range{Start: start, End: end}),
+		}
+	)
+
+	newTestOpts := func(session client.AdminSession) Options {
+		persistManager, err := fs.NewPersistManager(fsOpts)
+		require.NoError(t, err)
+
+		mockAdminClient := client.NewMockAdminClient(ctrl)
+		mockAdminClient.EXPECT().DefaultAdminSession().Return(session, nil)
+
+		return testDefaultOpts.
+			SetAdminClient(mockAdminClient).
+			SetPersistManager(persistManager)
+	}
+
+	fooBlock := block.NewDatabaseBlock(start,
+		ts.NewSegment(checked.NewBytes([]byte{1, 2, 3}, nil), nil, ts.FinalizeNone),
+		testBlockOpts)
+	fooResult := result.NewShardResult(0, testDefaultResultOpts)
+	fooResult.AddBlock(ident.StringID("foo"), nil, fooBlock)
+
+	// The first bootstrap persists the first block of the shard and is
+	// interrupted before the second block is fetched
+	firstSession := client.NewMockAdminSession(ctrl)
+	firstSession.EXPECT().
+		FetchBootstrapBlocksFromPeers(namespace.NewMetadataMatcher(testNsMd),
+			uint32(0), start, midway, gomock.Any(), client.FetchBlocksMetadataEndpointV1).
+		Return(fooResult, nil)
+	firstSession.EXPECT().
+		FetchBootstrapBlocksFromPeers(namespace.NewMetadataMatcher(testNsMd),
+			uint32(0), midway, end, gomock.Any(), client.FetchBlocksMetadataEndpointV1).
+		Return(nil, fmt.Errorf("an error"))
+
+	reporter := bootstrap.NewMockProgressReporter(ctrl)
+	reporter.EXPECT().ReportPersisted(PeersBootstrapperName, uint32(0), 1, 2)
+
+	src := newPeersSource(newTestOpts(firstSession))
+	r, err := src.Read(testNsMd, target,
+		testIncrementalRunOpts.SetProgressReporter(reporter))
+	require.NoError(t, err)
+	require.Equal(t, xtime.Ranges{}.AddRange(xtime.Range{
+		Start: midway,
+		End:   end,
+	}).String(), r.Unfulfilled()[0].String())
+
+	require.True(t, fs.FilesetExistsAt(dir, testNamespace, 0, start))
+	require.False(t, fs.FilesetExistsAt(dir, testNamespace, 0, midway))
+
+	// After a restart the filesystem bootstrapper loads the persisted block
+	// so that only the remaining block is fetched from peers
+	fsSrc := bfs.NewFileSystemSource(dir, bfs.NewOptions().
+		SetResultOptions(testDefaultResultOpts).
+		SetFilesystemOptions(fsOpts))
+	fsResult, err := fsSrc.Read(testNsMd, target, testDefaultRunOpts)
+	require.NoError(t, err)
+
+	bl, ok := fsResult.ShardResults()[0].BlockAt(ident.StringID("foo"), start)
+	require.True(t, ok)
+	assert.Equal(t, fooBlock.Checksum(), bl.Checksum())
+
+	remaining := fsResult.Unfulfilled()
+	require.Equal(t, xtime.Ranges{}.AddRange(xtime.Range{
+		Start: midway,
+		End:   end,
+	}).String(), remaining[0].String())
+
+	barBlock := block.NewDatabaseBlock(midway,
+		ts.NewSegment(checked.NewBytes([]byte{4, 5, 6}, nil), nil, ts.FinalizeNone),
+		testBlockOpts)
+	barResult := result.NewShardResult(0, testDefaultResultOpts)
+	barResult.AddBlock(ident.StringID("bar"), nil, barBlock)
+
+	secondSession := client.NewMockAdminSession(ctrl)
+	secondSession.EXPECT().
+		FetchBootstrapBlocksFromPeers(namespace.NewMetadataMatcher(testNsMd),
+			uint32(0), midway, end, gomock.Any(), client.FetchBlocksMetadataEndpointV1).
+		Return(barResult, nil)
+
+	src = newPeersSource(newTestOpts(secondSession))
+	r, err = src.Read(testNsMd, remaining, testIncrementalRunOpts)
+	require.NoError(t, err)
+	require.True(t, r.Unfulfilled()[0].IsEmpty())
+
+	bl, ok = r.ShardResults()[0].BlockAt(ident.StringID("bar"), midway)
+	require.True(t, ok)
+	assert.Equal(t, barBlock.Checksum(), bl.Checksum())
+	require.True(t, fs.FilesetExistsAt(dir, testNamespace, 0, midway))
+}
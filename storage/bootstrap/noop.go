@@ -46,3 +46,32 @@ func (b *noOpBootstrapProcess) Run(
 ) (result.BootstrapResult, error) {
 	return result.NewBootstrapResult(), nil
 }
+
+type noOpProgressReporter struct{}
+
+// NewNoOpProgressReporter creates a no-op bootstrap progress reporter.
+func NewNoOpProgressReporter() ProgressReporter {
+	return noOpProgressReporter{}
+}
+
+func (noOpProgressReporter) ReportSourceStarted(
+	source string,
+	shardsTimeRanges result.ShardTimeRanges,
+) {
+}
+
+func (noOpProgressReporter) ReportFetched(
+	source string,
+	shard uint32,
+	numSeries int64,
+	numBytes int64,
+) {
+}
+
+func (noOpProgressReporter) ReportPersisted(
+	source string,
+	shard uint32,
+	numPersisted int,
+	numTotal int,
+) {
+}
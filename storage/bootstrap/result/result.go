@@ -27,20 +27,25 @@ import (
 	"time"
 
 	"github.com/m3db/m3db/storage/block"
+	"github.com/m3db/m3db/storage/index"
 	"github.com/m3db/m3x/ident"
 	xtime "github.com/m3db/m3x/time"
 )
 
 type bootstrapResult struct {
-	results     ShardResults
-	unfulfilled ShardTimeRanges
+	results          ShardResults
+	unfulfilled      ShardTimeRanges
+	indexResults     IndexResults
+	coldWriteResults ShardResults
 }
 
 // NewBootstrapResult creates a new result.
 func NewBootstrapResult() BootstrapResult {
 	return &bootstrapResult{
-		results:     make(ShardResults),
-		unfulfilled: make(ShardTimeRanges),
+		results:          make(ShardResults),
+		unfulfilled:      make(ShardTimeRanges),
+		indexResults:     make(IndexResults),
+		coldWriteResults: make(ShardResults),
 	}
 }
 
@@ -52,6 +57,14 @@ func (r *bootstrapResult) Unfulfilled() ShardTimeRanges {
 	return r.unfulfilled
 }
 
+func (r *bootstrapResult) IndexResults() IndexResults {
+	return r.indexResults
+}
+
+func (r *bootstrapResult) ColdWriteResults() ShardResults {
+	return r.coldWriteResults
+}
+
 func (r *bootstrapResult) Add(shard uint32, result ShardResult, unfulfilled xtime.Ranges) {
 	r.results.AddResults(ShardResults{shard: result})
 	r.unfulfilled.AddRanges(ShardTimeRanges{shard: unfulfilled})
@@ -81,10 +94,14 @@ func MergedBootstrapResult(i, j BootstrapResult) BootstrapResult {
 	if sizeI >= sizeJ {
 		i.ShardResults().AddResults(j.ShardResults())
 		i.Unfulfilled().AddRanges(j.Unfulfilled())
+		i.IndexResults().AddResults(j.IndexResults())
+		i.ColdWriteResults().AddResults(j.ColdWriteResults())
 		return i
 	}
 	j.ShardResults().AddResults(i.ShardResults())
 	j.Unfulfilled().AddRanges(i.Unfulfilled())
+	j.IndexResults().AddResults(i.IndexResults())
+	j.ColdWriteResults().AddResults(i.ColdWriteResults())
 	return j
 }
 
@@ -107,29 +124,36 @@ func (sr *shardResult) IsEmpty() bool {
 }
 
 // AddBlock adds a data block.
-func (sr *shardResult) AddBlock(id ident.ID, b block.DatabaseBlock) {
-	curSeries, exists := sr.blocks[id.Hash()]
-	if !exists {
-		curSeries = sr.newBlocks(id)
-		sr.blocks[id.Hash()] = curSeries
-	}
+func (sr *shardResult) AddBlock(id ident.ID, tags ident.Tags, b block.DatabaseBlock) {
+	curSeries := sr.series(id, tags)
 	curSeries.Blocks.AddBlock(b)
 }
 
 // AddSeries adds a single series.
-func (sr *shardResult) AddSeries(id ident.ID, rawSeries block.DatabaseSeriesBlocks) {
+func (sr *shardResult) AddSeries(id ident.ID, tags ident.Tags, rawSeries block.DatabaseSeriesBlocks) {
+	curSeries := sr.series(id, tags)
+	curSeries.Blocks.AddSeries(rawSeries)
+}
+
+func (sr *shardResult) series(id ident.ID, tags ident.Tags) DatabaseSeriesBlocks {
 	curSeries, exists := sr.blocks[id.Hash()]
 	if !exists {
-		curSeries = sr.newBlocks(id)
+		curSeries = sr.newBlocks(id, tags)
+		sr.blocks[id.Hash()] = curSeries
+	} else if len(curSeries.Tags) == 0 && len(tags) > 0 {
+		// Not every source carries the tags of a series, so
+		// take them from whichever source first provides them.
+		curSeries.Tags = tags
 		sr.blocks[id.Hash()] = curSeries
 	}
-	curSeries.Blocks.AddSeries(rawSeries)
+	return curSeries
 }
 
-func (sr *shardResult) newBlocks(id ident.ID) DatabaseSeriesBlocks {
+func (sr *shardResult) newBlocks(id ident.ID, tags ident.Tags) DatabaseSeriesBlocks {
 	size := sr.opts.NewBlocksLen()
 	return DatabaseSeriesBlocks{
 		ID:     id,
+		Tags:   tags,
 		Blocks: block.NewDatabaseSeriesBlocks(size),
 	}
 }
@@ -141,7 +165,7 @@ func (sr *shardResult) AddResult(other ShardResult) {
 	}
 	otherSeries := other.AllSeries()
 	for _, series := range otherSeries {
-		sr.AddSeries(series.ID, series.Blocks)
+		sr.AddSeries(series.ID, series.Tags, series.Blocks)
 	}
 }
 
@@ -209,6 +233,32 @@ func (r ShardResults) AddResults(other ShardResults) {
 	}
 }
 
+// AddBlock adds a reverse index block, merging it with any existing
+// block for the same block start.
+func (r IndexResults) AddBlock(b index.Block) {
+	blockStart := xtime.ToUnixNano(b.StartTime())
+	existing, ok := r[blockStart]
+	if !ok {
+		r[blockStart] = b
+		return
+	}
+	// NB: Writes to an in-memory block never fail once the tags have
+	// been materialized so the error can be safely ignored.
+	_ = b.ForEach(func(id ident.ID, tags ident.Tags) error {
+		return existing.Write(id, ident.NewTagSliceIterator(tags))
+	})
+}
+
+// AddResults adds other index results to the current index results.
+func (r IndexResults) AddResults(other IndexResults) {
+	for _, b := range other {
+		if b == nil {
+			continue
+		}
+		r.AddBlock(b)
+	}
+}
+
 // Equal returns whether another shard results is equal to the current shard results,
 // will not perform a deep equal only a shallow equal of series and their block addresses.
 func (r ShardResults) Equal(other ShardResults) bool {
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3db/storage/block"
+	"github.com/m3db/m3db/storage/index"
 	"github.com/m3db/m3db/ts"
 	"github.com/m3db/m3x/ident"
 	xtime "github.com/m3db/m3x/time"
@@ -57,18 +58,18 @@ func TestResultAddMergesExistingShardResults(t *testing.T) {
 		NewShardResult(0, opts),
 	}
 
-	srs[0].AddBlock(ident.StringID("foo"), blocks[0])
-	srs[0].AddBlock(ident.StringID("foo"), blocks[1])
-	srs[1].AddBlock(ident.StringID("bar"), blocks[2])
+	srs[0].AddBlock(ident.StringID("foo"), nil, blocks[0])
+	srs[0].AddBlock(ident.StringID("foo"), nil, blocks[1])
+	srs[1].AddBlock(ident.StringID("bar"), nil, blocks[2])
 
 	r := NewBootstrapResult()
 	r.Add(0, srs[0], xtime.Ranges{})
 	r.Add(0, srs[1], xtime.Ranges{})
 
 	srMerged := NewShardResult(0, opts)
-	srMerged.AddBlock(ident.StringID("foo"), blocks[0])
-	srMerged.AddBlock(ident.StringID("foo"), blocks[1])
-	srMerged.AddBlock(ident.StringID("bar"), blocks[2])
+	srMerged.AddBlock(ident.StringID("foo"), nil, blocks[0])
+	srMerged.AddBlock(ident.StringID("foo"), nil, blocks[1])
+	srMerged.AddBlock(ident.StringID("bar"), nil, blocks[2])
 
 	merged := NewBootstrapResult()
 	merged.Add(0, srMerged, xtime.Ranges{})
@@ -148,9 +149,9 @@ func TestResultNumSeries(t *testing.T) {
 		NewShardResult(0, opts),
 	}
 
-	srs[0].AddBlock(ident.StringID("foo"), blocks[0])
-	srs[0].AddBlock(ident.StringID("foo"), blocks[1])
-	srs[1].AddBlock(ident.StringID("bar"), blocks[2])
+	srs[0].AddBlock(ident.StringID("foo"), nil, blocks[0])
+	srs[0].AddBlock(ident.StringID("foo"), nil, blocks[1])
+	srs[1].AddBlock(ident.StringID("bar"), nil, blocks[2])
 
 	r := NewBootstrapResult()
 	r.Add(0, srs[0], xtime.Ranges{})
@@ -176,9 +177,9 @@ func TestResultAddResult(t *testing.T) {
 		NewShardResult(0, opts),
 	}
 
-	srs[0].AddBlock(ident.StringID("foo"), blocks[0])
-	srs[0].AddBlock(ident.StringID("foo"), blocks[1])
-	srs[1].AddBlock(ident.StringID("bar"), blocks[2])
+	srs[0].AddBlock(ident.StringID("foo"), nil, blocks[0])
+	srs[0].AddBlock(ident.StringID("foo"), nil, blocks[1])
+	srs[1].AddBlock(ident.StringID("bar"), nil, blocks[2])
 
 	rs := []BootstrapResult{
 		NewBootstrapResult(),
@@ -198,9 +199,9 @@ func TestResultAddResult(t *testing.T) {
 	r := MergedBootstrapResult(rs[0], rs[1])
 
 	srMerged := NewShardResult(0, opts)
-	srMerged.AddBlock(ident.StringID("foo"), blocks[0])
-	srMerged.AddBlock(ident.StringID("foo"), blocks[1])
-	srMerged.AddBlock(ident.StringID("bar"), blocks[2])
+	srMerged.AddBlock(ident.StringID("foo"), nil, blocks[0])
+	srMerged.AddBlock(ident.StringID("foo"), nil, blocks[1])
+	srMerged.AddBlock(ident.StringID("bar"), nil, blocks[2])
 
 	expected := struct {
 		shardResults ShardResults
@@ -220,13 +221,67 @@ func TestResultAddResult(t *testing.T) {
 	assert.True(t, r.Unfulfilled().Equal(expected.unfulfilled))
 }
 
+func TestMergedBootstrapResultMergesColdWriteResults(t *testing.T) {
+	opts := testResultOptions()
+	blopts := opts.DatabaseBlockOptions()
+
+	start := time.Now().Truncate(testBlockSize)
+
+	blocks := []block.DatabaseBlock{
+		block.NewDatabaseBlock(start, ts.Segment{}, blopts),
+		block.NewDatabaseBlock(start.Add(testBlockSize), ts.Segment{}, blopts),
+	}
+
+	srs := []ShardResult{
+		NewShardResult(0, opts),
+		NewShardResult(0, opts),
+	}
+	srs[0].AddBlock(ident.StringID("foo"), nil, blocks[0])
+	srs[1].AddBlock(ident.StringID("bar"), nil, blocks[1])
+
+	i := NewBootstrapResult()
+	i.ColdWriteResults().AddResults(ShardResults{0: srs[0]})
+	j := NewBootstrapResult()
+	j.ColdWriteResults().AddResults(ShardResults{1: srs[1]})
+
+	merged := MergedBootstrapResult(i, j)
+	assert.Equal(t, 2, len(merged.ColdWriteResults()))
+	assert.Equal(t, int64(2), merged.ColdWriteResults().NumSeries())
+	assert.Equal(t, 0, len(merged.ShardResults()))
+}
+
+func TestIndexResultsAddResults(t *testing.T) {
+	start := time.Now().Truncate(testBlockSize)
+	tags := ident.NewTagSliceIterator(ident.Tags{
+		{Name: ident.StringID("city"), Value: ident.StringID("nyc")},
+	})
+
+	first := index.NewBlock(start, testBlockSize)
+	require.NoError(t, first.Write(ident.StringID("foo"), tags))
+	second := index.NewBlock(start, testBlockSize)
+	require.NoError(t, second.Write(ident.StringID("bar"), ident.EmptyTagIterator))
+	third := index.NewBlock(start.Add(testBlockSize), testBlockSize)
+	require.NoError(t, third.Write(ident.StringID("baz"), ident.EmptyTagIterator))
+
+	r := make(IndexResults)
+	r.AddResults(IndexResults{xtime.ToUnixNano(start): first})
+	r.AddResults(IndexResults{
+		xtime.ToUnixNano(start):                    second,
+		xtime.ToUnixNano(start.Add(testBlockSize)): third,
+	})
+
+	require.Equal(t, 2, len(r))
+	require.Equal(t, 2, r[xtime.ToUnixNano(start)].NumSeries())
+	require.Equal(t, 1, r[xtime.ToUnixNano(start.Add(testBlockSize))].NumSeries())
+}
+
 func TestShardResultIsEmpty(t *testing.T) {
 	opts := testResultOptions()
 	sr := NewShardResult(0, opts)
 	require.True(t, sr.IsEmpty())
 	block := opts.DatabaseBlockOptions().DatabaseBlockPool().Get()
 	block.Reset(time.Now(), ts.Segment{})
-	sr.AddBlock(ident.StringID("foo"), block)
+	sr.AddBlock(ident.StringID("foo"), nil, block)
 	require.False(t, sr.IsEmpty())
 }
 
@@ -245,7 +300,7 @@ func TestShardResultAddBlock(t *testing.T) {
 	for _, input := range inputs {
 		block := opts.DatabaseBlockOptions().DatabaseBlockPool().Get()
 		block.Reset(input.timestamp, ts.Segment{})
-		sr.AddBlock(ident.StringID(input.id), block)
+		sr.AddBlock(ident.StringID(input.id), nil, block)
 	}
 	allSeries := sr.AllSeries()
 	require.Len(t, allSeries, 2)
@@ -265,13 +320,13 @@ func TestShardResultAddSeries(t *testing.T) {
 		{"bar", block.NewDatabaseSeriesBlocks(0)},
 	}
 	for _, input := range inputs {
-		sr.AddSeries(ident.StringID(input.id), input.series)
+		sr.AddSeries(ident.StringID(input.id), nil, input.series)
 	}
 	moreSeries := block.NewDatabaseSeriesBlocks(0)
 	block := opts.DatabaseBlockOptions().DatabaseBlockPool().Get()
 	block.Reset(start, ts.Segment{})
 	moreSeries.AddBlock(block)
-	sr.AddSeries(ident.StringID("foo"), moreSeries)
+	sr.AddSeries(ident.StringID("foo"), nil, moreSeries)
 	allSeries := sr.AllSeries()
 	require.Len(t, allSeries, 2)
 	require.Equal(t, 1, allSeries[ident.StringID("foo").Hash()].Blocks.Len())
@@ -284,20 +339,34 @@ func TestShardResultAddResult(t *testing.T) {
 	sr.AddResult(nil)
 	require.True(t, sr.IsEmpty())
 	other := NewShardResult(0, opts)
-	other.AddSeries(ident.StringID("foo"), block.NewDatabaseSeriesBlocks(0))
-	other.AddSeries(ident.StringID("bar"), block.NewDatabaseSeriesBlocks(0))
+	other.AddSeries(ident.StringID("foo"), nil, block.NewDatabaseSeriesBlocks(0))
+	other.AddSeries(ident.StringID("bar"), nil, block.NewDatabaseSeriesBlocks(0))
 	sr.AddResult(other)
 	require.Len(t, sr.AllSeries(), 2)
 }
 
+func TestShardResultAddSeriesTags(t *testing.T) {
+	opts := testResultOptions()
+	tags := ident.Tags{{Name: ident.StringID("city"), Value: ident.StringID("nyc")}}
+	other := NewShardResult(0, opts)
+	other.AddSeries(ident.StringID("foo"), nil, block.NewDatabaseSeriesBlocks(0))
+	other.AddSeries(ident.StringID("foo"), tags, block.NewDatabaseSeriesBlocks(0))
+	other.AddSeries(ident.StringID("foo"), nil, block.NewDatabaseSeriesBlocks(0))
+	require.Equal(t, tags, other.AllSeries()[ident.StringID("foo").Hash()].Tags)
+
+	sr := NewShardResult(0, opts)
+	sr.AddResult(other)
+	require.Equal(t, tags, sr.AllSeries()[ident.StringID("foo").Hash()].Tags)
+}
+
 func TestShardResultNumSeries(t *testing.T) {
 	opts := testResultOptions()
 	sr := NewShardResult(0, opts)
 	sr.AddResult(nil)
 	require.True(t, sr.IsEmpty())
 	other := NewShardResult(0, opts)
-	other.AddSeries(ident.StringID("foo"), block.NewDatabaseSeriesBlocks(0))
-	other.AddSeries(ident.StringID("bar"), block.NewDatabaseSeriesBlocks(0))
+	other.AddSeries(ident.StringID("foo"), nil, block.NewDatabaseSeriesBlocks(0))
+	other.AddSeries(ident.StringID("bar"), nil, block.NewDatabaseSeriesBlocks(0))
 	sr.AddResult(other)
 	require.Equal(t, int64(2), sr.NumSeries())
 }
@@ -313,7 +382,7 @@ func TestShardResultRemoveSeries(t *testing.T) {
 		{"bar", block.NewDatabaseSeriesBlocks(0)},
 	}
 	for _, input := range inputs {
-		sr.AddSeries(ident.StringID(input.id), input.series)
+		sr.AddSeries(ident.StringID(input.id), nil, input.series)
 	}
 	require.Equal(t, 2, len(sr.AllSeries()))
 	sr.RemoveSeries(ident.StringID("foo"))
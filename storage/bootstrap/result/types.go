@@ -25,6 +25,7 @@ import (
 
 	"github.com/m3db/m3db/clock"
 	"github.com/m3db/m3db/storage/block"
+	"github.com/m3db/m3db/storage/index"
 	"github.com/m3db/m3db/storage/series"
 	"github.com/m3db/m3x/ident"
 	"github.com/m3db/m3x/instrument"
@@ -39,6 +40,13 @@ type BootstrapResult interface {
 	// Unfulfilled is the unfulfilled time ranges for the bootstrap.
 	Unfulfilled() ShardTimeRanges
 
+	// IndexResults is the reverse index blocks for the bootstrap.
+	IndexResults() IndexResults
+
+	// ColdWriteResults is the cold writes for blocks already flushed
+	// which are yet to be merged into their filesets.
+	ColdWriteResults() ShardResults
+
 	// Add adds a shard result with any unfulfilled time ranges.
 	Add(shard uint32, result ShardResult, unfulfilled xtime.Ranges)
 
@@ -61,11 +69,11 @@ type ShardResult interface {
 	// NumSeries returns the number of distinct series'.
 	NumSeries() int64
 
-	// AddBlock adds a data block.
-	AddBlock(id ident.ID, block block.DatabaseBlock)
+	// AddBlock adds a data block for a series with the given tags.
+	AddBlock(id ident.ID, tags ident.Tags, block block.DatabaseBlock)
 
-	// AddSeries adds a single series of blocks.
-	AddSeries(id ident.ID, rawSeries block.DatabaseSeriesBlocks)
+	// AddSeries adds a single series of blocks with the given tags.
+	AddSeries(id ident.ID, tags ident.Tags, rawSeries block.DatabaseSeriesBlocks)
 
 	// AddResult adds a shard result.
 	AddResult(other ShardResult)
@@ -80,15 +88,19 @@ type ShardResult interface {
 	Close()
 }
 
-// DatabaseSeriesBlocks represents a series of blocks and a associated series ID.
+// DatabaseSeriesBlocks represents a series of blocks and the associated series ID and tags.
 type DatabaseSeriesBlocks struct {
 	ID     ident.ID
+	Tags   ident.Tags
 	Blocks block.DatabaseSeriesBlocks
 }
 
 // ShardResults is a map of shards to shard results.
 type ShardResults map[uint32]ShardResult
 
+// IndexResults is a map of block starts to reverse index blocks.
+type IndexResults map[xtime.UnixNano]index.Block
+
 // ShardTimeRanges is a map of shards to time ranges.
 type ShardTimeRanges map[uint32]xtime.Ranges
 
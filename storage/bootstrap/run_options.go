@@ -27,13 +27,15 @@ const (
 )
 
 type runOptions struct {
-	incremental bool
+	incremental      bool
+	progressReporter ProgressReporter
 }
 
 // NewRunOptions creates new bootstrap run options
 func NewRunOptions() RunOptions {
 	return &runOptions{
-		incremental: defaultIncremental,
+		incremental:      defaultIncremental,
+		progressReporter: NewNoOpProgressReporter(),
 	}
 }
 
@@ -46,3 +48,13 @@ func (o *runOptions) SetIncremental(value bool) RunOptions {
 func (o *runOptions) Incremental() bool {
 	return o.incremental
 }
+
+func (o *runOptions) SetProgressReporter(value ProgressReporter) RunOptions {
+	opts := *o
+	opts.progressReporter = value
+	return &opts
+}
+
+func (o *runOptions) ProgressReporter() ProgressReporter {
+	return o.progressReporter
+}
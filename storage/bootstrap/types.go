@@ -62,6 +62,28 @@ type RunOptions interface {
 	// Incremental returns whether this bootstrap should be an incremental
 	// that saves intermediate results to durable storage or not.
 	Incremental() bool
+
+	// SetProgressReporter sets the reporter of the progress of this bootstrap.
+	SetProgressReporter(value ProgressReporter) RunOptions
+
+	// ProgressReporter returns the reporter of the progress of this bootstrap.
+	ProgressReporter() ProgressReporter
+}
+
+// ProgressReporter receives the progress of a bootstrap as sources bootstrap
+// shards, it must be safe for concurrent use as bootstrappers can run in parallel.
+type ProgressReporter interface {
+	// ReportSourceStarted reports that a source started bootstrapping time
+	// ranges of a set of shards.
+	ReportSourceStarted(source string, shardsTimeRanges result.ShardTimeRanges)
+
+	// ReportFetched reports the number of series and bytes of data a source
+	// fetched for a shard.
+	ReportFetched(source string, shard uint32, numSeries int64, numBytes int64)
+
+	// ReportPersisted reports the number of blocks of a shard a source has
+	// persisted so far out of the total number of blocks it is bootstrapping.
+	ReportPersisted(source string, shard uint32, numPersisted int, numTotal int)
 }
 
 // Strategy describes a bootstrap strategy.
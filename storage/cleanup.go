@@ -29,6 +29,7 @@ import (
 	"github.com/m3db/m3db/persist/fs"
 	"github.com/m3db/m3db/retention"
 	xerrors "github.com/m3db/m3x/errors"
+	"github.com/m3db/m3x/ident"
 
 	"github.com/uber-go/tally"
 )
@@ -37,6 +38,10 @@ type commitLogFilesBeforeFn func(commitLogsDir string, t time.Time) ([]string, e
 
 type commitLogFilesForTimeFn func(commitLogsDir string, t time.Time) ([]string, error)
 
+type indexFilesetFilesBeforeFn func(filePathPrefix string, namespace ident.ID, t time.Time) ([]string, error)
+
+type obsoleteSnapshotFilesFn func(filePathPrefix string, namespace ident.ID, shard uint32, earliestToRetain time.Time) ([]string, error)
+
 type deleteFilesFn func(files []string) error
 
 type deleteInactiveDirectoriesFn func(parentDirPath string, activeDirNames []string) error
@@ -51,6 +56,8 @@ type cleanupManager struct {
 	commitLogsDir               string
 	commitLogFilesBeforeFn      commitLogFilesBeforeFn
 	commitLogFilesForTimeFn     commitLogFilesForTimeFn
+	indexFilesetFilesBeforeFn   indexFilesetFilesBeforeFn
+	obsoleteSnapshotFilesFn     obsoleteSnapshotFilesFn
 	deleteFilesFn               deleteFilesFn
 	deleteInactiveDirectoriesFn deleteInactiveDirectoriesFn
 	cleanupInProgress           bool
@@ -70,6 +77,8 @@ func newCleanupManager(database database, scope tally.Scope) databaseCleanupMana
 		commitLogsDir:               commitLogsDir,
 		commitLogFilesBeforeFn:      fs.CommitLogFilesBefore,
 		commitLogFilesForTimeFn:     fs.CommitLogFilesForTime,
+		indexFilesetFilesBeforeFn:   fs.IndexFilesetBefore,
+		obsoleteSnapshotFilesFn:     fs.ObsoleteSnapshotFiles,
 		deleteFilesFn:               fs.DeleteFiles,
 		deleteInactiveDirectoriesFn: fs.DeleteInactiveDirectories,
 		status: scope.Gauge("cleanup"),
@@ -93,6 +102,11 @@ func (m *cleanupManager) Cleanup(t time.Time) error {
 			"encountered errors when cleaning up fileset files for %v: %v", t, err))
 	}
 
+	if err := m.cleanupSnapshotFiles(t); err != nil {
+		multiErr = multiErr.Add(fmt.Errorf(
+			"encountered errors when cleaning up snapshot files for %v: %v", t, err))
+	}
+
 	if err := m.deleteInactiveFilesetFiles(); err != nil {
 		multiErr = multiErr.Add(fmt.Errorf(
 			"encountered errors when deleting inactive fileset files for %v: %v", t, err))
@@ -144,7 +158,11 @@ func (m *cleanupManager) deleteInactiveNamespaceFiles() error {
 		namespaceDirNames = append(namespaceDirNames, n.ID().String())
 	}
 
-	return m.deleteInactiveDirectoriesFn(dataDirPath, namespaceDirNames)
+	multiErr := xerrors.NewMultiError()
+	multiErr = multiErr.Add(m.deleteInactiveDirectoriesFn(dataDirPath, namespaceDirNames))
+	indexDataDirPath := fs.IndexDataDirPath(filePathPrefix)
+	multiErr = multiErr.Add(m.deleteInactiveDirectoriesFn(indexDataDirPath, namespaceDirNames))
+	return multiErr.FinalError()
 }
 
 func (m *cleanupManager) deleteInactiveFilesetFiles() error {
@@ -178,11 +196,42 @@ func (m *cleanupManager) cleanupFilesetFiles(t time.Time) error {
 		shards := n.GetOwnedShards()
 		if n.Options().NeedsFilesetCleanup() {
 			multiErr = multiErr.Add(m.cleanupNamespaceFilesetFiles(earliestToRetain, shards))
+			multiErr = multiErr.Add(m.cleanupNamespaceIndexFilesetFiles(n, earliestToRetain))
+		}
+	}
+	return multiErr.FinalError()
+}
+
+// cleanupSnapshotFiles removes snapshots of blocks that have either expired
+// or been flushed since they are no longer needed to bootstrap those blocks.
+func (m *cleanupManager) cleanupSnapshotFiles(t time.Time) error {
+	multiErr := xerrors.NewMultiError()
+	namespaces, err := m.database.GetOwnedNamespaces()
+	if err != nil {
+		return err
+	}
+	for _, n := range namespaces {
+		earliestToRetain := retention.FlushTimeStart(n.Options().RetentionOptions(), t)
+		for _, shard := range n.GetOwnedShards() {
+			obsolete, err := m.obsoleteSnapshotFilesFn(m.filePathPrefix, n.ID(), shard.ID(), earliestToRetain)
+			if err != nil {
+				multiErr = multiErr.Add(err)
+			}
+			multiErr = multiErr.Add(m.deleteFilesFn(obsolete))
 		}
 	}
 	return multiErr.FinalError()
 }
 
+func (m *cleanupManager) cleanupNamespaceIndexFilesetFiles(n databaseNamespace, earliestToRetain time.Time) error {
+	expired, err := m.indexFilesetFilesBeforeFn(m.filePathPrefix, n.ID(), earliestToRetain)
+	if err != nil {
+		return fmt.Errorf("encountered errors when getting index fileset files prior to %v: %v",
+			earliestToRetain, err)
+	}
+	return m.deleteFilesFn(expired)
+}
+
 func (m *cleanupManager) cleanupNamespaceFilesetFiles(earliestToRetain time.Time, shards []databaseShard) error {
 	multiErr := xerrors.NewMultiError()
 	for _, shard := range shards {
@@ -230,7 +279,27 @@ func (m *cleanupManager) commitLogTimes(t time.Time) (time.Time, []time.Time, er
 	if err != nil {
 		return time.Time{}, nil, err
 	}
+
+	// Commit logs holding cold writes that have not yet been merged
+	// into filesets must be retained to be replayed on bootstrap.
+	var coldWritesPendingSince time.Time
+	for _, ns := range namespaces {
+		if !ns.Options().ColdWritesEnabled() {
+			continue
+		}
+		pendingSince := ns.ColdWritesPendingSince()
+		if pendingSince.IsZero() {
+			continue
+		}
+		if coldWritesPendingSince.IsZero() || pendingSince.Before(coldWritesPendingSince) {
+			coldWritesPendingSince = pendingSince
+		}
+	}
+
 	cleanupTimes := filterTimes(candidateTimes, func(t time.Time) bool {
+		if !coldWritesPendingSince.IsZero() && t.Add(blockSize).After(coldWritesPendingSince) {
+			return false
+		}
 		for _, ns := range namespaces {
 			ropts := ns.Options().RetentionOptions()
 			start, end := commitLogNamespaceBlockTimes(t, blockSize, ropts)
@@ -276,12 +345,30 @@ func commitLogNamespaceBlockTimes(
 
 func (m *cleanupManager) cleanupCommitLogs(earliestToRetain time.Time, cleanupTimes []time.Time) error {
 	multiErr := xerrors.NewMultiError()
+
+	// Commit logs that ended before the snapshot cutoff only hold writes that
+	// have since been persisted by snapshots so they can be removed as well.
+	snapshotCutoff, err := m.commitLogSnapshotCutoff()
+	if err != nil {
+		multiErr = multiErr.Add(err)
+	}
+	if !snapshotCutoff.IsZero() {
+		blockSize := m.opts.CommitLogOptions().BlockSize()
+		if covered := snapshotCutoff.Add(-blockSize); covered.After(earliestToRetain) {
+			earliestToRetain = covered
+		}
+	}
+
 	toCleanup, err := m.commitLogFilesBeforeFn(m.commitLogsDir, earliestToRetain)
 	if err != nil {
 		multiErr = multiErr.Add(err)
 	}
 
 	for _, t := range cleanupTimes {
+		if t.Before(earliestToRetain) {
+			// Already included in the files before the earliest to retain
+			continue
+		}
 		files, err := m.commitLogFilesForTimeFn(m.commitLogsDir, t)
 		if err != nil {
 			multiErr = multiErr.Add(err)
@@ -295,3 +382,36 @@ func (m *cleanupManager) cleanupCommitLogs(earliestToRetain time.Time, cleanupTi
 
 	return multiErr.FinalError()
 }
+
+// commitLogSnapshotCutoff returns the time before which all writes in commit
+// logs have been persisted by snapshots of every namespace, or zero if any
+// namespace is not snapshotted or has cold writes pending since before then.
+func (m *cleanupManager) commitLogSnapshotCutoff() (time.Time, error) {
+	namespaces, err := m.database.GetOwnedNamespaces()
+	if err != nil {
+		return timeZero, err
+	}
+
+	var cutoff time.Time
+	for _, ns := range namespaces {
+		if !ns.Options().SnapshotEnabled() {
+			return timeZero, nil
+		}
+		lastSnapshot := ns.LastSnapshotTime()
+		if lastSnapshot.IsZero() {
+			return timeZero, nil
+		}
+		if cutoff.IsZero() || lastSnapshot.Before(cutoff) {
+			cutoff = lastSnapshot
+		}
+		// Snapshots only cover unflushed blocks so cold writes to flushed
+		// blocks must still be replayed from the commit logs.
+		if !ns.Options().ColdWritesEnabled() {
+			continue
+		}
+		if pendingSince := ns.ColdWritesPendingSince(); !pendingSince.IsZero() && pendingSince.Before(cutoff) {
+			cutoff = pendingSince
+		}
+	}
+	return cutoff, nil
+}
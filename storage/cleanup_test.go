@@ -28,6 +28,7 @@ import (
 
 	"github.com/m3db/m3db/retention"
 	"github.com/m3db/m3db/storage/namespace"
+	"github.com/m3db/m3x/ident"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -123,6 +124,42 @@ func TestCleanupManagerDoesntNeedCleanup(t *testing.T) {
 	require.NoError(t, mgr.Cleanup(ts))
 }
 
+func TestCleanupManagerCleanupIndexFilesets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ts := timeFor(36000)
+	rOpts := retention.NewOptions().
+		SetRetentionPeriod(21600 * time.Second).
+		SetBlockSize(7200 * time.Second)
+	nsOpts := namespace.NewOptions().SetRetentionOptions(rOpts)
+
+	ns := NewMockdatabaseNamespace(ctrl)
+	ns.EXPECT().ID().Return(ident.StringID("testns")).AnyTimes()
+	ns.EXPECT().Options().Return(nsOpts).AnyTimes()
+	ns.EXPECT().GetOwnedShards().Return(nil)
+	namespaces := []databaseNamespace{ns}
+
+	db := newMockdatabase(ctrl, namespaces...)
+	mgr := newCleanupManager(db, tally.NoopScope).(*cleanupManager)
+
+	var earliest time.Time
+	mgr.indexFilesetFilesBeforeFn = func(_ string, nsID ident.ID, t time.Time) ([]string, error) {
+		require.Equal(t, "testns", nsID.String())
+		earliest = t
+		return []string{"foo", "bar"}, nil
+	}
+	var deletedFiles []string
+	mgr.deleteFilesFn = func(files []string) error {
+		deletedFiles = append(deletedFiles, files...)
+		return nil
+	}
+
+	require.NoError(t, mgr.cleanupFilesetFiles(ts))
+	require.Equal(t, retention.FlushTimeStart(rOpts, ts), earliest)
+	require.Equal(t, []string{"foo", "bar"}, deletedFiles)
+}
+
 func TestCleanupManagerPropagatesGetOwnedNamespacesError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -331,6 +368,7 @@ func newCleanupManagerCommitLogTimesTest(t *testing.T, ctrl *gomock.Controller)
 	)
 	no := namespace.NewMockOptions(ctrl)
 	no.EXPECT().RetentionOptions().Return(rOpts).AnyTimes()
+	no.EXPECT().ColdWritesEnabled().Return(false).AnyTimes()
 
 	ns := NewMockdatabaseNamespace(ctrl)
 	ns.EXPECT().Options().Return(no).AnyTimes()
@@ -428,6 +466,144 @@ func TestCleanupManagerCommitLogTimesAllPendingFlush(t *testing.T) {
 	require.Equal(t, 0, len(times))
 }
 
+func TestCleanupManagerCommitLogTimesColdWritesPending(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rOpts := retention.NewOptions().
+		SetRetentionPeriod(30 * time.Second).
+		SetBufferPast(0 * time.Second).
+		SetBufferFuture(0 * time.Second).
+		SetBlockSize(10 * time.Second)
+	no := namespace.NewMockOptions(ctrl)
+	no.EXPECT().RetentionOptions().Return(rOpts).AnyTimes()
+	no.EXPECT().ColdWritesEnabled().Return(true).AnyTimes()
+
+	ns := NewMockdatabaseNamespace(ctrl)
+	ns.EXPECT().Options().Return(no).AnyTimes()
+	ns.EXPECT().ColdWritesPendingSince().Return(timeFor(25))
+
+	db := newMockdatabase(ctrl, ns)
+	mgr := newCleanupManager(db, tally.NoopScope).(*cleanupManager)
+	mgr.opts = mgr.opts.SetCommitLogOptions(
+		mgr.opts.CommitLogOptions().
+			SetRetentionPeriod(rOpts.RetentionPeriod()).
+			SetBlockSize(rOpts.BlockSize()))
+	currentTime := timeFor(50)
+
+	// Commit logs holding the pending cold writes are retained
+	ns.EXPECT().NeedsFlush(timeFor(10), timeFor(20)).Return(false)
+
+	earliest, times, err := mgr.commitLogTimes(currentTime)
+	require.NoError(t, err)
+	require.Equal(t, timeFor(10), earliest)
+	require.Equal(t, 1, len(times))
+	require.True(t, contains(times, timeFor(10)))
+}
+
+func TestCleanupManagerCleanupCommitLogsCoveredBySnapshots(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ns := NewMockdatabaseNamespace(ctrl)
+	ns.EXPECT().Options().Return(namespace.NewOptions().SetSnapshotEnabled(true)).AnyTimes()
+	ns.EXPECT().LastSnapshotTime().Return(timeFor(50))
+
+	db := newMockdatabase(ctrl, ns)
+	mgr := newCleanupManager(db, tally.NoopScope).(*cleanupManager)
+	mgr.opts = mgr.opts.SetCommitLogOptions(
+		mgr.opts.CommitLogOptions().SetBlockSize(10 * time.Second))
+
+	// Commit logs ending before the last snapshot are removed
+	var before time.Time
+	mgr.commitLogFilesBeforeFn = func(_ string, t time.Time) ([]string, error) {
+		before = t
+		return []string{"foo"}, nil
+	}
+	var forTimes []time.Time
+	mgr.commitLogFilesForTimeFn = func(_ string, t time.Time) ([]string, error) {
+		forTimes = append(forTimes, t)
+		return []string{"bar"}, nil
+	}
+	var deletedFiles []string
+	mgr.deleteFilesFn = func(files []string) error {
+		deletedFiles = append(deletedFiles, files...)
+		return nil
+	}
+
+	require.NoError(t, mgr.cleanupCommitLogs(timeFor(10), []time.Time{timeFor(20), timeFor(40)}))
+	require.Equal(t, timeFor(40), before)
+	require.Equal(t, []time.Time{timeFor(40)}, forTimes)
+	require.Equal(t, []string{"foo", "bar"}, deletedFiles)
+}
+
+func TestCleanupManagerCommitLogSnapshotCutoff(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coldOpts := namespace.NewOptions().
+		SetSnapshotEnabled(true).
+		SetColdWritesEnabled(true)
+	ns1 := NewMockdatabaseNamespace(ctrl)
+	ns1.EXPECT().Options().Return(coldOpts).AnyTimes()
+	ns1.EXPECT().LastSnapshotTime().Return(timeFor(50)).AnyTimes()
+	ns1.EXPECT().ColdWritesPendingSince().Return(timeFor(30)).AnyTimes()
+	ns2 := NewMockdatabaseNamespace(ctrl)
+	ns2.EXPECT().Options().Return(namespace.NewOptions().SetSnapshotEnabled(true)).AnyTimes()
+	ns2.EXPECT().LastSnapshotTime().Return(timeFor(40)).AnyTimes()
+	ns3 := NewMockdatabaseNamespace(ctrl)
+	ns3.EXPECT().Options().Return(namespace.NewOptions()).AnyTimes()
+
+	// Pending cold writes hold back the cutoff
+	mgr := newCleanupManager(newMockdatabase(ctrl, ns1, ns2), tally.NoopScope).(*cleanupManager)
+	cutoff, err := mgr.commitLogSnapshotCutoff()
+	require.NoError(t, err)
+	require.Equal(t, timeFor(30), cutoff)
+
+	// Nothing is covered unless every namespace is snapshotted
+	mgr = newCleanupManager(newMockdatabase(ctrl, ns2, ns3), tally.NoopScope).(*cleanupManager)
+	cutoff, err = mgr.commitLogSnapshotCutoff()
+	require.NoError(t, err)
+	require.True(t, cutoff.IsZero())
+}
+
+func TestCleanupManagerCleanupSnapshotFiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ts := timeFor(36000)
+	rOpts := retention.NewOptions().
+		SetRetentionPeriod(21600 * time.Second).
+		SetBlockSize(7200 * time.Second)
+
+	shard := NewMockdatabaseShard(ctrl)
+	shard.EXPECT().ID().Return(uint32(3)).AnyTimes()
+	ns := NewMockdatabaseNamespace(ctrl)
+	ns.EXPECT().ID().Return(ident.StringID("testns")).AnyTimes()
+	ns.EXPECT().Options().Return(namespace.NewOptions().SetRetentionOptions(rOpts)).AnyTimes()
+	ns.EXPECT().GetOwnedShards().Return([]databaseShard{shard})
+
+	db := newMockdatabase(ctrl, ns)
+	mgr := newCleanupManager(db, tally.NoopScope).(*cleanupManager)
+
+	var earliest time.Time
+	mgr.obsoleteSnapshotFilesFn = func(_ string, nsID ident.ID, shardID uint32, t time.Time) ([]string, error) {
+		require.Equal(t, "testns", nsID.String())
+		require.Equal(t, uint32(3), shardID)
+		earliest = t
+		return []string{"foo"}, nil
+	}
+	var deletedFiles []string
+	mgr.deleteFilesFn = func(files []string) error {
+		deletedFiles = append(deletedFiles, files...)
+		return nil
+	}
+
+	require.NoError(t, mgr.cleanupSnapshotFiles(ts))
+	require.Equal(t, retention.FlushTimeStart(rOpts, ts), earliest)
+	require.Equal(t, []string{"foo"}, deletedFiles)
+}
+
 func timeFor(s int64) time.Time {
 	return time.Unix(s, 0)
 }
@@ -0,0 +1,156 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3db/persist/fs"
+	xerrors "github.com/m3db/m3x/errors"
+	"github.com/m3db/m3x/ident"
+
+	"github.com/uber-go/tally"
+)
+
+type supersededFilesetFilesFn func(filePathPrefix string, namespace ident.ID, shard uint32) ([]string, error)
+
+type supersededIndexFilesetFilesFn func(filePathPrefix string, namespace ident.ID) ([]string, error)
+
+// compactionManager removes fileset volumes that have been superseded by a
+// newer complete volume. Rewrites of a fileset, whether from repairs, cold
+// writes or peer streaming, always write a new volume holding the full merged
+// contents of the block so compacting the volumes of a block only requires
+// removing the older ones. Each snapshot of a block is also written as a new
+// volume so superseded snapshot volumes are removed the same way, as are the
+// reverse index fileset volumes rewritten when series are indexed after a
+// block was first flushed.
+type compactionManager struct {
+	sync.RWMutex
+
+	database                  database
+	filePathPrefix            string
+	supersededFilesetFilesFn  supersededFilesetFilesFn
+	supersededSnapshotFilesFn supersededFilesetFilesFn
+	supersededIndexFilesFn    supersededIndexFilesetFilesFn
+	deleteFilesFn             deleteFilesFn
+	compactionInProgress      bool
+	status                    tally.Gauge
+	deleted                   tally.Counter
+}
+
+func newCompactionManager(database database, scope tally.Scope) databaseCompactionManager {
+	opts := database.Options()
+	filePathPrefix := opts.CommitLogOptions().FilesystemOptions().FilePathPrefix()
+
+	return &compactionManager{
+		database:                  database,
+		filePathPrefix:            filePathPrefix,
+		supersededFilesetFilesFn:  fs.SupersededFilesetFiles,
+		supersededSnapshotFilesFn: fs.SupersededSnapshotFiles,
+		supersededIndexFilesFn:    fs.SupersededIndexFilesetFiles,
+		deleteFilesFn:             fs.DeleteFiles,
+		status:                    scope.Gauge("compaction"),
+		deleted:                   scope.Counter("compaction.deleted-files"),
+	}
+}
+
+func (m *compactionManager) Compact(t time.Time) error {
+	m.Lock()
+	m.compactionInProgress = true
+	m.Unlock()
+
+	defer func() {
+		m.Lock()
+		m.compactionInProgress = false
+		m.Unlock()
+	}()
+
+	namespaces, err := m.database.GetOwnedNamespaces()
+	if err != nil {
+		return err
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for _, n := range namespaces {
+		for _, s := range n.GetOwnedShards() {
+			if err := m.compactShard(n.ID(), s.ID()); err != nil {
+				multiErr = multiErr.Add(fmt.Errorf(
+					"encountered errors when compacting fileset volumes for namespace %s shard %d at %v: %v",
+					n.ID().String(), s.ID(), t, err))
+			}
+		}
+		if err := m.compactIndex(n.ID()); err != nil {
+			multiErr = multiErr.Add(fmt.Errorf(
+				"encountered errors when compacting index fileset volumes for namespace %s at %v: %v",
+				n.ID().String(), t, err))
+		}
+	}
+	return multiErr.FinalError()
+}
+
+func (m *compactionManager) compactShard(namespace ident.ID, shard uint32) error {
+	multiErr := xerrors.NewMultiError()
+	superseded, err := m.supersededFilesetFilesFn(m.filePathPrefix, namespace, shard)
+	if err != nil {
+		multiErr = multiErr.Add(err)
+	}
+	supersededSnapshots, err := m.supersededSnapshotFilesFn(m.filePathPrefix, namespace, shard)
+	if err != nil {
+		multiErr = multiErr.Add(err)
+	}
+	superseded = append(superseded, supersededSnapshots...)
+	return m.deleteSuperseded(superseded, multiErr)
+}
+
+func (m *compactionManager) compactIndex(namespace ident.ID) error {
+	multiErr := xerrors.NewMultiError()
+	superseded, err := m.supersededIndexFilesFn(m.filePathPrefix, namespace)
+	if err != nil {
+		multiErr = multiErr.Add(err)
+	}
+	return m.deleteSuperseded(superseded, multiErr)
+}
+
+func (m *compactionManager) deleteSuperseded(superseded []string, multiErr xerrors.MultiError) error {
+	if len(superseded) == 0 {
+		return multiErr.FinalError()
+	}
+	if err := m.deleteFilesFn(superseded); err != nil {
+		multiErr = multiErr.Add(err)
+	} else {
+		m.deleted.Inc(int64(len(superseded)))
+	}
+	return multiErr.FinalError()
+}
+
+func (m *compactionManager) Report() {
+	m.RLock()
+	compactionInProgress := m.compactionInProgress
+	m.RUnlock()
+
+	if compactionInProgress {
+		m.status.Update(1)
+	} else {
+		m.status.Update(0)
+	}
+}
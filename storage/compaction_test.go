@@ -0,0 +1,99 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3x/ident"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+func TestCompactionManagerCompact(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ts := timeFor(36000)
+	ns := NewMockdatabaseNamespace(ctrl)
+	ns.EXPECT().ID().Return(ident.StringID("ns")).AnyTimes()
+	var shards []databaseShard
+	for i := 0; i < 3; i++ {
+		shard := NewMockdatabaseShard(ctrl)
+		shard.EXPECT().ID().Return(uint32(i)).AnyTimes()
+		shards = append(shards, shard)
+	}
+	ns.EXPECT().GetOwnedShards().Return(shards)
+
+	namespaces := []databaseNamespace{ns}
+	db := newMockdatabase(ctrl, namespaces...)
+	mgr := newCompactionManager(db, tally.NoopScope).(*compactionManager)
+
+	mgr.supersededFilesetFilesFn = func(_ string, namespace ident.ID, shard uint32) ([]string, error) {
+		require.Equal(t, "ns", namespace.String())
+		switch shard {
+		case 0:
+			return []string{"foo", "bar"}, nil
+		case 1:
+			return nil, nil
+		}
+		return []string{"baz"}, errors.New("error2")
+	}
+	mgr.supersededSnapshotFilesFn = func(_ string, namespace ident.ID, shard uint32) ([]string, error) {
+		if shard == 1 {
+			return []string{"qux"}, nil
+		}
+		return nil, nil
+	}
+	mgr.supersededIndexFilesFn = func(_ string, namespace ident.ID) ([]string, error) {
+		require.Equal(t, "ns", namespace.String())
+		return []string{"quux"}, nil
+	}
+	var deletedFiles []string
+	mgr.deleteFilesFn = func(files []string) error {
+		deletedFiles = append(deletedFiles, files...)
+		return nil
+	}
+
+	err := mgr.Compact(ts)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "shard 2")
+	require.Equal(t, []string{"foo", "bar", "qux", "baz", "quux"}, deletedFiles)
+}
+
+func TestCompactionManagerCompactNamespacesError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := newMockdatabase(ctrl)
+	db.EXPECT().GetOwnedNamespaces().Return(nil, errors.New("foo"))
+	mgr := newCompactionManager(db, tally.NoopScope).(*compactionManager)
+	mgr.deleteFilesFn = func(files []string) error {
+		require.FailNow(t, "unexpected delete")
+		return nil
+	}
+
+	require.Error(t, mgr.Compact(time.Now()))
+}
@@ -24,6 +24,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -34,6 +35,7 @@ import (
 	"github.com/m3db/m3db/storage/block"
 	"github.com/m3db/m3db/storage/index"
 	"github.com/m3db/m3db/storage/namespace"
+	"github.com/m3db/m3db/storage/series"
 	"github.com/m3db/m3db/x/xcounter"
 	"github.com/m3db/m3db/x/xio"
 	"github.com/m3db/m3x/context"
@@ -83,6 +85,10 @@ type db struct {
 	commitLog  commitlog.CommitLog
 	index      databaseIndex
 
+	// ownedWiredList is set when the database created the wired list
+	// itself and so is responsible for closing it
+	ownedWiredList *block.WiredList
+
 	state    databaseState
 	mediator databaseMediator
 
@@ -138,6 +144,15 @@ func NewDatabase(
 	scope := iopts.MetricsScope().SubScope("database")
 	logger := iopts.Logger()
 
+	var ownedWiredList *block.WiredList
+	blockOpts := opts.DatabaseBlockOptions()
+	if opts.SeriesCachePolicy() == series.CacheLRU && blockOpts.WiredList() == nil {
+		// The LRU of retrieved blocks spans all shards and namespaces
+		ownedWiredList = block.NewWiredList(block.DefaultWiredListCapacity,
+			iopts.SetMetricsScope(scope))
+		opts = opts.SetDatabaseBlockOptions(blockOpts.SetWiredList(ownedWiredList))
+	}
+
 	index := databaseIndexNoOp
 	if opts.IndexingEnabled() {
 		index, err = newDatabaseIndex(opts)
@@ -147,18 +162,19 @@ func NewDatabase(
 	}
 
 	d := &db{
-		opts:         opts,
-		nowFn:        opts.ClockOptions().NowFn(),
-		shardSet:     shardSet,
-		namespaces:   make(map[ident.Hash]databaseNamespace),
-		commitLog:    commitLog,
-		index:        index,
-		scope:        scope,
-		metrics:      newDatabaseMetrics(scope),
-		log:          logger,
-		errors:       xcounter.NewFrequencyCounter(opts.ErrorCounterOptions()),
-		errWindow:    opts.ErrorWindowForLoad(),
-		errThreshold: opts.ErrorThresholdForLoad(),
+		opts:           opts,
+		nowFn:          opts.ClockOptions().NowFn(),
+		shardSet:       shardSet,
+		namespaces:     make(map[ident.Hash]databaseNamespace),
+		commitLog:      commitLog,
+		index:          index,
+		ownedWiredList: ownedWiredList,
+		scope:          scope,
+		metrics:        newDatabaseMetrics(scope),
+		log:            logger,
+		errors:         xcounter.NewFrequencyCounter(opts.ErrorCounterOptions()),
+		errWindow:      opts.ErrorWindowForLoad(),
+		errThreshold:   opts.ErrorThresholdForLoad(),
 	}
 
 	databaseIOpts := iopts.SetMetricsScope(scope)
@@ -202,7 +218,7 @@ func (d *db) UpdateOwnedNamespaces(newNamespaces namespace.Map) error {
 	d.Lock()
 	defer d.Unlock()
 
-	removes, adds, updates := d.namespaceDeltaWithLock(newNamespaces)
+	removes, adds, updates, quotaUpdates := d.namespaceDeltaWithLock(newNamespaces)
 	if err := d.logNamespaceUpdate(removes, adds, updates); err != nil {
 		enrichedErr := fmt.Errorf("unable to log namespace updates: %v", err)
 		d.log.Errorf("%v", enrichedErr)
@@ -216,6 +232,18 @@ func (d *db) UpdateOwnedNamespaces(newNamespaces namespace.Map) error {
 		return err
 	}
 
+	// apply any quota updates, these take effect without a restart
+	for _, md := range quotaUpdates {
+		ns, ok := d.namespaces[md.ID().Hash()]
+		if !ok {
+			continue
+		}
+		ns.SetQuotaOptions(md.Options().QuotaOptions())
+		d.log.WithFields(
+			xlog.NewField("namespace", md.ID().String()),
+		).Infof("updated namespace quotas")
+	}
+
 	// log that updates and removals are skipped
 	if len(removes) > 0 || len(updates) > 0 {
 		d.log.Warnf("skipping namespace removals and updates, restart process if you want changes to take effect.")
@@ -229,12 +257,15 @@ func (d *db) UpdateOwnedNamespaces(newNamespaces namespace.Map) error {
 	return nil
 }
 
-func (d *db) namespaceDeltaWithLock(newNamespaces namespace.Map) ([]ident.ID, []namespace.Metadata, []namespace.Metadata) {
+func (d *db) namespaceDeltaWithLock(newNamespaces namespace.Map) (
+	[]ident.ID, []namespace.Metadata, []namespace.Metadata, []namespace.Metadata,
+) {
 	var (
-		existing = d.namespaces
-		removes  []ident.ID
-		adds     []namespace.Metadata
-		updates  []namespace.Metadata
+		existing     = d.namespaces
+		removes      []ident.ID
+		adds         []namespace.Metadata
+		updates      []namespace.Metadata
+		quotaUpdates []namespace.Metadata
 	)
 
 	// check if existing namespaces exist in newNamespaces
@@ -255,6 +286,14 @@ func (d *db) namespaceDeltaWithLock(newNamespaces namespace.Map) ([]ident.ID, []
 			continue
 		}
 
+		// if only the quotas differ, the update can be applied in place
+		quotaOnly := newMd.Options().Equal(
+			ns.Options().SetQuotaOptions(newMd.Options().QuotaOptions()))
+		if quotaOnly {
+			quotaUpdates = append(quotaUpdates, newMd)
+			continue
+		}
+
 		// if options are not the same, we mark for updates
 		updates = append(updates, newMd)
 	}
@@ -267,7 +306,7 @@ func (d *db) namespaceDeltaWithLock(newNamespaces namespace.Map) ([]ident.ID, []
 		}
 	}
 
-	return removes, adds, updates
+	return removes, adds, updates, quotaUpdates
 }
 
 func (d *db) logNamespaceUpdate(removes []ident.ID, adds, updates []namespace.Metadata) error {
@@ -428,6 +467,10 @@ func (d *db) terminateWithLock() error {
 	// our reference to the namespaces to nil.
 	d.namespaces = nil
 
+	if d.ownedWiredList != nil {
+		d.ownedWiredList.Close()
+	}
+
 	// Finally close the commit log
 	return d.commitLog.Close()
 }
@@ -592,6 +635,21 @@ func (d *db) IsBootstrapped() bool {
 	return d.mediator.IsBootstrapped()
 }
 
+func (d *db) BootstrapStatus() []NamespaceBootstrapStatus {
+	d.RLock()
+	namespaces := d.ownedNamespacesWithLock()
+	d.RUnlock()
+
+	statuses := make([]NamespaceBootstrapStatus, 0, len(namespaces))
+	for _, n := range namespaces {
+		statuses = append(statuses, n.BootstrapStatus())
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Namespace.String() < statuses[j].Namespace.String()
+	})
+	return statuses
+}
+
 func (d *db) Repair() error {
 	return d.mediator.Repair()
 }
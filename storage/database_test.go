@@ -379,7 +379,7 @@ func TestDatabaseFetchBlocksMetadataShardOwned(t *testing.T) {
 	}()
 
 	expectedBlocks := block.NewFetchBlocksMetadataResults()
-	expectedBlocks.Add(block.NewFetchBlocksMetadataResult(ident.StringID("bar"), nil))
+	expectedBlocks.Add(block.NewFetchBlocksMetadataResult(ident.StringID("bar"), nil, nil))
 	expectedToken := new(int64)
 	mockNamespace := NewMockdatabaseNamespace(ctrl)
 	mockNamespace.
@@ -631,3 +631,47 @@ func TestDatabaseUpdateNamespace(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, defaultTestNs2Opts, ns2.Options())
 }
+
+func TestDatabaseUpdateNamespaceQuotas(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	d, mapCh := newTestDatabase(t, ctrl, bootstrapped)
+	require.NoError(t, d.Open())
+	defer func() {
+		require.NoError(t, d.Close())
+		close(mapCh)
+		leaktest.CheckTimeout(t, time.Second)()
+	}()
+
+	// retrieve the update channel to track propatation
+	updateCh := d.opts.NamespaceInitializer().(*mockNsInitializer).updateCh
+
+	// construct new namespace Map only changing the quotas of ns1
+	quotaOpts := namespace.NewQuotaOptions().
+		SetWriteDatapointsPerSecond(1000).
+		SetNewSeriesPerSecond(10)
+	md1, err := namespace.NewMetadata(defaultTestNs1ID, defaultTestNs1Opts.SetQuotaOptions(quotaOpts))
+	require.NoError(t, err)
+	md2, err := namespace.NewMetadata(defaultTestNs2ID, defaultTestNs2Opts)
+	require.NoError(t, err)
+	nsMap, err := namespace.NewMap([]namespace.Metadata{md1, md2})
+	require.NoError(t, err)
+
+	// update the database watch with new Map
+	mapCh <- nsMap
+
+	// wait till the update has propagated
+	<-updateCh
+	<-updateCh
+	time.Sleep(10 * time.Millisecond)
+
+	// ensure the quotas were applied without a restart
+	ns1, ok := d.Namespace(defaultTestNs1ID)
+	require.True(t, ok)
+	require.True(t, quotaOpts.Equal(ns1.Options().QuotaOptions()))
+	require.True(t, defaultTestNs1Opts.SetQuotaOptions(quotaOpts).Equal(ns1.Options()))
+	ns2, ok := d.Namespace(defaultTestNs2ID)
+	require.True(t, ok)
+	require.Equal(t, defaultTestNs2Opts, ns2.Options())
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"io"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3db/digest"
+	"github.com/m3db/m3db/persist"
+	"github.com/m3db/m3db/persist/fs"
+	"github.com/m3db/m3db/storage/block"
+	"github.com/m3db/m3db/storage/namespace"
+	"github.com/m3db/m3db/ts"
+	"github.com/m3db/m3x/context"
+	"github.com/m3db/m3x/ident"
+)
+
+type filesetBlock struct {
+	id    ident.ID
+	tags  ident.Tags
+	block block.DatabaseBlock
+}
+
+type filesetBlocksByID map[ident.Hash]filesetBlock
+
+// filesetBlockPersistedFn is called with each block of a fileset once the
+// fileset has been persisted, the segment is only valid for the duration of
+// the call.
+type filesetBlockPersistedFn func(id ident.ID, segment ts.Segment)
+
+// filesetMerger merges blocks with the fileset already persisted for a
+// block start and writes the merged fileset in place of it.
+type filesetMerger struct {
+	sync.Mutex
+
+	opts   Options
+	reader fs.FileSetReader
+}
+
+func newFilesetMerger(opts Options) (*filesetMerger, error) {
+	fsOpts := opts.CommitLogOptions().FilesystemOptions()
+	reader, err := fs.NewReader(opts.BytesPool(), fsOpts)
+	if err != nil {
+		return nil, err
+	}
+	return &filesetMerger{
+		opts:   opts,
+		reader: reader,
+	}, nil
+}
+
+// Merge merges the blocks with the fileset for the block start and persists
+// the result with the flush, returning the number of series persisted. The
+// merger takes ownership of the blocks and closes them, the IDs remain owned
+// by the caller. If set, onPersisted is called with each merged block once
+// the merged fileset has been persisted.
+func (m *filesetMerger) Merge(
+	ctx context.Context,
+	flush persist.Flush,
+	nsMeta namespace.Metadata,
+	shard uint32,
+	blockStart time.Time,
+	blocks filesetBlocksByID,
+	onPersisted filesetBlockPersistedFn,
+) (int, error) {
+	// NB: The reader is not thread safe and the merger is shared
+	// between the repairer and the flush manager.
+	m.Lock()
+	defer m.Unlock()
+
+	var (
+		nsID      = nsMeta.ID()
+		fsOpts    = m.opts.CommitLogOptions().FilesystemOptions()
+		prefix    = fsOpts.FilePathPrefix()
+		blockOpts = m.opts.DatabaseBlockOptions()
+		mergedIDs = make(map[ident.Hash]struct{}, len(blocks))
+		merged    = make([]filesetBlock, 0, len(blocks))
+		local     []filesetBlock
+	)
+	defer func() {
+		for _, lb := range local {
+			lb.block.Close()
+			lb.id.Finalize()
+		}
+		for idHash, fb := range blocks {
+			if _, ok := mergedIDs[idHash]; !ok {
+				fb.block.Close()
+			}
+		}
+	}()
+
+	// NB: The whole fileset is read into memory before being rewritten
+	// as a new volume, the previous volume is removed by the compactor once
+	// superseded.
+	if fs.FilesetExistsAt(prefix, nsID, shard, blockStart) {
+		if err := m.reader.Open(nsID, shard, blockStart); err != nil {
+			return 0, err
+		}
+		for {
+			id, tags, data, _, err := m.reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				m.reader.Close()
+				return 0, err
+			}
+
+			lb := filesetBlock{
+				id:   id,
+				tags: tags,
+				block: block.NewDatabaseBlock(blockStart,
+					ts.NewSegment(data, nil, ts.FinalizeHead), blockOpts),
+			}
+			local = append(local, lb)
+			if fb, ok := blocks[id.Hash()]; ok {
+				// The local block takes ownership of the merged block
+				lb.block.Merge(fb.block)
+				if len(lb.tags) == 0 {
+					lb.tags = fb.tags
+				}
+				mergedIDs[id.Hash()] = struct{}{}
+			}
+			merged = append(merged, lb)
+		}
+		if err := m.reader.Close(); err != nil {
+			return 0, err
+		}
+	}
+
+	// Series not in the fileset are written as is
+	for idHash, fb := range blocks {
+		if _, ok := mergedIDs[idHash]; !ok {
+			merged = append(merged, fb)
+		}
+	}
+
+	if err := persistFileset(ctx, flush, nsMeta, shard, blockStart, merged, onPersisted); err != nil {
+		return 0, err
+	}
+	return len(merged), nil
+}
+
+func persistFileset(
+	ctx context.Context,
+	flush persist.Flush,
+	nsMeta namespace.Metadata,
+	shard uint32,
+	blockStart time.Time,
+	blocks []filesetBlock,
+	onPersisted filesetBlockPersistedFn,
+) error {
+	prepared, err := flush.PrepareVolume(nsMeta, shard, blockStart)
+	if err != nil {
+		return err
+	}
+	if prepared.Persist == nil {
+		return nil
+	}
+
+	segments := make([]ts.Segment, len(blocks))
+	for i, fb := range blocks {
+		segment, err := persistFilesetBlock(ctx, fb, prepared.Persist)
+		if err != nil {
+			prepared.Close()
+			return err
+		}
+		segments[i] = segment
+	}
+	if err := prepared.Close(); err != nil {
+		return err
+	}
+
+	// Only notify once the fileset has superseded the previous volume
+	if onPersisted != nil {
+		for i, fb := range blocks {
+			if segments[i].Len() > 0 {
+				onPersisted(fb.id, segments[i])
+			}
+		}
+	}
+	return nil
+}
+
+func persistFilesetBlock(
+	ctx context.Context,
+	fb filesetBlock,
+	persistFn persist.Fn,
+) (ts.Segment, error) {
+	sr, err := fb.block.Stream(ctx)
+	if err != nil {
+		return ts.Segment{}, err
+	}
+	if sr == nil {
+		return ts.Segment{}, nil
+	}
+	segment, err := sr.Segment()
+	if err != nil {
+		return ts.Segment{}, err
+	}
+	return segment, persistFn(fb.id, fb.tags, segment, digest.SegmentChecksum(segment))
+}
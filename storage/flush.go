@@ -42,17 +42,27 @@ type flushManager struct {
 	sync.RWMutex
 
 	database        database
+	merger          *filesetMerger
+	rollup          *filesetRollup
 	opts            Options
 	nowFn           clock.NowFn
 	pm              persist.Manager
 	flushInProgress bool
+	lastSnapshot    time.Time
 	status          tally.Gauge
 }
 
-func newFlushManager(database database, scope tally.Scope) databaseFlushManager {
+func newFlushManager(
+	database database,
+	merger *filesetMerger,
+	rollup *filesetRollup,
+	scope tally.Scope,
+) databaseFlushManager {
 	opts := database.Options()
 	return &flushManager{
 		database: database,
+		merger:   merger,
+		rollup:   rollup,
 		opts:     opts,
 		nowFn:    opts.ClockOptions().NowFn(),
 		pm:       opts.PersistManager(),
@@ -87,10 +97,30 @@ func (m *flushManager) Flush(curr time.Time) error {
 		return err
 	}
 
-	multiErr := xerrors.NewMultiError()
+	var (
+		multiErr = xerrors.NewMultiError()
+		rollups  = rollupTargets(namespaces)
+	)
 	for _, ns := range namespaces {
 		flushTimes := m.namespaceFlushTimes(ns, curr)
-		multiErr = multiErr.Add(m.flushNamespaceWithTimes(ns, flushTimes, flush))
+		targets := rollups[ns.ID().Hash()]
+		multiErr = multiErr.Add(m.flushNamespaceWithTimes(ns, flushTimes, flush, targets))
+
+		// Cold writes are merged once the blocks they belong to are flushed
+		if !ns.Options().ColdWritesEnabled() {
+			continue
+		}
+		if err := ns.ColdFlush(flush, m.merger); err != nil {
+			detailedErr := fmt.Errorf("namespace %s failed to cold flush data: %v",
+				ns.ID().String(), err)
+			multiErr = multiErr.Add(detailedErr)
+		}
+	}
+
+	// Snapshot the data not yet flushed once flushing is done so snapshots
+	// never need to cover blocks that were just flushed
+	if m.shouldSnapshot(curr) {
+		multiErr = multiErr.Add(m.snapshot(namespaces, curr, flush))
 	}
 
 	// mark flush finished
@@ -127,9 +157,62 @@ func (m *flushManager) namespaceFlushTimes(ns databaseNamespace, curr time.Time)
 	})
 }
 
+func (m *flushManager) shouldSnapshot(curr time.Time) bool {
+	m.RLock()
+	lastSnapshot := m.lastSnapshot
+	m.RUnlock()
+	return lastSnapshot.IsZero() ||
+		!curr.Before(lastSnapshot.Add(m.opts.MinimumSnapshotInterval()))
+}
+
+// snapshot snapshots the unflushed blocks of all namespaces with snapshots
+// enabled, all using the same snapshot time.
+func (m *flushManager) snapshot(
+	namespaces []databaseNamespace,
+	curr time.Time,
+	flush persist.Flush,
+) error {
+	snapshotTime := m.nowFn()
+	multiErr := xerrors.NewMultiError()
+	for _, ns := range namespaces {
+		if !ns.Options().SnapshotEnabled() {
+			continue
+		}
+		blockStarts := m.namespaceSnapshotTimes(ns, curr)
+		if err := ns.Snapshot(blockStarts, snapshotTime, flush); err != nil {
+			detailedErr := fmt.Errorf("namespace %s failed to snapshot data: %v",
+				ns.ID().String(), err)
+			multiErr = multiErr.Add(detailedErr)
+		}
+	}
+
+	m.Lock()
+	m.lastSnapshot = curr
+	m.Unlock()
+	return multiErr.FinalError()
+}
+
+// namespaceSnapshotTimes returns the block starts that may hold data not yet
+// flushed, from the earliest flushable block to the block taking future writes.
+func (m *flushManager) namespaceSnapshotTimes(ns databaseNamespace, curr time.Time) []time.Time {
+	var (
+		rOpts     = ns.Options().RetentionOptions()
+		blockSize = rOpts.BlockSize()
+		earliest  = retention.FlushTimeStart(rOpts, curr)
+		latest    = curr.Add(rOpts.BufferFuture()).Truncate(blockSize)
+	)
+	return timesInRange(earliest, latest, blockSize)
+}
+
 // flushWithTime flushes in-memory data for a given namespace, at a given
-// time, returning any error encountered during flushing
-func (m *flushManager) flushNamespaceWithTimes(ns databaseNamespace, times []time.Time, flush persist.Flush) error {
+// time, returning any error encountered during flushing. Each flushed block
+// is then rolled up into the namespaces fed rollups from the namespace.
+func (m *flushManager) flushNamespaceWithTimes(
+	ns databaseNamespace,
+	times []time.Time,
+	flush persist.Flush,
+	rollupTargets []databaseNamespace,
+) error {
 	multiErr := xerrors.NewMultiError()
 	for _, t := range times {
 		// NB(xichen): we still want to proceed if a namespace fails to flush its data.
@@ -138,6 +221,14 @@ func (m *flushManager) flushNamespaceWithTimes(ns databaseNamespace, times []tim
 			detailedErr := fmt.Errorf("namespace %s failed to flush data: %v",
 				ns.ID().String(), err)
 			multiErr = multiErr.Add(detailedErr)
+			continue
+		}
+		for _, target := range rollupTargets {
+			if err := m.rollup.Rollup(flush, ns, target, t); err != nil {
+				detailedErr := fmt.Errorf("namespace %s failed to roll up data into namespace %s: %v",
+					ns.ID().String(), target.ID().String(), err)
+				multiErr = multiErr.Add(detailedErr)
+			}
 		}
 	}
 	return multiErr.FinalError()
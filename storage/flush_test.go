@@ -51,7 +51,7 @@ func newMultipleFlushManagerNeedsFlush(t *testing.T, ctrl *gomock.Controller) (
 	otherNamespace.EXPECT().ID().Return(ident.StringID("someString")).AnyTimes()
 
 	db := newMockdatabase(ctrl, namespace, otherNamespace)
-	fm := newFlushManager(db, tally.NoopScope).(*flushManager)
+	fm := newFlushManager(db, nil, nil, tally.NoopScope).(*flushManager)
 
 	return fm, namespace, otherNamespace
 }
@@ -81,7 +81,7 @@ func TestFlushManagerFlushAlreadyInProgress(t *testing.T) {
 	db.EXPECT().Options().Return(testOpts).AnyTimes()
 	db.EXPECT().GetOwnedNamespaces().Return(nil, nil).AnyTimes()
 
-	fm := newFlushManager(db, tally.NoopScope).(*flushManager)
+	fm := newFlushManager(db, nil, nil, tally.NoopScope).(*flushManager)
 	fm.pm = mockPersistManager
 
 	now := time.Unix(0, 0)
@@ -120,7 +120,7 @@ func TestFlushManagerFlushDoneError(t *testing.T) {
 	db.EXPECT().Options().Return(testOpts).AnyTimes()
 	db.EXPECT().GetOwnedNamespaces().Return(nil, nil).AnyTimes()
 
-	fm := newFlushManager(db, tally.NoopScope).(*flushManager)
+	fm := newFlushManager(db, nil, nil, tally.NoopScope).(*flushManager)
 	fm.pm = mockPersistManager
 
 	now := time.Unix(0, 0)
@@ -237,3 +237,83 @@ type timesInOrder []time.Time
 func (a timesInOrder) Len() int           { return len(a) }
 func (a timesInOrder) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (a timesInOrder) Less(i, j int) bool { return a[i].Before(a[j]) }
+
+func TestFlushManagerFlushColdWrites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFlusher := persist.NewMockFlush(ctrl)
+	mockFlusher.EXPECT().Done().Return(nil)
+	mockPersistManager := persist.NewMockManager(ctrl)
+	mockPersistManager.EXPECT().StartFlush().Return(mockFlusher, nil)
+
+	fakeErr := errors.New("fake error while cold flushing")
+	coldNamespace := NewMockdatabaseNamespace(ctrl)
+	coldNamespace.EXPECT().Options().
+		Return(namespace.NewOptions().SetColdWritesEnabled(true)).AnyTimes()
+	coldNamespace.EXPECT().ID().Return(defaultTestNs1ID).AnyTimes()
+	coldNamespace.EXPECT().NeedsFlush(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+	otherNamespace := NewMockdatabaseNamespace(ctrl)
+	otherNamespace.EXPECT().Options().Return(namespace.NewOptions()).AnyTimes()
+	otherNamespace.EXPECT().ID().Return(ident.StringID("someString")).AnyTimes()
+	otherNamespace.EXPECT().NeedsFlush(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+
+	testOpts := testDatabaseOptions().SetPersistManager(mockPersistManager)
+	db := newMockdatabase(ctrl, coldNamespace, otherNamespace)
+
+	merger, err := newFilesetMerger(testOpts)
+	require.NoError(t, err)
+	fm := newFlushManager(db, merger, nil, tally.NoopScope).(*flushManager)
+	fm.pm = mockPersistManager
+
+	// Only the namespace with cold writes enabled is cold flushed
+	coldNamespace.EXPECT().ColdFlush(mockFlusher, merger).Return(fakeErr)
+
+	err = fm.Flush(time.Unix(86400*2, 0))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), fakeErr.Error())
+}
+
+func TestFlushManagerFlushSnapshots(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFlusher := persist.NewMockFlush(ctrl)
+	mockFlusher.EXPECT().Done().Return(nil).Times(2)
+	mockPersistManager := persist.NewMockManager(ctrl)
+	mockPersistManager.EXPECT().StartFlush().Return(mockFlusher, nil).Times(2)
+
+	nsOpts := namespace.NewOptions().SetSnapshotEnabled(true)
+	snapshotNamespace := NewMockdatabaseNamespace(ctrl)
+	snapshotNamespace.EXPECT().Options().Return(nsOpts).AnyTimes()
+	snapshotNamespace.EXPECT().ID().Return(defaultTestNs1ID).AnyTimes()
+	snapshotNamespace.EXPECT().NeedsFlush(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+	otherNamespace := NewMockdatabaseNamespace(ctrl)
+	otherNamespace.EXPECT().Options().Return(namespace.NewOptions()).AnyTimes()
+	otherNamespace.EXPECT().ID().Return(ident.StringID("someString")).AnyTimes()
+	otherNamespace.EXPECT().NeedsFlush(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+
+	db := newMockdatabase(ctrl, snapshotNamespace, otherNamespace)
+	fm := newFlushManager(db, nil, nil, tally.NoopScope).(*flushManager)
+	fm.pm = mockPersistManager
+	fm.opts = fm.opts.SetMinimumSnapshotInterval(time.Hour)
+
+	var (
+		now          = time.Unix(86400*2, 0)
+		ropts        = nsOpts.RetentionOptions()
+		blockSize    = ropts.BlockSize()
+		earliest     = retention.FlushTimeStart(ropts, now)
+		latest       = now.Add(ropts.BufferFuture()).Truncate(blockSize)
+		snapshotTime = now.Add(time.Second)
+	)
+	fm.nowFn = func() time.Time { return snapshotTime }
+
+	// Only the namespace with snapshots enabled is snapshotted, and only
+	// once within the minimum snapshot interval
+	snapshotNamespace.EXPECT().
+		Snapshot(timesInRange(earliest, latest, blockSize), snapshotTime, mockFlusher).
+		Return(nil)
+
+	require.NoError(t, fm.Flush(now))
+	require.NoError(t, fm.Flush(now.Add(time.Minute)))
+}
@@ -58,6 +58,7 @@ const (
 type fileSystemManager struct {
 	databaseFlushManager
 	databaseCleanupManager
+	databaseCompactionManager
 	sync.RWMutex
 
 	log      xlog.Logger
@@ -69,16 +70,20 @@ type fileSystemManager struct {
 
 func newFileSystemManager(
 	database database,
+	merger *filesetMerger,
+	rollup *filesetRollup,
 	opts Options,
 ) databaseFileSystemManager {
 	instrumentOpts := opts.InstrumentOptions()
 	scope := instrumentOpts.MetricsScope().SubScope("fs")
-	fm := newFlushManager(database, scope)
+	fm := newFlushManager(database, merger, rollup, scope)
 	cm := newCleanupManager(database, scope)
+	om := newCompactionManager(database, scope)
 
 	return &fileSystemManager{
-		databaseFlushManager:   fm,
-		databaseCleanupManager: cm,
+		databaseFlushManager:      fm,
+		databaseCleanupManager:    cm,
+		databaseCompactionManager: om,
 		log:      instrumentOpts.Logger(),
 		database: database,
 		opts:     opts,
@@ -127,6 +132,11 @@ func (m *fileSystemManager) Run(t time.Time, runType runType, forceType forceTyp
 		if err := m.Flush(t); err != nil {
 			m.log.Errorf("error when flushing data for time %v: %v", t, err)
 		}
+		// NB: Compact after flushing so volumes superseded by cold flushes
+		// are removed in the same run.
+		if err := m.Compact(t); err != nil {
+			m.log.Errorf("error when compacting data for time %v: %v", t, err)
+		}
 		m.Lock()
 		m.status = fileOpNotStarted
 		m.Unlock()
@@ -143,6 +153,7 @@ func (m *fileSystemManager) Run(t time.Time, runType runType, forceType forceTyp
 func (m *fileSystemManager) Report() {
 	m.databaseCleanupManager.Report()
 	m.databaseFlushManager.Report()
+	m.databaseCompactionManager.Report()
 }
 
 func (m *fileSystemManager) shouldRunWithLock() bool {
@@ -22,33 +22,106 @@ package storage
 
 import (
 	"errors"
+	"sync"
+	"time"
 
+	"github.com/m3db/m3db/clock"
+	"github.com/m3db/m3db/persist"
+	"github.com/m3db/m3db/retention"
+	"github.com/m3db/m3db/storage/bootstrap/result"
 	"github.com/m3db/m3db/storage/index"
+	"github.com/m3db/m3db/storage/namespace"
 	"github.com/m3db/m3x/context"
 	"github.com/m3db/m3x/ident"
+	xtime "github.com/m3db/m3x/time"
+
+	"github.com/uber-go/tally"
 )
 
 var (
-	errIndexingNotImplemented = errors.New("indexing is not implemented")
+	errIndexQueryTimeRange = errors.New("index query start must be before end")
 )
 
 type dbIndex struct {
-	opts Options
+	sync.RWMutex
+
+	opts       Options
+	nowFn      clock.NowFn
+	namespaces map[ident.Hash]*nsIndex
+	metrics    dbIndexMetrics
+}
+
+type dbIndexMetrics struct {
+	insert        tally.Counter
+	insertErrors  tally.Counter
+	query         tally.Counter
+	queryErrors   tally.Counter
+	blocksCreated tally.Counter
+	blocksExpired tally.Counter
+	flush         tally.Counter
+	flushErrors   tally.Counter
+}
+
+func newDatabaseIndexMetrics(scope tally.Scope) dbIndexMetrics {
+	return dbIndexMetrics{
+		insert:        scope.Counter("insert"),
+		insertErrors:  scope.Counter("insert-errors"),
+		query:         scope.Counter("query"),
+		queryErrors:   scope.Counter("query-errors"),
+		blocksCreated: scope.Counter("blocks-created"),
+		blocksExpired: scope.Counter("blocks-expired"),
+		flush:         scope.Counter("flush"),
+		flushErrors:   scope.Counter("flush-errors"),
+	}
+}
+
+// nsIndex is the reverse index for a single namespace, partitioned into
+// blocks aligned to the namespace block size.
+type nsIndex struct {
+	sync.RWMutex
+
+	id              ident.ID
+	blockSize       time.Duration
+	retentionPeriod time.Duration
+	blocks          map[xtime.UnixNano]index.Block
+	// flushed is the number of series each block held when last flushed.
+	flushed map[xtime.UnixNano]int
 }
 
 func newDatabaseIndex(o Options) (databaseIndex, error) {
+	scope := o.InstrumentOptions().MetricsScope().SubScope("index")
 	return &dbIndex{
-		opts: o,
+		opts:       o,
+		nowFn:      o.ClockOptions().NowFn(),
+		namespaces: make(map[ident.Hash]*nsIndex),
+		metrics:    newDatabaseIndexMetrics(scope),
 	}, nil
 }
 
 func (i *dbIndex) Write(
 	ctx context.Context,
-	namespace ident.ID,
+	namespace namespace.Metadata,
 	id ident.ID,
 	tags ident.TagIterator,
+	timestamp time.Time,
 ) error {
-	return errIndexingNotImplemented
+	nsIdx := i.namespaceIndex(namespace)
+	block, expired := nsIdx.blockFor(timestamp, i.nowFn())
+	if expired > 0 {
+		i.metrics.blocksExpired.Inc(int64(expired))
+	}
+	if block == nil {
+		i.metrics.blocksCreated.Inc(1)
+		block = nsIdx.createBlock(timestamp)
+	}
+
+	if err := block.Write(id, tags); err != nil {
+		i.metrics.insertErrors.Inc(1)
+		return err
+	}
+
+	i.metrics.insert.Inc(1)
+	return nil
 }
 
 func (i *dbIndex) Query(
@@ -56,21 +129,279 @@ func (i *dbIndex) Query(
 	query index.Query,
 	opts index.QueryOptions,
 ) (index.QueryResults, error) {
-	return index.QueryResults{}, errIndexingNotImplemented
+	if !opts.StartInclusive.Before(opts.EndExclusive) {
+		i.metrics.queryErrors.Inc(1)
+		return index.QueryResults{}, errIndexQueryTimeRange
+	}
+
+	i.RLock()
+	namespaces := make([]*nsIndex, 0, len(i.namespaces))
+	for _, nsIdx := range i.namespaces {
+		namespaces = append(namespaces, nsIdx)
+	}
+	i.RUnlock()
+
+	var (
+		results    = make([]index.Results, 0, len(namespaces))
+		total      int
+		exhaustive = true
+	)
+	for _, nsIdx := range namespaces {
+		limit := 0
+		if opts.Limit > 0 {
+			limit = opts.Limit - total
+			if limit <= 0 {
+				exhaustive = false
+				break
+			}
+		}
+
+		res := index.NewResults(nsIdx.id)
+		nsExhaustive, err := nsIdx.query(query, opts, limit, res)
+		if err != nil {
+			i.metrics.queryErrors.Inc(1)
+			return index.QueryResults{}, err
+		}
+		exhaustive = exhaustive && nsExhaustive
+		total += res.Size()
+		results = append(results, res)
+	}
+
+	i.metrics.query.Inc(1)
+	return index.QueryResults{
+		Iter:       index.NewMultiTaggedIDsIter(results...),
+		Exhaustive: exhaustive,
+	}, nil
+}
+
+func (i *dbIndex) Bootstrap(
+	namespace namespace.Metadata,
+	blocks result.IndexResults,
+) error {
+	nsIdx := i.namespaceIndex(namespace)
+	for _, b := range blocks {
+		if b == nil {
+			continue
+		}
+		if err := nsIdx.bootstrapBlock(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *dbIndex) Flush(
+	namespace namespace.Metadata,
+	blockStart time.Time,
+	flush persist.Flush,
+) error {
+	var (
+		nsIdx = i.namespaceIndex(namespace)
+		key   = xtime.ToUnixNano(blockStart)
+	)
+	nsIdx.RLock()
+	block, ok := nsIdx.blocks[key]
+	flushedNumSeries, flushed := nsIdx.flushed[key]
+	nsIdx.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	// Series are only ever added to a block so it has not changed since it
+	// was last flushed, or loaded from its index fileset when bootstrapping,
+	// if it still holds as many series.
+	numSeries := block.NumSeries()
+	if flushed && numSeries == flushedNumSeries {
+		return nil
+	}
+
+	prepared, err := flush.PrepareIndex(namespace, blockStart)
+	if err != nil {
+		i.metrics.flushErrors.Inc(1)
+		return err
+	}
+
+	err = block.ForEach(func(id ident.ID, tags ident.Tags) error {
+		return prepared.Persist(id, tags)
+	})
+	// Always close to release the files, the checkpoint file is only
+	// written if no errors were encountered while persisting.
+	if closeErr := prepared.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		i.metrics.flushErrors.Inc(1)
+		return err
+	}
+
+	nsIdx.Lock()
+	nsIdx.flushed[key] = numSeries
+	nsIdx.Unlock()
+
+	i.metrics.flush.Inc(1)
+	return nil
+}
+
+func (i *dbIndex) BlockStarts(namespace namespace.Metadata) []time.Time {
+	nsIdx := i.namespaceIndex(namespace)
+	nsIdx.RLock()
+	defer nsIdx.RUnlock()
+
+	blockStarts := make([]time.Time, 0, len(nsIdx.blocks))
+	for blockStart := range nsIdx.blocks {
+		blockStarts = append(blockStarts, blockStart.ToTime())
+	}
+	return blockStarts
+}
+
+func (i *dbIndex) namespaceIndex(md namespace.Metadata) *nsIndex {
+	hash := md.ID().Hash()
+
+	i.RLock()
+	nsIdx, ok := i.namespaces[hash]
+	i.RUnlock()
+	if ok {
+		return nsIdx
+	}
+
+	i.Lock()
+	defer i.Unlock()
+
+	nsIdx, ok = i.namespaces[hash]
+	if ok {
+		return nsIdx
+	}
+
+	ropts := md.Options().RetentionOptions()
+	nsIdx = &nsIndex{
+		id:              ident.StringID(md.ID().String()),
+		blockSize:       ropts.BlockSize(),
+		retentionPeriod: ropts.RetentionPeriod(),
+		blocks:          make(map[xtime.UnixNano]index.Block),
+		flushed:         make(map[xtime.UnixNano]int),
+	}
+	i.namespaces[hash] = nsIdx
+	return nsIdx
+}
+
+// blockFor returns the block for the timestamp if it exists, expiring any
+// blocks that have fallen out of retention while holding the lock.
+func (n *nsIndex) blockFor(timestamp time.Time, now time.Time) (index.Block, int) {
+	blockStart := xtime.ToUnixNano(timestamp.Truncate(n.blockSize))
+
+	n.RLock()
+	block, ok := n.blocks[blockStart]
+	n.RUnlock()
+	if ok {
+		return block, 0
+	}
+
+	// Only expire blocks when a new block is about to be created, this
+	// happens at most once per block size for a namespace.
+	earliest := retention.FlushTimeStartForRetentionPeriod(n.retentionPeriod,
+		n.blockSize, now)
+	n.Lock()
+	defer n.Unlock()
+	expired := 0
+	for start, b := range n.blocks {
+		if b.EndTime().After(earliest) {
+			continue
+		}
+		delete(n.blocks, start)
+		delete(n.flushed, start)
+		expired++
+	}
+	return n.blocks[blockStart], expired
+}
+
+func (n *nsIndex) createBlock(timestamp time.Time) index.Block {
+	blockStart := timestamp.Truncate(n.blockSize)
+
+	n.Lock()
+	defer n.Unlock()
+
+	block, ok := n.blocks[xtime.ToUnixNano(blockStart)]
+	if ok {
+		return block
+	}
+	block = index.NewBlock(blockStart, n.blockSize)
+	n.blocks[xtime.ToUnixNano(blockStart)] = block
+	return block
+}
+
+// bootstrapBlock adds a block loaded during bootstrap, merging it with any
+// block already created for the same block start by incoming writes. The
+// series the loaded block holds are already on disk so they are recorded
+// as flushed, the block is only rewritten once it holds more series.
+func (n *nsIndex) bootstrapBlock(b index.Block) error {
+	blockStart := xtime.ToUnixNano(b.StartTime())
+
+	n.Lock()
+	existing, ok := n.blocks[blockStart]
+	if !ok {
+		n.blocks[blockStart] = b
+	}
+	if _, flushed := n.flushed[blockStart]; !flushed {
+		n.flushed[blockStart] = b.NumSeries()
+	}
+	n.Unlock()
+	if !ok {
+		return nil
+	}
+
+	return b.ForEach(func(id ident.ID, tags ident.Tags) error {
+		return existing.Write(id, ident.NewTagSliceIterator(tags))
+	})
+}
+
+func (n *nsIndex) query(
+	query index.Query,
+	opts index.QueryOptions,
+	limit int,
+	results index.Results,
+) (bool, error) {
+	n.RLock()
+	blocks := make([]index.Block, 0, len(n.blocks))
+	for _, b := range n.blocks {
+		if !b.StartTime().Before(opts.EndExclusive) ||
+			!b.EndTime().After(opts.StartInclusive) {
+			continue
+		}
+		blocks = append(blocks, b)
+	}
+	n.RUnlock()
+
+	for _, b := range blocks {
+		exhaustive, err := b.Query(query, limit, results)
+		if err != nil {
+			return false, err
+		}
+		if !exhaustive {
+			return false, nil
+		}
+	}
+	return true, nil
 }
 
 type databaseIndexWriter interface {
 	Write(
 		ctx context.Context,
-		namespace ident.ID,
+		namespace namespace.Metadata,
 		id ident.ID,
 		tags ident.TagIterator,
+		timestamp time.Time,
 	) error
 }
 
 type dbIndexNoOp struct{}
 
-func (n dbIndexNoOp) Write(context.Context, ident.ID, ident.ID, ident.TagIterator) error {
+func (n dbIndexNoOp) Write(
+	context.Context,
+	namespace.Metadata,
+	ident.ID,
+	ident.TagIterator,
+	time.Time,
+) error {
 	return nil
 }
 
@@ -78,4 +409,16 @@ func (n dbIndexNoOp) Query(context.Context, index.Query, index.QueryOptions) (in
 	return index.QueryResults{}, nil
 }
 
+func (n dbIndexNoOp) Bootstrap(namespace.Metadata, result.IndexResults) error {
+	return nil
+}
+
+func (n dbIndexNoOp) Flush(namespace.Metadata, time.Time, persist.Flush) error {
+	return nil
+}
+
+func (n dbIndexNoOp) BlockStarts(namespace.Metadata) []time.Time {
+	return nil
+}
+
 var databaseIndexNoOp databaseIndex = dbIndexNoOp{}
@@ -0,0 +1,271 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3ninx/index/segment"
+	"github.com/m3db/m3x/ident"
+)
+
+var (
+	errUnsupportedConjunction = errors.New("query conjunction is not supported")
+)
+
+// postingsID is the identifier of a document within a single block.
+type postingsID uint32
+
+// doc is a series ID and its tags as indexed by a block.
+type doc struct {
+	id   []byte
+	tags []tagField
+}
+
+type tagField struct {
+	name  []byte
+	value []byte
+}
+
+// memBlock is an in-memory Block, it keeps a postings list for every tag
+// name and value pair written during the block.
+type memBlock struct {
+	sync.RWMutex
+
+	startTime time.Time
+	endTime   time.Time
+
+	docs     []doc
+	ids      map[string]postingsID
+	postings map[string]map[string]postingsList
+}
+
+// NewBlock returns a new in-memory Block indexing the period
+// [startTime, startTime+blockSize).
+func NewBlock(startTime time.Time, blockSize time.Duration) Block {
+	return &memBlock{
+		startTime: startTime,
+		endTime:   startTime.Add(blockSize),
+		ids:       make(map[string]postingsID),
+		postings:  make(map[string]map[string]postingsList),
+	}
+}
+
+func (b *memBlock) StartTime() time.Time {
+	return b.startTime
+}
+
+func (b *memBlock) EndTime() time.Time {
+	return b.endTime
+}
+
+func (b *memBlock) NumSeries() int {
+	b.RLock()
+	n := len(b.docs)
+	b.RUnlock()
+	return n
+}
+
+func (b *memBlock) ForEach(fn func(id ident.ID, tags ident.Tags) error) error {
+	// Docs are only ever appended and never mutated so it is safe to
+	// iterate over a snapshot of the slice without holding the lock.
+	b.RLock()
+	docs := b.docs
+	b.RUnlock()
+
+	for _, d := range docs {
+		if err := fn(ident.StringID(string(d.id)), d.identTags()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *memBlock) Write(id ident.ID, tags ident.TagIterator) error {
+	idBytes := id.Data().Get()
+
+	b.RLock()
+	_, exists := b.ids[string(idBytes)]
+	b.RUnlock()
+	if exists {
+		return nil
+	}
+
+	// NB: Take a copy of the ID and tags before acquiring the write lock,
+	// the caller owns the bytes and they are only valid for this call.
+	d := doc{id: append([]byte(nil), idBytes...)}
+	for tags.Next() {
+		tag := tags.Current()
+		d.tags = append(d.tags, tagField{
+			name:  append([]byte(nil), tag.Name.Data().Get()...),
+			value: append([]byte(nil), tag.Value.Data().Get()...),
+		})
+	}
+	if err := tags.Err(); err != nil {
+		return err
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	if _, exists := b.ids[string(d.id)]; exists {
+		// Inserted by a concurrent writer.
+		return nil
+	}
+
+	docID := postingsID(len(b.docs))
+	b.docs = append(b.docs, d)
+	b.ids[string(d.id)] = docID
+	for _, f := range d.tags {
+		values, ok := b.postings[string(f.name)]
+		if !ok {
+			values = make(map[string]postingsList)
+			b.postings[string(f.name)] = values
+		}
+		// Doc IDs are assigned in increasing order so appending
+		// keeps each postings list sorted.
+		values[string(f.value)] = append(values[string(f.value)], docID)
+	}
+
+	return nil
+}
+
+func (b *memBlock) Query(
+	query Query,
+	limit int,
+	results Results,
+) (bool, error) {
+	b.RLock()
+	defer b.RUnlock()
+
+	matches, err := b.matchQueryWithRLock(query.Query)
+	if err != nil {
+		return false, err
+	}
+
+	for _, docID := range matches {
+		if limit > 0 && results.Size() >= limit {
+			return false, nil
+		}
+		d := b.docs[docID]
+		results.Add(ident.StringID(string(d.id)), d.identTags())
+	}
+
+	return true, nil
+}
+
+func (b *memBlock) matchQueryWithRLock(q segment.Query) (postingsList, error) {
+	if q.Conjunction != segment.AndConjunction {
+		return nil, errUnsupportedConjunction
+	}
+
+	var (
+		result  postingsList
+		negated []postingsList
+		matched bool
+	)
+	intersect := func(pl postingsList) {
+		if !matched {
+			result, matched = pl, true
+			return
+		}
+		result = result.intersect(pl)
+	}
+
+	for _, f := range q.Filters {
+		pl, err := b.matchFilterWithRLock(f)
+		if err != nil {
+			return nil, err
+		}
+		if f.Negate {
+			negated = append(negated, pl)
+			continue
+		}
+		intersect(pl)
+	}
+
+	for _, sub := range q.SubQueries {
+		pl, err := b.matchQueryWithRLock(sub)
+		if err != nil {
+			return nil, err
+		}
+		intersect(pl)
+	}
+
+	if !matched {
+		// Only negations, or no constraints at all, start from every doc.
+		result = b.allDocsWithRLock()
+	}
+	for _, pl := range negated {
+		result = result.difference(pl)
+	}
+
+	return result, nil
+}
+
+func (b *memBlock) matchFilterWithRLock(f segment.Filter) (postingsList, error) {
+	values, ok := b.postings[string(f.FieldName)]
+	if !ok {
+		return nil, nil
+	}
+
+	if !f.Regexp {
+		return values[string(f.FieldValueFilter)], nil
+	}
+
+	// NB: Regexps are anchored to the whole value, similar to the
+	// semantics of Prometheus label matchers.
+	re, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", f.FieldValueFilter))
+	if err != nil {
+		return nil, err
+	}
+
+	var result postingsList
+	for value, pl := range values {
+		if re.MatchString(value) {
+			result = result.union(pl)
+		}
+	}
+	return result, nil
+}
+
+func (b *memBlock) allDocsWithRLock() postingsList {
+	all := make(postingsList, len(b.docs))
+	for i := range all {
+		all[i] = postingsID(i)
+	}
+	return all
+}
+
+func (d doc) identTags() ident.Tags {
+	tags := make(ident.Tags, 0, len(d.tags))
+	for _, f := range d.tags {
+		tags = append(tags, ident.Tag{
+			Name:  ident.StringID(string(f.name)),
+			Value: ident.StringID(string(f.value)),
+		})
+	}
+	return tags
+}
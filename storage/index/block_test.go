@@ -0,0 +1,167 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3ninx/index/segment"
+	"github.com/m3db/m3x/ident"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testTags(nameValues ...string) ident.TagIterator {
+	var tags ident.Tags
+	for i := 0; i < len(nameValues); i += 2 {
+		tags = append(tags, ident.Tag{
+			Name:  ident.StringID(nameValues[i]),
+			Value: ident.StringID(nameValues[i+1]),
+		})
+	}
+	return ident.NewTagSliceIterator(tags)
+}
+
+func testQuery(filters ...segment.Filter) Query {
+	return Query{segment.Query{
+		Conjunction: segment.AndConjunction,
+		Filters:     filters,
+	}}
+}
+
+func testBlock(t *testing.T) Block {
+	b := NewBlock(time.Unix(0, 0), time.Hour)
+	require.NoError(t, b.Write(ident.StringID("foo"), testTags("city", "nyc", "app", "web")))
+	require.NoError(t, b.Write(ident.StringID("bar"), testTags("city", "sf", "app", "web")))
+	require.NoError(t, b.Write(ident.StringID("baz"), testTags("city", "nyc", "app", "db")))
+	return b
+}
+
+func queryIDs(t *testing.T, b Block, q Query, limit int) ([]string, bool) {
+	results := NewResults(ident.StringID("ns"))
+	exhaustive, err := b.Query(q, limit, results)
+	require.NoError(t, err)
+
+	var ids []string
+	iter := results.Iter()
+	for iter.Next() {
+		ns, id, _ := iter.Current()
+		require.Equal(t, "ns", ns.String())
+		ids = append(ids, id.String())
+	}
+	require.NoError(t, iter.Err())
+	return ids, exhaustive
+}
+
+func TestBlockWriteDuplicate(t *testing.T) {
+	b := testBlock(t)
+	require.NoError(t, b.Write(ident.StringID("foo"), testTags("city", "la")))
+	require.Equal(t, 3, b.NumSeries())
+
+	ids, _ := queryIDs(t, b, testQuery(segment.Filter{
+		FieldName:        []byte("city"),
+		FieldValueFilter: []byte("la"),
+	}), 0)
+	require.Empty(t, ids)
+}
+
+func TestBlockQueryTermConjunction(t *testing.T) {
+	b := testBlock(t)
+	ids, exhaustive := queryIDs(t, b, testQuery(
+		segment.Filter{FieldName: []byte("city"), FieldValueFilter: []byte("nyc")},
+		segment.Filter{FieldName: []byte("app"), FieldValueFilter: []byte("web")},
+	), 0)
+	require.True(t, exhaustive)
+	require.Equal(t, []string{"foo"}, ids)
+}
+
+func TestBlockQueryNegateAndRegexp(t *testing.T) {
+	b := testBlock(t)
+	ids, exhaustive := queryIDs(t, b, testQuery(
+		segment.Filter{FieldName: []byte("city"), FieldValueFilter: []byte("n.*|s.*"), Regexp: true},
+		segment.Filter{FieldName: []byte("app"), FieldValueFilter: []byte("web"), Negate: true},
+	), 0)
+	require.True(t, exhaustive)
+	require.Equal(t, []string{"baz"}, ids)
+
+	// Regexps are anchored to the whole value.
+	ids, _ = queryIDs(t, b, testQuery(
+		segment.Filter{FieldName: []byte("city"), FieldValueFilter: []byte("n"), Regexp: true},
+	), 0)
+	require.Empty(t, ids)
+}
+
+func TestBlockQuerySubQueries(t *testing.T) {
+	b := testBlock(t)
+	q := testQuery(segment.Filter{FieldName: []byte("app"), FieldValueFilter: []byte("web")})
+	q.SubQueries = []segment.Query{{
+		Conjunction: segment.AndConjunction,
+		Filters: []segment.Filter{
+			{FieldName: []byte("city"), FieldValueFilter: []byte("sf")},
+		},
+	}}
+	ids, _ := queryIDs(t, b, q, 0)
+	require.Equal(t, []string{"bar"}, ids)
+}
+
+func TestBlockQueryLimit(t *testing.T) {
+	b := testBlock(t)
+	q := testQuery(segment.Filter{FieldName: []byte("city"), FieldValueFilter: []byte("nyc")})
+
+	ids, exhaustive := queryIDs(t, b, q, 1)
+	require.False(t, exhaustive)
+	require.Equal(t, []string{"foo"}, ids)
+
+	ids, exhaustive = queryIDs(t, b, q, 2)
+	require.True(t, exhaustive)
+	require.Equal(t, []string{"foo", "baz"}, ids)
+}
+
+func TestBlockQueryInvalidRegexp(t *testing.T) {
+	b := testBlock(t)
+	_, err := b.Query(testQuery(
+		segment.Filter{FieldName: []byte("city"), FieldValueFilter: []byte("("), Regexp: true},
+	), 0, NewResults(ident.StringID("ns")))
+	require.Error(t, err)
+}
+
+func TestBlockForEach(t *testing.T) {
+	b := testBlock(t)
+
+	var ids []string
+	err := b.ForEach(func(id ident.ID, tags ident.Tags) error {
+		ids = append(ids, id.String())
+		require.Equal(t, 2, len(tags))
+		require.Equal(t, "city", tags[0].Name.String())
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"foo", "bar", "baz"}, ids)
+}
+
+func TestPostingsListOperations(t *testing.T) {
+	a := postingsList{1, 3, 5, 7}
+	b := postingsList{3, 4, 7, 8}
+	require.Equal(t, postingsList{3, 7}, a.intersect(b))
+	require.Equal(t, postingsList{1, 5}, a.difference(b))
+	require.Equal(t, postingsList{1, 3, 4, 5, 7, 8}, a.union(b))
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+// postingsList is a sorted list of document IDs.
+type postingsList []postingsID
+
+func (pl postingsList) intersect(other postingsList) postingsList {
+	var (
+		result postingsList
+		i, j   int
+	)
+	for i < len(pl) && j < len(other) {
+		switch {
+		case pl[i] < other[j]:
+			i++
+		case pl[i] > other[j]:
+			j++
+		default:
+			result = append(result, pl[i])
+			i++
+			j++
+		}
+	}
+	return result
+}
+
+func (pl postingsList) difference(other postingsList) postingsList {
+	var (
+		result postingsList
+		i, j   int
+	)
+	for i < len(pl) {
+		switch {
+		case j >= len(other) || pl[i] < other[j]:
+			result = append(result, pl[i])
+			i++
+		case pl[i] > other[j]:
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+	return result
+}
+
+func (pl postingsList) union(other postingsList) postingsList {
+	result := make(postingsList, 0, len(pl)+len(other))
+	var i, j int
+	for i < len(pl) || j < len(other) {
+		switch {
+		case j >= len(other) || (i < len(pl) && pl[i] < other[j]):
+			result = append(result, pl[i])
+			i++
+		case i >= len(pl) || pl[i] > other[j]:
+			result = append(result, other[j])
+			j++
+		default:
+			result = append(result, pl[i])
+			i++
+			j++
+		}
+	}
+	return result
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"github.com/m3db/m3x/ident"
+)
+
+type result struct {
+	id   ident.ID
+	tags ident.Tags
+}
+
+type results struct {
+	nsID    ident.ID
+	ids     map[string]struct{}
+	results []result
+}
+
+// NewResults returns a new Results collection for the given namespace.
+func NewResults(namespaceID ident.ID) Results {
+	return &results{
+		nsID: namespaceID,
+		ids:  make(map[string]struct{}),
+	}
+}
+
+func (r *results) Namespace() ident.ID {
+	return r.nsID
+}
+
+func (r *results) Add(seriesID ident.ID, tags ident.Tags) bool {
+	key := seriesID.String()
+	if _, ok := r.ids[key]; ok {
+		return false
+	}
+	r.ids[key] = struct{}{}
+	r.results = append(r.results, result{id: seriesID, tags: tags})
+	return true
+}
+
+func (r *results) Size() int {
+	return len(r.results)
+}
+
+func (r *results) Iter() TaggedIDsIter {
+	return NewMultiTaggedIDsIter(r)
+}
+
+type multiTaggedIDsIter struct {
+	results []Results
+	curr    *results
+	idx     int
+	tags    ident.TagIterator
+}
+
+// NewMultiTaggedIDsIter returns an iterator over the concatenation of the
+// given results, each result must have been created with NewResults.
+func NewMultiTaggedIDsIter(rs ...Results) TaggedIDsIter {
+	return &multiTaggedIDsIter{results: rs, idx: -1}
+}
+
+func (it *multiTaggedIDsIter) Next() bool {
+	for {
+		if it.curr != nil && it.idx+1 < len(it.curr.results) {
+			it.idx++
+			it.tags = ident.NewTagSliceIterator(it.curr.results[it.idx].tags)
+			return true
+		}
+		if len(it.results) == 0 {
+			it.curr = nil
+			it.tags = nil
+			return false
+		}
+		it.curr = it.results[0].(*results)
+		it.results = it.results[1:]
+		it.idx = -1
+	}
+}
+
+func (it *multiTaggedIDsIter) Current() (ident.ID, ident.ID, ident.TagIterator) {
+	return it.curr.nsID, it.curr.results[it.idx].id, it.tags
+}
+
+func (it *multiTaggedIDsIter) Err() error {
+	return nil
+}
@@ -58,3 +58,46 @@ type TaggedIDsIter interface {
 	// Err returns any error encountered
 	Err() error
 }
+
+// Block is a reverse index for all series written during the time
+// period [StartTime, EndTime).
+type Block interface {
+	// StartTime returns the start time of the period this block indexes.
+	StartTime() time.Time
+
+	// EndTime returns the end time of the period this block indexes.
+	EndTime() time.Time
+
+	// Write indexes the series ID with the given tags, if the ID is
+	// already indexed by this block the write is a no-op.
+	Write(id ident.ID, tags ident.TagIterator) error
+
+	// Query resolves the given query into known IDs, adding them to the
+	// results until limit is reached. It returns whether all matching IDs
+	// in the block were added to the results.
+	Query(query Query, limit int, results Results) (exhaustive bool, err error)
+
+	// NumSeries returns the number of series indexed by this block.
+	NumSeries() int
+
+	// ForEach calls fn with every series ID and tags indexed by this block
+	// in the order they were written, stopping at the first error.
+	ForEach(fn func(id ident.ID, tags ident.Tags) error) error
+}
+
+// Results is a collection of query results for a single namespace
+// deduplicated by series ID.
+type Results interface {
+	// Namespace returns the namespace the results belong to.
+	Namespace() ident.ID
+
+	// Add adds the series to the results, returning false if it was
+	// already present.
+	Add(seriesID ident.ID, tags ident.Tags) bool
+
+	// Size returns the number of series in the results.
+	Size() int
+
+	// Iter returns an iterator over the results.
+	Iter() TaggedIDsIter
+}
@@ -0,0 +1,296 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3db/persist"
+	"github.com/m3db/m3db/storage/bootstrap/result"
+	"github.com/m3db/m3db/storage/index"
+	"github.com/m3db/m3db/storage/namespace"
+	"github.com/m3db/m3ninx/index/segment"
+	"github.com/m3db/m3x/context"
+	"github.com/m3db/m3x/ident"
+	xtime "github.com/m3db/m3x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func testIndexQuery(name, value string) index.Query {
+	return index.Query{segment.Query{
+		Conjunction: segment.AndConjunction,
+		Filters: []segment.Filter{
+			{FieldName: []byte(name), FieldValueFilter: []byte(value)},
+		},
+	}}
+}
+
+func testIndexTags(name, value string) ident.TagIterator {
+	return ident.NewTagSliceIterator(ident.Tags{
+		{Name: ident.StringID(name), Value: ident.StringID(value)},
+	})
+}
+
+func TestDatabaseIndexWriteQuery(t *testing.T) {
+	now := time.Now()
+	opts := testDatabaseOptions()
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return now
+	}))
+
+	idx, err := newDatabaseIndex(opts)
+	require.NoError(t, err)
+
+	md, err := namespace.NewMetadata(defaultTestNs1ID, defaultTestNs1Opts)
+	require.NoError(t, err)
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	blockSize := defaultTestRetentionOpts.BlockSize()
+	require.NoError(t, idx.Write(ctx, md, ident.StringID("foo"),
+		testIndexTags("city", "nyc"), now))
+	require.NoError(t, idx.Write(ctx, md, ident.StringID("bar"),
+		testIndexTags("city", "nyc"), now.Add(-blockSize)))
+	require.NoError(t, idx.Write(ctx, md, ident.StringID("baz"),
+		testIndexTags("city", "sf"), now))
+
+	query := func(start, end time.Time, limit int) ([]string, bool) {
+		res, err := idx.Query(ctx, testIndexQuery("city", "nyc"), index.QueryOptions{
+			StartInclusive: start,
+			EndExclusive:   end,
+			Limit:          limit,
+		})
+		require.NoError(t, err)
+
+		var ids []string
+		for res.Iter.Next() {
+			ns, id, _ := res.Iter.Current()
+			require.True(t, defaultTestNs1ID.Equal(ns))
+			ids = append(ids, id.String())
+		}
+		require.NoError(t, res.Iter.Err())
+		return ids, res.Exhaustive
+	}
+
+	ids, exhaustive := query(now.Add(-2*blockSize), now.Add(blockSize), 0)
+	require.True(t, exhaustive)
+	require.Len(t, ids, 2)
+
+	ids, exhaustive = query(now.Truncate(blockSize), now.Add(blockSize), 0)
+	require.True(t, exhaustive)
+	require.Equal(t, []string{"foo"}, ids)
+
+	ids, exhaustive = query(now.Add(-2*blockSize), now.Add(blockSize), 1)
+	require.False(t, exhaustive)
+	require.Len(t, ids, 1)
+}
+
+func TestDatabaseIndexQueryInvalidTimeRange(t *testing.T) {
+	idx, err := newDatabaseIndex(testDatabaseOptions())
+	require.NoError(t, err)
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	now := time.Now()
+	_, err = idx.Query(ctx, testIndexQuery("city", "nyc"), index.QueryOptions{
+		StartInclusive: now,
+		EndExclusive:   now,
+	})
+	require.Error(t, err)
+}
+
+func TestDatabaseIndexExpiresBlocks(t *testing.T) {
+	now := time.Now()
+	opts := testDatabaseOptions()
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return now
+	}))
+
+	idx, err := newDatabaseIndex(opts)
+	require.NoError(t, err)
+
+	md, err := namespace.NewMetadata(defaultTestNs1ID, defaultTestNs1Opts)
+	require.NoError(t, err)
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	ropts := defaultTestRetentionOpts
+	expired := now.Add(-ropts.RetentionPeriod() - 2*ropts.BlockSize())
+	require.NoError(t, idx.Write(ctx, md, ident.StringID("foo"),
+		testIndexTags("city", "nyc"), expired))
+	require.NoError(t, idx.Write(ctx, md, ident.StringID("bar"),
+		testIndexTags("city", "nyc"), now))
+
+	nsIdx := idx.(*dbIndex).namespaces[defaultTestNs1ID.Hash()]
+	require.Len(t, nsIdx.blocks, 1)
+}
+
+func TestDatabaseIndexFlush(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	idx, err := newDatabaseIndex(testDatabaseOptions())
+	require.NoError(t, err)
+
+	md, err := namespace.NewMetadata(defaultTestNs1ID, defaultTestNs1Opts)
+	require.NoError(t, err)
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	require.NoError(t, idx.Write(ctx, md, ident.StringID("foo"),
+		testIndexTags("city", "nyc"), now))
+
+	var (
+		blockStart = now.Truncate(defaultTestRetentionOpts.BlockSize())
+		persisted  []string
+		closed     bool
+	)
+	flush := persist.NewMockFlush(ctrl)
+	flush.EXPECT().PrepareIndex(md, blockStart).Return(persist.PreparedIndexPersist{
+		Persist: func(id ident.ID, tags ident.Tags) error {
+			persisted = append(persisted, id.String())
+			return nil
+		},
+		Close: func() error {
+			closed = true
+			return nil
+		},
+	}, nil)
+
+	require.NoError(t, idx.Flush(md, blockStart, flush))
+	require.Equal(t, []string{"foo"}, persisted)
+	require.True(t, closed)
+
+	// The block is unchanged so it is not rewritten
+	require.NoError(t, idx.Flush(md, blockStart, flush))
+	require.Equal(t, 1, len(persisted))
+
+	// Series indexed after the block was flushed are written to a new volume
+	require.NoError(t, idx.Write(ctx, md, ident.StringID("bar"),
+		testIndexTags("city", "sf"), now))
+	persisted = nil
+	flush.EXPECT().PrepareIndex(md, blockStart).Return(persist.PreparedIndexPersist{
+		Persist: func(id ident.ID, tags ident.Tags) error {
+			persisted = append(persisted, id.String())
+			return nil
+		},
+		Close: func() error {
+			return nil
+		},
+	}, nil)
+	require.NoError(t, idx.Flush(md, blockStart, flush))
+	require.Equal(t, []string{"foo", "bar"}, persisted)
+
+	blockStarts := idx.BlockStarts(md)
+	require.Equal(t, 1, len(blockStarts))
+	require.True(t, blockStart.Equal(blockStarts[0]))
+
+	// No block exists for the previous block start so nothing is prepared.
+	require.NoError(t, idx.Flush(md, blockStart.Add(-defaultTestRetentionOpts.BlockSize()), flush))
+}
+
+func TestDatabaseIndexFlushSkipsUnchangedBootstrappedBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	idx, err := newDatabaseIndex(testDatabaseOptions())
+	require.NoError(t, err)
+
+	md, err := namespace.NewMetadata(defaultTestNs1ID, defaultTestNs1Opts)
+	require.NoError(t, err)
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	blockSize := defaultTestRetentionOpts.BlockSize()
+	blockStart := now.Truncate(blockSize)
+	block := index.NewBlock(blockStart, blockSize)
+	require.NoError(t, block.Write(ident.StringID("foo"), testIndexTags("city", "nyc")))
+	require.NoError(t, idx.Bootstrap(md, result.IndexResults{
+		xtime.ToUnixNano(blockStart): block,
+	}))
+
+	// The block loaded from disk is unchanged so it is not rewritten
+	flush := persist.NewMockFlush(ctrl)
+	require.NoError(t, idx.Flush(md, blockStart, flush))
+
+	// Series indexed after bootstrapping are written to a new volume
+	require.NoError(t, idx.Write(ctx, md, ident.StringID("bar"),
+		testIndexTags("city", "sf"), now))
+	var persisted []string
+	flush.EXPECT().PrepareIndex(md, blockStart).Return(persist.PreparedIndexPersist{
+		Persist: func(id ident.ID, tags ident.Tags) error {
+			persisted = append(persisted, id.String())
+			return nil
+		},
+		Close: func() error {
+			return nil
+		},
+	}, nil)
+	require.NoError(t, idx.Flush(md, blockStart, flush))
+	require.Equal(t, []string{"foo", "bar"}, persisted)
+}
+
+func TestDatabaseIndexBootstrap(t *testing.T) {
+	now := time.Now()
+	idx, err := newDatabaseIndex(testDatabaseOptions())
+	require.NoError(t, err)
+
+	md, err := namespace.NewMetadata(defaultTestNs1ID, defaultTestNs1Opts)
+	require.NoError(t, err)
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	blockSize := defaultTestRetentionOpts.BlockSize()
+	blockStart := now.Truncate(blockSize)
+	require.NoError(t, idx.Write(ctx, md, ident.StringID("foo"),
+		testIndexTags("city", "nyc"), now))
+
+	block := index.NewBlock(blockStart, blockSize)
+	require.NoError(t, block.Write(ident.StringID("bar"), testIndexTags("city", "nyc")))
+	require.NoError(t, idx.Bootstrap(md, result.IndexResults{
+		xtime.ToUnixNano(blockStart): block,
+	}))
+
+	res, err := idx.Query(ctx, testIndexQuery("city", "nyc"), index.QueryOptions{
+		StartInclusive: blockStart,
+		EndExclusive:   blockStart.Add(blockSize),
+	})
+	require.NoError(t, err)
+
+	var ids []string
+	for res.Iter.Next() {
+		_, id, _ := res.Iter.Current()
+		ids = append(ids, id.String())
+	}
+	require.NoError(t, res.Iter.Err())
+	require.Equal(t, []string{"foo", "bar"}, ids)
+}
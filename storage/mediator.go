@@ -89,13 +89,24 @@ func newMediator(database database, opts Options) (databaseMediator, error) {
 		closedCh: make(chan struct{}),
 	}
 
-	fsm := newFileSystemManager(database, opts)
+	// NB: The fileset merger is shared between the flush manager and the
+	// repairer so that merges into the same fileset are serialized.
+	merger, err := newFilesetMerger(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	rollup, err := newFilesetRollup(opts, merger)
+	if err != nil {
+		return nil, err
+	}
+
+	fsm := newFileSystemManager(database, merger, rollup, opts)
 	d.databaseFileSystemManager = fsm
 
 	d.databaseRepairer = newNoopDatabaseRepairer()
 	if opts.RepairEnabled() {
-		var err error
-		d.databaseRepairer, err = newDatabaseRepairer(database, opts)
+		d.databaseRepairer, err = newDatabaseRepairer(database, merger, opts)
 		if err != nil {
 			return nil, err
 		}
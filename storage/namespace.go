@@ -115,7 +115,10 @@ type dbNamespace struct {
 
 	increasingIndex increasingIndex
 	commitLogWriter commitLogWriter
-	indexWriter     databaseIndexWriter
+	index           databaseIndex
+	quotas          *namespaceQuotas
+	numSeries       *namespaceSeriesCount
+	bootstrapStatus *bootstrapStatusTracker
 
 	tickWorkers            xsync.WorkerPool
 	tickWorkersConcurrency int
@@ -133,6 +136,8 @@ type databaseNamespaceStatsLastTick struct {
 type databaseNamespaceMetrics struct {
 	bootstrap           instrument.MethodMetrics
 	flush               instrument.MethodMetrics
+	coldFlush           instrument.MethodMetrics
+	snapshot            instrument.MethodMetrics
 	write               instrument.MethodMetrics
 	writeTagged         instrument.MethodMetrics
 	read                instrument.MethodMetrics
@@ -181,6 +186,8 @@ func newDatabaseNamespaceMetrics(scope tally.Scope, samplingRate float64) databa
 	return databaseNamespaceMetrics{
 		bootstrap:           instrument.NewMethodMetrics(scope, "bootstrap", samplingRate),
 		flush:               instrument.NewMethodMetrics(scope, "flush", samplingRate),
+		coldFlush:           instrument.NewMethodMetrics(scope, "coldFlush", samplingRate),
+		snapshot:            instrument.NewMethodMetrics(scope, "snapshot", samplingRate),
 		write:               instrument.NewMethodMetrics(scope, "write", samplingRate),
 		writeTagged:         instrument.NewMethodMetrics(scope, "write-tagged", samplingRate),
 		read:                instrument.NewMethodMetrics(scope, "read", samplingRate),
@@ -219,7 +226,7 @@ func newDatabaseNamespace(
 	blockRetriever block.DatabaseBlockRetriever,
 	increasingIndex increasingIndex,
 	commitLogWriter commitLogWriter,
-	indexWriter databaseIndexWriter,
+	index databaseIndex,
 	opts Options,
 ) (databaseNamespace, error) {
 	var (
@@ -229,6 +236,9 @@ func newDatabaseNamespace(
 	if !nopts.WritesToCommitLog() {
 		commitLogWriter = commitLogWriteNoOp
 	}
+	if index == nil {
+		index = databaseIndexNoOp
+	}
 
 	iops := opts.InstrumentOptions()
 	logger := iops.Logger().WithFields(xlog.NewField("namespace", id.String()))
@@ -244,7 +254,8 @@ func newDatabaseNamespace(
 	tickWorkers := xsync.NewWorkerPool(tickWorkersConcurrency)
 	tickWorkers.Init()
 
-	seriesOpts := NewSeriesOptionsFromOptions(opts, nopts.RetentionOptions())
+	seriesOpts := NewSeriesOptionsFromOptions(opts, nopts.RetentionOptions()).
+		SetColdWritesEnabled(nopts.ColdWritesEnabled())
 	if err := seriesOpts.Validate(); err != nil {
 		return nil, fmt.Errorf(
 			"unable to create namespace %v, invalid series options: %v",
@@ -265,7 +276,10 @@ func newDatabaseNamespace(
 		log:                    logger,
 		increasingIndex:        increasingIndex,
 		commitLogWriter:        commitLogWriter,
-		indexWriter:            indexWriter,
+		index:                  index,
+		quotas:                 newNamespaceQuotas(nopts.QuotaOptions(), opts.ClockOptions().NowFn(), scope),
+		numSeries:              &namespaceSeriesCount{},
+		bootstrapStatus:        newBootstrapStatusTracker(scope.SubScope("bootstrap")),
 		tickWorkers:            tickWorkers,
 		tickWorkersConcurrency: tickWorkersConcurrency,
 		metrics:                newDatabaseNamespaceMetrics(scope, iops.MetricsSamplingRate()),
@@ -290,12 +304,17 @@ func (n *dbNamespace) reportStatusLoop() {
 			n.metrics.status.activeSeries.Update(float64(n.statsLastTick.activeSeries))
 			n.metrics.status.activeBlocks.Update(float64(n.statsLastTick.activeBlocks))
 			n.statsLastTick.RUnlock()
+			n.bootstrapStatus.report()
 		}
 	}
 }
 
 func (n *dbNamespace) Options() namespace.Options {
-	return n.nopts
+	return n.nopts.SetQuotaOptions(n.quotas.options())
+}
+
+func (n *dbNamespace) SetQuotaOptions(value namespace.QuotaOptions) {
+	n.quotas.setOptions(value)
 }
 
 func (n *dbNamespace) ID() ident.ID {
@@ -310,6 +329,13 @@ func (n *dbNamespace) NumSeries() int64 {
 	return count
 }
 
+func (n *dbNamespace) BootstrapStatus() NamespaceBootstrapStatus {
+	return NamespaceBootstrapStatus{
+		Namespace: n.id,
+		Shards:    n.bootstrapStatus.status(n.GetOwnedShards()),
+	}
+}
+
 func (n *dbNamespace) Shards() []Shard {
 	n.RLock()
 	shards := n.shardSet.AllIDs()
@@ -349,8 +375,8 @@ func (n *dbNamespace) AssignShardSet(shardSet sharding.ShardSet) {
 		} else {
 			needsBootstrap := n.nopts.NeedsBootstrap()
 			n.shards[shard] = newDatabaseShard(n.metadata, shard, n.blockRetriever,
-				n.namespaceReaderMgr, n.increasingIndex, n.commitLogWriter, n.indexWriter,
-				needsBootstrap, n.opts, n.seriesOpts)
+				n.namespaceReaderMgr, n.increasingIndex, n.commitLogWriter, n.index,
+				n.quotas, n.numSeries, needsBootstrap, n.opts, n.seriesOpts)
 			n.metrics.shards.add.Inc(1)
 		}
 	}
@@ -470,7 +496,16 @@ func (n *dbNamespace) Write(
 		n.metrics.write.ReportError(n.nowFn().Sub(callStart))
 		return err
 	}
+	charge, err := n.quotas.allowWriteDatapoint()
+	if err != nil {
+		n.metrics.write.ReportError(n.nowFn().Sub(callStart))
+		return err
+	}
 	err = shard.Write(ctx, id, timestamp, value, unit, annotation)
+	if err != nil {
+		// Only successful writes count against the quota
+		charge.refund()
+	}
 	n.metrics.write.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
 	return err
 }
@@ -490,7 +525,16 @@ func (n *dbNamespace) WriteTagged(
 		n.metrics.writeTagged.ReportError(n.nowFn().Sub(callStart))
 		return err
 	}
+	charge, err := n.quotas.allowWriteDatapoint()
+	if err != nil {
+		n.metrics.writeTagged.ReportError(n.nowFn().Sub(callStart))
+		return err
+	}
 	err = shard.WriteTagged(ctx, id, tags, timestamp, value, unit, annotation)
+	if err != nil {
+		// Only successful writes count against the quota
+		charge.refund()
+	}
 	n.metrics.writeTagged.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
 	return err
 }
@@ -506,11 +550,32 @@ func (n *dbNamespace) ReadEncoded(
 		n.metrics.read.ReportError(n.nowFn().Sub(callStart))
 		return nil, err
 	}
+	if err := n.quotas.allowFetch(); err != nil {
+		n.metrics.read.ReportError(n.nowFn().Sub(callStart))
+		return nil, err
+	}
 	res, err := shard.ReadEncoded(ctx, id, start, end)
+	if err == nil && n.quotas.tracksFetchedBytes() {
+		n.quotas.addFetchedBytes(segmentReadersLen(res))
+	}
 	n.metrics.read.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
 	return res, err
 }
 
+func segmentReadersLen(readers [][]xio.SegmentReader) int64 {
+	var total int64
+	for _, blockReaders := range readers {
+		for _, reader := range blockReaders {
+			segment, err := reader.Segment()
+			if err != nil {
+				continue
+			}
+			total += int64(segment.Len())
+		}
+	}
+	return total
+}
+
 func (n *dbNamespace) FetchBlocks(
 	ctx context.Context,
 	shardID uint32,
@@ -618,8 +683,24 @@ func (n *dbNamespace) Bootstrap(
 		shardIDs[i] = shard.ID()
 	}
 
-	bootstrapResult, err := process.Run(n.metadata, shardIDs, targetRanges)
+	n.bootstrapStatus.setPending(shardIDs)
+
+	// Report the progress of the bootstrap sources to the status tracker
+	trackedRanges := make([]bootstrap.TargetRange, 0, len(targetRanges))
+	for _, target := range targetRanges {
+		runOpts := target.RunOptions
+		if runOpts == nil {
+			runOpts = bootstrap.NewRunOptions()
+		}
+		target.RunOptions = runOpts.SetProgressReporter(n.bootstrapStatus)
+		trackedRanges = append(trackedRanges, target)
+	}
+
+	bootstrapResult, err := process.Run(n.metadata, shardIDs, trackedRanges)
 	if err != nil {
+		for _, shard := range shardIDs {
+			n.bootstrapStatus.setFailed(shard, err)
+		}
 		n.log.Errorf("bootstrap for namespace %s aborted due to error: %v",
 			n.id.String(), err)
 		return err
@@ -641,10 +722,11 @@ func (n *dbNamespace) Bootstrap(
 	).Infof("bootstrap data fetched now initializing shards with series blocks")
 
 	var (
-		multiErr = xerrors.NewMultiError()
-		results  = bootstrapResult.ShardResults()
-		mutex    sync.Mutex
-		wg       sync.WaitGroup
+		multiErr    = xerrors.NewMultiError()
+		results     = bootstrapResult.ShardResults()
+		coldResults = bootstrapResult.ColdWriteResults()
+		mutex       sync.Mutex
+		wg          sync.WaitGroup
 	)
 	for _, shard := range shards {
 		shard := shard
@@ -656,6 +738,17 @@ func (n *dbNamespace) Bootstrap(
 			}
 
 			err := shard.Bootstrap(bootstrapped)
+			if result, ok := coldResults[shard.ID()]; ok && err == nil {
+				// Cold writes are added once the shard is bootstrapped so
+				// that they are merged with the flushed blocks
+				err = shard.BootstrapColdWrites(result.AllSeries())
+			}
+
+			if err != nil {
+				n.bootstrapStatus.setFailed(shard.ID(), err)
+			} else {
+				n.bootstrapStatus.setBootstrapped(shard.ID())
+			}
 
 			mutex.Lock()
 			multiErr = multiErr.Add(err)
@@ -667,6 +760,11 @@ func (n *dbNamespace) Bootstrap(
 
 	wg.Wait()
 
+	if bootstrapResult != nil {
+		err := n.index.Bootstrap(n.metadata, bootstrapResult.IndexResults())
+		multiErr = multiErr.Add(err)
+	}
+
 	// Counter, tag this with namespace
 	unfulfilled := int64(len(bootstrapResult.Unfulfilled()))
 	n.metrics.unfulfilled.Inc(unfulfilled)
@@ -723,11 +821,146 @@ func (n *dbNamespace) Flush(
 		}
 	}
 
+	// Only flush the reverse index once all shards have flushed their data
+	// so that the index never references series missing from disk.
+	if multiErr.FinalError() == nil {
+		if err := n.index.Flush(n.metadata, blockStart, flush); err != nil {
+			detailedErr := fmt.Errorf("index failed to flush: %v", err)
+			multiErr = multiErr.Add(detailedErr)
+		}
+	}
+
 	res := multiErr.FinalError()
 	n.metrics.flush.ReportSuccessOrError(res, n.nowFn().Sub(callStart))
 	return res
 }
 
+func (n *dbNamespace) ColdFlush(
+	flush persist.Flush,
+	merger *filesetMerger,
+) error {
+	callStart := n.nowFn()
+
+	n.RLock()
+	if n.bs != bootstrapped {
+		n.RUnlock()
+		n.metrics.coldFlush.ReportError(n.nowFn().Sub(callStart))
+		return errNamespaceNotBootstrapped
+	}
+	n.RUnlock()
+
+	if !n.nopts.NeedsFlush() || !n.nopts.ColdWritesEnabled() {
+		n.metrics.coldFlush.ReportSuccess(n.nowFn().Sub(callStart))
+		return nil
+	}
+
+	multiErr := xerrors.NewMultiError()
+	shards := n.GetOwnedShards()
+	for _, shard := range shards {
+		if err := shard.ColdFlush(flush, merger); err != nil {
+			detailedErr := fmt.Errorf("shard %d failed to cold flush data: %v",
+				shard.ID(), err)
+			multiErr = multiErr.Add(detailedErr)
+		}
+	}
+
+	// Rewrite the reverse index blocks that gained series from cold writes
+	// once all shards have merged them into filesets, only blocks already
+	// flushed by every shard are rewritten so that the index never
+	// references series missing from disk.
+	if multiErr.FinalError() == nil {
+		for _, blockStart := range n.index.BlockStarts(n.metadata) {
+			if !shardsFlushed(shards, blockStart) {
+				continue
+			}
+			if err := n.index.Flush(n.metadata, blockStart, flush); err != nil {
+				detailedErr := fmt.Errorf("index failed to cold flush block %v: %v",
+					blockStart, err)
+				multiErr = multiErr.Add(detailedErr)
+			}
+		}
+	}
+
+	res := multiErr.FinalError()
+	n.metrics.coldFlush.ReportSuccessOrError(res, n.nowFn().Sub(callStart))
+	return res
+}
+
+// shardsFlushed returns whether every shard has flushed the given block.
+func shardsFlushed(shards []databaseShard, blockStart time.Time) bool {
+	for _, shard := range shards {
+		if shard.FlushState(blockStart).Status != fileOpSuccess {
+			return false
+		}
+	}
+	return true
+}
+
+func (n *dbNamespace) Snapshot(
+	blockStarts []time.Time,
+	snapshotTime time.Time,
+	flush persist.Flush,
+) error {
+	callStart := n.nowFn()
+
+	n.RLock()
+	if n.bs != bootstrapped {
+		n.RUnlock()
+		n.metrics.snapshot.ReportError(n.nowFn().Sub(callStart))
+		return errNamespaceNotBootstrapped
+	}
+	n.RUnlock()
+
+	if !n.nopts.NeedsFlush() || !n.nopts.SnapshotEnabled() {
+		n.metrics.snapshot.ReportSuccess(n.nowFn().Sub(callStart))
+		return nil
+	}
+
+	multiErr := xerrors.NewMultiError()
+	shards := n.GetOwnedShards()
+	for _, shard := range shards {
+		if err := shard.Snapshot(blockStarts, snapshotTime, flush); err != nil {
+			detailedErr := fmt.Errorf("shard %d failed to snapshot data: %v",
+				shard.ID(), err)
+			multiErr = multiErr.Add(detailedErr)
+		}
+	}
+
+	res := multiErr.FinalError()
+	n.metrics.snapshot.ReportSuccessOrError(res, n.nowFn().Sub(callStart))
+	return res
+}
+
+func (n *dbNamespace) LastSnapshotTime() time.Time {
+	var lastSnapshot time.Time
+	shards := n.GetOwnedShards()
+	for _, shard := range shards {
+		t := shard.LastSnapshotTime()
+		if t.IsZero() {
+			return timeZero
+		}
+		if lastSnapshot.IsZero() || t.Before(lastSnapshot) {
+			lastSnapshot = t
+		}
+	}
+	return lastSnapshot
+}
+
+func (n *dbNamespace) ColdWritesPendingSince() time.Time {
+	var pendingSince time.Time
+	shards := n.GetOwnedShards()
+	for _, shard := range shards {
+		t := shard.ColdWritesPendingSince()
+		if t.IsZero() {
+			continue
+		}
+		if pendingSince.IsZero() || t.Before(pendingSince) {
+			pendingSince = t
+		}
+	}
+	return pendingSince
+}
+
 func (n *dbNamespace) NeedsFlush(alignedInclusiveStart time.Time, alignedInclusiveEnd time.Time) bool {
 	var (
 		blockSize   = n.nopts.RetentionOptions().BlockSize()
@@ -931,8 +1164,8 @@ func (n *dbNamespace) initShards(needBootstrap bool) {
 	dbShards := make([]databaseShard, n.shardSet.Max()+1)
 	for _, shard := range shards {
 		dbShards[shard] = newDatabaseShard(n.metadata, shard, n.blockRetriever,
-			n.namespaceReaderMgr, n.increasingIndex, n.commitLogWriter, n.indexWriter,
-			needBootstrap, n.opts, n.seriesOpts)
+			n.namespaceReaderMgr, n.increasingIndex, n.commitLogWriter, n.index,
+			n.quotas, n.numSeries, needBootstrap, n.opts, n.seriesOpts)
 	}
 	n.shards = dbShards
 	n.Unlock()
@@ -0,0 +1,123 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	errAggregationTypeUnspecified = errors.New("aggregation type unspecified")
+)
+
+// AggregationType is the function used to aggregate the datapoints of a
+// series within each interval of a rollup.
+type AggregationType uint
+
+const (
+	// AggregationMean aggregates datapoints to their mean.
+	AggregationMean AggregationType = iota
+	// AggregationMin aggregates datapoints to their minimum.
+	AggregationMin
+	// AggregationMax aggregates datapoints to their maximum.
+	AggregationMax
+	// AggregationSum aggregates datapoints to their sum.
+	AggregationSum
+	// AggregationCount aggregates datapoints to their count.
+	AggregationCount
+	// AggregationLast aggregates datapoints to the latest of them.
+	AggregationLast
+
+	// DefaultAggregationType is the default aggregation type.
+	DefaultAggregationType = AggregationMean
+)
+
+// ValidAggregationTypes returns the valid aggregation types.
+func ValidAggregationTypes() []AggregationType {
+	return []AggregationType{
+		AggregationMean,
+		AggregationMin,
+		AggregationMax,
+		AggregationSum,
+		AggregationCount,
+		AggregationLast,
+	}
+}
+
+func (t AggregationType) String() string {
+	switch t {
+	case AggregationMean:
+		return "mean"
+	case AggregationMin:
+		return "min"
+	case AggregationMax:
+		return "max"
+	case AggregationSum:
+		return "sum"
+	case AggregationCount:
+		return "count"
+	case AggregationLast:
+		return "last"
+	}
+	return "unknown"
+}
+
+// ValidateAggregationType validates an aggregation type.
+func ValidateAggregationType(v AggregationType) error {
+	for _, valid := range ValidAggregationTypes() {
+		if valid == v {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid AggregationType '%d' valid types are: %v",
+		uint(v), ValidAggregationTypes())
+}
+
+// ParseAggregationType parses an AggregationType from a string.
+func ParseAggregationType(str string) (AggregationType, error) {
+	var r AggregationType
+	if str == "" {
+		return r, errAggregationTypeUnspecified
+	}
+	for _, valid := range ValidAggregationTypes() {
+		if str == valid.String() {
+			r = valid
+			return r, nil
+		}
+	}
+	return r, fmt.Errorf("invalid AggregationType '%s' valid types are: %v",
+		str, ValidAggregationTypes())
+}
+
+// UnmarshalYAML unmarshals an AggregationType into a valid type from string.
+func (t *AggregationType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	r, err := ParseAggregationType(str)
+	if err != nil {
+		return err
+	}
+	*t = r
+	return nil
+}
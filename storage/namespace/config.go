@@ -22,6 +22,7 @@ package namespace
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/m3db/m3db/retention"
 	"github.com/m3db/m3x/ident"
@@ -40,7 +41,44 @@ type MetadataConfiguration struct {
 	WritesToCommitLog   *bool                   `yaml:"writesToCommitLog"`
 	NeedsFilesetCleanup *bool                   `yaml:"needsFilesetCleanup"`
 	NeedsRepair         *bool                   `yaml:"needsRepair"`
+	RepairFetchesBlocks *bool                   `yaml:"repairFetchesBlocks"`
+	ColdWritesEnabled   *bool                   `yaml:"coldWritesEnabled"`
+	SnapshotEnabled     *bool                   `yaml:"snapshotEnabled"`
 	Retention           retention.Configuration `yaml:"retention" validate:"nonzero"`
+	Rollup              *RollupConfiguration    `yaml:"rollup"`
+	Quota               *QuotaConfiguration     `yaml:"quota"`
+}
+
+// RollupConfiguration is the configuration for a namespace holding rollups
+// of a source namespace
+type RollupConfiguration struct {
+	SourceNamespace string          `yaml:"sourceNamespace" validate:"nonzero"`
+	Resolution      time.Duration   `yaml:"resolution" validate:"nonzero"`
+	Aggregation     AggregationType `yaml:"aggregation"`
+}
+
+// Options returns the rollup options corresponding to the receiver struct
+func (rc *RollupConfiguration) Options() RollupOptions {
+	return NewRollupOptions().
+		SetSourceNamespace(ident.StringID(rc.SourceNamespace)).
+		SetResolution(rc.Resolution).
+		SetAggregation(rc.Aggregation)
+}
+
+// QuotaConfiguration is the configuration for the quotas of a namespace,
+// a quota of zero is disabled
+type QuotaConfiguration struct {
+	WriteDatapointsPerSecond int64 `yaml:"writeDatapointsPerSecond" validate:"min=0"`
+	NewSeriesPerSecond       int64 `yaml:"newSeriesPerSecond" validate:"min=0"`
+	FetchedBytesPerSecond    int64 `yaml:"fetchedBytesPerSecond" validate:"min=0"`
+}
+
+// Options returns the quota options corresponding to the receiver struct
+func (qc *QuotaConfiguration) Options() QuotaOptions {
+	return NewQuotaOptions().
+		SetWriteDatapointsPerSecond(qc.WriteDatapointsPerSecond).
+		SetNewSeriesPerSecond(qc.NewSeriesPerSecond).
+		SetFetchedBytesPerSecond(qc.FetchedBytesPerSecond)
 }
 
 // Map returns a Map corresponding to the receiver struct
@@ -75,5 +113,20 @@ func (mc *MetadataConfiguration) Metadata() (Metadata, error) {
 	if v := mc.NeedsRepair; v != nil {
 		opts = opts.SetNeedsRepair(*v)
 	}
+	if v := mc.RepairFetchesBlocks; v != nil {
+		opts = opts.SetRepairFetchesBlocks(*v)
+	}
+	if v := mc.ColdWritesEnabled; v != nil {
+		opts = opts.SetColdWritesEnabled(*v)
+	}
+	if v := mc.SnapshotEnabled; v != nil {
+		opts = opts.SetSnapshotEnabled(*v)
+	}
+	if v := mc.Rollup; v != nil {
+		opts = opts.SetRollupOptions(v.Options())
+	}
+	if v := mc.Quota; v != nil {
+		opts = opts.SetQuotaOptions(v.Options())
+	}
 	return NewMetadata(ident.StringID(mc.ID), opts)
 }
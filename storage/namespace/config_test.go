@@ -87,6 +87,9 @@ func TestMetadataConfig(t *testing.T) {
 		writesToCommitLog   = true
 		needsFilesetCleanup = false
 		needsRepair         = false
+		repairFetchesBlocks = true
+		coldWritesEnabled   = true
+		snapshotEnabled     = true
 		retention           = retention.Configuration{
 			BlockSize:       time.Hour,
 			RetentionPeriod: time.Hour,
@@ -100,6 +103,9 @@ func TestMetadataConfig(t *testing.T) {
 			WritesToCommitLog:   &writesToCommitLog,
 			NeedsFilesetCleanup: &needsFilesetCleanup,
 			NeedsRepair:         &needsRepair,
+			RepairFetchesBlocks: &repairFetchesBlocks,
+			ColdWritesEnabled:   &coldWritesEnabled,
+			SnapshotEnabled:     &snapshotEnabled,
 			Retention:           retention,
 		}
 	)
@@ -114,6 +120,9 @@ func TestMetadataConfig(t *testing.T) {
 	require.Equal(t, writesToCommitLog, opts.WritesToCommitLog())
 	require.Equal(t, needsFilesetCleanup, opts.NeedsFilesetCleanup())
 	require.Equal(t, needsRepair, opts.NeedsRepair())
+	require.Equal(t, repairFetchesBlocks, opts.RepairFetchesBlocks())
+	require.Equal(t, coldWritesEnabled, opts.ColdWritesEnabled())
+	require.Equal(t, snapshotEnabled, opts.SnapshotEnabled())
 	require.Equal(t, retention.Options(), opts.RetentionOptions())
 }
 
@@ -215,3 +224,86 @@ metadatas:
 	require.True(t, testRetentionOpts.Equal(opts.RetentionOptions()))
 
 }
+
+func TestRollupConfigFromBytes(t *testing.T) {
+	yamlBytes := []byte(`
+metadatas:
+  - id: "metrics-raw"
+    retention:
+      retentionPeriod: 48h
+      blockSize: 2h
+      bufferFuture: 10m
+      bufferPast: 10m
+  - id: "metrics-1m"
+    retention:
+      retentionPeriod: 8760h
+      blockSize: 24h
+      bufferFuture: 10m
+      bufferPast: 10m
+    rollup:
+      sourceNamespace: "metrics-raw"
+      resolution: 1m
+      aggregation: max
+`)
+
+	var conf MapConfiguration
+	require.NoError(t, yaml.Unmarshal(yamlBytes, &conf))
+
+	nsMap, err := conf.Map()
+	require.NoError(t, err)
+
+	ns, err := nsMap.Get(ident.StringID("metrics-raw"))
+	require.NoError(t, err)
+	require.Nil(t, ns.Options().RollupOptions())
+
+	ns, err = nsMap.Get(ident.StringID("metrics-1m"))
+	require.NoError(t, err)
+	rollupOpts := ns.Options().RollupOptions()
+	require.NotNil(t, rollupOpts)
+	require.Equal(t, "metrics-raw", rollupOpts.SourceNamespace().String())
+	require.Equal(t, time.Minute, rollupOpts.Resolution())
+	require.Equal(t, AggregationMax, rollupOpts.Aggregation())
+
+	// Aggregation types are validated when parsed
+	yamlBytes = []byte(`
+sourceNamespace: "metrics-raw"
+resolution: 1m
+aggregation: median
+`)
+	var rollupConf RollupConfiguration
+	require.Error(t, yaml.Unmarshal(yamlBytes, &rollupConf))
+}
+
+func TestQuotaConfigFromBytes(t *testing.T) {
+	yamlBytes := []byte(`
+metadatas:
+  - id: "metrics-shared"
+    retention:
+      retentionPeriod: 48h
+      blockSize: 2h
+      bufferFuture: 10m
+      bufferPast: 10m
+    quota:
+      writeDatapointsPerSecond: 100000
+      newSeriesPerSecond: 1000
+      fetchedBytesPerSecond: 10485760
+`)
+
+	var conf MapConfiguration
+	require.NoError(t, yaml.Unmarshal(yamlBytes, &conf))
+
+	nsMap, err := conf.Map()
+	require.NoError(t, err)
+
+	ns, err := nsMap.Get(ident.StringID("metrics-shared"))
+	require.NoError(t, err)
+	quotaOpts := ns.Options().QuotaOptions()
+	require.Equal(t, int64(100000), quotaOpts.WriteDatapointsPerSecond())
+	require.Equal(t, int64(1000), quotaOpts.NewSeriesPerSecond())
+	require.Equal(t, int64(10485760), quotaOpts.FetchedBytesPerSecond())
+
+	// Negative quotas are rejected when building the metadata
+	conf.Metadatas[0].Quota.NewSeriesPerSecond = -1
+	_, err = conf.Map()
+	require.Error(t, err)
+}
@@ -63,6 +63,43 @@ func ToRetention(
 	return ropts, nil
 }
 
+// ToRollup converts nsproto.RollupOptions to RollupOptions
+func ToRollup(
+	ro *nsproto.RollupOptions,
+) (RollupOptions, error) {
+	aggregation, err := ParseAggregationType(ro.Aggregation)
+	if err != nil {
+		return nil, err
+	}
+
+	rollupOpts := NewRollupOptions().
+		SetSourceNamespace(ident.StringID(ro.SourceNamespace)).
+		SetResolution(xtime.FromNormalizedDuration(ro.ResolutionNanos, time.Nanosecond)).
+		SetAggregation(aggregation)
+
+	if err := rollupOpts.Validate(); err != nil {
+		return nil, err
+	}
+
+	return rollupOpts, nil
+}
+
+// ToQuota converts nsproto.QuotaOptions to QuotaOptions
+func ToQuota(
+	qo *nsproto.QuotaOptions,
+) (QuotaOptions, error) {
+	quotaOpts := NewQuotaOptions().
+		SetWriteDatapointsPerSecond(qo.WriteDatapointsPerSecond).
+		SetNewSeriesPerSecond(qo.NewSeriesPerSecond).
+		SetFetchedBytesPerSecond(qo.FetchedBytesPerSecond)
+
+	if err := quotaOpts.Validate(); err != nil {
+		return nil, err
+	}
+
+	return quotaOpts, nil
+}
+
 // ToMetadata converts nsproto.Options to Metadata
 func ToMetadata(
 	id string,
@@ -82,9 +119,28 @@ func ToMetadata(
 		SetNeedsFlush(opts.NeedsFlush).
 		SetNeedsFilesetCleanup(opts.NeedsFilesetCleanup).
 		SetNeedsRepair(opts.NeedsRepair).
+		SetRepairFetchesBlocks(opts.RepairFetchesBlocks).
+		SetColdWritesEnabled(opts.ColdWritesEnabled).
+		SetSnapshotEnabled(opts.SnapshotEnabled).
 		SetWritesToCommitLog(opts.WritesToCommitLog).
 		SetRetentionOptions(ropts)
 
+	if opts.RollupOptions != nil {
+		rollupOpts, err := ToRollup(opts.RollupOptions)
+		if err != nil {
+			return nil, err
+		}
+		mopts = mopts.SetRollupOptions(rollupOpts)
+	}
+
+	if opts.QuotaOptions != nil {
+		quotaOpts, err := ToQuota(opts.QuotaOptions)
+		if err != nil {
+			return nil, err
+		}
+		mopts = mopts.SetQuotaOptions(quotaOpts)
+	}
+
 	return NewMetadata(ident.StringID(id), mopts)
 }
 
@@ -100,11 +156,14 @@ func ToProto(m Map) *nsproto.Registry {
 
 	for _, md := range m.Metadatas() {
 		ropts := md.Options().RetentionOptions()
-		reg.Namespaces[md.ID().String()] = &nsproto.NamespaceOptions{
+		nsOpts := &nsproto.NamespaceOptions{
 			NeedsBootstrap:      md.Options().NeedsBootstrap(),
 			NeedsFlush:          md.Options().NeedsFlush(),
 			NeedsFilesetCleanup: md.Options().NeedsFilesetCleanup(),
 			NeedsRepair:         md.Options().NeedsRepair(),
+			RepairFetchesBlocks: md.Options().RepairFetchesBlocks(),
+			ColdWritesEnabled:   md.Options().ColdWritesEnabled(),
+			SnapshotEnabled:     md.Options().SnapshotEnabled(),
 			WritesToCommitLog:   md.Options().WritesToCommitLog(),
 			RetentionOptions: &nsproto.RetentionOptions{
 				BlockSizeNanos:                           toNanos(ropts.BlockSize()),
@@ -115,6 +174,21 @@ func ToProto(m Map) *nsproto.Registry {
 				BlockDataExpiryAfterNotAccessPeriodNanos: toNanos(ropts.BlockDataExpiryAfterNotAccessedPeriod()),
 			},
 		}
+		if rollupOpts := md.Options().RollupOptions(); rollupOpts != nil {
+			nsOpts.RollupOptions = &nsproto.RollupOptions{
+				SourceNamespace: rollupOpts.SourceNamespace().String(),
+				ResolutionNanos: toNanos(rollupOpts.Resolution()),
+				Aggregation:     rollupOpts.Aggregation().String(),
+			}
+		}
+		if quotaOpts := md.Options().QuotaOptions(); quotaOpts != nil {
+			nsOpts.QuotaOptions = &nsproto.QuotaOptions{
+				WriteDatapointsPerSecond: quotaOpts.WriteDatapointsPerSecond(),
+				NewSeriesPerSecond:       quotaOpts.NewSeriesPerSecond(),
+				FetchedBytesPerSecond:    quotaOpts.FetchedBytesPerSecond(),
+			}
+		}
+		reg.Namespaces[md.ID().String()] = nsOpts
 	}
 
 	return &reg
@@ -79,7 +79,7 @@ func genMap() gopter.Gen {
 func genMetadata() gopter.Gen {
 	return gopter.CombineGens(
 		gen.Identifier(),
-		gen.SliceOfN(5, gen.Bool()),
+		gen.SliceOfN(8, gen.Bool()),
 		genRetention(),
 	).Map(func(values []interface{}) namespace.Metadata {
 		var (
@@ -93,6 +93,9 @@ func genMetadata() gopter.Gen {
 			SetNeedsFlush(bools[2]).
 			SetNeedsRepair(bools[3]).
 			SetWritesToCommitLog(bools[4]).
+			SetRepairFetchesBlocks(bools[5]).
+			SetColdWritesEnabled(bools[6]).
+			SetSnapshotEnabled(bools[7]).
 			SetRetentionOptions(retention))
 		if err != nil {
 			panic(err.Error())
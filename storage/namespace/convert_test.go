@@ -52,6 +52,9 @@ var (
 		WritesToCommitLog:   true,
 		NeedsFilesetCleanup: true,
 		NeedsRepair:         true,
+		RepairFetchesBlocks: true,
+		ColdWritesEnabled:   true,
+		SnapshotEnabled:     true,
 		RetentionOptions:    &validRetentionOpts,
 	}
 
@@ -114,6 +117,53 @@ func TestToNamespaceInvalid(t *testing.T) {
 	}
 }
 
+func TestToNamespaceRollup(t *testing.T) {
+	opts := validNamespaceOpts
+	opts.RollupOptions = &nsproto.RollupOptions{
+		SourceNamespace: "raw",
+		ResolutionNanos: toNanos(10),
+		Aggregation:     "max",
+	}
+	md, err := namespace.ToMetadata("abc", &opts)
+	require.NoError(t, err)
+	assertEqualMetadata(t, "abc", opts, md)
+
+	opts.RollupOptions = &nsproto.RollupOptions{
+		SourceNamespace: "raw",
+		ResolutionNanos: toNanos(10),
+		Aggregation:     "median",
+	}
+	_, err = namespace.ToMetadata("abc", &opts)
+	require.Error(t, err)
+
+	// Resolution must evenly divide the block size
+	opts.RollupOptions = &nsproto.RollupOptions{
+		SourceNamespace: "raw",
+		ResolutionNanos: toNanos(7),
+		Aggregation:     "max",
+	}
+	_, err = namespace.ToMetadata("abc", &opts)
+	require.Error(t, err)
+}
+
+func TestToNamespaceQuota(t *testing.T) {
+	opts := validNamespaceOpts
+	opts.QuotaOptions = &nsproto.QuotaOptions{
+		WriteDatapointsPerSecond: 1000,
+		NewSeriesPerSecond:       10,
+		FetchedBytesPerSecond:    1 << 20,
+	}
+	md, err := namespace.ToMetadata("abc", &opts)
+	require.NoError(t, err)
+	assertEqualMetadata(t, "abc", opts, md)
+
+	opts.QuotaOptions = &nsproto.QuotaOptions{
+		NewSeriesPerSecond: -1,
+	}
+	_, err = namespace.ToMetadata("abc", &opts)
+	require.Error(t, err)
+}
+
 func TestFromProto(t *testing.T) {
 	validRegistry := nsproto.Registry{
 		Namespaces: map[string]*nsproto.NamespaceOptions{
@@ -141,16 +191,29 @@ func TestToProto(t *testing.T) {
 	md2, err := namespace.NewMetadata(ident.StringID("ns2"),
 		namespace.NewOptions().SetNeedsBootstrap(false))
 	require.NoError(t, err)
-	nsMap, err := namespace.NewMap([]namespace.Metadata{md1, md2})
+	md3, err := namespace.NewMetadata(ident.StringID("ns3"),
+		namespace.NewOptions().SetRollupOptions(namespace.NewRollupOptions().
+			SetSourceNamespace(ident.StringID("ns1")).
+			SetResolution(time.Minute).
+			SetAggregation(namespace.AggregationSum)))
+	require.NoError(t, err)
+	md4, err := namespace.NewMetadata(ident.StringID("ns4"),
+		namespace.NewOptions().SetQuotaOptions(namespace.NewQuotaOptions().
+			SetWriteDatapointsPerSecond(1000).
+			SetFetchedBytesPerSecond(1<<20)))
+	require.NoError(t, err)
+	nsMap, err := namespace.NewMap([]namespace.Metadata{md1, md2, md3, md4})
 	require.NoError(t, err)
 
 	// convert to nsproto map
 	reg := namespace.ToProto(nsMap)
-	require.Len(t, reg.Namespaces, 2)
+	require.Len(t, reg.Namespaces, 4)
 
 	// NB(prateek): expected/observed are inverted here
 	assertEqualMetadata(t, "ns1", *(reg.Namespaces["ns1"]), md1)
 	assertEqualMetadata(t, "ns2", *(reg.Namespaces["ns2"]), md2)
+	assertEqualMetadata(t, "ns3", *(reg.Namespaces["ns3"]), md3)
+	assertEqualMetadata(t, "ns4", *(reg.Namespaces["ns4"]), md4)
 }
 
 func assertEqualMetadata(t *testing.T, name string, expected nsproto.NamespaceOptions, observed namespace.Metadata) {
@@ -162,8 +225,31 @@ func assertEqualMetadata(t *testing.T, name string, expected nsproto.NamespaceOp
 	require.Equal(t, expected.WritesToCommitLog, opts.WritesToCommitLog())
 	require.Equal(t, expected.NeedsFilesetCleanup, opts.NeedsFilesetCleanup())
 	require.Equal(t, expected.NeedsRepair, opts.NeedsRepair())
+	require.Equal(t, expected.RepairFetchesBlocks, opts.RepairFetchesBlocks())
+	require.Equal(t, expected.ColdWritesEnabled, opts.ColdWritesEnabled())
+	require.Equal(t, expected.SnapshotEnabled, opts.SnapshotEnabled())
 
 	assertEqualRetentions(t, *expected.RetentionOptions, opts.RetentionOptions())
+
+	quotaOpts := opts.QuotaOptions()
+	require.NotNil(t, quotaOpts)
+	if expected.QuotaOptions == nil {
+		require.True(t, quotaOpts.Equal(namespace.NewQuotaOptions()))
+	} else {
+		require.Equal(t, expected.QuotaOptions.WriteDatapointsPerSecond, quotaOpts.WriteDatapointsPerSecond())
+		require.Equal(t, expected.QuotaOptions.NewSeriesPerSecond, quotaOpts.NewSeriesPerSecond())
+		require.Equal(t, expected.QuotaOptions.FetchedBytesPerSecond, quotaOpts.FetchedBytesPerSecond())
+	}
+
+	if expected.RollupOptions == nil {
+		require.Nil(t, opts.RollupOptions())
+		return
+	}
+	rollupOpts := opts.RollupOptions()
+	require.NotNil(t, rollupOpts)
+	require.Equal(t, expected.RollupOptions.SourceNamespace, rollupOpts.SourceNamespace().String())
+	require.Equal(t, expected.RollupOptions.ResolutionNanos, rollupOpts.Resolution().Nanoseconds())
+	require.Equal(t, expected.RollupOptions.Aggregation, rollupOpts.Aggregation().String())
 }
 
 func assertEqualRetentions(t *testing.T, expected nsproto.RetentionOptions, observed retention.Options) {
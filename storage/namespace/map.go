@@ -64,6 +64,27 @@ func NewMap(metadatas []Metadata) (Map, error) {
 		idsMap[id.Hash()] = struct{}{}
 	}
 
+	// Rollups are computed from the blocks flushed by the source namespace so
+	// the source must be known and hold raw data.
+	for _, m := range metadatas {
+		rollupOpts := m.Options().RollupOptions()
+		if rollupOpts == nil {
+			continue
+		}
+		source, ok := ns[rollupOpts.SourceNamespace().Hash()]
+		if !ok {
+			multiErr = multiErr.Add(fmt.Errorf(
+				"namespace %v rolls up unknown namespace: %v",
+				m.ID().String(), rollupOpts.SourceNamespace().String()))
+			continue
+		}
+		if source.Options().RollupOptions() != nil {
+			multiErr = multiErr.Add(fmt.Errorf(
+				"namespace %v rolls up namespace %v which is itself a rollup",
+				m.ID().String(), source.ID().String()))
+		}
+	}
+
 	if err := multiErr.FinalError(); err != nil {
 		return nil, err
 	}
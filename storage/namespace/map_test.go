@@ -22,6 +22,7 @@ package namespace
 
 import (
 	"testing"
+	"time"
 
 	"github.com/m3db/m3x/ident"
 
@@ -113,6 +114,7 @@ func TestMapValidateDuplicateID(t *testing.T) {
 		id   = ident.StringID("someID")
 	)
 	opts.EXPECT().Validate().Return(nil).AnyTimes()
+	opts.EXPECT().RollupOptions().Return(nil).AnyTimes()
 
 	md1, err := NewMetadata(id, opts)
 	require.NoError(t, err)
@@ -124,3 +126,29 @@ func TestMapValidateDuplicateID(t *testing.T) {
 	_, err = NewMap(metadatas)
 	require.Error(t, err)
 }
+
+func TestMapValidateRollupSource(t *testing.T) {
+	rollupOpts := NewRollupOptions().
+		SetSourceNamespace(ident.StringID("raw")).
+		SetResolution(time.Minute)
+
+	raw, err := NewMetadata(ident.StringID("raw"), NewOptions())
+	require.NoError(t, err)
+	rollup, err := NewMetadata(ident.StringID("rollup"),
+		NewOptions().SetRollupOptions(rollupOpts))
+	require.NoError(t, err)
+
+	_, err = NewMap([]Metadata{raw, rollup})
+	require.NoError(t, err)
+
+	// Source namespace must be known
+	_, err = NewMap([]Metadata{rollup})
+	require.Error(t, err)
+
+	// Source namespace must not itself be a rollup
+	chained, err := NewMetadata(ident.StringID("chained"),
+		NewOptions().SetRollupOptions(rollupOpts.SetSourceNamespace(ident.StringID("rollup"))))
+	require.NoError(t, err)
+	_, err = NewMap([]Metadata{raw, rollup, chained})
+	require.Error(t, err)
+}
@@ -21,6 +21,8 @@
 package namespace
 
 import (
+	"errors"
+
 	"github.com/m3db/m3db/retention"
 )
 
@@ -39,6 +41,19 @@ const (
 
 	// Namespace requires repair by default
 	defaultNeedsRepair = true
+
+	// Namespace repairs only compare metadata by default
+	defaultRepairFetchesBlocks = false
+
+	// Namespace rejects writes outside the buffer past and future window by default
+	defaultColdWritesEnabled = false
+
+	// Namespace does not snapshot unflushed data by default
+	defaultSnapshotEnabled = false
+)
+
+var (
+	errRollupResolutionNotBlockSizeFactor = errors.New("rollup resolution must evenly divide the block size")
 )
 
 type options struct {
@@ -47,7 +62,12 @@ type options struct {
 	writesToCommitLog   bool
 	needsFilesetCleanup bool
 	needsRepair         bool
+	repairFetchesBlocks bool
+	coldWritesEnabled   bool
+	snapshotEnabled     bool
 	retentionOpts       retention.Options
+	rollupOpts          RollupOptions
+	quotaOpts           QuotaOptions
 }
 
 // NewOptions creates a new namespace options
@@ -58,12 +78,31 @@ func NewOptions() Options {
 		writesToCommitLog:   defaultWritesToCommitLog,
 		needsFilesetCleanup: defaultNeedsFilesetCleanup,
 		needsRepair:         defaultNeedsRepair,
+		repairFetchesBlocks: defaultRepairFetchesBlocks,
+		coldWritesEnabled:   defaultColdWritesEnabled,
+		snapshotEnabled:     defaultSnapshotEnabled,
 		retentionOpts:       retention.NewOptions(),
+		quotaOpts:           NewQuotaOptions(),
 	}
 }
 
 func (o *options) Validate() error {
-	return o.retentionOpts.Validate()
+	if err := o.retentionOpts.Validate(); err != nil {
+		return err
+	}
+	if err := o.quotaOpts.Validate(); err != nil {
+		return err
+	}
+	if o.rollupOpts == nil {
+		return nil
+	}
+	if err := o.rollupOpts.Validate(); err != nil {
+		return err
+	}
+	if o.retentionOpts.BlockSize()%o.rollupOpts.Resolution() != 0 {
+		return errRollupResolutionNotBlockSizeFactor
+	}
+	return nil
 }
 
 func (o *options) Equal(value Options) bool {
@@ -72,7 +111,19 @@ func (o *options) Equal(value Options) bool {
 		o.writesToCommitLog == value.WritesToCommitLog() &&
 		o.needsFilesetCleanup == value.NeedsFilesetCleanup() &&
 		o.needsRepair == value.NeedsRepair() &&
-		o.retentionOpts.Equal(value.RetentionOptions())
+		o.repairFetchesBlocks == value.RepairFetchesBlocks() &&
+		o.coldWritesEnabled == value.ColdWritesEnabled() &&
+		o.snapshotEnabled == value.SnapshotEnabled() &&
+		o.retentionOpts.Equal(value.RetentionOptions()) &&
+		o.rollupOptionsEqual(value.RollupOptions()) &&
+		o.quotaOpts.Equal(value.QuotaOptions())
+}
+
+func (o *options) rollupOptionsEqual(value RollupOptions) bool {
+	if o.rollupOpts == nil || value == nil {
+		return o.rollupOpts == nil && value == nil
+	}
+	return o.rollupOpts.Equal(value)
 }
 
 func (o *options) SetNeedsBootstrap(value bool) Options {
@@ -125,6 +176,36 @@ func (o *options) NeedsRepair() bool {
 	return o.needsRepair
 }
 
+func (o *options) SetRepairFetchesBlocks(value bool) Options {
+	opts := *o
+	opts.repairFetchesBlocks = value
+	return &opts
+}
+
+func (o *options) RepairFetchesBlocks() bool {
+	return o.repairFetchesBlocks
+}
+
+func (o *options) SetColdWritesEnabled(value bool) Options {
+	opts := *o
+	opts.coldWritesEnabled = value
+	return &opts
+}
+
+func (o *options) ColdWritesEnabled() bool {
+	return o.coldWritesEnabled
+}
+
+func (o *options) SetSnapshotEnabled(value bool) Options {
+	opts := *o
+	opts.snapshotEnabled = value
+	return &opts
+}
+
+func (o *options) SnapshotEnabled() bool {
+	return o.snapshotEnabled
+}
+
 func (o *options) SetRetentionOptions(value retention.Options) Options {
 	opts := *o
 	opts.retentionOpts = value
@@ -134,3 +215,23 @@ func (o *options) SetRetentionOptions(value retention.Options) Options {
 func (o *options) RetentionOptions() retention.Options {
 	return o.retentionOpts
 }
+
+func (o *options) SetRollupOptions(value RollupOptions) Options {
+	opts := *o
+	opts.rollupOpts = value
+	return &opts
+}
+
+func (o *options) RollupOptions() RollupOptions {
+	return o.rollupOpts
+}
+
+func (o *options) SetQuotaOptions(value QuotaOptions) Options {
+	opts := *o
+	opts.quotaOpts = value
+	return &opts
+}
+
+func (o *options) QuotaOptions() QuotaOptions {
+	return o.quotaOpts
+}
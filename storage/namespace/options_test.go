@@ -23,8 +23,10 @@ package namespace
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/m3db/m3db/retention"
+	"github.com/m3db/m3x/ident"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -78,3 +80,67 @@ func TestOptionsValidate(t *testing.T) {
 	rOpts.EXPECT().Validate().Return(fmt.Errorf("test error"))
 	require.Error(t, o1.Validate())
 }
+
+func TestOptionsValidateRollup(t *testing.T) {
+	rollupOpts := NewRollupOptions().
+		SetSourceNamespace(ident.StringID("raw")).
+		SetResolution(time.Minute)
+	o1 := NewOptions().SetRollupOptions(rollupOpts)
+	require.NoError(t, o1.Validate())
+
+	o2 := NewOptions().SetRollupOptions(rollupOpts.SetResolution(7 * time.Minute))
+	require.Error(t, o2.Validate())
+
+	o3 := NewOptions().SetRollupOptions(rollupOpts.SetSourceNamespace(nil))
+	require.Error(t, o3.Validate())
+
+	o4 := NewOptions().SetRollupOptions(rollupOpts.SetAggregation(AggregationType(100)))
+	require.Error(t, o4.Validate())
+}
+
+func TestOptionsEqualsRollup(t *testing.T) {
+	rollupOpts := NewRollupOptions().
+		SetSourceNamespace(ident.StringID("raw")).
+		SetResolution(time.Minute)
+	o1 := NewOptions().SetRollupOptions(rollupOpts)
+	require.True(t, o1.Equal(o1))
+	require.False(t, o1.Equal(NewOptions()))
+	require.False(t, NewOptions().Equal(o1))
+
+	o2 := NewOptions().SetRollupOptions(rollupOpts.SetAggregation(AggregationLast))
+	require.False(t, o1.Equal(o2))
+
+	o3 := NewOptions().SetRollupOptions(rollupOpts.SetSourceNamespace(ident.StringID("raw")))
+	require.True(t, o1.Equal(o3))
+}
+
+func TestOptionsValidateQuota(t *testing.T) {
+	o1 := NewOptions().SetQuotaOptions(NewQuotaOptions().
+		SetWriteDatapointsPerSecond(1000).
+		SetNewSeriesPerSecond(10).
+		SetFetchedBytesPerSecond(1 << 20))
+	require.NoError(t, o1.Validate())
+
+	o2 := NewOptions().SetQuotaOptions(NewQuotaOptions().SetWriteDatapointsPerSecond(-1))
+	require.Error(t, o2.Validate())
+
+	o3 := NewOptions().SetQuotaOptions(NewQuotaOptions().SetNewSeriesPerSecond(-1))
+	require.Error(t, o3.Validate())
+
+	o4 := NewOptions().SetQuotaOptions(NewQuotaOptions().SetFetchedBytesPerSecond(-1))
+	require.Error(t, o4.Validate())
+}
+
+func TestOptionsEqualsQuota(t *testing.T) {
+	quotaOpts := NewQuotaOptions().SetWriteDatapointsPerSecond(1000)
+	o1 := NewOptions().SetQuotaOptions(quotaOpts)
+	require.True(t, o1.Equal(o1))
+	require.False(t, o1.Equal(NewOptions()))
+	require.False(t, NewOptions().Equal(o1))
+
+	o2 := NewOptions().SetQuotaOptions(quotaOpts.SetNewSeriesPerSecond(10))
+	require.False(t, o1.Equal(o2))
+
+	o3 := NewOptions().SetQuotaOptions(NewQuotaOptions().SetWriteDatapointsPerSecond(1000))
+	require.True(t, o1.Equal(o3))
+}
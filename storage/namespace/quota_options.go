@@ -0,0 +1,117 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"errors"
+
+	xerrors "github.com/m3db/m3x/errors"
+)
+
+var (
+	errQuotaNegative = errors.New("namespace quotas must be non-negative")
+)
+
+type quotaOptions struct {
+	writeDatapointsPerSecond int64
+	newSeriesPerSecond       int64
+	fetchedBytesPerSecond    int64
+}
+
+// NewQuotaOptions creates new quota options, all quotas are disabled
+func NewQuotaOptions() QuotaOptions {
+	return &quotaOptions{}
+}
+
+func (o *quotaOptions) Validate() error {
+	if o.writeDatapointsPerSecond < 0 ||
+		o.newSeriesPerSecond < 0 ||
+		o.fetchedBytesPerSecond < 0 {
+		return errQuotaNegative
+	}
+	return nil
+}
+
+func (o *quotaOptions) Equal(value QuotaOptions) bool {
+	return o.writeDatapointsPerSecond == value.WriteDatapointsPerSecond() &&
+		o.newSeriesPerSecond == value.NewSeriesPerSecond() &&
+		o.fetchedBytesPerSecond == value.FetchedBytesPerSecond()
+}
+
+func (o *quotaOptions) SetWriteDatapointsPerSecond(value int64) QuotaOptions {
+	opts := *o
+	opts.writeDatapointsPerSecond = value
+	return &opts
+}
+
+func (o *quotaOptions) WriteDatapointsPerSecond() int64 {
+	return o.writeDatapointsPerSecond
+}
+
+func (o *quotaOptions) SetNewSeriesPerSecond(value int64) QuotaOptions {
+	opts := *o
+	opts.newSeriesPerSecond = value
+	return &opts
+}
+
+func (o *quotaOptions) NewSeriesPerSecond() int64 {
+	return o.newSeriesPerSecond
+}
+
+func (o *quotaOptions) SetFetchedBytesPerSecond(value int64) QuotaOptions {
+	opts := *o
+	opts.fetchedBytesPerSecond = value
+	return &opts
+}
+
+func (o *quotaOptions) FetchedBytesPerSecond() int64 {
+	return o.fetchedBytesPerSecond
+}
+
+type quotaExceededError struct {
+	err error
+}
+
+// NewQuotaExceededError wraps an error to mark it as the rejection of a
+// request that exceeds a namespace quota
+func NewQuotaExceededError(err error) error {
+	return quotaExceededError{err: err}
+}
+
+// IsQuotaExceededError returns whether the error or any of the errors it
+// wraps is the rejection of a request that exceeds a namespace quota
+func IsQuotaExceededError(err error) bool {
+	for err != nil {
+		if _, ok := err.(quotaExceededError); ok {
+			return true
+		}
+		err = xerrors.InnerError(err)
+	}
+	return false
+}
+
+func (e quotaExceededError) Error() string {
+	return e.err.Error()
+}
+
+func (e quotaExceededError) InnerError() error {
+	return e.err
+}
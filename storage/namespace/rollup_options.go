@@ -0,0 +1,96 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"errors"
+	"time"
+
+	"github.com/m3db/m3x/ident"
+)
+
+var (
+	errRollupSourceNamespaceNotSet = errors.New("rollup source namespace must be set")
+	errRollupResolutionNotPositive = errors.New("rollup resolution must be positive")
+)
+
+type rollupOptions struct {
+	sourceNamespace ident.ID
+	resolution      time.Duration
+	aggregation     AggregationType
+}
+
+// NewRollupOptions creates new rollup options
+func NewRollupOptions() RollupOptions {
+	return &rollupOptions{
+		aggregation: DefaultAggregationType,
+	}
+}
+
+func (o *rollupOptions) Validate() error {
+	if o.sourceNamespace == nil || o.sourceNamespace.String() == "" {
+		return errRollupSourceNamespaceNotSet
+	}
+	if o.resolution <= 0 {
+		return errRollupResolutionNotPositive
+	}
+	return ValidateAggregationType(o.aggregation)
+}
+
+func (o *rollupOptions) Equal(value RollupOptions) bool {
+	sourceEqual := o.sourceNamespace == nil && value.SourceNamespace() == nil
+	if o.sourceNamespace != nil && value.SourceNamespace() != nil {
+		sourceEqual = o.sourceNamespace.Equal(value.SourceNamespace())
+	}
+	return sourceEqual &&
+		o.resolution == value.Resolution() &&
+		o.aggregation == value.Aggregation()
+}
+
+func (o *rollupOptions) SetSourceNamespace(value ident.ID) RollupOptions {
+	opts := *o
+	opts.sourceNamespace = value
+	return &opts
+}
+
+func (o *rollupOptions) SourceNamespace() ident.ID {
+	return o.sourceNamespace
+}
+
+func (o *rollupOptions) SetResolution(value time.Duration) RollupOptions {
+	opts := *o
+	opts.resolution = value
+	return &opts
+}
+
+func (o *rollupOptions) Resolution() time.Duration {
+	return o.resolution
+}
+
+func (o *rollupOptions) SetAggregation(value AggregationType) RollupOptions {
+	opts := *o
+	opts.aggregation = value
+	return &opts
+}
+
+func (o *rollupOptions) Aggregation() AggregationType {
+	return o.aggregation
+}
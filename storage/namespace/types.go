@@ -67,11 +67,105 @@ type Options interface {
 	// NeedsRepair returns whether the data for this namespace needs to be repaired
 	NeedsRepair() bool
 
+	// SetRepairFetchesBlocks sets whether repairs for this namespace fetch mismatched
+	// blocks from peers and persist them, rather than only comparing metadata
+	SetRepairFetchesBlocks(value bool) Options
+
+	// RepairFetchesBlocks returns whether repairs for this namespace fetch mismatched
+	// blocks from peers and persist them, rather than only comparing metadata
+	RepairFetchesBlocks() bool
+
+	// SetColdWritesEnabled sets whether writes outside the buffer past and future
+	// window are accepted for this namespace and merged into filesets on flush
+	SetColdWritesEnabled(value bool) Options
+
+	// ColdWritesEnabled returns whether writes outside the buffer past and future
+	// window are accepted for this namespace and merged into filesets on flush
+	ColdWritesEnabled() bool
+
+	// SetSnapshotEnabled sets whether the unflushed data of this namespace is
+	// periodically snapshotted so bootstraps replay fewer commit logs
+	SetSnapshotEnabled(value bool) Options
+
+	// SnapshotEnabled returns whether the unflushed data of this namespace is
+	// periodically snapshotted so bootstraps replay fewer commit logs
+	SnapshotEnabled() bool
+
 	// SetRetentionOptions sets the retention options for this namespace
 	SetRetentionOptions(value retention.Options) Options
 
 	// RetentionOptions returns the retention options for this namespace
 	RetentionOptions() retention.Options
+
+	// SetRollupOptions sets the rollup options for this namespace, nil
+	// unless the namespace holds rollups of a source namespace
+	SetRollupOptions(value RollupOptions) Options
+
+	// RollupOptions returns the rollup options for this namespace, nil
+	// unless the namespace holds rollups of a source namespace
+	RollupOptions() RollupOptions
+
+	// SetQuotaOptions sets the quotas enforced on writes and reads of this namespace
+	SetQuotaOptions(value QuotaOptions) Options
+
+	// QuotaOptions returns the quotas enforced on writes and reads of this namespace
+	QuotaOptions() QuotaOptions
+}
+
+// RollupOptions controls how a namespace is fed rollups of the blocks
+// flushed by a source namespace
+type RollupOptions interface {
+	// Validate validates the options
+	Validate() error
+
+	// Equal returns true if the provide value is equal to this one
+	Equal(value RollupOptions) bool
+
+	// SetSourceNamespace sets the namespace whose flushed blocks are rolled up
+	SetSourceNamespace(value ident.ID) RollupOptions
+
+	// SourceNamespace returns the namespace whose flushed blocks are rolled up
+	SourceNamespace() ident.ID
+
+	// SetResolution sets the interval the datapoints are aggregated over
+	SetResolution(value time.Duration) RollupOptions
+
+	// Resolution returns the interval the datapoints are aggregated over
+	Resolution() time.Duration
+
+	// SetAggregation sets the function the datapoints are aggregated with
+	SetAggregation(value AggregationType) RollupOptions
+
+	// Aggregation returns the function the datapoints are aggregated with
+	Aggregation() AggregationType
+}
+
+// QuotaOptions controls the rates at which a namespace accepts writes and
+// serves reads, a quota of zero is disabled
+type QuotaOptions interface {
+	// Validate validates the options
+	Validate() error
+
+	// Equal returns true if the provide value is equal to this one
+	Equal(value QuotaOptions) bool
+
+	// SetWriteDatapointsPerSecond sets the datapoints written per second quota
+	SetWriteDatapointsPerSecond(value int64) QuotaOptions
+
+	// WriteDatapointsPerSecond returns the datapoints written per second quota
+	WriteDatapointsPerSecond() int64
+
+	// SetNewSeriesPerSecond sets the new series inserted per second quota
+	SetNewSeriesPerSecond(value int64) QuotaOptions
+
+	// NewSeriesPerSecond returns the new series inserted per second quota
+	NewSeriesPerSecond() int64
+
+	// SetFetchedBytesPerSecond sets the encoded bytes fetched per second quota
+	SetFetchedBytesPerSecond(value int64) QuotaOptions
+
+	// FetchedBytesPerSecond returns the encoded bytes fetched per second quota
+	FetchedBytesPerSecond() int64
 }
 
 // Metadata represents namespace metadata information
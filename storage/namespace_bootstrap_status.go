@@ -0,0 +1,214 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/m3db/m3db/storage/bootstrap/result"
+
+	"github.com/uber-go/tally"
+)
+
+var shardBootstrapStates = []ShardBootstrapState{
+	ShardBootstrapPending,
+	ShardBootstrapping,
+	ShardBootstrapped,
+	ShardBootstrapFailed,
+}
+
+// String returns the name of the bootstrap state.
+func (s ShardBootstrapState) String() string {
+	switch s {
+	case ShardBootstrapPending:
+		return "pending"
+	case ShardBootstrapping:
+		return "bootstrapping"
+	case ShardBootstrapped:
+		return "bootstrapped"
+	case ShardBootstrapFailed:
+		return "failed"
+	}
+	return "unknown"
+}
+
+type bootstrapStatusMetrics struct {
+	seriesFetched   tally.Counter
+	bytesFetched    tally.Counter
+	blocksPersisted tally.Counter
+	shards          map[ShardBootstrapState]tally.Gauge
+}
+
+func newBootstrapStatusMetrics(scope tally.Scope) bootstrapStatusMetrics {
+	shards := make(map[ShardBootstrapState]tally.Gauge, len(shardBootstrapStates))
+	for _, state := range shardBootstrapStates {
+		shards[state] = scope.Tagged(map[string]string{
+			"state": state.String(),
+		}).Gauge("shards")
+	}
+	return bootstrapStatusMetrics{
+		seriesFetched:   scope.Counter("series-fetched"),
+		bytesFetched:    scope.Counter("bytes-fetched"),
+		blocksPersisted: scope.Counter("blocks-persisted"),
+		shards:          shards,
+	}
+}
+
+// bootstrapStatusTracker tracks the bootstrap state of the shards of a
+// namespace, it receives the progress of the bootstrap process as the
+// sources bootstrap the shards.
+type bootstrapStatusTracker struct {
+	sync.RWMutex
+
+	shards  map[uint32]ShardBootstrapStatus
+	metrics bootstrapStatusMetrics
+}
+
+func newBootstrapStatusTracker(scope tally.Scope) *bootstrapStatusTracker {
+	return &bootstrapStatusTracker{
+		shards:  make(map[uint32]ShardBootstrapStatus),
+		metrics: newBootstrapStatusMetrics(scope),
+	}
+}
+
+// setPending resets the status of shards about to be bootstrapped.
+func (t *bootstrapStatusTracker) setPending(shards []uint32) {
+	t.Lock()
+	for _, shard := range shards {
+		t.shards[shard] = ShardBootstrapStatus{
+			Shard: shard,
+			State: ShardBootstrapPending,
+		}
+	}
+	t.Unlock()
+}
+
+func (t *bootstrapStatusTracker) setBootstrapped(shard uint32) {
+	t.update(shard, func(status *ShardBootstrapStatus) {
+		status.State = ShardBootstrapped
+	})
+}
+
+func (t *bootstrapStatusTracker) setFailed(shard uint32, err error) {
+	t.update(shard, func(status *ShardBootstrapStatus) {
+		status.State = ShardBootstrapFailed
+		status.Err = err
+	})
+}
+
+func (t *bootstrapStatusTracker) ReportSourceStarted(
+	source string,
+	shardsTimeRanges result.ShardTimeRanges,
+) {
+	for shard := range shardsTimeRanges {
+		t.update(shard, func(status *ShardBootstrapStatus) {
+			status.State = ShardBootstrapping
+			status.Source = source
+		})
+	}
+}
+
+func (t *bootstrapStatusTracker) ReportFetched(
+	source string,
+	shard uint32,
+	numSeries int64,
+	numBytes int64,
+) {
+	t.update(shard, func(status *ShardBootstrapStatus) {
+		status.NumSeries += numSeries
+		status.NumBytes += numBytes
+	})
+	t.metrics.seriesFetched.Inc(numSeries)
+	t.metrics.bytesFetched.Inc(numBytes)
+}
+
+func (t *bootstrapStatusTracker) ReportPersisted(
+	source string,
+	shard uint32,
+	numPersisted int,
+	numTotal int,
+) {
+	var persisted int
+	t.update(shard, func(status *ShardBootstrapStatus) {
+		persisted = numPersisted - status.NumPersistedBlocks
+		status.NumPersistedBlocks = numPersisted
+		status.NumTotalBlocks = numTotal
+	})
+	if persisted > 0 {
+		t.metrics.blocksPersisted.Inc(int64(persisted))
+	}
+}
+
+// update updates the status of a shard that is tracked, shards that are
+// not being bootstrapped are ignored.
+func (t *bootstrapStatusTracker) update(
+	shard uint32,
+	fn func(status *ShardBootstrapStatus),
+) {
+	t.Lock()
+	status, ok := t.shards[shard]
+	if ok {
+		fn(&status)
+		t.shards[shard] = status
+	}
+	t.Unlock()
+}
+
+// status returns the status of the shards, the state of shards that have
+// never been bootstrapped by the tracker is derived from the shards.
+func (t *bootstrapStatusTracker) status(shards []databaseShard) []ShardBootstrapStatus {
+	statuses := make([]ShardBootstrapStatus, 0, len(shards))
+	t.RLock()
+	for _, shard := range shards {
+		status, ok := t.shards[shard.ID()]
+		if !ok {
+			status = ShardBootstrapStatus{
+				Shard: shard.ID(),
+				State: ShardBootstrapPending,
+			}
+			if shard.IsBootstrapped() {
+				status.State = ShardBootstrapped
+			}
+		}
+		statuses = append(statuses, status)
+	}
+	t.RUnlock()
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Shard < statuses[j].Shard
+	})
+	return statuses
+}
+
+// report reports the number of tracked shards in each bootstrap state.
+func (t *bootstrapStatusTracker) report() {
+	counts := make(map[ShardBootstrapState]int, len(shardBootstrapStates))
+	t.RLock()
+	for _, status := range t.shards {
+		counts[status.State]++
+	}
+	t.RUnlock()
+
+	for _, state := range shardBootstrapStates {
+		t.metrics.shards[state].Update(float64(counts[state]))
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3db/storage/bootstrap/result"
+	xtime "github.com/m3db/m3x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+func newTestBootstrapStatusShards(
+	ctrl *gomock.Controller,
+	bootstrapped []bool,
+) []databaseShard {
+	shards := make([]databaseShard, 0, len(bootstrapped))
+	for i, isBootstrapped := range bootstrapped {
+		shard := NewMockdatabaseShard(ctrl)
+		shard.EXPECT().ID().Return(uint32(i)).AnyTimes()
+		shard.EXPECT().IsBootstrapped().Return(isBootstrapped).AnyTimes()
+		shards = append(shards, shard)
+	}
+	return shards
+}
+
+func TestBootstrapStatusTrackerUntrackedShards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tracker := newBootstrapStatusTracker(tally.NoopScope)
+	shards := newTestBootstrapStatusShards(ctrl, []bool{false, true})
+
+	statuses := tracker.status(shards)
+	require.Equal(t, []ShardBootstrapStatus{
+		{Shard: 0, State: ShardBootstrapPending},
+		{Shard: 1, State: ShardBootstrapped},
+	}, statuses)
+}
+
+func TestBootstrapStatusTrackerProgress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	scope := tally.NewTestScope("", nil)
+	tracker := newBootstrapStatusTracker(scope)
+	shards := newTestBootstrapStatusShards(ctrl, []bool{false, false, false})
+
+	tracker.setPending([]uint32{0, 1, 2})
+
+	now := time.Now()
+	ranges := xtime.Ranges{}.AddRange(xtime.Range{
+		Start: now.Add(-time.Hour),
+		End:   now,
+	})
+	tracker.ReportSourceStarted("filesystem", result.ShardTimeRanges{
+		0: ranges,
+		1: ranges,
+	})
+	tracker.ReportFetched("filesystem", 0, 2, 100)
+	tracker.ReportFetched("filesystem", 1, 1, 50)
+	// Shards that are not being bootstrapped are ignored
+	tracker.ReportFetched("filesystem", 3, 1, 50)
+
+	statuses := tracker.status(shards)
+	require.Equal(t, []ShardBootstrapStatus{
+		{Shard: 0, State: ShardBootstrapping, Source: "filesystem", NumSeries: 2, NumBytes: 100},
+		{Shard: 1, State: ShardBootstrapping, Source: "filesystem", NumSeries: 1, NumBytes: 50},
+		{Shard: 2, State: ShardBootstrapPending},
+	}, statuses)
+
+	tracker.ReportSourceStarted("peers", result.ShardTimeRanges{1: ranges})
+	tracker.ReportFetched("peers", 1, 3, 150)
+	tracker.ReportPersisted("peers", 1, 1, 2)
+	tracker.ReportPersisted("peers", 1, 2, 2)
+
+	errShard := errors.New("an error")
+	tracker.setBootstrapped(0)
+	tracker.setBootstrapped(1)
+	tracker.setFailed(2, errShard)
+
+	statuses = tracker.status(shards)
+	require.Equal(t, []ShardBootstrapStatus{
+		{Shard: 0, State: ShardBootstrapped, Source: "filesystem", NumSeries: 2, NumBytes: 100},
+		{Shard: 1, State: ShardBootstrapped, Source: "peers", NumSeries: 4, NumBytes: 200,
+			NumPersistedBlocks: 2, NumTotalBlocks: 2},
+		{Shard: 2, State: ShardBootstrapFailed, Err: errShard},
+	}, statuses)
+
+	tracker.report()
+
+	snapshot := scope.Snapshot()
+	counters := snapshot.Counters()
+	require.Equal(t, int64(7), counters["series-fetched+"].Value())
+	require.Equal(t, int64(350), counters["bytes-fetched+"].Value())
+	require.Equal(t, int64(2), counters["blocks-persisted+"].Value())
+
+	gauges := snapshot.Gauges()
+	require.Equal(t, float64(0), gauges["shards+state=pending"].Value())
+	require.Equal(t, float64(0), gauges["shards+state=bootstrapping"].Value())
+	require.Equal(t, float64(2), gauges["shards+state=bootstrapped"].Value())
+	require.Equal(t, float64(1), gauges["shards+state=failed"].Value())
+}
+
+func TestBootstrapStatusTrackerSetPendingResets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tracker := newBootstrapStatusTracker(tally.NoopScope)
+	shards := newTestBootstrapStatusShards(ctrl, []bool{false})
+
+	tracker.setPending([]uint32{0})
+	tracker.ReportFetched("peers", 0, 1, 10)
+	tracker.setFailed(0, errors.New("an error"))
+
+	tracker.setPending([]uint32{0})
+	require.Equal(t, []ShardBootstrapStatus{
+		{Shard: 0, State: ShardBootstrapPending},
+	}, tracker.status(shards))
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3db/clock"
+	"github.com/m3db/m3db/storage/namespace"
+
+	"github.com/uber-go/tally"
+)
+
+var (
+	errWriteDatapointsQuotaExceeded = errors.New("namespace write datapoints per second quota exceeded")
+	errNewSeriesQuotaExceeded       = errors.New("namespace new series per second quota exceeded")
+	errFetchedBytesQuotaExceeded    = errors.New("namespace fetched bytes per second quota exceeded")
+)
+
+// namespaceQuotas enforces the per second quotas of a namespace, a nil
+// value enforces no quotas.
+type namespaceQuotas struct {
+	sync.Mutex
+
+	nowFn clock.NowFn
+	opts  namespace.QuotaOptions
+
+	writeDatapoints quotaWindow
+	newSeries       quotaWindow
+	fetchedBytes    quotaWindow
+
+	metrics namespaceQuotasMetrics
+}
+
+type quotaWindow struct {
+	windowNanos int64
+	value       int64
+}
+
+// current returns the value of the window at the given time, rolling into
+// a new window if required.
+func (w *quotaWindow) current(windowNanos int64) int64 {
+	if w.windowNanos != windowNanos {
+		w.windowNanos = windowNanos
+		w.value = 0
+	}
+	return w.value
+}
+
+type namespaceQuotasMetrics struct {
+	writeDatapointsExceeded tally.Counter
+	newSeriesExceeded       tally.Counter
+	fetchedBytesExceeded    tally.Counter
+}
+
+func newNamespaceQuotasMetrics(scope tally.Scope) namespaceQuotasMetrics {
+	exceeded := func(quota string) tally.Counter {
+		return scope.Tagged(map[string]string{
+			"quota": quota,
+		}).Counter("quota-exceeded")
+	}
+	return namespaceQuotasMetrics{
+		writeDatapointsExceeded: exceeded("write-datapoints"),
+		newSeriesExceeded:       exceeded("new-series"),
+		fetchedBytesExceeded:    exceeded("fetched-bytes"),
+	}
+}
+
+func newNamespaceQuotas(
+	opts namespace.QuotaOptions,
+	nowFn clock.NowFn,
+	scope tally.Scope,
+) *namespaceQuotas {
+	if opts == nil {
+		opts = namespace.NewQuotaOptions()
+	}
+	return &namespaceQuotas{
+		nowFn:   nowFn,
+		opts:    opts,
+		metrics: newNamespaceQuotasMetrics(scope),
+	}
+}
+
+func (q *namespaceQuotas) options() namespace.QuotaOptions {
+	q.Lock()
+	opts := q.opts
+	q.Unlock()
+	return opts
+}
+
+func (q *namespaceQuotas) setOptions(value namespace.QuotaOptions) {
+	q.Lock()
+	q.opts = value
+	q.Unlock()
+}
+
+// quotaCharge is a charge against a quota window, it is refunded if the
+// operation it was charged for fails.
+type quotaCharge struct {
+	quotas      *namespaceQuotas
+	window      *quotaWindow
+	windowNanos int64
+}
+
+// refund returns the charge to its window, charges made in a window that
+// has since rolled over are not refunded as the window is already reset.
+func (c quotaCharge) refund() {
+	if c.quotas == nil {
+		return
+	}
+	c.quotas.Lock()
+	if c.window.windowNanos == c.windowNanos && c.window.value > 0 {
+		c.window.value--
+	}
+	c.quotas.Unlock()
+}
+
+// allowWriteDatapoint charges a datapoint write, returning an error if it
+// would exceed the write datapoints quota. The charge is refunded if the
+// write fails so that only successful writes count against the quota.
+func (q *namespaceQuotas) allowWriteDatapoint() (quotaCharge, error) {
+	if q == nil {
+		return quotaCharge{}, nil
+	}
+	return q.charge(&q.writeDatapoints, q.options().WriteDatapointsPerSecond(),
+		q.metrics.writeDatapointsExceeded, errWriteDatapointsQuotaExceeded)
+}
+
+// allowNewSeries charges the insert of a new series, returning an error if
+// it would exceed the new series quota. The charge is refunded if the
+// series is not inserted.
+func (q *namespaceQuotas) allowNewSeries() (quotaCharge, error) {
+	if q == nil {
+		return quotaCharge{}, nil
+	}
+	return q.charge(&q.newSeries, q.options().NewSeriesPerSecond(),
+		q.metrics.newSeriesExceeded, errNewSeriesQuotaExceeded)
+}
+
+func (q *namespaceQuotas) charge(
+	window *quotaWindow,
+	limit int64,
+	exceeded tally.Counter,
+	exceededErr error,
+) (quotaCharge, error) {
+	windowNanos := q.nowFn().Truncate(time.Second).UnixNano()
+
+	q.Lock()
+	if limit > 0 && window.current(windowNanos) >= limit {
+		q.Unlock()
+		exceeded.Inc(1)
+		return quotaCharge{}, namespace.NewQuotaExceededError(exceededErr)
+	}
+	// Roll into the current window even if no limit is set so a refund
+	// always applies to the window that was charged
+	window.current(windowNanos)
+	window.value++
+	q.Unlock()
+	return quotaCharge{quotas: q, window: window, windowNanos: windowNanos}, nil
+}
+
+// tracksFetchedBytes returns whether reads need to account for the bytes
+// they fetch.
+func (q *namespaceQuotas) tracksFetchedBytes() bool {
+	if q == nil {
+		return false
+	}
+	return q.options().FetchedBytesPerSecond() > 0
+}
+
+// allowFetch returns an error if the bytes fetched in the current window
+// already exceed the fetched bytes quota, since the size of a read is only
+// known once it has been performed.
+func (q *namespaceQuotas) allowFetch() error {
+	if q == nil {
+		return nil
+	}
+	windowNanos := q.nowFn().Truncate(time.Second).UnixNano()
+
+	q.Lock()
+	limit := q.opts.FetchedBytesPerSecond()
+	if limit > 0 && q.fetchedBytes.current(windowNanos) >= limit {
+		q.Unlock()
+		q.metrics.fetchedBytesExceeded.Inc(1)
+		return namespace.NewQuotaExceededError(errFetchedBytesQuotaExceeded)
+	}
+	q.Unlock()
+	return nil
+}
+
+// addFetchedBytes accounts for the bytes fetched by a read.
+func (q *namespaceQuotas) addFetchedBytes(value int64) {
+	if q == nil {
+		return
+	}
+	windowNanos := q.nowFn().Truncate(time.Second).UnixNano()
+
+	q.Lock()
+	q.fetchedBytes.current(windowNanos)
+	q.fetchedBytes.value += value
+	q.Unlock()
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3db/storage/namespace"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+func newTestNamespaceQuotas(
+	opts namespace.QuotaOptions,
+) (*namespaceQuotas, *time.Time, tally.TestScope) {
+	now := time.Now().Truncate(time.Second)
+	nowFn := func() time.Time { return now }
+	scope := tally.NewTestScope("", nil)
+	return newNamespaceQuotas(opts, nowFn, scope), &now, scope
+}
+
+func TestNamespaceQuotasDisabled(t *testing.T) {
+	q, _, _ := newTestNamespaceQuotas(namespace.NewQuotaOptions())
+	for i := 0; i < 100; i++ {
+		_, err := q.allowWriteDatapoint()
+		require.NoError(t, err)
+		_, err = q.allowNewSeries()
+		require.NoError(t, err)
+		require.NoError(t, q.allowFetch())
+		q.addFetchedBytes(1 << 20)
+	}
+	require.False(t, q.tracksFetchedBytes())
+
+	var nilQuotas *namespaceQuotas
+	charge, err := nilQuotas.allowWriteDatapoint()
+	require.NoError(t, err)
+	charge.refund()
+	_, err = nilQuotas.allowNewSeries()
+	require.NoError(t, err)
+	require.NoError(t, nilQuotas.allowFetch())
+	require.False(t, nilQuotas.tracksFetchedBytes())
+}
+
+func TestNamespaceQuotasWriteDatapoints(t *testing.T) {
+	q, now, scope := newTestNamespaceQuotas(namespace.NewQuotaOptions().
+		SetWriteDatapointsPerSecond(3))
+	for i := 0; i < 3; i++ {
+		_, err := q.allowWriteDatapoint()
+		require.NoError(t, err)
+	}
+	_, err := q.allowWriteDatapoint()
+	require.Error(t, err)
+	require.True(t, namespace.IsQuotaExceededError(err))
+
+	counters := scope.Snapshot().Counters()
+	counter, ok := counters["quota-exceeded+quota=write-datapoints"]
+	require.True(t, ok)
+	require.Equal(t, int64(1), counter.Value())
+
+	// Rolling into the next window resets the quota
+	*now = now.Add(time.Second)
+	_, err = q.allowWriteDatapoint()
+	require.NoError(t, err)
+}
+
+func TestNamespaceQuotasRefund(t *testing.T) {
+	q, now, _ := newTestNamespaceQuotas(namespace.NewQuotaOptions().
+		SetWriteDatapointsPerSecond(1))
+	charge, err := q.allowWriteDatapoint()
+	require.NoError(t, err)
+	_, err = q.allowWriteDatapoint()
+	require.Error(t, err)
+
+	// A refunded charge no longer counts against the quota
+	charge.refund()
+	charge, err = q.allowWriteDatapoint()
+	require.NoError(t, err)
+
+	// Refunding a charge from a previous window leaves the current one as is
+	*now = now.Add(time.Second)
+	_, err = q.allowWriteDatapoint()
+	require.NoError(t, err)
+	charge.refund()
+	_, err = q.allowWriteDatapoint()
+	require.Error(t, err)
+}
+
+func TestNamespaceQuotasNewSeries(t *testing.T) {
+	q, now, _ := newTestNamespaceQuotas(namespace.NewQuotaOptions().
+		SetNewSeriesPerSecond(1))
+	_, err := q.allowNewSeries()
+	require.NoError(t, err)
+	_, err = q.allowNewSeries()
+	require.Error(t, err)
+	require.True(t, namespace.IsQuotaExceededError(err))
+
+	*now = now.Add(time.Second)
+	_, err = q.allowNewSeries()
+	require.NoError(t, err)
+}
+
+func TestNamespaceQuotasFetchedBytes(t *testing.T) {
+	q, now, _ := newTestNamespaceQuotas(namespace.NewQuotaOptions().
+		SetFetchedBytesPerSecond(100))
+	require.True(t, q.tracksFetchedBytes())
+
+	require.NoError(t, q.allowFetch())
+	q.addFetchedBytes(60)
+	require.NoError(t, q.allowFetch())
+	q.addFetchedBytes(60)
+	err := q.allowFetch()
+	require.Error(t, err)
+	require.True(t, namespace.IsQuotaExceededError(err))
+
+	*now = now.Add(time.Second)
+	require.NoError(t, q.allowFetch())
+}
+
+func TestNamespaceQuotasSetOptions(t *testing.T) {
+	q, _, _ := newTestNamespaceQuotas(namespace.NewQuotaOptions())
+	_, err := q.allowWriteDatapoint()
+	require.NoError(t, err)
+
+	q.setOptions(namespace.NewQuotaOptions().SetWriteDatapointsPerSecond(1))
+	require.True(t, q.options().Equal(
+		namespace.NewQuotaOptions().SetWriteDatapointsPerSecond(1)))
+	_, err = q.allowWriteDatapoint()
+	require.Error(t, err)
+}
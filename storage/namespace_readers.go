@@ -262,7 +262,7 @@ func (m *namespaceReaderManager) get(
 
 	// Fast fwd through if in the middle of a volume
 	for i := 0; i < position.dataIdx; i++ {
-		id, data, _, err := reader.Read()
+		id, _, data, _, err := reader.Read()
 		if err != nil {
 			return nil, err
 		}
@@ -270,7 +270,7 @@ func (m *namespaceReaderManager) get(
 		data.Finalize()
 	}
 	for i := 0; i < position.metadataIdx; i++ {
-		id, _, _, err := reader.ReadMetadata()
+		id, _, _, _, err := reader.ReadMetadata()
 		if err != nil {
 			return nil, err
 		}
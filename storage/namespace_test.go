@@ -148,6 +148,64 @@ func TestNamespaceWriteShardOwned(t *testing.T) {
 	require.NoError(t, ns.Write(ctx, id, ts, val, unit, ant))
 }
 
+func TestNamespaceWriteQuotaExceeded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	id := ident.StringID("foo")
+	ts := time.Now()
+	val := 0.0
+	unit := xtime.Second
+	ant := []byte(nil)
+
+	ns := newTestNamespace(t)
+	ns.quotas.nowFn = func() time.Time { return ts }
+	ns.SetQuotaOptions(namespace.NewQuotaOptions().SetWriteDatapointsPerSecond(1))
+	require.Equal(t, int64(1), ns.Options().QuotaOptions().WriteDatapointsPerSecond())
+	shard := NewMockdatabaseShard(ctrl)
+	shard.EXPECT().Write(ctx, id, ts, val, unit, ant).Return(nil)
+	ns.shards[testShardIDs[0].ID()] = shard
+
+	require.NoError(t, ns.Write(ctx, id, ts, val, unit, ant))
+	err := ns.Write(ctx, id, ts, val, unit, ant)
+	require.Error(t, err)
+	require.True(t, namespace.IsQuotaExceededError(err))
+}
+
+func TestNamespaceWriteNewSeriesQuotaExceededRefundsDatapoint(t *testing.T) {
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	now := time.Now()
+	ns := newTestNamespace(t)
+	ns.quotas.nowFn = func() time.Time { return now }
+	ns.SetQuotaOptions(namespace.NewQuotaOptions().
+		SetWriteDatapointsPerSecond(2).
+		SetNewSeriesPerSecond(1))
+
+	shard := newDatabaseShard(ns.metadata, testShardIDs[0].ID(), nil, nil,
+		&testIncreasingIndex{}, commitLogWriteNoOp, databaseIndexNoOp,
+		ns.quotas, ns.numSeries, false, ns.opts, ns.seriesOpts)
+	defer shard.Close()
+	ns.shards[testShardIDs[0].ID()] = shard
+
+	require.NoError(t, ns.Write(ctx, ident.StringID("foo"), now, 1.0, xtime.Second, nil))
+
+	// The new series quota rejects the write so it is not charged against
+	// the datapoints quota
+	err := ns.Write(ctx, ident.StringID("bar"), now, 2.0, xtime.Second, nil)
+	require.Error(t, err)
+	require.True(t, namespace.IsQuotaExceededError(err))
+	ns.quotas.Lock()
+	require.Equal(t, int64(1), ns.quotas.writeDatapoints.value)
+	ns.quotas.Unlock()
+
+	require.NoError(t, ns.Write(ctx, ident.StringID("foo"), now.Add(time.Second), 3.0, xtime.Second, nil))
+}
+
 func TestNamespaceReadEncodedShardNotOwned(t *testing.T) {
 	ctx := context.NewContext()
 	defer ctx.Close()
@@ -319,7 +377,10 @@ func TestNamespaceBootstrapAllShards(t *testing.T) {
 	errs := []error{nil, errors.New("foo")}
 	bs := bootstrap.NewMockProcess(ctrl)
 	bs.EXPECT().
-		Run(ns.metadata, sharding.IDs(testShardIDs), ranges).
+		Run(ns.metadata, sharding.IDs(testShardIDs), gomock.Any()).
+		Do(func(_ namespace.Metadata, _ []uint32, targetRanges []bootstrap.TargetRange) {
+			requireTrackedTargetRanges(t, ns, ranges, targetRanges)
+		}).
 		Return(result.NewBootstrapResult(), nil)
 	for i := range errs {
 		shard := NewMockdatabaseShard(ctrl)
@@ -331,6 +392,30 @@ func TestNamespaceBootstrapAllShards(t *testing.T) {
 
 	require.Equal(t, "foo", ns.Bootstrap(bs, ranges).Error())
 	require.Equal(t, bootstrapped, ns.bs)
+
+	statuses := ns.bootstrapStatus.status([]databaseShard{
+		ns.shards[testShardIDs[0].ID()],
+		ns.shards[testShardIDs[1].ID()],
+	})
+	require.Equal(t, 2, len(statuses))
+	require.Equal(t, ShardBootstrapped, statuses[0].State)
+	require.NoError(t, statuses[0].Err)
+	require.Equal(t, ShardBootstrapFailed, statuses[1].State)
+	require.Equal(t, errs[1], statuses[1].Err)
+}
+
+func requireTrackedTargetRanges(
+	t *testing.T,
+	ns *dbNamespace,
+	expected []bootstrap.TargetRange,
+	actual []bootstrap.TargetRange,
+) {
+	require.Equal(t, len(expected), len(actual))
+	for i := range expected {
+		require.Equal(t, expected[i].Range, actual[i].Range)
+		require.NotNil(t, actual[i].RunOptions)
+		require.Equal(t, ns.bootstrapStatus, actual[i].RunOptions.ProgressReporter())
+	}
 }
 
 func TestNamespaceBootstrapOnlyNonBootstrappedShards(t *testing.T) {
@@ -364,7 +449,10 @@ func TestNamespaceBootstrapOnlyNonBootstrappedShards(t *testing.T) {
 	ns := newTestNamespace(t)
 	bs := bootstrap.NewMockProcess(ctrl)
 	bs.EXPECT().
-		Run(ns.metadata, sharding.IDs(needsBootstrap), ranges).
+		Run(ns.metadata, sharding.IDs(needsBootstrap), gomock.Any()).
+		Do(func(_ namespace.Metadata, _ []uint32, targetRanges []bootstrap.TargetRange) {
+			requireTrackedTargetRanges(t, ns, ranges, targetRanges)
+		}).
 		Return(result.NewBootstrapResult(), nil)
 
 	for _, testShard := range needsBootstrap {
@@ -448,6 +536,58 @@ func TestNamespaceFlushAllShards(t *testing.T) {
 	require.Error(t, ns.Flush(blockStart, nil))
 }
 
+func TestNamespaceFlushIndexAfterShards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ns := newTestNamespace(t)
+	ns.bs = bootstrapped
+	blockStart := time.Now().Truncate(ns.Options().RetentionOptions().BlockSize())
+
+	for i := range testShardIDs {
+		shard := NewMockdatabaseShard(ctrl)
+		shard.EXPECT().FlushState(blockStart).Return(fileOpState{Status: fileOpNotStarted})
+		shard.EXPECT().Flush(blockStart, nil).Return(nil)
+		ns.shards[testShardIDs[i].ID()] = shard
+	}
+
+	idx := NewMockdatabaseIndex(ctrl)
+	idx.EXPECT().Flush(ns.metadata, blockStart, nil).Return(errors.New("foo"))
+	ns.index = idx
+
+	require.Error(t, ns.Flush(blockStart, nil))
+}
+
+func TestNamespaceColdFlushIndexFlushedBlocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ns := newTestNamespaceWithIDOpts(t, defaultTestNs1ID,
+		defaultTestNs1Opts.SetColdWritesEnabled(true))
+	ns.bs = bootstrapped
+	var (
+		blockSize  = ns.Options().RetentionOptions().BlockSize()
+		flushed    = time.Now().Truncate(blockSize).Add(-blockSize)
+		notFlushed = flushed.Add(blockSize)
+	)
+
+	for i := range testShardIDs {
+		shard := NewMockdatabaseShard(ctrl)
+		shard.EXPECT().ColdFlush(nil, nil).Return(nil)
+		shard.EXPECT().FlushState(flushed).Return(fileOpState{Status: fileOpSuccess})
+		shard.EXPECT().FlushState(notFlushed).Return(fileOpState{Status: fileOpNotStarted}).MaxTimes(1)
+		ns.shards[testShardIDs[i].ID()] = shard
+	}
+
+	// Only the block flushed by every shard is rewritten
+	idx := NewMockdatabaseIndex(ctrl)
+	idx.EXPECT().BlockStarts(ns.metadata).Return([]time.Time{flushed, notFlushed})
+	idx.EXPECT().Flush(ns.metadata, flushed, nil).Return(nil)
+	ns.index = idx
+
+	require.NoError(t, ns.ColdFlush(nil, nil))
+}
+
 func TestNamespaceTruncate(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -50,6 +50,9 @@ const (
 	// defaultMaxFlushRetries is the default number of retries when flush fails
 	defaultMaxFlushRetries = 3
 
+	// defaultMinimumSnapshotInterval is the default minimum time between snapshots
+	defaultMinimumSnapshotInterval = time.Minute
+
 	// defaultBytesPoolBucketCapacity is the default bytes buffer capacity for the default bytes pool bucket
 	defaultBytesPoolBucketCapacity = 256
 
@@ -121,6 +124,7 @@ type options struct {
 	bootstrapProcess               bootstrap.Process
 	persistManager                 persist.Manager
 	maxFlushRetries                int
+	minimumSnapshotInterval        time.Duration
 	blockRetrieverManager          block.DatabaseBlockRetrieverManager
 	poolOpts                       pool.ObjectPoolOptions
 	contextPool                    context.Pool
@@ -149,20 +153,21 @@ func newOptions(poolOpts pool.ObjectPoolOptions) Options {
 	bytesPool.Init()
 	seriesOpts := series.NewOptions()
 	o := &options{
-		clockOpts:           clock.NewOptions(),
-		instrumentOpts:      instrument.NewOptions(),
-		blockOpts:           block.NewOptions(),
-		commitLogOpts:       commitlog.NewOptions(),
-		runtimeOptsMgr:      runtime.NewOptionsManager(),
-		errCounterOpts:      xcounter.NewOptions(),
-		errWindowForLoad:    defaultErrorWindowForLoad,
-		errThresholdForLoad: defaultErrorThresholdForLoad,
-		indexingEnabled:     defaultIndexingEnabled,
-		repairEnabled:       defaultRepairEnabled,
-		repairOpts:          repair.NewOptions(),
-		bootstrapProcess:    defaultBootstrapProcess,
-		maxFlushRetries:     defaultMaxFlushRetries,
-		poolOpts:            poolOpts,
+		clockOpts:               clock.NewOptions(),
+		instrumentOpts:          instrument.NewOptions(),
+		blockOpts:               block.NewOptions(),
+		commitLogOpts:           commitlog.NewOptions(),
+		runtimeOptsMgr:          runtime.NewOptionsManager(),
+		errCounterOpts:          xcounter.NewOptions(),
+		errWindowForLoad:        defaultErrorWindowForLoad,
+		errThresholdForLoad:     defaultErrorThresholdForLoad,
+		indexingEnabled:         defaultIndexingEnabled,
+		repairEnabled:           defaultRepairEnabled,
+		repairOpts:              repair.NewOptions(),
+		bootstrapProcess:        defaultBootstrapProcess,
+		maxFlushRetries:         defaultMaxFlushRetries,
+		minimumSnapshotInterval: defaultMinimumSnapshotInterval,
+		poolOpts:                poolOpts,
 		contextPool: context.NewPool(context.NewOptions().
 			SetContextPoolOptions(poolOpts).
 			SetFinalizerPoolOptions(poolOpts)),
@@ -453,6 +458,16 @@ func (o *options) MaxFlushRetries() int {
 	return o.maxFlushRetries
 }
 
+func (o *options) SetMinimumSnapshotInterval(value time.Duration) Options {
+	opts := *o
+	opts.minimumSnapshotInterval = value
+	return &opts
+}
+
+func (o *options) MinimumSnapshotInterval() time.Duration {
+	return o.minimumSnapshotInterval
+}
+
 func (o *options) SetDatabaseBlockRetrieverManager(value block.DatabaseBlockRetrieverManager) Options {
 	opts := *o
 	opts.blockRetrieverManager = value
@@ -32,9 +32,13 @@ import (
 
 	"github.com/m3db/m3db/client"
 	"github.com/m3db/m3db/clock"
+	"github.com/m3db/m3db/persist"
 	"github.com/m3db/m3db/storage/block"
 	"github.com/m3db/m3db/storage/bootstrap/result"
+	"github.com/m3db/m3db/storage/namespace"
 	"github.com/m3db/m3db/storage/repair"
+	"github.com/m3db/m3db/topology"
+	"github.com/m3db/m3db/ts"
 	"github.com/m3db/m3x/context"
 	xerrors "github.com/m3db/m3x/errors"
 	"github.com/m3db/m3x/ident"
@@ -60,23 +64,53 @@ type shardRepairer struct {
 	logger   xlog.Logger
 	scope    tally.Scope
 	nowFn    clock.NowFn
+
+	// NB: The persist manager is shared with the flush manager so that
+	// repaired blocks are written subject to the same persist rate limit,
+	// starting a flush waits for any flush or repair already in progress.
+	persistManager persist.Manager
+	merger         *filesetMerger
+	metrics        shardRepairerMetrics
+}
+
+type shardRepairerMetrics struct {
+	fetchedBlocks   tally.Counter
+	persistedBlocks tally.Counter
+	skippedBlocks   tally.Counter
+	persistErrors   tally.Counter
+}
+
+func newShardRepairerMetrics(scope tally.Scope) shardRepairerMetrics {
+	return shardRepairerMetrics{
+		fetchedBlocks:   scope.Counter("fetched-blocks"),
+		persistedBlocks: scope.Counter("persisted-blocks"),
+		skippedBlocks:   scope.Counter("skipped-blocks"),
+		persistErrors:   scope.Counter("persist-errors"),
+	}
 }
 
-func newShardRepairer(opts Options, rpopts repair.Options) databaseShardRepairer {
+func newShardRepairer(
+	opts Options,
+	rpopts repair.Options,
+	merger *filesetMerger,
+) (databaseShardRepairer, error) {
 	iopts := opts.InstrumentOptions()
 	scope := iopts.MetricsScope().SubScope("repair")
 
 	r := shardRepairer{
-		opts:   opts,
-		rpopts: rpopts,
-		client: rpopts.AdminClient(),
-		logger: iopts.Logger(),
-		scope:  scope,
-		nowFn:  opts.ClockOptions().NowFn(),
+		opts:           opts,
+		rpopts:         rpopts,
+		client:         rpopts.AdminClient(),
+		logger:         iopts.Logger(),
+		scope:          scope,
+		nowFn:          opts.ClockOptions().NowFn(),
+		persistManager: opts.PersistManager(),
+		merger:         merger,
+		metrics:        newShardRepairerMetrics(scope),
 	}
 	r.recordFn = r.recordDifferences
 
-	return r
+	return r, nil
 }
 
 func (r shardRepairer) Options() repair.Options {
@@ -85,7 +119,7 @@ func (r shardRepairer) Options() repair.Options {
 
 func (r shardRepairer) Repair(
 	ctx context.Context,
-	namespace ident.ID,
+	nsMeta namespace.Metadata,
 	tr xtime.Range,
 	shard databaseShard,
 ) (repair.MetadataComparisonResult, error) {
@@ -95,10 +129,11 @@ func (r shardRepairer) Repair(
 	}
 
 	var (
-		start    = tr.Start
-		end      = tr.End
-		origin   = session.Origin()
-		replicas = session.Replicas()
+		namespace = nsMeta.ID()
+		start     = tr.Start
+		end       = tr.End
+		origin    = session.Origin()
+		replicas  = session.Replicas()
 	)
 
 	metadata := repair.NewReplicaMetadataComparer(replicas, r.rpopts)
@@ -132,9 +167,195 @@ func (r shardRepairer) Repair(
 
 	r.recordFn(namespace, shard, metadataRes)
 
+	if !nsMeta.Options().RepairFetchesBlocks() {
+		return metadataRes, nil
+	}
+
+	if err := r.repairDifferences(ctx, session, nsMeta, shard, origin, metadataRes); err != nil {
+		return repair.MetadataComparisonResult{}, err
+	}
+
 	return metadataRes, nil
 }
 
+type repairedBlocksByTime map[xtime.UnixNano]filesetBlocksByID
+
+func (b repairedBlocksByTime) add(id ident.ID, blk block.DatabaseBlock, idPool ident.Pool) {
+	start := xtime.ToUnixNano(blk.StartTime())
+	blocksByID, ok := b[start]
+	if !ok {
+		blocksByID = make(filesetBlocksByID)
+		b[start] = blocksByID
+	}
+	idHash := id.Hash()
+	if existing, ok := blocksByID[idHash]; ok {
+		// Merge blocks from several peers for the same series, the
+		// new block takes ownership of the existing one.
+		blk.Merge(existing.block)
+		blocksByID[idHash] = filesetBlock{id: existing.id, block: blk}
+		return
+	}
+	// NB: The ID is only valid until the iterator moves on
+	// so take a copy of it since we're holding onto it.
+	blocksByID[idHash] = filesetBlock{id: idPool.Clone(id), block: blk}
+}
+
+func (b repairedBlocksByTime) close() {
+	for _, blocksByID := range b {
+		for _, fb := range blocksByID {
+			fb.block.Close()
+			fb.id.Finalize()
+		}
+	}
+}
+
+// repairDifferences fetches the blocks that differ between the local host
+// and its peers, merges them with the local blocks and persists the result.
+func (r shardRepairer) repairDifferences(
+	ctx context.Context,
+	session client.AdminSession,
+	nsMeta namespace.Metadata,
+	shard databaseShard,
+	origin topology.Host,
+	metadataRes repair.MetadataComparisonResult,
+) error {
+	metadatas := peerReplicaMetadatas(origin,
+		metadataRes.SizeDifferences, metadataRes.ChecksumDifferences)
+	if len(metadatas) == 0 {
+		return nil
+	}
+
+	iter, err := session.FetchBlocksFromPeers(nsMeta, shard.ID(),
+		metadatas, result.NewOptions())
+	if err != nil {
+		return err
+	}
+
+	var (
+		idPool  = r.opts.IdentifierPool()
+		fetched = make(repairedBlocksByTime)
+	)
+	defer fetched.close()
+
+	for iter.Next() {
+		_, id, blk := iter.Current()
+		fetched.add(id, blk, idPool)
+		r.metrics.fetchedBlocks.Inc(1)
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for blockStart, blocksByID := range fetched {
+		// The blocks are owned by the persist whether it succeeds or not
+		delete(fetched, blockStart)
+		err := r.persistRepairedBlocks(ctx, nsMeta, shard, blockStart.ToTime(), blocksByID)
+		for _, fb := range blocksByID {
+			fb.id.Finalize()
+		}
+		if err != nil {
+			r.metrics.persistErrors.Inc(1)
+			multiErr = multiErr.Add(err)
+		}
+	}
+	return multiErr.FinalError()
+}
+
+// peerReplicaMetadatas returns the replica metadata for each differing block
+// held by a peer, skipping duplicates found in more than one set of differences.
+func peerReplicaMetadatas(
+	origin topology.Host,
+	differences ...repair.ReplicaSeriesMetadata,
+) []block.ReplicaMetadata {
+	type replicaKey struct {
+		id    ident.Hash
+		start xtime.UnixNano
+		host  string
+	}
+
+	var (
+		seen      = make(map[replicaKey]struct{})
+		metadatas []block.ReplicaMetadata
+	)
+	for _, diff := range differences {
+		for idHash, series := range diff.Series() {
+			for start, blk := range series.Metadata.Blocks() {
+				for _, hm := range blk.Metadata() {
+					if hm.Host.ID() == origin.ID() {
+						continue
+					}
+					if hm.Size == 0 && hm.Checksum == nil {
+						// Peer does not have this block
+						continue
+					}
+					key := replicaKey{id: idHash, start: start, host: hm.Host.ID()}
+					if _, ok := seen[key]; ok {
+						continue
+					}
+					seen[key] = struct{}{}
+					metadatas = append(metadatas, block.ReplicaMetadata{
+						Metadata: block.NewMetadata(blk.Start(), hm.Size, hm.Checksum, timeZero),
+						ID:       series.ID,
+						Host:     hm.Host,
+					})
+				}
+			}
+		}
+	}
+	return metadatas
+}
+
+// persistRepairedBlocks merges the repaired blocks with the local fileset
+// for the block start and writes the merged fileset as a new volume, which
+// only supersedes the existing fileset once complete. The blocks held in
+// memory are then replaced with the repaired blocks so that subsequent
+// repairs compare against the persisted data. The repaired blocks are closed
+// once persisted.
+func (r shardRepairer) persistRepairedBlocks(
+	ctx context.Context,
+	nsMeta namespace.Metadata,
+	shard databaseShard,
+	blockStart time.Time,
+	repaired filesetBlocksByID,
+) error {
+	// Only blocks that have been flushed are repaired, otherwise
+	// the repaired fileset would prevent the in-memory data from
+	// being flushed later.
+	if shard.FlushState(blockStart).Status != fileOpSuccess {
+		for _, fb := range repaired {
+			fb.block.Close()
+		}
+		r.metrics.skippedBlocks.Inc(int64(len(repaired)))
+		return nil
+	}
+
+	flush, err := r.persistManager.StartFlush()
+	if err != nil {
+		for _, fb := range repaired {
+			fb.block.Close()
+		}
+		return err
+	}
+
+	onPersisted := func(id ident.ID, segment ts.Segment) {
+		if _, ok := repaired[id.Hash()]; ok {
+			shard.OnRepairedBlock(id, blockStart, segment)
+		}
+	}
+
+	multiErr := xerrors.NewMultiError()
+	persisted, err := r.merger.Merge(ctx, flush, nsMeta, shard.ID(), blockStart, repaired, onPersisted)
+	if err != nil {
+		multiErr = multiErr.Add(err)
+	} else {
+		r.metrics.persistedBlocks.Inc(int64(persisted))
+	}
+	multiErr = multiErr.Add(flush.Done())
+
+	return multiErr.FinalError()
+}
+
 func (r shardRepairer) recordDifferences(
 	namespace ident.ID,
 	shard databaseShard,
@@ -243,7 +464,11 @@ type dbRepairer struct {
 	closed     bool
 }
 
-func newDatabaseRepairer(database database, opts Options) (databaseRepairer, error) {
+func newDatabaseRepairer(
+	database database,
+	merger *filesetMerger,
+	opts Options,
+) (databaseRepairer, error) {
 	nowFn := opts.ClockOptions().NowFn()
 	scope := opts.InstrumentOptions().MetricsScope()
 	ropts := opts.RepairOptions()
@@ -254,7 +479,10 @@ func newDatabaseRepairer(database database, opts Options) (databaseRepairer, err
 		return nil, err
 	}
 
-	shardRepairer := newShardRepairer(opts, ropts)
+	shardRepairer, err := newShardRepairer(opts, ropts, merger)
+	if err != nil {
+		return nil, err
+	}
 
 	var jitter time.Duration
 	if repairJitter := ropts.RepairTimeJitter(); repairJitter > 0 {
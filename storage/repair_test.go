@@ -21,17 +21,25 @@
 package storage
 
 import (
+	"bytes"
 	"errors"
+	"io/ioutil"
+	"os"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/m3db/m3db/client"
+	"github.com/m3db/m3db/digest"
+	"github.com/m3db/m3db/persist/fs"
 	"github.com/m3db/m3db/retention"
 	"github.com/m3db/m3db/storage/block"
+	"github.com/m3db/m3db/storage/bootstrap/result"
 	"github.com/m3db/m3db/storage/namespace"
 	"github.com/m3db/m3db/storage/repair"
 	"github.com/m3db/m3db/topology"
+	"github.com/m3db/m3db/ts"
+	"github.com/m3db/m3x/checked"
 	"github.com/m3db/m3x/context"
 	"github.com/m3db/m3x/ident"
 	xtime "github.com/m3db/m3x/time"
@@ -49,7 +57,7 @@ func TestDatabaseRepairerStartStop(t *testing.T) {
 	db := NewMockdatabase(ctrl)
 	db.EXPECT().Options().Return(opts).AnyTimes()
 
-	databaseRepairer, err := newDatabaseRepairer(db, opts)
+	databaseRepairer, err := newDatabaseRepairer(db, nil, opts)
 	require.NoError(t, err)
 	repairer := databaseRepairer.(*dbRepairer)
 
@@ -115,7 +123,7 @@ func TestDatabaseRepairerHaveNotReachedOffset(t *testing.T) {
 	mockDatabase := NewMockdatabase(ctrl)
 	mockDatabase.EXPECT().Options().Return(opts).AnyTimes()
 
-	databaseRepairer, err := newDatabaseRepairer(mockDatabase, opts)
+	databaseRepairer, err := newDatabaseRepairer(mockDatabase, nil, opts)
 	require.NoError(t, err)
 	repairer := databaseRepairer.(*dbRepairer)
 
@@ -172,7 +180,7 @@ func TestDatabaseRepairerOnlyOncePerInterval(t *testing.T) {
 	mockDatabase := NewMockdatabase(ctrl)
 	mockDatabase.EXPECT().Options().Return(opts).AnyTimes()
 
-	databaseRepairer, err := newDatabaseRepairer(mockDatabase, opts)
+	databaseRepairer, err := newDatabaseRepairer(mockDatabase, nil, opts)
 	require.NoError(t, err)
 	repairer := databaseRepairer.(*dbRepairer)
 
@@ -200,7 +208,7 @@ func TestDatabaseRepairerRepairNotBootstrapped(t *testing.T) {
 	opts := testDatabaseOptions().SetRepairOptions(testRepairOptions(ctrl))
 	mockDatabase := NewMockdatabase(ctrl)
 
-	databaseRepairer, err := newDatabaseRepairer(mockDatabase, opts)
+	databaseRepairer, err := newDatabaseRepairer(mockDatabase, nil, opts)
 	require.NoError(t, err)
 	repairer := databaseRepairer.(*dbRepairer)
 
@@ -231,8 +239,11 @@ func TestDatabaseShardRepairerRepair(t *testing.T) {
 		SetClockOptions(copts.SetNowFn(nowFn)).
 		SetInstrumentOptions(iopts.SetMetricsScope(tally.NoopScope))
 
+	md, err := namespace.NewMetadata(ident.StringID("testNamespace"), namespace.NewOptions())
+	require.NoError(t, err)
+
 	var (
-		namespace       = ident.StringID("testNamespace")
+		namespace       = md.ID()
 		start           = now
 		end             = now.Add(rtopts.BlockSize())
 		repairTimeRange = xtime.Range{Start: start, End: end}
@@ -255,11 +266,11 @@ func TestDatabaseShardRepairerRepair(t *testing.T) {
 		sizes[0], &checksums[0], lastRead, nil))
 	results.Add(block.NewFetchBlockMetadataResult(now.Add(time.Hour),
 		sizes[1], &checksums[1], lastRead, nil))
-	expectedResults.Add(block.NewFetchBlocksMetadataResult(ident.StringID("foo"), results))
+	expectedResults.Add(block.NewFetchBlocksMetadataResult(ident.StringID("foo"), nil, results))
 	results = block.NewFetchBlockMetadataResults()
 	results.Add(block.NewFetchBlockMetadataResult(now.Add(30*time.Minute),
 		sizes[2], &checksums[2], lastRead, nil))
-	expectedResults.Add(block.NewFetchBlocksMetadataResult(ident.StringID("bar"), results))
+	expectedResults.Add(block.NewFetchBlocksMetadataResult(ident.StringID("bar"), nil, results))
 
 	any := gomock.Any()
 	shard.EXPECT().
@@ -300,7 +311,8 @@ func TestDatabaseShardRepairerRepair(t *testing.T) {
 		resDiff      repair.MetadataComparisonResult
 	)
 
-	databaseShardRepairer := newShardRepairer(opts, rpOpts)
+	databaseShardRepairer, err := newShardRepairer(opts, rpOpts, nil)
+	require.NoError(t, err)
 	repairer := databaseShardRepairer.(shardRepairer)
 	repairer.recordFn = func(namespace ident.ID, shard databaseShard, diffRes repair.MetadataComparisonResult) {
 		resNamespace = namespace
@@ -309,7 +321,7 @@ func TestDatabaseShardRepairerRepair(t *testing.T) {
 	}
 
 	ctx := context.NewContext()
-	repairer.Repair(ctx, namespace, repairTimeRange, shard)
+	repairer.Repair(ctx, md, repairTimeRange, shard)
 	require.Equal(t, namespace, resNamespace)
 	require.Equal(t, resShard, shard)
 	require.Equal(t, int64(2), resDiff.NumSeries)
@@ -331,6 +343,198 @@ func TestDatabaseShardRepairerRepair(t *testing.T) {
 	require.Equal(t, expected, block.Metadata())
 }
 
+func TestDatabaseShardRepairerRepairFetchesBlocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir, err := ioutil.TempDir("", "testdir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	opts := testDatabaseOptions()
+	opts = opts.
+		SetInstrumentOptions(opts.InstrumentOptions().SetMetricsScope(tally.NoopScope)).
+		SetCommitLogOptions(opts.CommitLogOptions().
+			SetFilesystemOptions(opts.CommitLogOptions().FilesystemOptions().
+				SetFilePathPrefix(dir)))
+
+	var (
+		rtopts     = defaultTestRetentionOpts
+		blockSize  = rtopts.BlockSize()
+		blockStart = time.Now().Truncate(blockSize).Add(-2 * blockSize)
+		tr         = xtime.Range{Start: blockStart, End: blockStart.Add(blockSize)}
+		shardID    = uint32(0)
+		origin     = topology.NewHost("0", "addr0")
+		peer       = topology.NewHost("1", "addr1")
+		fooID      = ident.StringID("foo")
+		fooTags    = ident.Tags{{Name: ident.StringID("city"), Value: ident.StringID("nyc")}}
+		barID      = ident.StringID("bar")
+		nsOpts     = namespace.NewOptions().SetRepairFetchesBlocks(true)
+	)
+	md, err := namespace.NewMetadata(ident.StringID("testNamespace"), nsOpts)
+	require.NoError(t, err)
+
+	encode := func(dps []ts.Datapoint) ts.Segment {
+		enc := opts.EncoderPool().Get()
+		enc.Reset(blockStart, 0)
+		for _, dp := range dps {
+			require.NoError(t, enc.Encode(dp, xtime.Second, nil))
+		}
+		return enc.Discard()
+	}
+
+	var (
+		localFoo = []ts.Datapoint{{Timestamp: blockStart.Add(time.Minute), Value: 1.0}}
+		peerFoo  = []ts.Datapoint{{Timestamp: blockStart.Add(2 * time.Minute), Value: 2.0}}
+		localBar = []ts.Datapoint{{Timestamp: blockStart.Add(time.Minute), Value: 3.0}}
+		fooSeg   = encode(localFoo)
+		barSeg   = encode(localBar)
+		peerSeg  = encode(peerFoo)
+	)
+
+	// Write the local fileset
+	fsOpts := opts.CommitLogOptions().FilesystemOptions()
+	w, err := fs.NewWriter(fsOpts)
+	require.NoError(t, err)
+	require.NoError(t, w.Open(md.ID(), blockSize, shardID, blockStart))
+	require.NoError(t, w.WriteAll(fooID, fooTags, []checked.Bytes{fooSeg.Head, fooSeg.Tail},
+		digest.SegmentChecksum(fooSeg)))
+	require.NoError(t, w.WriteAll(barID, nil, []checked.Bytes{barSeg.Head, barSeg.Tail},
+		digest.SegmentChecksum(barSeg)))
+	require.NoError(t, w.Close())
+
+	pm, err := fs.NewPersistManager(fsOpts)
+	require.NoError(t, err)
+	opts = opts.SetPersistManager(pm)
+
+	var (
+		fooChecksum      = digest.SegmentChecksum(fooSeg)
+		peerChecksum     = digest.SegmentChecksum(peerSeg)
+		repairedChecksum uint32
+	)
+
+	shard := NewMockdatabaseShard(ctrl)
+	shard.EXPECT().ID().Return(shardID).AnyTimes()
+	shard.EXPECT().FlushState(blockStart).Return(fileOpState{Status: fileOpSuccess})
+	// Only the repaired series is replaced in memory
+	shard.EXPECT().
+		OnRepairedBlock(gomock.Any(), blockStart, gomock.Any()).
+		Do(func(id ident.ID, _ time.Time, segment ts.Segment) {
+			require.True(t, fooID.Equal(id))
+			repairedChecksum = digest.SegmentChecksum(segment)
+		})
+
+	localResults := block.NewFetchBlockMetadataResults()
+	localResults.Add(block.NewFetchBlockMetadataResult(blockStart,
+		int64(fooSeg.Len()), &fooChecksum, timeZero, nil))
+	localMetadata := block.NewFetchBlocksMetadataResults()
+	localMetadata.Add(block.NewFetchBlocksMetadataResult(fooID, nil, localResults))
+	shard.EXPECT().
+		FetchBlocksMetadata(gomock.Any(), tr.Start, tr.End, gomock.Any(), int64(0), gomock.Any()).
+		Return(localMetadata, nil, nil)
+
+	peerMetadataIter := client.NewMockPeerBlocksMetadataIter(ctrl)
+	gomock.InOrder(
+		peerMetadataIter.EXPECT().Next().Return(true),
+		peerMetadataIter.EXPECT().Current().Return(peer, block.NewBlocksMetadata(fooID, []block.Metadata{
+			block.NewMetadata(blockStart, int64(peerSeg.Len()), &peerChecksum, timeZero),
+		})),
+		peerMetadataIter.EXPECT().Next().Return(false),
+		peerMetadataIter.EXPECT().Err().Return(nil),
+	)
+
+	peerBlock := block.NewDatabaseBlock(blockStart, peerSeg, opts.DatabaseBlockOptions())
+	peerBlocksIter := client.NewMockPeerBlocksIter(ctrl)
+	gomock.InOrder(
+		peerBlocksIter.EXPECT().Next().Return(true),
+		peerBlocksIter.EXPECT().Current().Return(peer, fooID, peerBlock),
+		peerBlocksIter.EXPECT().Next().Return(false),
+		peerBlocksIter.EXPECT().Err().Return(nil),
+	)
+
+	session := client.NewMockAdminSession(ctrl)
+	session.EXPECT().Origin().Return(origin)
+	session.EXPECT().Replicas().Return(2)
+	session.EXPECT().
+		FetchBlocksMetadataFromPeers(md.ID(), shardID, tr.Start, tr.End,
+			gomock.Any(), client.FetchBlocksMetadataEndpointV2).
+		Return(peerMetadataIter, nil)
+	session.EXPECT().
+		FetchBlocksFromPeers(md, shardID, gomock.Any(), gomock.Any()).
+		Do(func(_ namespace.Metadata, _ uint32, metadatas []block.ReplicaMetadata, _ result.Options) {
+			require.Equal(t, 1, len(metadatas))
+			require.True(t, fooID.Equal(metadatas[0].ID))
+			require.Equal(t, peer.ID(), metadatas[0].Host.ID())
+			require.Equal(t, blockStart, metadatas[0].Start)
+		}).
+		Return(peerBlocksIter, nil)
+
+	mockClient := client.NewMockAdminClient(ctrl)
+	mockClient.EXPECT().DefaultAdminSession().Return(session, nil)
+	rpOpts := testRepairOptions(ctrl).SetAdminClient(mockClient)
+
+	merger, err := newFilesetMerger(opts)
+	require.NoError(t, err)
+
+	repairer, err := newShardRepairer(opts, rpOpts, merger)
+	require.NoError(t, err)
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	res, err := repairer.Repair(ctx, md, tr, shard)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.ChecksumDifferences.NumBlocks())
+
+	// Verify the fileset now holds the merged data
+	r, err := fs.NewReader(nil, fsOpts)
+	require.NoError(t, err)
+	require.NoError(t, r.Open(md.ID(), shardID, blockStart))
+	defer r.Close()
+	require.Equal(t, 1, r.Status().Volume)
+
+	expected := map[string][]ts.Datapoint{
+		fooID.String(): append(localFoo, peerFoo...),
+		barID.String(): localBar,
+	}
+	require.Equal(t, len(expected), r.Entries())
+	for i := 0; i < len(expected); i++ {
+		id, tags, data, _, err := r.Read()
+		require.NoError(t, err)
+
+		dps, ok := expected[id.String()]
+		require.True(t, ok)
+
+		data.IncRef()
+
+		// Tags of the local fileset are kept when merging repaired blocks
+		if id.Equal(fooID) {
+			require.Equal(t, 1, len(tags))
+			require.Equal(t, "city", tags[0].Name.String())
+			require.Equal(t, "nyc", tags[0].Value.String())
+			require.Equal(t, repairedChecksum, digest.Checksum(data.Get()))
+		}
+
+		iter := opts.ReaderIteratorPool().Get()
+		iter.Reset(bytes.NewReader(data.Get()))
+		var decoded []ts.Datapoint
+		for iter.Next() {
+			dp, _, _ := iter.Current()
+			decoded = append(decoded, dp)
+		}
+		require.NoError(t, iter.Err())
+		iter.Close()
+		data.DecRef()
+
+		require.Equal(t, len(dps), len(decoded))
+		for j := range dps {
+			require.True(t, dps[j].Timestamp.Equal(decoded[j].Timestamp))
+			require.Equal(t, dps[j].Value, decoded[j].Value)
+		}
+	}
+	require.NoError(t, r.Validate())
+}
+
 func TestRepairerRepairTimes(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -351,7 +555,7 @@ func TestRepairerRepairTimes(t *testing.T) {
 		{time.Unix(36000, 0), repairState{repairNotStarted, 0}},
 		{time.Unix(43200, 0), repairState{repairSuccess, 1}},
 	}
-	repairer, err := newDatabaseRepairer(database, opts)
+	repairer, err := newDatabaseRepairer(database, nil, opts)
 	require.NoError(t, err)
 	r := repairer.(*dbRepairer)
 	for _, input := range inputTimes {
@@ -376,7 +580,7 @@ func TestRepairerRepairWithTime(t *testing.T) {
 	database := NewMockdatabase(ctrl)
 	database.EXPECT().Options().Return(opts).AnyTimes()
 
-	repairer, err := newDatabaseRepairer(database, opts)
+	repairer, err := newDatabaseRepairer(database, nil, opts)
 	require.NoError(t, err)
 	r := repairer.(*dbRepairer)
 
@@ -444,7 +648,7 @@ func TestRepairerTimesMultipleNamespaces(t *testing.T) {
 		{defaultTestNs2ID, tf4(4), repairState{repairNotStarted, 0}},
 		{defaultTestNs2ID, tf4(6), repairState{repairSuccess, 1}},
 	}
-	repairer, err := newDatabaseRepairer(database, opts)
+	repairer, err := newDatabaseRepairer(database, nil, opts)
 	require.NoError(t, err)
 	r := repairer.(*dbRepairer)
 	for _, input := range inputTimes {
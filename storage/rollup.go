@@ -0,0 +1,321 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3db/encoding"
+	"github.com/m3db/m3db/persist"
+	"github.com/m3db/m3db/persist/fs"
+	"github.com/m3db/m3db/storage/block"
+	"github.com/m3db/m3db/storage/namespace"
+	"github.com/m3db/m3db/ts"
+	"github.com/m3db/m3db/x/xio"
+	xerrors "github.com/m3db/m3x/errors"
+	"github.com/m3db/m3x/ident"
+	xtime "github.com/m3db/m3x/time"
+)
+
+// filesetRollup aggregates the filesets flushed by a source namespace at the
+// resolution of each namespace fed rollups from it and merges the aggregated
+// series into the filesets of those namespaces.
+type filesetRollup struct {
+	sync.Mutex
+
+	opts   Options
+	reader fs.FileSetReader
+	merger *filesetMerger
+}
+
+func newFilesetRollup(opts Options, merger *filesetMerger) (*filesetRollup, error) {
+	fsOpts := opts.CommitLogOptions().FilesystemOptions()
+	reader, err := fs.NewReader(opts.BytesPool(), fsOpts)
+	if err != nil {
+		return nil, err
+	}
+	return &filesetRollup{
+		opts:   opts,
+		reader: reader,
+		merger: merger,
+	}, nil
+}
+
+// rollupTargets returns the namespaces fed rollups by each source namespace.
+func rollupTargets(namespaces []databaseNamespace) map[ident.Hash][]databaseNamespace {
+	targets := make(map[ident.Hash][]databaseNamespace)
+	for _, ns := range namespaces {
+		rollupOpts := ns.Options().RollupOptions()
+		if rollupOpts == nil {
+			continue
+		}
+		sourceHash := rollupOpts.SourceNamespace().Hash()
+		targets[sourceHash] = append(targets[sourceHash], ns)
+	}
+	return targets
+}
+
+// Rollup rolls up the flushed block of each shard of the source namespace
+// into the target namespace.
+func (r *filesetRollup) Rollup(
+	flush persist.Flush,
+	source databaseNamespace,
+	target databaseNamespace,
+	blockStart time.Time,
+) error {
+	targetMeta, err := namespace.NewMetadata(target.ID(), target.Options())
+	if err != nil {
+		return err
+	}
+	sourceBlockSize := source.Options().RetentionOptions().BlockSize()
+	if sourceBlockSize%targetMeta.Options().RollupOptions().Resolution() != 0 {
+		// Otherwise a rollup interval would span two source blocks and only
+		// the aggregate of the latter would be kept
+		return fmt.Errorf("rollup resolution %v does not evenly divide block size %v",
+			targetMeta.Options().RollupOptions().Resolution(), sourceBlockSize)
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for _, shard := range source.GetOwnedShards() {
+		if err := r.rollupShard(flush, source.ID(), targetMeta, shard.ID(), blockStart); err != nil {
+			detailedErr := fmt.Errorf("shard %d failed to roll up block %v: %v",
+				shard.ID(), blockStart, err)
+			multiErr = multiErr.Add(detailedErr)
+		}
+	}
+	return multiErr.FinalError()
+}
+
+func (r *filesetRollup) rollupShard(
+	flush persist.Flush,
+	source ident.ID,
+	targetMeta namespace.Metadata,
+	shard uint32,
+	blockStart time.Time,
+) error {
+	// NB: The reader is not thread safe, the lock is released before
+	// merging as the merger has its own.
+	r.Lock()
+	blocksByStart, ids, err := r.aggregateFileset(source, targetMeta, shard, blockStart)
+	r.Unlock()
+	defer func() {
+		for _, id := range ids {
+			id.Finalize()
+		}
+	}()
+	if err != nil {
+		return err
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for start, blocks := range blocksByStart {
+		ctx := r.opts.ContextPool().Get()
+		_, err := r.merger.Merge(ctx, flush, targetMeta, shard, start.ToTime(), blocks, nil)
+		ctx.BlockingClose()
+		multiErr = multiErr.Add(err)
+	}
+	return multiErr.FinalError()
+}
+
+// aggregateFileset aggregates each series of a fileset into blocks of the
+// target namespace. The IDs of the series are returned to be finalized once
+// the blocks have been merged.
+func (r *filesetRollup) aggregateFileset(
+	source ident.ID,
+	targetMeta namespace.Metadata,
+	shard uint32,
+	blockStart time.Time,
+) (map[xtime.UnixNano]filesetBlocksByID, []ident.ID, error) {
+	prefix := r.opts.CommitLogOptions().FilesystemOptions().FilePathPrefix()
+	if !fs.FilesetExistsAt(prefix, source, shard, blockStart) {
+		return nil, nil, nil
+	}
+	if err := r.reader.Open(source, shard, blockStart); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		blocksByStart = make(map[xtime.UnixNano]filesetBlocksByID)
+		ids           []ident.ID
+	)
+	for {
+		id, tags, data, _, err := r.reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err == nil {
+			ids = append(ids, id)
+			segReader := xio.NewSegmentReader(ts.NewSegment(data, nil, ts.FinalizeHead))
+			err = r.aggregateSeries(id, tags, segReader, targetMeta.Options(), blocksByStart)
+		}
+		if err != nil {
+			r.reader.Close()
+			closeRollupBlocks(blocksByStart)
+			return nil, ids, err
+		}
+	}
+	if err := r.reader.Close(); err != nil {
+		closeRollupBlocks(blocksByStart)
+		return nil, ids, err
+	}
+	return blocksByStart, ids, nil
+}
+
+func (r *filesetRollup) aggregateSeries(
+	id ident.ID,
+	tags ident.Tags,
+	data xio.SegmentReader,
+	targetOpts namespace.Options,
+	blocksByStart map[xtime.UnixNano]filesetBlocksByID,
+) error {
+	var (
+		rollupOpts  = targetOpts.RollupOptions()
+		resolution  = rollupOpts.Resolution()
+		blockSize   = targetOpts.RetentionOptions().BlockSize()
+		blockOpts   = r.opts.DatabaseBlockOptions()
+		encoderPool = r.opts.EncoderPool()
+		encoders    = make(map[xtime.UnixNano]encoding.Encoder)
+		agg         = rollupAggregator{aggregation: rollupOpts.Aggregation()}
+		bucketStart time.Time
+		unit        xtime.Unit
+	)
+
+	encodeBucket := func() error {
+		if agg.count == 0 {
+			return nil
+		}
+		start := xtime.ToUnixNano(bucketStart.Truncate(blockSize))
+		enc, ok := encoders[start]
+		if !ok {
+			enc = encoderPool.Get()
+			enc.Reset(start.ToTime(), blockOpts.DatabaseBlockAllocSize())
+			encoders[start] = enc
+		}
+		dp := ts.Datapoint{Timestamp: bucketStart, Value: agg.value()}
+		return enc.Encode(dp, unit, nil)
+	}
+
+	iter := r.opts.ReaderIteratorPool().Get()
+	iter.Reset(data)
+	defer func() {
+		iter.Close()
+		data.Finalize()
+	}()
+
+	var err error
+	for err == nil && iter.Next() {
+		dp, dpUnit, _ := iter.Current()
+		if curr := dp.Timestamp.Truncate(resolution); !curr.Equal(bucketStart) {
+			err = encodeBucket()
+			bucketStart = curr
+			agg.reset()
+		}
+		unit = dpUnit
+		agg.add(dp.Value)
+	}
+	if err == nil {
+		err = iter.Err()
+	}
+	if err == nil {
+		err = encodeBucket()
+	}
+	if err != nil {
+		for _, enc := range encoders {
+			enc.Close()
+		}
+		return err
+	}
+
+	for start, enc := range encoders {
+		blocks, ok := blocksByStart[start]
+		if !ok {
+			blocks = make(filesetBlocksByID)
+			blocksByStart[start] = blocks
+		}
+		blocks[id.Hash()] = filesetBlock{
+			id:    id,
+			tags:  tags,
+			block: block.NewDatabaseBlock(start.ToTime(), enc.Discard(), blockOpts),
+		}
+	}
+	return nil
+}
+
+func closeRollupBlocks(blocksByStart map[xtime.UnixNano]filesetBlocksByID) {
+	for _, blocks := range blocksByStart {
+		for _, fb := range blocks {
+			fb.block.Close()
+		}
+	}
+}
+
+// rollupAggregator aggregates the datapoints within a single rollup interval.
+type rollupAggregator struct {
+	aggregation namespace.AggregationType
+	count       int64
+	sum         float64
+	min         float64
+	max         float64
+	last        float64
+}
+
+func (a *rollupAggregator) reset() {
+	a.count = 0
+	a.sum = 0
+	a.min = 0
+	a.max = 0
+	a.last = 0
+}
+
+func (a *rollupAggregator) add(v float64) {
+	if math.IsNaN(v) {
+		return
+	}
+	if a.count == 0 || v < a.min {
+		a.min = v
+	}
+	if a.count == 0 || v > a.max {
+		a.max = v
+	}
+	a.count++
+	a.sum += v
+	a.last = v
+}
+
+func (a *rollupAggregator) value() float64 {
+	switch a.aggregation {
+	case namespace.AggregationMin:
+		return a.min
+	case namespace.AggregationMax:
+		return a.max
+	case namespace.AggregationSum:
+		return a.sum
+	case namespace.AggregationCount:
+		return float64(a.count)
+	case namespace.AggregationLast:
+		return a.last
+	}
+	return a.sum / float64(a.count)
+}
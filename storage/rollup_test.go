@@ -0,0 +1,89 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3db/storage/namespace"
+	"github.com/m3db/m3x/ident"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollupAggregator(t *testing.T) {
+	values := []float64{3, 1, math.NaN(), 4, 2}
+	tests := []struct {
+		aggregation namespace.AggregationType
+		expected    float64
+	}{
+		{aggregation: namespace.AggregationMean, expected: 2.5},
+		{aggregation: namespace.AggregationMin, expected: 1},
+		{aggregation: namespace.AggregationMax, expected: 4},
+		{aggregation: namespace.AggregationSum, expected: 10},
+		{aggregation: namespace.AggregationCount, expected: 4},
+		{aggregation: namespace.AggregationLast, expected: 2},
+	}
+
+	for _, test := range tests {
+		agg := rollupAggregator{aggregation: test.aggregation}
+		for _, v := range values {
+			agg.add(v)
+		}
+		require.Equal(t, test.expected, agg.value(), test.aggregation.String())
+
+		agg.reset()
+		agg.add(-1)
+		if test.aggregation == namespace.AggregationCount {
+			require.Equal(t, float64(1), agg.value())
+		} else {
+			require.Equal(t, float64(-1), agg.value(), test.aggregation.String())
+		}
+	}
+}
+
+func TestRollupTargets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rollupOpts := func(source string) namespace.RollupOptions {
+		return namespace.NewRollupOptions().
+			SetSourceNamespace(ident.StringID(source)).
+			SetResolution(time.Minute)
+	}
+
+	raw := NewMockdatabaseNamespace(ctrl)
+	raw.EXPECT().Options().Return(namespace.NewOptions()).AnyTimes()
+	rollup1m := NewMockdatabaseNamespace(ctrl)
+	rollup1m.EXPECT().Options().Return(namespace.NewOptions().
+		SetRollupOptions(rollupOpts("raw"))).AnyTimes()
+	rollup10m := NewMockdatabaseNamespace(ctrl)
+	rollup10m.EXPECT().Options().Return(namespace.NewOptions().
+		SetRollupOptions(rollupOpts("raw"))).AnyTimes()
+
+	targets := rollupTargets([]databaseNamespace{raw, rollup1m, rollup10m})
+	require.Equal(t, 1, len(targets))
+	require.Equal(t, []databaseNamespace{rollup1m, rollup10m},
+		targets[ident.StringID("raw").Hash()])
+}
@@ -29,6 +29,7 @@ import (
 
 	"github.com/m3db/m3db/clock"
 	"github.com/m3db/m3db/encoding"
+	"github.com/m3db/m3db/retention"
 	"github.com/m3db/m3db/storage/block"
 	"github.com/m3db/m3db/ts"
 	"github.com/m3db/m3db/x/xio"
@@ -99,6 +100,18 @@ type databaseBuffer interface {
 
 	Bootstrap(bl block.DatabaseBlock) error
 
+	ColdStreams(ctx context.Context, blockStart time.Time) []xio.SegmentReader
+
+	SnapshotStreams(ctx context.Context, blockStart time.Time) []xio.SegmentReader
+
+	ColdStreamsLen(blockStart time.Time) int
+
+	ColdBlock(blockStart time.Time) (block.DatabaseBlock, int, error)
+
+	DiscardCold(blockStart time.Time, version int) (block.DatabaseBlock, error)
+
+	BootstrapCold(bl block.DatabaseBlock)
+
 	Reset(opts Options)
 }
 
@@ -124,6 +137,11 @@ type dbBuffer struct {
 	blockSize         time.Duration
 	bufferPast        time.Duration
 	bufferFuture      time.Duration
+	coldWritesEnabled bool
+
+	// coldBuckets holds writes outside of the buffer past and future window
+	// for blocks no longer held by the buffer, until they are cold flushed.
+	coldBuckets map[xtime.UnixNano]*dbBufferBucket
 }
 
 type databaseBufferDrainFn func(b block.DatabaseBlock)
@@ -144,10 +162,19 @@ func (b *dbBuffer) Reset(opts Options) {
 	b.blockSize = ropts.BlockSize()
 	b.bufferPast = ropts.BufferPast()
 	b.bufferFuture = ropts.BufferFuture()
+	b.coldWritesEnabled = opts.ColdWritesEnabled()
+	b.resetColdBuckets()
 	// Avoid capturing any variables with callback
 	b.computedForEachBucketAsc(computeAndResetBucketIdx, bucketResetStart)
 }
 
+func (b *dbBuffer) resetColdBuckets() {
+	for t, bucket := range b.coldBuckets {
+		bucket.finalize()
+		delete(b.coldBuckets, t)
+	}
+}
+
 func bucketResetStart(now time.Time, b *dbBuffer, idx int, start time.Time) int {
 	b.buckets[idx].opts = b.opts
 	b.buckets[idx].resetTo(start)
@@ -181,7 +208,10 @@ func (b *dbBuffer) Write(
 		return xerrors.NewInvalidParamsError(errTooFuture)
 	}
 	if !pastLimit.Before(timestamp) {
-		return xerrors.NewInvalidParamsError(errTooPast)
+		if !b.coldWritesEnabled {
+			return xerrors.NewInvalidParamsError(errTooPast)
+		}
+		return b.writeCold(now, timestamp, value, unit, annotation)
 	}
 
 	bucketStart := timestamp.Truncate(b.blockSize)
@@ -194,6 +224,55 @@ func (b *dbBuffer) Write(
 	return b.buckets[idx].write(timestamp, value, unit, annotation)
 }
 
+func (b *dbBuffer) writeCold(
+	now time.Time,
+	timestamp time.Time,
+	value float64,
+	unit xtime.Unit,
+	annotation []byte,
+) error {
+	blockStart := timestamp.Truncate(b.blockSize)
+	if blockStart.Before(retention.FlushTimeStart(b.opts.RetentionOptions(), now)) {
+		return xerrors.NewInvalidParamsError(errTooPast)
+	}
+
+	// If the block is still held by the buffer then write to it directly, it
+	// will be drained and flushed along with the rest of the block.
+	pastMostBucketStart := now.Truncate(b.blockSize).Add(-1 * b.blockSize)
+	if !blockStart.Before(pastMostBucketStart) {
+		idx := b.writableBucketIdx(timestamp)
+		if b.buckets[idx].needsReset(blockStart) {
+			b.DrainAndReset()
+		}
+		if !b.buckets[idx].drained {
+			return b.buckets[idx].write(timestamp, value, unit, annotation)
+		}
+	}
+
+	bucket := b.coldBucket(blockStart)
+	if err := bucket.write(timestamp, value, unit, annotation); err != nil {
+		return err
+	}
+	bucket.version++
+	return nil
+}
+
+// coldBucket returns the cold bucket for a block start, creating it if
+// it does not exist yet.
+func (b *dbBuffer) coldBucket(blockStart time.Time) *dbBufferBucket {
+	if b.coldBuckets == nil {
+		b.coldBuckets = make(map[xtime.UnixNano]*dbBufferBucket)
+	}
+	key := xtime.ToUnixNano(blockStart)
+	bucket, ok := b.coldBuckets[key]
+	if !ok {
+		bucket = &dbBufferBucket{opts: b.opts}
+		bucket.resetTo(blockStart)
+		b.coldBuckets[key] = bucket
+	}
+	return bucket
+}
+
 func (b *dbBuffer) writableBucketIdx(t time.Time) int {
 	return int(t.Truncate(b.blockSize).UnixNano() / int64(b.blockSize) % bucketsLen)
 }
@@ -203,6 +282,9 @@ func (b *dbBuffer) IsEmpty() bool {
 	for i := range b.buckets {
 		canReadAny = canReadAny || b.buckets[i].canRead()
 	}
+	for _, bucket := range b.coldBuckets {
+		canReadAny = canReadAny || bucket.canRead()
+	}
 	return !canReadAny
 }
 
@@ -218,6 +300,11 @@ func (b *dbBuffer) Stats() bufferStats {
 		}
 		stats.wiredBlocks++
 	}
+	for _, bucket := range b.coldBuckets {
+		if bucket.canRead() {
+			stats.wiredBlocks++
+		}
+	}
 	return stats
 }
 
@@ -236,11 +323,42 @@ func bucketNeedsDrain(now time.Time, b *dbBuffer, idx int, start time.Time) int
 func (b *dbBuffer) Tick() bufferTickResult {
 	// Avoid capturing any variables with callback
 	mergedOutOfOrder := b.computedForEachBucketAsc(computeAndResetBucketIdx, bucketTick)
+	mergedOutOfOrder += b.tickColdBuckets()
 	return bufferTickResult{
 		mergedOutOfOrderBlocks: mergedOutOfOrder,
 	}
 }
 
+func (b *dbBuffer) tickColdBuckets() int {
+	if len(b.coldBuckets) == 0 {
+		return 0
+	}
+
+	var (
+		mergedOutOfOrderBlocks int
+		expireCutoff           = retention.FlushTimeStart(b.opts.RetentionOptions(), b.nowFn())
+	)
+	for t, bucket := range b.coldBuckets {
+		if t.ToTime().Before(expireCutoff) || !bucket.canRead() {
+			bucket.finalize()
+			delete(b.coldBuckets, t)
+			continue
+		}
+		if !bucket.needsMerge() {
+			continue
+		}
+		r, err := bucket.merge()
+		if err != nil {
+			log := b.opts.InstrumentOptions().Logger()
+			log.Errorf("buffer cold merge encode error: %v", err)
+		}
+		if r.merges > 0 {
+			mergedOutOfOrderBlocks++
+		}
+	}
+	return mergedOutOfOrderBlocks
+}
+
 func bucketTick(now time.Time, b *dbBuffer, idx int, start time.Time) int {
 	// Perform a drain and reset if necessary
 	mergedOutOfOrderBlocks := bucketDrainAndReset(now, b, idx, start)
@@ -321,6 +439,90 @@ func (b *dbBuffer) Bootstrap(bl block.DatabaseBlock) error {
 	return nil
 }
 
+func (b *dbBuffer) BootstrapCold(bl block.DatabaseBlock) {
+	blockStart := bl.StartTime()
+	for i := range b.buckets {
+		if b.buckets[i].start.Equal(blockStart) && !b.buckets[i].drained {
+			b.buckets[i].bootstrap(bl)
+			return
+		}
+	}
+	bucket := b.coldBucket(blockStart)
+	bucket.bootstrap(bl)
+	bucket.version++
+}
+
+func (b *dbBuffer) ColdStreams(ctx context.Context, blockStart time.Time) []xio.SegmentReader {
+	bucket, ok := b.coldBuckets[xtime.ToUnixNano(blockStart)]
+	if !ok || !bucket.canRead() {
+		return nil
+	}
+	return bucket.streams(ctx)
+}
+
+// SnapshotStreams returns the streams of all data held by the buffer for a
+// given block start, both from the buckets taking writes and any cold writes.
+func (b *dbBuffer) SnapshotStreams(ctx context.Context, blockStart time.Time) []xio.SegmentReader {
+	var res []xio.SegmentReader
+	b.forEachBucketAsc(func(bucket *dbBufferBucket) {
+		if !bucket.canRead() || !bucket.start.Equal(blockStart) {
+			return
+		}
+		res = append(res, bucket.streams(ctx)...)
+	})
+	return append(res, b.ColdStreams(ctx, blockStart)...)
+}
+
+func (b *dbBuffer) ColdStreamsLen(blockStart time.Time) int {
+	bucket, ok := b.coldBuckets[xtime.ToUnixNano(blockStart)]
+	if !ok || !bucket.canRead() {
+		return 0
+	}
+	return bucket.streamsLen()
+}
+
+func (b *dbBuffer) ColdBlock(blockStart time.Time) (block.DatabaseBlock, int, error) {
+	bucket, ok := b.coldBuckets[xtime.ToUnixNano(blockStart)]
+	if !ok || !bucket.canRead() {
+		return nil, 0, nil
+	}
+	if !bucket.hasJustSingleEncoder() {
+		if _, err := bucket.merge(); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// NB: Streams from an encoder are a copy of the encoded data so the
+	// block can be handed to the caller while the bucket keeps taking writes.
+	stream := bucket.encoders[0].encoder.Stream()
+	if stream == nil {
+		return nil, 0, nil
+	}
+	segment, err := stream.Segment()
+	if err != nil {
+		return nil, 0, err
+	}
+	bopts := b.opts.DatabaseBlockOptions()
+	return block.NewDatabaseBlock(blockStart, segment, bopts), bucket.version, nil
+}
+
+func (b *dbBuffer) DiscardCold(blockStart time.Time, version int) (block.DatabaseBlock, error) {
+	key := xtime.ToUnixNano(blockStart)
+	bucket, ok := b.coldBuckets[key]
+	if !ok || bucket.version != version {
+		// Written to since the version was flushed, keep it for the next flush
+		return nil, nil
+	}
+
+	delete(b.coldBuckets, key)
+	result, err := bucket.discardMerged()
+	bucket.finalize()
+	if err != nil {
+		return nil, err
+	}
+	return result.block, nil
+}
+
 // forEachBucketAsc iterates over the buckets in time ascending order
 // to read bucket data
 func (b *dbBuffer) forEachBucketAsc(fn func(*dbBufferBucket)) {
@@ -442,6 +644,29 @@ func (b *dbBuffer) FetchBlocksMetadata(
 		})
 	})
 
+	for _, bucket := range b.coldBuckets {
+		if !bucket.canRead() {
+			continue
+		}
+		if !start.Before(bucket.start.Add(blockSize)) || !bucket.start.Before(end) {
+			continue
+		}
+		size := int64(bucket.streamsLen())
+		if size == 0 {
+			continue
+		}
+		var resultSize int64
+		if opts.IncludeSizes {
+			resultSize = size
+		}
+		// NB: Checksums are also not calculated for cold writes since
+		// they are still being mutated until flushed
+		res.Add(block.FetchBlockMetadataResult{
+			Start: bucket.start,
+			Size:  resultSize,
+		})
+	}
+
 	return res
 }
 
@@ -454,6 +679,10 @@ type dbBufferBucket struct {
 	lastReadUnixNanos int64
 	empty             bool
 	drained           bool
+
+	// version is incremented on each write to a cold bucket to determine
+	// whether it has been written to since it was last flushed.
+	version int
 }
 
 type inOrderEncoder struct {
@@ -677,3 +677,108 @@ func TestBufferTickReordersOutOfOrderBuffers(t *testing.T) {
 	// Ensure single encoder again
 	assert.Equal(t, 1, len(encoders))
 }
+
+func newBufferColdWritesTestOptions() (Options, time.Time) {
+	opts := newBufferTestOptions().SetColdWritesEnabled(true)
+	curr := time.Now().Truncate(opts.RetentionOptions().BlockSize())
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return curr
+	}))
+	return opts, curr
+}
+
+func TestBufferWriteColdWrite(t *testing.T) {
+	opts, curr := newBufferColdWritesTestOptions()
+	blockSize := opts.RetentionOptions().BlockSize()
+	buffer := newDatabaseBuffer(nil).(*dbBuffer)
+	buffer.Reset(opts)
+
+	blockStart := curr.Add(-5 * blockSize)
+	data := []value{
+		{blockStart.Add(secs(10)), 2, xtime.Second, nil},
+		{blockStart.Add(secs(5)), 1, xtime.Second, nil},
+	}
+	for _, v := range data {
+		ctx := context.NewContext()
+		require.NoError(t, buffer.Write(ctx, v.timestamp, v.value, v.unit, v.annotation))
+		ctx.Close()
+	}
+	sort.Sort(valuesByTime(data))
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	// Cold writes are not read with the buffered blocks
+	require.False(t, buffer.IsEmpty())
+	require.Equal(t, 0, len(buffer.ReadEncoded(ctx, timeZero, timeDistantFuture)))
+	require.Nil(t, buffer.ColdStreams(ctx, blockStart.Add(blockSize)))
+	assertValuesEqual(t, data, [][]xio.SegmentReader{
+		buffer.ColdStreams(ctx, blockStart),
+	}, opts)
+	require.True(t, buffer.ColdStreamsLen(blockStart) > 0)
+
+	metadata := buffer.FetchBlocksMetadata(ctx, timeZero, timeDistantFuture,
+		FetchBlocksMetadataOptions{})
+	require.Equal(t, 1, len(metadata.Results()))
+	require.Equal(t, blockStart, metadata.Results()[0].Start)
+
+	b, version, err := buffer.ColdBlock(blockStart)
+	require.NoError(t, err)
+	require.NotNil(t, b)
+	require.Equal(t, 2, version)
+	assertValuesEqual(t, data, [][]xio.SegmentReader{
+		[]xio.SegmentReader{requireDrainedStream(ctx, t, b)},
+	}, opts)
+
+	// Writing after the block was taken keeps the cold writes
+	later := value{blockStart.Add(secs(20)), 3, xtime.Second, nil}
+	require.NoError(t, buffer.Write(ctx, later.timestamp, later.value, later.unit, nil))
+	discarded, err := buffer.DiscardCold(blockStart, version)
+	require.NoError(t, err)
+	require.Nil(t, discarded)
+	require.False(t, buffer.IsEmpty())
+
+	discarded, err = buffer.DiscardCold(blockStart, version+1)
+	require.NoError(t, err)
+	require.NotNil(t, discarded)
+	assertValuesEqual(t, append(data, later), [][]xio.SegmentReader{
+		[]xio.SegmentReader{requireDrainedStream(ctx, t, discarded)},
+	}, opts)
+	require.True(t, buffer.IsEmpty())
+	require.Nil(t, buffer.ColdStreams(ctx, blockStart))
+}
+
+func TestBufferWriteColdWriteToBufferedBlock(t *testing.T) {
+	opts, curr := newBufferColdWritesTestOptions()
+	rops := opts.RetentionOptions()
+	buffer := newDatabaseBuffer(nil).(*dbBuffer)
+	buffer.Reset(opts)
+
+	data := []value{
+		{curr.Add(-1 * rops.BufferPast()), 1, xtime.Second, nil},
+	}
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	require.NoError(t, buffer.Write(ctx, data[0].timestamp, data[0].value, data[0].unit, nil))
+	require.Equal(t, 0, len(buffer.coldBuckets))
+
+	results := buffer.ReadEncoded(ctx, timeZero, timeDistantFuture)
+	assertValuesEqual(t, data, results, opts)
+}
+
+func TestBufferWriteColdWriteTooPast(t *testing.T) {
+	opts, curr := newBufferColdWritesTestOptions()
+	rops := opts.RetentionOptions()
+	buffer := newDatabaseBuffer(nil).(*dbBuffer)
+	buffer.Reset(opts)
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	past := curr.Add(-rops.RetentionPeriod()).Add(-rops.BlockSize())
+	err := buffer.Write(ctx, past, 1, xtime.Second, nil)
+	assert.Error(t, err)
+	assert.True(t, xerrors.IsInvalidParams(err))
+}
@@ -95,7 +95,11 @@ func (r Reader) readersWithBlocksMapAndBuffer(
 		size         = ropts.BlockSize()
 		alignedStart = start.Truncate(size)
 		alignedEnd   = end.Truncate(size)
+		wiredList    *block.WiredList
 	)
+	if cachePolicy == CacheLRU {
+		wiredList = r.opts.DatabaseBlockOptions().WiredList()
+	}
 	if alignedEnd.Equal(end) {
 		// Move back to make range [start, end)
 		alignedEnd = alignedEnd.Add(-1 * size)
@@ -112,39 +116,22 @@ func (r Reader) readersWithBlocksMapAndBuffer(
 
 	first, last := alignedStart, alignedEnd
 	for blockAt := first; !blockAt.After(last); blockAt = blockAt.Add(size) {
-		if seriesBlocks != nil {
-			if block, ok := seriesBlocks.BlockAt(blockAt); ok {
-				// Block served from in-memory or in-memory metadata
-				// will defer to disk read
-				stream, err := block.Stream(ctx)
-				if err != nil {
-					return nil, err
-				}
-				if stream != nil {
-					results = append(results, []xio.SegmentReader{stream})
-					// NB(r): Mark this block as read now
-					block.SetLastReadTime(now)
-				}
-				continue
-			}
+		stream, err := r.blockStream(ctx, blockAt, now, seriesBlocks, wiredList)
+		if err != nil {
+			return nil, err
 		}
 
-		switch {
-		case cachePolicy == CacheAll:
-			// No-op, block metadata should have been in-memory
-		case cachePolicy == CacheAllMetadata:
-			// No-op, block metadata should have been in-memory
-		case r.retriever != nil:
-			// Try to stream from disk
-			if r.retriever.IsBlockRetrievable(blockAt) {
-				stream, err := r.retriever.Stream(ctx, r.id, blockAt, r.onRetrieve)
-				if err != nil {
-					return nil, err
-				}
-				if stream != nil {
-					results = append(results, []xio.SegmentReader{stream})
-				}
-			}
+		var streams []xio.SegmentReader
+		if stream != nil {
+			streams = append(streams, stream)
+		}
+		if seriesBuffer != nil {
+			// Cold writes for the block are read along with the block so
+			// that they are merged in order with the block data
+			streams = append(streams, seriesBuffer.ColdStreams(ctx, blockAt)...)
+		}
+		if len(streams) > 0 {
+			results = append(results, streams)
 		}
 	}
 
@@ -158,6 +145,57 @@ func (r Reader) readersWithBlocksMapAndBuffer(
 	return results, nil
 }
 
+func (r Reader) blockAt(
+	seriesBlocks block.DatabaseSeriesBlocks,
+	blockAt time.Time,
+) (block.DatabaseBlock, bool) {
+	if seriesBlocks == nil {
+		return nil, false
+	}
+	return seriesBlocks.BlockAt(blockAt)
+}
+
+func (r Reader) blockStream(
+	ctx context.Context,
+	blockAt time.Time,
+	now time.Time,
+	seriesBlocks block.DatabaseSeriesBlocks,
+	wiredList *block.WiredList,
+) (xio.SegmentReader, error) {
+	if block, ok := r.blockAt(seriesBlocks, blockAt); ok {
+		// Block served from in-memory or in-memory metadata
+		// will defer to disk read
+		stream, err := block.Stream(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if stream != nil {
+			// NB(r): Mark this block as read now
+			block.SetLastReadTime(now)
+			if wiredList != nil && block.WasRetrieved() {
+				wiredList.MarkRead(block)
+			}
+		}
+		return stream, nil
+	}
+
+	switch {
+	case r.opts.CachePolicy() == CacheAll:
+		// No-op, block metadata should have been in-memory
+	case r.opts.CachePolicy() == CacheAllMetadata:
+		// No-op, block metadata should have been in-memory
+	case r.retriever != nil:
+		// Try to stream from disk
+		if r.retriever.IsBlockRetrievable(blockAt) {
+			if wiredList != nil {
+				wiredList.MarkMissed()
+			}
+			return r.retriever.Stream(ctx, r.id, blockAt, r.onRetrieve)
+		}
+	}
+	return nil, nil
+}
+
 // FetchBlocks returns data blocks given a list of block start times using
 // just a block retriever.
 func (r Reader) FetchBlocks(
@@ -185,43 +223,42 @@ func (r Reader) fetchBlocksWithBlocksMapAndBuffer(
 		onRetrieve block.OnRetrieveBlock
 	)
 	for _, start := range starts {
-		if seriesBlocks != nil {
-			if b, exists := seriesBlocks.BlockAt(start); exists {
-				stream, err := b.Stream(ctx)
-				if err != nil {
-					r := block.NewFetchBlockResult(start, nil,
-						fmt.Errorf("unable to retrieve block stream for series %s time %v: %v",
-							r.id.String(), start, err))
-					res = append(res, r)
-				}
-				if stream != nil {
-					r := block.NewFetchBlockResult(start, []xio.SegmentReader{stream}, nil)
-					res = append(res, r)
+		var (
+			stream xio.SegmentReader
+			err    error
+		)
+		if b, exists := r.blockAt(seriesBlocks, start); exists {
+			stream, err = b.Stream(ctx)
+		} else {
+			switch {
+			case cachePolicy == CacheAll:
+				// No-op, block metadata should have been in-memory
+			case cachePolicy == CacheAllMetadata:
+				// No-op, block metadata should have been in-memory
+			case r.retriever != nil:
+				// Try to stream from disk
+				if r.retriever.IsBlockRetrievable(start) {
+					stream, err = r.retriever.Stream(ctx, r.id, start, onRetrieve)
 				}
-				continue
 			}
 		}
+		if err != nil {
+			r := block.NewFetchBlockResult(start, nil,
+				fmt.Errorf("unable to retrieve block stream for series %s time %v: %v",
+					r.id.String(), start, err))
+			res = append(res, r)
+		}
 
-		switch {
-		case cachePolicy == CacheAll:
-			// No-op, block metadata should have been in-memory
-		case cachePolicy == CacheAllMetadata:
-			// No-op, block metadata should have been in-memory
-		case r.retriever != nil:
-			// Try to stream from disk
-			if r.retriever.IsBlockRetrievable(start) {
-				stream, err := r.retriever.Stream(ctx, r.id, start, onRetrieve)
-				if err != nil {
-					r := block.NewFetchBlockResult(start, nil,
-						fmt.Errorf("unable to retrieve block stream for series %s time %v: %v",
-							r.id.String(), start, err))
-					res = append(res, r)
-				}
-				if stream != nil {
-					r := block.NewFetchBlockResult(start, []xio.SegmentReader{stream}, nil)
-					res = append(res, r)
-				}
-			}
+		var streams []xio.SegmentReader
+		if stream != nil {
+			streams = append(streams, stream)
+		}
+		if seriesBuffer != nil {
+			// Cold writes are returned with the block they belong to
+			streams = append(streams, seriesBuffer.ColdStreams(ctx, start)...)
+		}
+		if len(streams) > 0 {
+			res = append(res, block.NewFetchBlockResult(start, streams, nil))
 		}
 	}
 
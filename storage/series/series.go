@@ -23,6 +23,7 @@ package series
 import (
 	"errors"
 	"fmt"
+	"io"
 	"sync"
 	"time"
 
@@ -34,6 +35,7 @@ import (
 	"github.com/m3db/m3x/context"
 	xerrors "github.com/m3db/m3x/errors"
 	"github.com/m3db/m3x/ident"
+	"github.com/m3db/m3x/resource"
 	xtime "github.com/m3db/m3x/time"
 )
 
@@ -61,7 +63,8 @@ type dbSeries struct {
 	// series ID before changing ownership semantics (e.g.
 	// pooling the ID rather than releasing it to the GC on
 	// calling series.Reset()).
-	id ident.ID
+	id   ident.ID
+	tags ident.Tags
 
 	buffer          databaseBuffer
 	blocks          block.DatabaseSeriesBlocks
@@ -72,9 +75,9 @@ type dbSeries struct {
 }
 
 // NewDatabaseSeries creates a new database series
-func NewDatabaseSeries(id ident.ID, opts Options) DatabaseSeries {
+func NewDatabaseSeries(id ident.ID, tags ident.Tags, opts Options) DatabaseSeries {
 	s := newDatabaseSeries()
-	s.Reset(id, nil, nil, opts)
+	s.Reset(id, tags, nil, nil, opts)
 	return s
 }
 
@@ -108,6 +111,13 @@ func (s *dbSeries) ID() ident.ID {
 	return id
 }
 
+func (s *dbSeries) Tags() ident.Tags {
+	s.RLock()
+	tags := s.tags
+	s.RUnlock()
+	return tags
+}
+
 func (s *dbSeries) Tick() (TickResult, error) {
 	var r TickResult
 
@@ -148,6 +158,7 @@ func (s *dbSeries) updateBlocksWithLock() updateBlocksResult {
 	for startNano, currBlock := range s.blocks.AllBlocks() {
 		start := startNano.ToTime()
 		if start.Before(expireCutoff) {
+			s.removeFromWiredList(currBlock)
 			s.blocks.RemoveBlockAt(start)
 			currBlock.Close()
 			result.madeExpiredBlocks++
@@ -180,6 +191,11 @@ func (s *dbSeries) updateBlocksWithLock() updateBlocksResult {
 			case CacheRecentlyRead:
 				sinceLastRead := now.Sub(currBlock.LastReadTime())
 				shouldUnwire = sinceLastRead >= wiredTimeout
+			case CacheLRU:
+				// Blocks retrieved from disk are evicted by the wired list,
+				// any other block that is now on disk can be unwired and
+				// will enter the wired list when next read.
+				shouldUnwire = !currBlock.WasRetrieved()
 			}
 		}
 		if shouldUnwire {
@@ -198,6 +214,7 @@ func (s *dbSeries) updateBlocksWithLock() updateBlocksResult {
 				currBlock.ResetRetrievable(start, retriever, metadata)
 			default:
 				// Remove the block and it will be looked up later
+				s.removeFromWiredList(currBlock)
 				s.blocks.RemoveBlockAt(start)
 				currBlock.Close()
 			}
@@ -300,8 +317,10 @@ func (s *dbSeries) FetchBlocksMetadata(
 	s.RLock()
 	defer s.RUnlock()
 
-	blocks := s.blocks.AllBlocks()
-
+	var (
+		blocks     = s.blocks.AllBlocks()
+		coldMerged []time.Time
+	)
 	for tNano, b := range blocks {
 		t := tNano.ToTime()
 		if !start.Before(t.Add(blockSize)) || !t.Before(end) {
@@ -318,11 +337,18 @@ func (s *dbSeries) FetchBlocksMetadata(
 			size     int64
 			checksum *uint32
 			lastRead time.Time
+			coldSize = int64(s.buffer.ColdStreamsLen(t))
 		)
+		if coldSize > 0 {
+			// Report cold writes for the block as part of the block
+			coldMerged = append(coldMerged, t)
+		}
 		if opts.IncludeSizes {
-			size = int64(b.Len())
+			size = int64(b.Len()) + coldSize
 		}
-		if opts.IncludeChecksums {
+		if opts.IncludeChecksums && coldSize == 0 {
+			// NB: Avoid calculating the checksum if the block has
+			// cold writes that are still being mutated
 			v := b.Checksum()
 			checksum = &v
 		}
@@ -341,6 +367,10 @@ func (s *dbSeries) FetchBlocksMetadata(
 	if !s.buffer.IsEmpty() {
 		bufferResults := s.buffer.FetchBlocksMetadata(ctx, start, end, opts)
 		for _, result := range bufferResults.Results() {
+			if containsTime(coldMerged, result.Start) {
+				// Already reported along with the block
+				continue
+			}
 			res.Add(result)
 		}
 		bufferResults.Close()
@@ -350,7 +380,16 @@ func (s *dbSeries) FetchBlocksMetadata(
 
 	res.Sort()
 
-	return block.NewFetchBlocksMetadataResult(id, res)
+	return block.NewFetchBlocksMetadataResult(id, s.tags, res)
+}
+
+func containsTime(times []time.Time, t time.Time) bool {
+	for i := range times {
+		if times[i].Equal(t) {
+			return true
+		}
+	}
+	return false
 }
 
 func (s *dbSeries) bufferDrained(newBlock block.DatabaseBlock) {
@@ -433,6 +472,83 @@ func (s *dbSeries) Bootstrap(blocks block.DatabaseSeriesBlocks) error {
 	return multiErr.FinalError()
 }
 
+func (s *dbSeries) BootstrapColdWrites(blocks block.DatabaseSeriesBlocks) {
+	s.Lock()
+	for _, bl := range blocks.AllBlocks() {
+		s.buffer.BootstrapCold(bl)
+	}
+	s.Unlock()
+}
+
+func (s *dbSeries) ColdFlushBlock(blockStart time.Time) (block.DatabaseBlock, int, error) {
+	s.Lock()
+	b, version, err := s.buffer.ColdBlock(blockStart)
+	s.Unlock()
+	return b, version, err
+}
+
+func (s *dbSeries) OnColdFlushed(blockStart time.Time, version int) error {
+	s.Lock()
+	defer s.Unlock()
+
+	b, err := s.buffer.DiscardCold(blockStart, version)
+	if err != nil || b == nil {
+		return err
+	}
+
+	existing, ok := s.blocks.BlockAt(blockStart)
+	if !ok {
+		switch s.opts.CachePolicy() {
+		case CacheAll, CacheAllMetadata:
+			// Blocks are expected to be held in memory
+			s.blocks.AddBlock(b)
+		default:
+			// The cold writes are now read from disk
+			b.Close()
+		}
+		return nil
+	}
+
+	// Keep any block held in memory consistent with the flushed fileset
+	s.removeFromWiredList(existing)
+	s.mergeBlock(s.blocks, b)
+	return nil
+}
+
+func (s *dbSeries) OnRepaired(blockStart time.Time, segment ts.Segment) {
+	s.Lock()
+	defer s.Unlock()
+
+	if existing, ok := s.blocks.BlockAt(blockStart); ok {
+		s.removeFromWiredList(existing)
+		s.blocks.RemoveBlockAt(blockStart)
+		existing.Close()
+	}
+
+	switch s.opts.CachePolicy() {
+	case CacheAll:
+		// Blocks are expected to be held in memory
+		b := s.opts.DatabaseBlockOptions().DatabaseBlockPool().Get()
+		b.Reset(blockStart, segment)
+		s.blocks.AddBlock(b)
+	case CacheAllMetadata:
+		// Block metadata is expected to be held in memory, the data itself
+		// is read from the repaired fileset
+		b := s.opts.DatabaseBlockOptions().DatabaseBlockPool().Get()
+		metadata := block.RetrievableBlockMetadata{
+			ID:       s.id,
+			Length:   segment.Len(),
+			Checksum: digest.SegmentChecksum(segment),
+		}
+		b.ResetRetrievable(blockStart, s.blockRetriever, metadata)
+		s.blocks.AddBlock(b)
+		segment.Finalize()
+	default:
+		// The repaired block is read from disk when next required
+		segment.Finalize()
+	}
+}
+
 func (s *dbSeries) OnRetrieveBlock(
 	id ident.ID,
 	startTime time.Time,
@@ -460,6 +576,60 @@ func (s *dbSeries) OnRetrieveBlock(
 
 	// If we retrieved this from disk then we directly emplace it
 	s.blocks.AddBlock(b)
+
+	if wiredList := s.wiredList(); wiredList != nil {
+		wiredList.Update(b, s)
+	}
+}
+
+func (s *dbSeries) OnEvictedFromWiredList(b block.DatabaseBlock) {
+	s.Lock()
+	defer s.Unlock()
+
+	// NB: The block may have already been removed from the series, or the
+	// series reset, by the time the eviction is processed so only remove
+	// the block if it is still held by this series.
+	start := b.StartTime()
+	if curr, ok := s.blocks.BlockAt(start); !ok || curr != b {
+		return
+	}
+	// The block may also have been closed and reused since being evicted,
+	// blocks retrieved by this series are added to the wired list under the
+	// series lock so a reused block is either not retrieved or held by the
+	// list again.
+	if !b.WasRetrieved() {
+		return
+	}
+	if wiredList := s.wiredList(); wiredList != nil && wiredList.Contains(b) {
+		return
+	}
+	s.blocks.RemoveBlockAt(start)
+	b.Close()
+}
+
+// wiredList returns the wired list if the series is using the LRU cache
+// policy, otherwise nil.
+func (s *dbSeries) wiredList() *block.WiredList {
+	if s.opts.CachePolicy() != CacheLRU {
+		return nil
+	}
+	return s.opts.DatabaseBlockOptions().WiredList()
+}
+
+func (s *dbSeries) removeFromWiredList(b block.DatabaseBlock) {
+	if wiredList := s.wiredList(); wiredList != nil {
+		wiredList.Remove(b)
+	}
+}
+
+func (s *dbSeries) removeAllFromWiredListWithLock() {
+	wiredList := s.wiredList()
+	if wiredList == nil {
+		return
+	}
+	for _, b := range s.blocks.AllBlocks() {
+		wiredList.Remove(b)
+	}
 }
 
 func (s *dbSeries) newBootstrapBlockError(
@@ -504,7 +674,92 @@ func (s *dbSeries) Flush(
 	if err != nil {
 		return err
 	}
-	return persistFn(s.id, segment, b.Checksum())
+	return persistFn(s.id, s.tags, segment, b.Checksum())
+}
+
+func (s *dbSeries) Snapshot(
+	ctx context.Context,
+	blockStart time.Time,
+	persistFn persist.Fn,
+) error {
+	// NB: Only hold the lock while taking the streams, merging them and
+	// persisting the result happens outside of the series lock.
+	s.RLock()
+
+	if s.bs != bootstrapped {
+		s.RUnlock()
+		return errSeriesNotBootstrapped
+	}
+
+	var streams []xio.SegmentReader
+	if b, exists := s.blocks.BlockAt(blockStart); exists {
+		sr, err := b.Stream(ctx)
+		if err != nil {
+			s.RUnlock()
+			return err
+		}
+		if sr != nil {
+			streams = append(streams, sr)
+		}
+	}
+	streams = append(streams, s.buffer.SnapshotStreams(ctx, blockStart)...)
+	s.RUnlock()
+
+	if len(streams) == 0 {
+		return nil
+	}
+
+	segment, err := s.mergeStreams(ctx, blockStart, streams)
+	if err != nil {
+		return err
+	}
+	if segment.Len() == 0 {
+		return nil
+	}
+	return persistFn(s.id, s.tags, segment, digest.SegmentChecksum(segment))
+}
+
+// mergeStreams merges streams for a block start into a single segment that
+// remains valid until the context is closed.
+func (s *dbSeries) mergeStreams(
+	ctx context.Context,
+	blockStart time.Time,
+	streams []xio.SegmentReader,
+) (ts.Segment, error) {
+	if len(streams) == 1 {
+		return streams[0].Segment()
+	}
+
+	readers := make([]io.Reader, 0, len(streams))
+	for _, stream := range streams {
+		readers = append(readers, stream)
+	}
+
+	iter := s.opts.MultiReaderIteratorPool().Get()
+	iter.Reset(readers)
+	defer iter.Close()
+
+	bopts := s.opts.DatabaseBlockOptions()
+	encoder := bopts.EncoderPool().Get()
+	encoder.Reset(blockStart, bopts.DatabaseBlockAllocSize())
+	ctx.RegisterFinalizer(resource.FinalizerFn(encoder.Close))
+
+	for iter.Next() {
+		dp, unit, annotation := iter.Current()
+		if err := encoder.Encode(dp, unit, annotation); err != nil {
+			return ts.Segment{}, err
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return ts.Segment{}, err
+	}
+
+	stream := encoder.Stream()
+	if stream == nil {
+		return ts.Segment{}, nil
+	}
+	ctx.RegisterFinalizer(stream)
+	return stream.Segment()
 }
 
 func (s *dbSeries) Close() {
@@ -519,10 +774,12 @@ func (s *dbSeries) Close() {
 	// of not releasing back an ID to a pool is amortized over
 	// a long period of time.
 	s.id = nil
+	s.tags = nil
 
 	// Reset (not close) underlying resources because the series will go
 	// back into the pool and be re-used.
 	s.buffer.Reset(s.opts)
+	s.removeAllFromWiredListWithLock()
 	s.blocks.Reset()
 
 	if s.pool != nil {
@@ -532,6 +789,7 @@ func (s *dbSeries) Close() {
 
 func (s *dbSeries) Reset(
 	id ident.ID,
+	tags ident.Tags,
 	blockRetriever QueryableBlockRetriever,
 	onRetrieveBlock block.OnRetrieveBlock,
 	opts Options,
@@ -540,6 +798,10 @@ func (s *dbSeries) Reset(
 	defer s.Unlock()
 
 	s.id = id
+	s.tags = tags
+	if s.opts != nil {
+		s.removeAllFromWiredListWithLock()
+	}
 	s.blocks.Reset()
 	s.buffer.Reset(opts)
 	s.opts = opts
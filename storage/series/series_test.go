@@ -78,7 +78,7 @@ func newSeriesTestOptions() Options {
 
 func TestSeriesEmpty(t *testing.T) {
 	opts := newSeriesTestOptions()
-	series := NewDatabaseSeries(ident.StringID("foo"), opts).(*dbSeries)
+	series := NewDatabaseSeries(ident.StringID("foo"), nil, opts).(*dbSeries)
 	assert.NoError(t, series.Bootstrap(nil))
 	assert.True(t, series.IsEmpty())
 }
@@ -90,7 +90,7 @@ func TestSeriesWriteFlush(t *testing.T) {
 	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
 		return curr
 	}))
-	series := NewDatabaseSeries(ident.StringID("foo"), opts).(*dbSeries)
+	series := NewDatabaseSeries(ident.StringID("foo"), nil, opts).(*dbSeries)
 	assert.NoError(t, series.Bootstrap(nil))
 
 	data := []value{
@@ -138,7 +138,7 @@ func TestSeriesWriteFlushRead(t *testing.T) {
 	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
 		return curr
 	}))
-	series := NewDatabaseSeries(ident.StringID("foo"), opts).(*dbSeries)
+	series := NewDatabaseSeries(ident.StringID("foo"), nil, opts).(*dbSeries)
 	assert.NoError(t, series.Bootstrap(nil))
 
 	data := []value{
@@ -174,7 +174,7 @@ func TestSeriesWriteFlushRead(t *testing.T) {
 
 func TestSeriesReadEndBeforeStart(t *testing.T) {
 	opts := newSeriesTestOptions()
-	series := NewDatabaseSeries(ident.StringID("foo"), opts).(*dbSeries)
+	series := NewDatabaseSeries(ident.StringID("foo"), nil, opts).(*dbSeries)
 	assert.NoError(t, series.Bootstrap(nil))
 
 	ctx := context.NewContext()
@@ -188,7 +188,7 @@ func TestSeriesReadEndBeforeStart(t *testing.T) {
 
 func TestSeriesFlushNoBlock(t *testing.T) {
 	opts := newSeriesTestOptions()
-	series := NewDatabaseSeries(ident.StringID("foo"), opts).(*dbSeries)
+	series := NewDatabaseSeries(ident.StringID("foo"), nil, opts).(*dbSeries)
 	assert.NoError(t, series.Bootstrap(nil))
 	flushTime := time.Unix(7200, 0)
 	err := series.Flush(nil, flushTime, nil)
@@ -200,7 +200,7 @@ func TestSeriesFlush(t *testing.T) {
 	defer ctrl.Finish()
 
 	opts := newSeriesTestOptions()
-	series := NewDatabaseSeries(ident.StringID("foo"), opts).(*dbSeries)
+	series := NewDatabaseSeries(ident.StringID("foo"), nil, opts).(*dbSeries)
 	assert.NoError(t, series.Bootstrap(nil))
 	flushTime := time.Unix(7200, 0)
 	head := checked.NewBytes([]byte{0x1, 0x2}, nil)
@@ -212,7 +212,7 @@ func TestSeriesFlush(t *testing.T) {
 
 	inputs := []error{errors.New("some error"), nil}
 	for _, input := range inputs {
-		persistFn := func(id ident.ID, segment ts.Segment, checksum uint32) error { return input }
+		persistFn := func(id ident.ID, tags ident.Tags, segment ts.Segment, checksum uint32) error { return input }
 		ctx := context.NewContext()
 		err := series.Flush(ctx, flushTime, persistFn)
 		ctx.BlockingClose()
@@ -222,7 +222,7 @@ func TestSeriesFlush(t *testing.T) {
 
 func TestSeriesTickEmptySeries(t *testing.T) {
 	opts := newSeriesTestOptions()
-	series := NewDatabaseSeries(ident.StringID("foo"), opts).(*dbSeries)
+	series := NewDatabaseSeries(ident.StringID("foo"), nil, opts).(*dbSeries)
 	assert.NoError(t, series.Bootstrap(nil))
 	_, err := series.Tick()
 	require.Equal(t, ErrSeriesAllDatapointsExpired, err)
@@ -233,7 +233,7 @@ func TestSeriesTickDrainAndResetBuffer(t *testing.T) {
 	defer ctrl.Finish()
 
 	opts := newSeriesTestOptions()
-	series := NewDatabaseSeries(ident.StringID("foo"), opts).(*dbSeries)
+	series := NewDatabaseSeries(ident.StringID("foo"), nil, opts).(*dbSeries)
 	assert.NoError(t, series.Bootstrap(nil))
 	buffer := NewMockdatabaseBuffer(ctrl)
 	series.buffer = buffer
@@ -257,7 +257,7 @@ func TestSeriesTickNeedsBlockExpiry(t *testing.T) {
 	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
 		return curr
 	}))
-	series := NewDatabaseSeries(ident.StringID("foo"), opts).(*dbSeries)
+	series := NewDatabaseSeries(ident.StringID("foo"), nil, opts).(*dbSeries)
 	assert.NoError(t, series.Bootstrap(nil))
 	blockStart := curr.Add(-ropts.RetentionPeriod()).Add(-ropts.BlockSize())
 	b := block.NewMockDatabaseBlock(ctrl)
@@ -287,6 +287,64 @@ func TestSeriesTickNeedsBlockExpiry(t *testing.T) {
 	require.True(t, exists)
 }
 
+func TestSeriesOnRetrieveBlockEvictedFromWiredList(t *testing.T) {
+	opts := newSeriesTestOptions()
+	wiredList := block.NewWiredList(1, opts.InstrumentOptions())
+	defer wiredList.Close()
+	opts = opts.
+		SetCachePolicy(CacheLRU).
+		SetDatabaseBlockOptions(opts.DatabaseBlockOptions().SetWiredList(wiredList))
+	ropts := opts.RetentionOptions()
+	curr := time.Now().Truncate(ropts.BlockSize())
+
+	id := ident.StringID("foo")
+	series := NewDatabaseSeries(id, nil, opts).(*dbSeries)
+	assert.NoError(t, series.Bootstrap(nil))
+
+	segment := func() ts.Segment {
+		return ts.NewSegment(checked.NewBytes([]byte{1, 2, 3}, nil), nil, ts.FinalizeNone)
+	}
+	series.OnRetrieveBlock(id, curr.Add(-ropts.BlockSize()), segment())
+	require.Equal(t, 1, series.NumActiveBlocks())
+	require.Equal(t, 1, wiredList.Len())
+
+	// Retrieving another block evicts the least recently used block
+	series.OnRetrieveBlock(id, curr, segment())
+	require.Equal(t, 1, wiredList.Len())
+	for series.NumActiveBlocks() != 1 {
+		time.Sleep(time.Millisecond)
+	}
+	series.RLock()
+	_, exists := series.blocks.BlockAt(curr)
+	series.RUnlock()
+	require.True(t, exists)
+}
+
+func TestSeriesOnEvictedFromWiredListIgnoresBlockHeldByList(t *testing.T) {
+	opts := newSeriesTestOptions()
+	wiredList := block.NewWiredList(1, opts.InstrumentOptions())
+	defer wiredList.Close()
+	opts = opts.
+		SetCachePolicy(CacheLRU).
+		SetDatabaseBlockOptions(opts.DatabaseBlockOptions().SetWiredList(wiredList))
+	curr := time.Now().Truncate(opts.RetentionOptions().BlockSize())
+
+	id := ident.StringID("foo")
+	series := NewDatabaseSeries(id, nil, opts).(*dbSeries)
+	assert.NoError(t, series.Bootstrap(nil))
+
+	segment := ts.NewSegment(checked.NewBytes([]byte{1, 2, 3}, nil), nil, ts.FinalizeNone)
+	series.OnRetrieveBlock(id, curr, segment)
+	b, ok := series.blocks.BlockAt(curr)
+	require.True(t, ok)
+
+	// A stale eviction of a block that has been reused and added back to
+	// the wired list must not close it
+	series.OnEvictedFromWiredList(b)
+	require.Equal(t, 1, series.NumActiveBlocks())
+	require.True(t, wiredList.Contains(b))
+}
+
 func TestSeriesBootstrapWithError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -295,7 +353,7 @@ func TestSeriesBootstrapWithError(t *testing.T) {
 	now := time.Now()
 	blockSize := 2 * time.Hour
 
-	series := NewDatabaseSeries(ident.StringID("foo"), opts).(*dbSeries)
+	series := NewDatabaseSeries(ident.StringID("foo"), nil, opts).(*dbSeries)
 
 	bufferMin := now.Truncate(blockSize).Add(-blockSize)
 	bufferMax := now.Truncate(blockSize).Add(2 * blockSize)
@@ -354,12 +412,13 @@ func TestSeriesFetchBlocks(t *testing.T) {
 
 	// Set up the buffer
 	buffer := NewMockdatabaseBuffer(ctrl)
+	buffer.EXPECT().ColdStreams(ctx, gomock.Any()).Return(nil).AnyTimes()
 	buffer.EXPECT().IsEmpty().Return(false)
 	buffer.EXPECT().
 		FetchBlocks(ctx, starts).
 		Return([]block.FetchBlockResult{block.NewFetchBlockResult(starts[2], nil, nil)})
 
-	series := NewDatabaseSeries(ident.StringID("foo"), opts).(*dbSeries)
+	series := NewDatabaseSeries(ident.StringID("foo"), nil, opts).(*dbSeries)
 	require.NoError(t, series.Bootstrap(nil))
 
 	series.blocks = blocks
@@ -423,12 +482,13 @@ func TestSeriesFetchBlocksMetadata(t *testing.T) {
 			IncludeLastRead:  true,
 		},
 	}
+	buffer.EXPECT().ColdStreamsLen(starts[0]).Return(0)
 	buffer.EXPECT().IsEmpty().Return(false)
 	buffer.EXPECT().
 		FetchBlocksMetadata(ctx, start, end, fetchOpts).
 		Return(expectedResults)
 
-	series := NewDatabaseSeries(ident.StringID("bar"), opts).(*dbSeries)
+	series := NewDatabaseSeries(ident.StringID("bar"), nil, opts).(*dbSeries)
 	assert.NoError(t, series.Bootstrap(nil))
 	mockBlocks := block.NewMockDatabaseSeriesBlocks(ctrl)
 	mockBlocks.EXPECT().AllBlocks().Return(blocks)
@@ -490,8 +550,8 @@ func TestSeriesOutOfOrderWritesAndRotate(t *testing.T) {
 		expected   []ts.Datapoint
 	)
 
-	series := NewDatabaseSeries(id, opts).(*dbSeries)
-	series.Reset(id, nil, nil, opts)
+	series := NewDatabaseSeries(id, nil, opts).(*dbSeries)
+	series.Reset(id, nil, nil, nil, opts)
 
 	for iter := 0; iter < numBlocks; iter++ {
 		start := now
@@ -551,7 +611,7 @@ func TestSeriesWriteReadFromTheSameBucket(t *testing.T) {
 	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
 		return curr
 	}))
-	series := NewDatabaseSeries(ident.StringID("foo"), opts).(*dbSeries)
+	series := NewDatabaseSeries(ident.StringID("foo"), nil, opts).(*dbSeries)
 	assert.NoError(t, series.Bootstrap(nil))
 
 	ctx := context.NewContext()
@@ -568,3 +628,158 @@ func TestSeriesWriteReadFromTheSameBucket(t *testing.T) {
 
 	require.Equal(t, 3, len(values))
 }
+
+func TestSeriesColdWriteReadAndFlush(t *testing.T) {
+	opts := newSeriesTestOptions().
+		SetCachePolicy(CacheAll).
+		SetColdWritesEnabled(true)
+	blockSize := opts.RetentionOptions().BlockSize()
+	curr := time.Now().Truncate(blockSize)
+	start := curr
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return curr
+	}))
+	series := NewDatabaseSeries(ident.StringID("foo"), nil, opts).(*dbSeries)
+	assert.NoError(t, series.Bootstrap(nil))
+
+	data := []value{
+		{start, 1, xtime.Second, nil},
+		{start.Add(secs(30)), 2, xtime.Second, nil},
+		{start.Add(mins(1)), 3, xtime.Second, nil},
+	}
+
+	for _, v := range []value{data[0], data[2]} {
+		ctx := context.NewContext()
+		assert.NoError(t, series.Write(ctx, v.timestamp, v.value, v.unit, v.annotation))
+		ctx.Close()
+	}
+
+	// Move past the block and drain it from the buffer
+	curr = start.Add(3 * blockSize)
+	_, err := series.Tick()
+	require.NoError(t, err)
+	_, ok := series.blocks.BlockAt(start)
+	require.True(t, ok)
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	v := data[1]
+	require.NoError(t, series.Write(ctx, v.timestamp, v.value, v.unit, v.annotation))
+
+	results, err := series.ReadEncoded(ctx, start, start.Add(blockSize))
+	require.NoError(t, err)
+	assertValuesEqual(t, data, results, opts)
+
+	b, version, err := series.ColdFlushBlock(start)
+	require.NoError(t, err)
+	require.NotNil(t, b)
+	require.Equal(t, 1, version)
+	b.Close()
+
+	require.NoError(t, series.OnColdFlushed(start, version))
+	require.True(t, series.buffer.IsEmpty())
+
+	results, err = series.ReadEncoded(ctx, start, start.Add(blockSize))
+	require.NoError(t, err)
+	assertValuesEqual(t, data, results, opts)
+}
+
+func TestSeriesOnRepaired(t *testing.T) {
+	opts := newSeriesTestOptions().SetCachePolicy(CacheAll)
+	blockSize := opts.RetentionOptions().BlockSize()
+	curr := time.Now().Truncate(blockSize)
+	start := curr
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return curr
+	}))
+	series := NewDatabaseSeries(ident.StringID("foo"), nil, opts).(*dbSeries)
+	assert.NoError(t, series.Bootstrap(nil))
+
+	data := []value{
+		{start, 1, xtime.Second, nil},
+		{start.Add(secs(30)), 2, xtime.Second, nil},
+	}
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	v := data[0]
+	require.NoError(t, series.Write(ctx, v.timestamp, v.value, v.unit, v.annotation))
+
+	// Move past the block and drain it from the buffer
+	curr = start.Add(3 * blockSize)
+	_, err := series.Tick()
+	require.NoError(t, err)
+
+	// The repaired block holds the datapoints missing locally
+	enc := opts.EncoderPool().Get()
+	enc.Reset(start, 0)
+	for _, v := range data {
+		require.NoError(t, enc.Encode(ts.Datapoint{Timestamp: v.timestamp, Value: v.value}, v.unit, v.annotation))
+	}
+	series.OnRepaired(start, enc.Discard())
+
+	results, err := series.ReadEncoded(ctx, start, start.Add(blockSize))
+	require.NoError(t, err)
+	assertValuesEqual(t, data, results, opts)
+	require.Equal(t, 1, series.blocks.Len())
+}
+
+func TestSeriesSnapshot(t *testing.T) {
+	opts := newSeriesTestOptions().
+		SetCachePolicy(CacheAll).
+		SetColdWritesEnabled(true)
+	blockSize := opts.RetentionOptions().BlockSize()
+	curr := time.Now().Truncate(blockSize)
+	start := curr
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return curr
+	}))
+	series := NewDatabaseSeries(ident.StringID("foo"), nil, opts).(*dbSeries)
+	assert.NoError(t, series.Bootstrap(nil))
+
+	data := []value{
+		{start, 1, xtime.Second, nil},
+		{start.Add(secs(30)), 2, xtime.Second, nil},
+		{start.Add(mins(1)), 3, xtime.Second, nil},
+	}
+
+	for _, v := range []value{data[0], data[2]} {
+		ctx := context.NewContext()
+		assert.NoError(t, series.Write(ctx, v.timestamp, v.value, v.unit, v.annotation))
+		ctx.Close()
+	}
+
+	// Drain the block from the buffer and then write to it again
+	curr = start.Add(3 * blockSize)
+	_, err := series.Tick()
+	require.NoError(t, err)
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	v := data[1]
+	require.NoError(t, series.Write(ctx, v.timestamp, v.value, v.unit, v.annotation))
+
+	var (
+		persisted ts.Segment
+		calls     int
+	)
+	persistFn := func(id ident.ID, tags ident.Tags, segment ts.Segment, checksum uint32) error {
+		require.Equal(t, "foo", id.String())
+		require.Equal(t, digest.SegmentChecksum(segment), checksum)
+		persisted = segment
+		calls++
+		return nil
+	}
+	require.NoError(t, series.Snapshot(ctx, start, persistFn))
+	require.Equal(t, 1, calls)
+	assertValuesEqual(t, data, [][]xio.SegmentReader{[]xio.SegmentReader{
+		xio.NewSegmentReader(persisted),
+	}}, opts)
+
+	// Snapshotting a block start without data persists nothing
+	require.NoError(t, series.Snapshot(ctx, start.Add(blockSize), persistFn))
+	require.Equal(t, 1, calls)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/m3db/m3db/persist"
 	"github.com/m3db/m3db/retention"
 	"github.com/m3db/m3db/storage/block"
+	"github.com/m3db/m3db/ts"
 	"github.com/m3db/m3db/x/xio"
 	"github.com/m3db/m3x/context"
 	"github.com/m3db/m3x/ident"
@@ -42,6 +43,9 @@ type DatabaseSeries interface {
 	// ID returns the ID of the series
 	ID() ident.ID
 
+	// Tags returns the tags of the series
+	Tags() ident.Tags
+
 	// Tick executes any updates to ensure buffer drains, blocks are flushed, etc
 	Tick() (TickResult, error)
 
@@ -85,15 +89,39 @@ type DatabaseSeries interface {
 	// Bootstrap merges the raw series bootstrapped along with any buffered data
 	Bootstrap(blocks block.DatabaseSeriesBlocks) error
 
+	// BootstrapColdWrites bootstraps cold writes replayed for blocks
+	// that have already been flushed
+	BootstrapColdWrites(blocks block.DatabaseSeriesBlocks)
+
 	// Flush flushes the data blocks of this series for a given start time
 	Flush(ctx context.Context, blockStart time.Time, persistFn persist.Fn) error
 
+	// Snapshot persists the unflushed data of this series for a given start
+	// time, merging the data drained from the buffer with that still buffered
+	Snapshot(ctx context.Context, blockStart time.Time, persistFn persist.Fn) error
+
+	// ColdFlushBlock returns a block holding the cold writes for a given
+	// block start along with the version of the cold writes it holds, the
+	// returned block is nil if there are no cold writes for the block start
+	// and is otherwise owned by the caller
+	ColdFlushBlock(blockStart time.Time) (block.DatabaseBlock, int, error)
+
+	// OnColdFlushed discards the cold writes for a given block start once
+	// they have been flushed, unless written to since the flushed version
+	OnColdFlushed(blockStart time.Time, version int) error
+
+	// OnRepaired replaces the block held in memory for a given block start
+	// with the repaired block persisted in its place, the series takes
+	// ownership of the segment
+	OnRepaired(blockStart time.Time, segment ts.Segment)
+
 	// Close will close the series and if pooled returned to the pool
 	Close()
 
 	// Reset resets the series for reuse
 	Reset(
 		id ident.ID,
+		tags ident.Tags,
 		blockRetriever QueryableBlockRetriever,
 		onRetrieveBlock block.OnRetrieveBlock,
 		opts Options,
@@ -219,4 +247,12 @@ type Options interface {
 
 	// IdentifierPool returns the identifierPool
 	IdentifierPool() ident.Pool
+
+	// SetColdWritesEnabled sets whether writes outside the buffer past
+	// and future window are accepted
+	SetColdWritesEnabled(value bool) Options
+
+	// ColdWritesEnabled returns whether writes outside the buffer past
+	// and future window are accepted
+	ColdWritesEnabled() bool
 }
@@ -44,6 +44,7 @@ import (
 	"github.com/m3db/m3db/storage/series"
 	"github.com/m3db/m3db/ts"
 	"github.com/m3db/m3db/x/xio"
+	"github.com/m3db/m3x/checked"
 	xclose "github.com/m3db/m3x/close"
 	"github.com/m3db/m3x/context"
 	xerrors "github.com/m3db/m3x/errors"
@@ -66,6 +67,8 @@ var (
 	errShardAlreadyTicking        = errors.New("shard is already ticking")
 	errShardClosingTickTerminated = errors.New("shard is closing, terminating tick")
 	errShardInvalidPageToken      = errors.New("shard could not unmarshal page token")
+	errShardMaxSeriesExceeded     = errors.New("shard max series limit reached, rejecting new series")
+	errNamespaceMaxSeriesExceeded = errors.New("namespace max series limit reached, rejecting new series")
 )
 
 type filesetBeforeFn func(
@@ -104,6 +107,8 @@ type dbShard struct {
 	seriesPool               series.DatabaseSeriesPool
 	commitLogWriter          commitLogWriter
 	indexWriter              databaseIndexWriter
+	quotas                   *namespaceQuotas
+	namespaceNumSeries       *namespaceSeriesCount
 	insertQueue              *dbShardInsertQueue
 	lookup                   map[ident.Hash]*list.Element
 	list                     *list.List
@@ -114,6 +119,8 @@ type dbShard struct {
 	identifierPool           ident.Pool
 	contextPool              context.Pool
 	flushState               shardFlushState
+	coldWrites               shardColdWrites
+	lastSnapshotTime         time.Time
 	tickWg                   *sync.WaitGroup
 	runtimeOptsListenClosers []xclose.SimpleCloser
 	currRuntimeOptions       dbShardRuntimeOptions
@@ -131,6 +138,8 @@ type dbShard struct {
 // these are protected under the same shard mutex.
 type dbShardRuntimeOptions struct {
 	writeNewSeriesAsync      bool
+	maxSeriesPerShard        int
+	maxSeriesPerNamespace    int
 	tickSleepSeriesBatchSize int
 	tickSleepPerSeries       time.Duration
 }
@@ -143,6 +152,8 @@ type dbShardMetrics struct {
 	insertAsyncInsertErrors    tally.Counter
 	insertAsyncBootstrapErrors tally.Counter
 	insertAsyncWriteErrors     tally.Counter
+	shardMaxSeriesExceeded     tally.Counter
+	namespaceMaxSeriesExceeded tally.Counter
 }
 
 func newDatabaseShardMetrics(scope tally.Scope) dbShardMetrics {
@@ -160,6 +171,12 @@ func newDatabaseShardMetrics(scope tally.Scope) dbShardMetrics {
 		insertAsyncWriteErrors: scope.Tagged(map[string]string{
 			"error_type": "write-value",
 		}).Counter("insert-async.errors"),
+		shardMaxSeriesExceeded: scope.Tagged(map[string]string{
+			"limit": "shard",
+		}).Counter("max-series-exceeded"),
+		namespaceMaxSeriesExceeded: scope.Tagged(map[string]string{
+			"limit": "namespace",
+		}).Counter("max-series-exceeded"),
 	}
 }
 
@@ -188,6 +205,58 @@ type shardFlushState struct {
 	statesByTime map[xtime.UnixNano]fileOpState
 }
 
+// shardColdWrites tracks the block starts that have received cold writes
+// which have not yet been merged into their filesets.
+type shardColdWrites struct {
+	sync.Mutex
+	pendingSince time.Time
+	blockStarts  map[xtime.UnixNano]struct{}
+}
+
+func newShardColdWrites() shardColdWrites {
+	return shardColdWrites{
+		blockStarts: make(map[xtime.UnixNano]struct{}),
+	}
+}
+
+func (w *shardColdWrites) add(since time.Time, blockStart time.Time) {
+	w.Lock()
+	if w.pendingSince.IsZero() || since.Before(w.pendingSince) {
+		w.pendingSince = since
+	}
+	w.blockStarts[xtime.ToUnixNano(blockStart)] = struct{}{}
+	w.Unlock()
+}
+
+// namespaceSeriesCount tracks the number of live series held across all
+// the shards of a namespace, a nil value tracks nothing.
+type namespaceSeriesCount struct {
+	value int64
+}
+
+func (c *namespaceSeriesCount) add(delta int64) {
+	if c == nil {
+		return
+	}
+	atomic.AddInt64(&c.value, delta)
+}
+
+func (c *namespaceSeriesCount) load() int64 {
+	if c == nil {
+		return 0
+	}
+	return atomic.LoadInt64(&c.value)
+}
+
+func (w *shardColdWrites) reset() (time.Time, map[xtime.UnixNano]struct{}) {
+	w.Lock()
+	pendingSince, blockStarts := w.pendingSince, w.blockStarts
+	w.pendingSince = timeZero
+	w.blockStarts = make(map[xtime.UnixNano]struct{})
+	w.Unlock()
+	return pendingSince, blockStarts
+}
+
 func newShardFlushState() shardFlushState {
 	return shardFlushState{
 		statesByTime: make(map[xtime.UnixNano]fileOpState),
@@ -202,6 +271,8 @@ func newDatabaseShard(
 	increasingIndex increasingIndex,
 	commitLogWriter commitLogWriter,
 	indexWriter databaseIndexWriter,
+	quotas *namespaceQuotas,
+	namespaceNumSeries *namespaceSeriesCount,
 	needsBootstrap bool,
 	opts Options,
 	seriesOpts series.Options,
@@ -221,6 +292,8 @@ func newDatabaseShard(
 		seriesPool:         opts.DatabaseSeriesPool(),
 		commitLogWriter:    commitLogWriter,
 		indexWriter:        indexWriter,
+		quotas:             quotas,
+		namespaceNumSeries: namespaceNumSeries,
 		lookup:             make(map[ident.Hash]*list.Element),
 		list:               list.New(),
 		filesetBeforeFn:    fs.FilesetBefore,
@@ -229,6 +302,7 @@ func newDatabaseShard(
 		identifierPool:     opts.IdentifierPool(),
 		contextPool:        opts.ContextPool(),
 		flushState:         newShardFlushState(),
+		coldWrites:         newShardColdWrites(),
 		tickWg:             &sync.WaitGroup{},
 		logger:             opts.InstrumentOptions().Logger(),
 		metrics:            newDatabaseShardMetrics(scope),
@@ -274,6 +348,8 @@ func (s *dbShard) SetRuntimeOptions(value runtime.Options) {
 	s.Lock()
 	s.currRuntimeOptions = dbShardRuntimeOptions{
 		writeNewSeriesAsync:      value.WriteNewSeriesAsync(),
+		maxSeriesPerShard:        value.MaxSeriesPerShard(),
+		maxSeriesPerNamespace:    value.MaxSeriesPerNamespace(),
 		tickSleepSeriesBatchSize: value.TickSeriesBatchSize(),
 		tickSleepPerSeries:       value.TickPerSeriesSleepDuration(),
 	}
@@ -337,7 +413,7 @@ func (s *dbShard) OnRetrieveBlock(
 	}
 
 	// Insert batched with the retrieved block
-	entry = s.newShardEntry(id)
+	entry = s.newShardEntry(id, nil)
 	copiedID := entry.series.ID()
 	s.insertQueue.Insert(dbShardInsert{
 		entry: entry,
@@ -582,6 +658,7 @@ func (s *dbShard) tickAndExpire(
 
 func (s *dbShard) purgeExpiredSeries(expired []series.DatabaseSeries) {
 	// Remove all expired series from lookup and list.
+	var purged int64
 	s.Lock()
 	for _, series := range expired {
 		hash := series.ID().Hash()
@@ -609,8 +686,10 @@ func (s *dbShard) purgeExpiredSeries(expired []series.DatabaseSeries) {
 		series.Close()
 		s.list.Remove(elem)
 		delete(s.lookup, hash)
+		purged++
 	}
 	s.Unlock()
+	s.namespaceNumSeries.add(-purged)
 }
 
 func (s *dbShard) WriteTagged(
@@ -622,7 +701,8 @@ func (s *dbShard) WriteTagged(
 	unit xtime.Unit,
 	annotation []byte,
 ) error {
-	return errIndexingNotImplemented
+	return s.writeAndIndex(ctx, id, tags, timestamp,
+		value, unit, annotation, true)
 }
 
 func (s *dbShard) Write(
@@ -632,6 +712,20 @@ func (s *dbShard) Write(
 	value float64,
 	unit xtime.Unit,
 	annotation []byte,
+) error {
+	return s.writeAndIndex(ctx, id, ident.EmptyTagIterator, timestamp,
+		value, unit, annotation, false)
+}
+
+func (s *dbShard) writeAndIndex(
+	ctx context.Context,
+	id ident.ID,
+	tags ident.TagIterator,
+	timestamp time.Time,
+	value float64,
+	unit xtime.Unit,
+	annotation []byte,
+	shouldIndex bool,
 ) error {
 	// Prepare write
 	entry, opts, err := s.tryRetrieveWritableSeries(id)
@@ -641,11 +735,36 @@ func (s *dbShard) Write(
 
 	writable := entry != nil
 
+	// If no entry the write inserts a new series
+	var (
+		seriesTags      ident.Tags
+		newSeriesCharge quotaCharge
+	)
+	if !writable {
+		if err := s.checkSeriesLimits(opts); err != nil {
+			return err
+		}
+		newSeriesCharge, err = s.quotas.allowNewSeries()
+		if err != nil {
+			return err
+		}
+		// Take a copy of the tags for the new series so they are persisted
+		// along with it, the iterator is consumed so replace it for indexing
+		seriesTags, err = s.cloneTags(tags)
+		if err != nil {
+			newSeriesCharge.refund()
+			return err
+		}
+		tags = ident.NewTagSliceIterator(seriesTags)
+	}
+
 	// If no entry and we are not writing new series asynchronously
 	if !writable && !opts.writeNewSeriesAsync {
 		// Avoid double lookup by enqueueing insert immediately
-		result, err := s.insertSeriesAsyncBatched(id, dbShardInsertAsyncOptions{})
+		result, err := s.insertSeriesAsyncBatched(id, seriesTags,
+			dbShardInsertAsyncOptions{})
 		if err != nil {
+			newSeriesCharge.refund()
 			return err
 		}
 
@@ -681,7 +800,7 @@ func (s *dbShard) Write(
 		}
 	} else {
 		// This is an asynchronous insert and write
-		result, err := s.insertSeriesAsyncBatched(id, dbShardInsertAsyncOptions{
+		result, err := s.insertSeriesAsyncBatched(id, seriesTags, dbShardInsertAsyncOptions{
 			hasPendingWrite: true,
 			pendingWrite: dbShardPendingWrite{
 				timestamp:  timestamp,
@@ -691,6 +810,7 @@ func (s *dbShard) Write(
 			},
 		})
 		if err != nil {
+			newSeriesCharge.refund()
 			return err
 		}
 		// NB(r): Make sure to use the copied ID which will eventually
@@ -702,6 +822,13 @@ func (s *dbShard) Write(
 		commitLogSeriesUniqueIndex = result.entry.index
 	}
 
+	if s.isColdWrite(timestamp) {
+		// Track the cold write so it is merged into the fileset on the
+		// next flush and the commit log holding it is retained until then
+		s.coldWrites.add(s.nowFn(), timestamp.Truncate(s.namespace.Options().
+			RetentionOptions().BlockSize()))
+	}
+
 	// Write commit log
 	series := commitlog.Series{
 		UniqueIndex: commitLogSeriesUniqueIndex,
@@ -715,8 +842,20 @@ func (s *dbShard) Write(
 		Value:     value,
 	}
 
-	return s.commitLogWriter.Write(ctx, series, datapoint,
+	err = s.commitLogWriter.Write(ctx, series, datapoint,
 		unit, annotation)
+	if err != nil {
+		return err
+	}
+
+	if shouldIndex {
+		// NB: Index the series only once the write is in both the buffer
+		// and the commit log so that queries never resolve to IDs whose
+		// write was not made durable, if indexing fails the error is
+		// returned so that the write is retried and the series indexed.
+		return s.indexWriter.Write(ctx, s.namespace, id, tags, timestamp)
+	}
+	return nil
 }
 
 func (s *dbShard) ReadEncoded(
@@ -758,6 +897,16 @@ func (s *dbShard) ReadEncoded(
 	return reader.ReadEncoded(ctx, start, end)
 }
 
+func (s *dbShard) isColdWrite(timestamp time.Time) bool {
+	nsOpts := s.namespace.Options()
+	if !nsOpts.ColdWritesEnabled() {
+		return false
+	}
+	ropts := nsOpts.RetentionOptions()
+	now := s.nowFn()
+	return timestamp.Before(now.Add(-ropts.BufferPast()))
+}
+
 // lookupEntryWithLock returns the entry for a given id while holding a read lock or a write lock.
 func (s *dbShard) lookupEntryWithLock(id ident.ID) (*dbShardEntry, *list.Element, error) {
 	if s.state != dbShardStateOpen {
@@ -783,7 +932,7 @@ func (s *dbShard) writableSeries(id ident.ID) (*dbShardEntry, error) {
 		}
 
 		// Not inserted, attempt a batched insert
-		result, err := s.insertSeriesAsyncBatched(id, dbShardInsertAsyncOptions{})
+		result, err := s.insertSeriesAsyncBatched(id, nil, dbShardInsertAsyncOptions{})
 		if err != nil {
 			return nil, err
 		}
@@ -794,7 +943,10 @@ func (s *dbShard) writableSeries(id ident.ID) (*dbShardEntry, error) {
 }
 
 type writableSeriesOptions struct {
-	writeNewSeriesAsync bool
+	writeNewSeriesAsync   bool
+	maxSeriesPerShard     int
+	maxSeriesPerNamespace int
+	numSeries             int
 }
 
 func (s *dbShard) tryRetrieveWritableSeries(id ident.ID) (
@@ -804,7 +956,9 @@ func (s *dbShard) tryRetrieveWritableSeries(id ident.ID) (
 ) {
 	s.RLock()
 	opts := writableSeriesOptions{
-		writeNewSeriesAsync: s.currRuntimeOptions.writeNewSeriesAsync,
+		writeNewSeriesAsync:   s.currRuntimeOptions.writeNewSeriesAsync,
+		maxSeriesPerShard:     s.currRuntimeOptions.maxSeriesPerShard,
+		maxSeriesPerNamespace: s.currRuntimeOptions.maxSeriesPerNamespace,
 	}
 	if entry, _, err := s.lookupEntryWithLock(id); err == nil {
 		entry.incrementReaderWriterCount()
@@ -814,19 +968,54 @@ func (s *dbShard) tryRetrieveWritableSeries(id ident.ID) (
 		s.RUnlock()
 		return nil, opts, err
 	}
+	opts.numSeries = s.list.Len()
 	s.RUnlock()
 	return nil, opts, nil
 }
 
-func (s *dbShard) newShardEntry(id ident.ID) *dbShardEntry {
+// checkSeriesLimits returns an error if inserting a new series would
+// exceed the max series limits of the shard or its namespace. Since
+// inserts are batched the limits may be exceeded by the number of new
+// series inserts that are in flight concurrently.
+func (s *dbShard) checkSeriesLimits(opts writableSeriesOptions) error {
+	if limit := opts.maxSeriesPerShard; limit > 0 && opts.numSeries >= limit {
+		s.metrics.shardMaxSeriesExceeded.Inc(1)
+		return namespace.NewQuotaExceededError(errShardMaxSeriesExceeded)
+	}
+	if limit := opts.maxSeriesPerNamespace; limit > 0 &&
+		s.namespaceNumSeries.load() >= int64(limit) {
+		s.metrics.namespaceMaxSeriesExceeded.Inc(1)
+		return namespace.NewQuotaExceededError(errNamespaceMaxSeriesExceeded)
+	}
+	return nil
+}
+
+func (s *dbShard) newShardEntry(id ident.ID, tags ident.Tags) *dbShardEntry {
 	series := s.seriesPool.Get()
 	clonedID := s.identifierPool.Clone(id)
-	series.Reset(clonedID, s.seriesBlockRetriever,
+	series.Reset(clonedID, tags, s.seriesBlockRetriever,
 		s.seriesOnRetrieveBlock, s.seriesOpts)
 	uniqueIndex := s.increasingIndex.nextIndex()
 	return &dbShardEntry{series: series, index: uniqueIndex}
 }
 
+// cloneTags takes a copy of the tags from the iterator so they can be
+// held by a series beyond the lifetime of the write that created it.
+func (s *dbShard) cloneTags(iter ident.TagIterator) (ident.Tags, error) {
+	var tags ident.Tags
+	for iter.Next() {
+		tag := iter.Current()
+		tags = append(tags, ident.Tag{
+			Name:  s.identifierPool.Clone(tag.Name),
+			Value: s.identifierPool.Clone(tag.Value),
+		})
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
 type insertAsyncResult struct {
 	wg       *sync.WaitGroup
 	copiedID ident.ID
@@ -838,9 +1027,10 @@ type insertAsyncResult struct {
 
 func (s *dbShard) insertSeriesAsyncBatched(
 	id ident.ID,
+	tags ident.Tags,
 	opts dbShardInsertAsyncOptions,
 ) (insertAsyncResult, error) {
-	entry := s.newShardEntry(id)
+	entry := s.newShardEntry(id, tags)
 
 	wg, err := s.insertQueue.Insert(dbShardInsert{
 		entry: entry,
@@ -864,6 +1054,7 @@ const (
 
 func (s *dbShard) insertSeriesSync(
 	id ident.ID,
+	tags ident.Tags,
 	insertType insertSyncType,
 ) (*dbShardEntry, error) {
 	var (
@@ -892,7 +1083,7 @@ func (s *dbShard) insertSeriesSync(
 		return entry, nil
 	}
 
-	entry = s.newShardEntry(id)
+	entry = s.newShardEntry(id, tags)
 	if s.newSeriesBootstrapped {
 		if err := entry.series.Bootstrap(nil); err != nil {
 			entry = nil // Don't increment the writer count for this series
@@ -900,6 +1091,7 @@ func (s *dbShard) insertSeriesSync(
 		}
 	}
 	s.lookup[entry.series.ID().Hash()] = s.list.PushBack(entry)
+	s.namespaceNumSeries.add(1)
 	return entry, nil
 }
 
@@ -945,6 +1137,7 @@ func (s *dbShard) insertSeriesBatch(inserts []dbShardInsert) error {
 			}
 		}
 		s.lookup[entry.series.ID().Hash()] = s.list.PushBack(entry)
+		s.namespaceNumSeries.add(1)
 	}
 	s.Unlock()
 
@@ -1261,7 +1454,7 @@ func (s *dbShard) FetchBlocksMetadataV2(
 		}
 
 		for numResults < limit {
-			id, size, checksum, err := reader.ReadMetadata()
+			id, tags, size, checksum, err := reader.ReadMetadata()
 			if err == io.EOF {
 				// Clean end of volume, we can break now
 				if err := reader.Close(); err != nil {
@@ -1295,7 +1488,7 @@ func (s *dbShard) FetchBlocksMetadataV2(
 			blockResult.Add(value)
 
 			numResults++
-			result.Add(block.NewFetchBlocksMetadataResult(id, blockResult))
+			result.Add(block.NewFetchBlocksMetadataResult(id, tags, blockResult))
 		}
 
 		// Return the reader to the cache
@@ -1351,7 +1544,7 @@ func (s *dbShard) Bootstrap(
 		if entry == nil {
 			// Synchronously insert to avoid waiting for
 			// the insert queue potential delayed insert
-			entry, err = s.insertSeriesSync(dbBlocks.ID,
+			entry, err = s.insertSeriesSync(dbBlocks.ID, dbBlocks.Tags,
 				insertSyncIncReaderWriterCount)
 			if err != nil {
 				multiErr = multiErr.Add(err)
@@ -1409,6 +1602,42 @@ func (s *dbShard) Bootstrap(
 	return multiErr.FinalError()
 }
 
+func (s *dbShard) BootstrapColdWrites(
+	bootstrappedSeries map[ident.Hash]result.DatabaseSeriesBlocks,
+) error {
+	var (
+		multiErr = xerrors.NewMultiError()
+		// NB: The cold writes were read from commit logs which are
+		// retained for the commit log retention period at most, so the
+		// commit logs within it must be kept until they are flushed.
+		pendingSince = s.nowFn().Add(-s.opts.CommitLogOptions().RetentionPeriod())
+	)
+	for _, dbBlocks := range bootstrappedSeries {
+		entry, _, err := s.tryRetrieveWritableSeries(dbBlocks.ID)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		if entry == nil {
+			entry, err = s.insertSeriesSync(dbBlocks.ID, dbBlocks.Tags,
+				insertSyncIncReaderWriterCount)
+			if err != nil {
+				multiErr = multiErr.Add(err)
+				continue
+			}
+		}
+
+		entry.series.BootstrapColdWrites(dbBlocks.Blocks)
+		for _, b := range dbBlocks.Blocks.AllBlocks() {
+			s.coldWrites.add(pendingSince, b.StartTime())
+		}
+
+		entry.decrementReaderWriterCount()
+	}
+
+	return multiErr.FinalError()
+}
+
 func (s *dbShard) Flush(
 	blockStart time.Time,
 	flush persist.Flush,
@@ -1452,6 +1681,180 @@ func (s *dbShard) Flush(
 	return s.markFlushStateSuccessOrError(blockStart, multiErr.FinalError())
 }
 
+func (s *dbShard) Snapshot(
+	blockStarts []time.Time,
+	snapshotTime time.Time,
+	flush persist.Flush,
+) error {
+	// We don't snapshot data when the shard is still bootstrapping
+	s.RLock()
+	if s.bs != bootstrapped {
+		s.RUnlock()
+		return errShardNotBootstrappedToSnapshot
+	}
+	s.RUnlock()
+
+	multiErr := xerrors.NewMultiError()
+	for _, blockStart := range blockStarts {
+		// Flushed blocks are bootstrapped from their filesets instead
+		if s.FlushState(blockStart).Status == fileOpSuccess {
+			continue
+		}
+		if err := s.snapshotBlock(blockStart, snapshotTime, flush); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+	if err := multiErr.FinalError(); err != nil {
+		return err
+	}
+
+	// Only consider the shard snapshotted once every unflushed block has
+	// been, otherwise commit logs may still hold writes missing from disk.
+	s.Lock()
+	s.lastSnapshotTime = snapshotTime
+	s.Unlock()
+	return nil
+}
+
+func (s *dbShard) snapshotBlock(
+	blockStart time.Time,
+	snapshotTime time.Time,
+	flush persist.Flush,
+) error {
+	var multiErr xerrors.MultiError
+	prepared, err := flush.PrepareSnapshot(s.namespace, s.ID(), blockStart, snapshotTime)
+	multiErr = multiErr.Add(err)
+	if prepared.Persist == nil {
+		return multiErr.FinalError()
+	}
+
+	// If we encounter an error when persisting a series, we continue regardless.
+	tmpCtx := context.NewContext()
+	s.forEachShardEntry(func(entry *dbShardEntry) bool {
+		tmpCtx.Reset()
+		err := entry.series.Snapshot(tmpCtx, blockStart, prepared.Persist)
+		tmpCtx.BlockingClose()
+		multiErr = multiErr.Add(err)
+		return true
+	})
+
+	if err := prepared.Close(); err != nil {
+		multiErr = multiErr.Add(err)
+	}
+	return multiErr.FinalError()
+}
+
+func (s *dbShard) LastSnapshotTime() time.Time {
+	s.RLock()
+	t := s.lastSnapshotTime
+	s.RUnlock()
+	return t
+}
+
+func (s *dbShard) ColdFlush(
+	flush persist.Flush,
+	merger *filesetMerger,
+) error {
+	// We don't flush data when the shard is still bootstrapping
+	s.RLock()
+	if s.bs != bootstrapped {
+		s.RUnlock()
+		return errShardNotBootstrappedToFlush
+	}
+	s.RUnlock()
+
+	pendingSince, blockStarts := s.coldWrites.reset()
+	if len(blockStarts) == 0 {
+		return nil
+	}
+
+	var (
+		multiErr = xerrors.NewMultiError()
+		ropts    = s.namespace.Options().RetentionOptions()
+		earliest = retention.FlushTimeStart(ropts, s.nowFn())
+	)
+	for blockStart := range blockStarts {
+		t := blockStart.ToTime()
+		if t.Before(earliest) {
+			// Block has expired, the cold writes are dropped with it
+			continue
+		}
+		if s.FlushState(t).Status != fileOpSuccess {
+			// Cold writes are merged with the fileset once the block is
+			// flushed, until then they remain pending
+			s.coldWrites.add(pendingSince, t)
+			continue
+		}
+		if err := s.coldFlushBlock(t, flush, merger); err != nil {
+			s.coldWrites.add(pendingSince, t)
+			multiErr = multiErr.Add(err)
+		}
+	}
+
+	return multiErr.FinalError()
+}
+
+func (s *dbShard) coldFlushBlock(
+	blockStart time.Time,
+	flush persist.Flush,
+	merger *filesetMerger,
+) error {
+	var (
+		multiErr = xerrors.NewMultiError()
+		blocks   = make(filesetBlocksByID)
+		entries  []*dbShardEntry
+		versions []int
+	)
+	defer func() {
+		for _, entry := range entries {
+			entry.decrementReaderWriterCount()
+		}
+	}()
+
+	s.forEachShardEntry(func(entry *dbShardEntry) bool {
+		b, version, err := entry.series.ColdFlushBlock(blockStart)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			return true
+		}
+		if b == nil {
+			return true
+		}
+		// Hold onto the entry so the series isn't purged before the
+		// cold writes are marked as flushed
+		entry.incrementReaderWriterCount()
+		entries = append(entries, entry)
+		versions = append(versions, version)
+		id := entry.series.ID()
+		blocks[id.Hash()] = filesetBlock{id: id, tags: entry.series.Tags(), block: b}
+		return true
+	})
+	if len(blocks) == 0 {
+		return multiErr.FinalError()
+	}
+
+	ctx := s.contextPool.Get()
+	_, err := merger.Merge(ctx, flush, s.namespace, s.shard, blockStart, blocks, nil)
+	ctx.BlockingClose()
+	if err != nil {
+		return multiErr.Add(err).FinalError()
+	}
+
+	for i, entry := range entries {
+		err := entry.series.OnColdFlushed(blockStart, versions[i])
+		multiErr = multiErr.Add(err)
+	}
+
+	return multiErr.FinalError()
+}
+
+func (s *dbShard) ColdWritesPendingSince() time.Time {
+	s.coldWrites.Lock()
+	pendingSince := s.coldWrites.pendingSince
+	s.coldWrites.Unlock()
+	return pendingSince
+}
+
 func (s *dbShard) FlushState(blockStart time.Time) fileOpState {
 	s.flushState.RLock()
 	state, ok := s.flushState.statesByTime[xtime.ToUnixNano(blockStart)]
@@ -1463,6 +1866,46 @@ func (s *dbShard) FlushState(blockStart time.Time) fileOpState {
 	return state
 }
 
+func (s *dbShard) OnRepairedBlock(
+	id ident.ID,
+	blockStart time.Time,
+	segment ts.Segment,
+) {
+	// Take a copy of the segment as it is only valid for the duration of the call
+	var data []byte
+	if segment.Head != nil {
+		data = append(data, segment.Head.Get()...)
+	}
+	if segment.Tail != nil {
+		data = append(data, segment.Tail.Get()...)
+	}
+	copied := ts.NewSegment(checked.NewBytes(data, nil), nil, ts.FinalizeNone)
+
+	s.RLock()
+	entry, _, err := s.lookupEntryWithLock(id)
+	if entry != nil {
+		entry.incrementReaderWriterCount()
+		defer entry.decrementReaderWriterCount()
+	}
+	s.RUnlock()
+
+	if entry != nil {
+		entry.series.OnRepaired(blockStart, copied)
+		return
+	}
+
+	switch s.opts.SeriesCachePolicy() {
+	case series.CacheAll, series.CacheAllMetadata:
+		if err == errShardEntryNotFound {
+			// All series are expected to be held in memory, insert the series
+			// repaired from peers along with its block
+			s.OnRetrieveBlock(id, blockStart, copied)
+			return
+		}
+	}
+	copied.Finalize()
+}
+
 func (s *dbShard) markFlushStateSuccessOrError(blockStart time.Time, err error) error {
 	// Track flush state for block state
 	if err == nil {
@@ -1521,5 +1964,5 @@ func (s *dbShard) Repair(
 	tr xtime.Range,
 	repairer databaseShardRepairer,
 ) (repair.MetadataComparisonResult, error) {
-	return repairer.Repair(ctx, s.namespace.ID(), tr, s)
+	return repairer.Repair(ctx, s.namespace, tr, s)
 }
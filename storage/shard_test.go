@@ -23,6 +23,8 @@ package storage
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -30,6 +32,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3db/persist"
+	"github.com/m3db/m3db/persist/fs/commitlog"
 	"github.com/m3db/m3db/retention"
 	"github.com/m3db/m3db/runtime"
 	"github.com/m3db/m3db/storage/block"
@@ -64,7 +67,7 @@ func testDatabaseShard(t *testing.T, opts Options) *dbShard {
 	nsReaderMgr := newNamespaceReaderManager(ns.metadata, tally.NoopScope, opts)
 	seriesOpts := NewSeriesOptionsFromOptions(opts, ns.Options().RetentionOptions())
 	return newDatabaseShard(ns.metadata, 0, nil, nsReaderMgr,
-		&testIncreasingIndex{}, commitLogWriteNoOp, databaseIndexNoOp, true, opts, seriesOpts).(*dbShard)
+		&testIncreasingIndex{}, commitLogWriteNoOp, databaseIndexNoOp, nil, nil, true, opts, seriesOpts).(*dbShard)
 }
 
 func addMockSeries(ctrl *gomock.Controller, shard *dbShard, id ident.ID, index uint64) *series.MockDatabaseSeries {
@@ -80,7 +83,7 @@ func TestShardDontNeedBootstrap(t *testing.T) {
 	testNs := newTestNamespace(t)
 	seriesOpts := NewSeriesOptionsFromOptions(opts, testNs.Options().RetentionOptions())
 	shard := newDatabaseShard(testNs.metadata, 0, nil, nil,
-		&testIncreasingIndex{}, commitLogWriteNoOp, databaseIndexNoOp, false, opts, seriesOpts).(*dbShard)
+		&testIncreasingIndex{}, commitLogWriteNoOp, databaseIndexNoOp, nil, nil, false, opts, seriesOpts).(*dbShard)
 	defer shard.Close()
 
 	require.Equal(t, bootstrapped, shard.bs)
@@ -224,7 +227,7 @@ func TestShardFlushSeriesFlushError(t *testing.T) {
 	var closed bool
 	flush := persist.NewMockFlush(ctrl)
 	prepared := persist.PreparedPersist{
-		Persist: func(ident.ID, ts.Segment, uint32) error { return nil },
+		Persist: func(ident.ID, ident.Tags, ts.Segment, uint32) error { return nil },
 		Close:   func() error { closed = true; return nil },
 	}
 	expectedErr := errors.New("error foo")
@@ -286,7 +289,7 @@ func TestShardFlushSeriesFlushSuccess(t *testing.T) {
 	var closed bool
 	flush := persist.NewMockFlush(ctrl)
 	prepared := persist.PreparedPersist{
-		Persist: func(ident.ID, ts.Segment, uint32) error { return nil },
+		Persist: func(ident.ID, ident.Tags, ts.Segment, uint32) error { return nil },
 		Close:   func() error { closed = true; return nil },
 	}
 
@@ -326,6 +329,72 @@ func TestShardFlushSeriesFlushSuccess(t *testing.T) {
 	}, flushState)
 }
 
+func TestShardColdFlush(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir, err := ioutil.TempDir("", "testdir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	opts := testDatabaseOptions()
+	opts = opts.SetCommitLogOptions(opts.CommitLogOptions().
+		SetFilesystemOptions(opts.CommitLogOptions().FilesystemOptions().
+			SetFilePathPrefix(dir)))
+
+	s := testDatabaseShard(t, opts)
+	defer s.Close()
+	s.bs = bootstrapped
+
+	merger, err := newFilesetMerger(opts)
+	require.NoError(t, err)
+
+	var (
+		blockSize    = s.namespace.Options().RetentionOptions().BlockSize()
+		flushed      = time.Now().Truncate(blockSize).Add(-2 * blockSize)
+		unflushed    = flushed.Add(blockSize)
+		pendingSince = time.Now()
+	)
+	s.markFlushStateSuccess(flushed)
+	s.coldWrites.add(pendingSince, flushed)
+	s.coldWrites.add(pendingSince, unflushed)
+
+	var persisted []string
+	flush := persist.NewMockFlush(ctrl)
+	flush.EXPECT().PrepareVolume(namespace.NewMetadataMatcher(s.namespace),
+		s.shard, flushed).Return(persist.PreparedPersist{
+		Persist: func(id ident.ID, _ ident.Tags, _ ts.Segment, _ uint32) error {
+			persisted = append(persisted, id.String())
+			return nil
+		},
+		Close: func() error { return nil },
+	}, nil)
+
+	segment := ts.NewSegment(checked.NewBytes([]byte("foo"), nil), nil, ts.FinalizeNone)
+	segment.Head.IncRef()
+	reader := xio.NewMockSegmentReader(ctrl)
+	reader.EXPECT().Segment().Return(segment, nil)
+
+	b := block.NewMockDatabaseBlock(ctrl)
+	b.EXPECT().Stream(gomock.Any()).Return(reader, nil)
+	b.EXPECT().Close()
+
+	foo := addMockSeries(ctrl, s, ident.StringID("foo"), 0)
+	foo.EXPECT().ColdFlushBlock(flushed).Return(b, 3, nil)
+	foo.EXPECT().OnColdFlushed(flushed, 3).Return(nil)
+	bar := addMockSeries(ctrl, s, ident.StringID("bar"), 1)
+	bar.EXPECT().ColdFlushBlock(flushed).Return(nil, 0, nil)
+
+	require.NoError(t, s.ColdFlush(flush, merger))
+	require.Equal(t, []string{"foo"}, persisted)
+
+	// Cold writes for the block not yet flushed remain pending
+	require.Equal(t, pendingSince, s.ColdWritesPendingSince())
+	require.Equal(t, 1, len(s.coldWrites.blockStarts))
+	_, ok := s.coldWrites.blockStarts[xtime.ToUnixNano(unflushed)]
+	require.True(t, ok)
+}
+
 func addMockTestSeries(ctrl *gomock.Controller, shard *dbShard, id ident.ID) *series.MockDatabaseSeries {
 	series := series.NewMockDatabaseSeries(ctrl)
 	series.EXPECT().ID().AnyTimes().Return(id)
@@ -336,7 +405,7 @@ func addMockTestSeries(ctrl *gomock.Controller, shard *dbShard, id ident.ID) *se
 }
 
 func addTestSeries(shard *dbShard, id ident.ID) series.DatabaseSeries {
-	series := series.NewDatabaseSeries(id, shard.seriesOpts)
+	series := series.NewDatabaseSeries(id, nil, shard.seriesOpts)
 	series.Bootstrap(nil)
 	shard.Lock()
 	shard.lookup[id.Hash()] = shard.list.PushBack(&dbShardEntry{series: series})
@@ -490,6 +559,121 @@ func TestShardWriteAsync(t *testing.T) {
 	require.True(t, ok)
 }
 
+func TestShardWriteMaxSeriesPerShard(t *testing.T) {
+	opts := testDatabaseOptions()
+	shard := testDatabaseShard(t, opts)
+	shard.SetRuntimeOptions(runtime.NewOptions().SetMaxSeriesPerShard(2))
+	defer shard.Close()
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	now := time.Now()
+	require.NoError(t, shard.Write(ctx, ident.StringID("foo"), now, 1.0, xtime.Second, nil))
+	require.NoError(t, shard.Write(ctx, ident.StringID("bar"), now, 2.0, xtime.Second, nil))
+
+	// New series are rejected once the limit is reached
+	err := shard.Write(ctx, ident.StringID("baz"), now, 3.0, xtime.Second, nil)
+	require.Error(t, err)
+	require.True(t, namespace.IsQuotaExceededError(err))
+	require.Equal(t, int64(2), shard.NumSeries())
+
+	// Existing series are still accepted
+	require.NoError(t, shard.Write(ctx, ident.StringID("foo"), now.Add(time.Second), 4.0, xtime.Second, nil))
+
+	// Raising the limit accepts new series again
+	shard.SetRuntimeOptions(runtime.NewOptions().SetMaxSeriesPerShard(3))
+	require.NoError(t, shard.Write(ctx, ident.StringID("baz"), now, 3.0, xtime.Second, nil))
+}
+
+type testIndexWriterFn func(
+	ctx context.Context,
+	namespace namespace.Metadata,
+	id ident.ID,
+	tags ident.TagIterator,
+	timestamp time.Time,
+) error
+
+func (fn testIndexWriterFn) Write(
+	ctx context.Context,
+	namespace namespace.Metadata,
+	id ident.ID,
+	tags ident.TagIterator,
+	timestamp time.Time,
+) error {
+	return fn(ctx, namespace, id, tags, timestamp)
+}
+
+func TestShardWriteTaggedWritesCommitLogBeforeIndexing(t *testing.T) {
+	opts := testDatabaseOptions()
+	ns := newTestNamespace(t)
+	seriesOpts := NewSeriesOptionsFromOptions(opts, ns.Options().RetentionOptions())
+
+	var (
+		commitLogWrites int
+		indexErr        = errors.New("index error")
+	)
+	commitLogWriter := commitLogWriterFn(func(
+		ctx context.Context,
+		series commitlog.Series,
+		datapoint ts.Datapoint,
+		unit xtime.Unit,
+		annotation ts.Annotation,
+	) error {
+		commitLogWrites++
+		return nil
+	})
+	indexWriter := testIndexWriterFn(func(
+		ctx context.Context,
+		namespace namespace.Metadata,
+		id ident.ID,
+		tags ident.TagIterator,
+		timestamp time.Time,
+	) error {
+		// The write is in the commit log before the series is indexed
+		require.Equal(t, 1, commitLogWrites)
+		return indexErr
+	})
+	shard := newDatabaseShard(ns.metadata, 0, nil, nil, &testIncreasingIndex{},
+		commitLogWriter, indexWriter, nil, nil, true, opts, seriesOpts).(*dbShard)
+	shard.SetRuntimeOptions(runtime.NewOptions().SetWriteNewSeriesAsync(false))
+	defer shard.Close()
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	// A failure to index is returned but the write is already durable
+	err := shard.WriteTagged(ctx, ident.StringID("foo"),
+		testIndexTags("name", "value"),
+		time.Now(), 1.0, xtime.Second, nil)
+	require.Equal(t, indexErr, err)
+	require.Equal(t, 1, commitLogWrites)
+	require.Equal(t, int64(1), shard.NumSeries())
+}
+
+func TestShardWriteMaxSeriesPerNamespace(t *testing.T) {
+	opts := testDatabaseOptions()
+	shard := testDatabaseShard(t, opts)
+	numSeries := &namespaceSeriesCount{}
+	shard.namespaceNumSeries = numSeries
+	shard.SetRuntimeOptions(runtime.NewOptions().SetMaxSeriesPerNamespace(1))
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	now := time.Now()
+	require.NoError(t, shard.Write(ctx, ident.StringID("foo"), now, 1.0, xtime.Second, nil))
+	require.Equal(t, int64(1), numSeries.load())
+
+	err := shard.Write(ctx, ident.StringID("bar"), now, 2.0, xtime.Second, nil)
+	require.Error(t, err)
+	require.True(t, namespace.IsQuotaExceededError(err))
+
+	// Closing the shard purges its series from the namespace count
+	require.NoError(t, shard.Close())
+	require.Equal(t, int64(0), numSeries.load())
+}
+
 // This tests a race in shard ticking with an empty series pending expiration.
 func TestShardTickRace(t *testing.T) {
 	opts := testDatabaseOptions()
@@ -37,6 +37,7 @@ import (
 	"github.com/m3db/m3db/storage/namespace"
 	"github.com/m3db/m3db/storage/repair"
 	"github.com/m3db/m3db/storage/series"
+	"github.com/m3db/m3db/ts"
 	"github.com/m3db/m3db/x/xcounter"
 	"github.com/m3db/m3db/x/xio"
 	"github.com/m3db/m3x/context"
@@ -157,6 +158,10 @@ type Database interface {
 	// IsBootstrapped determines whether the database is bootstrapped.
 	IsBootstrapped() bool
 
+	// BootstrapStatus returns the bootstrap status of the shards of each
+	// owned namespace in ascending order of namespace ID.
+	BootstrapStatus() []NamespaceBootstrapStatus
+
 	// IsOverloaded determines whether the database is overloaded
 	IsOverloaded() bool
 
@@ -211,6 +216,9 @@ type databaseNamespace interface {
 	// AssignShardSet sets the shard set assignment and returns immediately
 	AssignShardSet(shardSet sharding.ShardSet)
 
+	// SetQuotaOptions updates the quotas enforced for the namespace
+	SetQuotaOptions(value namespace.QuotaOptions)
+
 	// GetOwnedShards returns the database shards
 	GetOwnedShards() []databaseShard
 
@@ -279,9 +287,27 @@ type databaseNamespace interface {
 		targetRanges []bootstrap.TargetRange,
 	) error
 
+	// BootstrapStatus returns the bootstrap status of the owned shards
+	BootstrapStatus() NamespaceBootstrapStatus
+
 	// Flush flushes in-memory data
 	Flush(blockStart time.Time, flush persist.Flush) error
 
+	// ColdFlush merges cold writes with the filesets already flushed
+	ColdFlush(flush persist.Flush, merger *filesetMerger) error
+
+	// Snapshot snapshots the unflushed in-memory data for the given block
+	// starts as of the snapshot time
+	Snapshot(blockStarts []time.Time, snapshotTime time.Time, flush persist.Flush) error
+
+	// LastSnapshotTime returns the time of the last snapshot that covered all
+	// the owned shards, or zero if any shard has not been snapshotted
+	LastSnapshotTime() time.Time
+
+	// ColdWritesPendingSince returns the earliest time cold writes not
+	// yet merged into filesets were received, or zero if there are none
+	ColdWritesPendingSince() time.Time
+
 	// NeedsFlush returns true if the namespace needs a flush for the
 	// period: [start, end] (both inclusive).
 	// NB: The start/end times are assumed to be aligned to block size boundary.
@@ -294,6 +320,63 @@ type databaseNamespace interface {
 	Repair(repairer databaseShardRepairer, tr xtime.Range) error
 }
 
+// ShardBootstrapState is the bootstrap state of a shard.
+type ShardBootstrapState int
+
+const (
+	// ShardBootstrapPending is the state of a shard waiting to be bootstrapped.
+	ShardBootstrapPending ShardBootstrapState = iota
+
+	// ShardBootstrapping is the state of a shard being bootstrapped.
+	ShardBootstrapping
+
+	// ShardBootstrapped is the state of a bootstrapped shard.
+	ShardBootstrapped
+
+	// ShardBootstrapFailed is the state of a shard that failed to bootstrap.
+	ShardBootstrapFailed
+)
+
+// ShardBootstrapStatus is the bootstrap status of a shard.
+type ShardBootstrapStatus struct {
+	// Shard is the ID of the shard.
+	Shard uint32
+
+	// State is the bootstrap state of the shard.
+	State ShardBootstrapState
+
+	// Source is the name of the bootstrapper that is bootstrapping the
+	// shard, or that last bootstrapped the shard once no longer bootstrapping.
+	Source string
+
+	// NumSeries is the number of series fetched to bootstrap the shard.
+	NumSeries int64
+
+	// NumBytes is the number of bytes of data fetched to bootstrap the shard.
+	NumBytes int64
+
+	// NumPersistedBlocks is the number of blocks persisted so far by an
+	// incremental bootstrap of the shard.
+	NumPersistedBlocks int
+
+	// NumTotalBlocks is the number of blocks an incremental bootstrap of
+	// the shard persists once complete.
+	NumTotalBlocks int
+
+	// Err is the error the shard failed to bootstrap with.
+	Err error
+}
+
+// NamespaceBootstrapStatus is the bootstrap status of the shards of a namespace.
+type NamespaceBootstrapStatus struct {
+	// Namespace is the ID of the namespace.
+	Namespace ident.ID
+
+	// Shards are the bootstrap statuses of the owned shards in ascending
+	// order of shard ID.
+	Shards []ShardBootstrapStatus
+}
+
 // Shard is a time series database shard
 type Shard interface {
 	// ID returns the ID of the shard
@@ -370,15 +453,48 @@ type databaseShard interface {
 		bootstrappedSeries map[ident.Hash]result.DatabaseSeriesBlocks,
 	) error
 
+	// BootstrapColdWrites adds the cold writes replayed during bootstrap.
+	BootstrapColdWrites(
+		bootstrappedSeries map[ident.Hash]result.DatabaseSeriesBlocks,
+	) error
+
 	// Flush flushes the series' in this shard.
 	Flush(
 		blockStart time.Time,
 		flush persist.Flush,
 	) error
 
+	// Snapshot snapshots the unflushed data of the series' in this shard
+	// for the given block starts as of the snapshot time.
+	Snapshot(
+		blockStarts []time.Time,
+		snapshotTime time.Time,
+		flush persist.Flush,
+	) error
+
+	// LastSnapshotTime returns the time of the last snapshot that covered
+	// all the unflushed blocks of this shard, or zero if there is none.
+	LastSnapshotTime() time.Time
+
+	// ColdFlush merges the cold writes in this shard with the filesets
+	// of the blocks they were written to.
+	ColdFlush(
+		flush persist.Flush,
+		merger *filesetMerger,
+	) error
+
+	// ColdWritesPendingSince returns the earliest time cold writes not
+	// yet merged into filesets were received, or zero if there are none.
+	ColdWritesPendingSince() time.Time
+
 	// FlushState returns the flush state for this shard at block start.
 	FlushState(blockStart time.Time) fileOpState
 
+	// OnRepairedBlock replaces the block held in memory for a series with
+	// the repaired block once it has been persisted, the segment is only
+	// valid for the duration of the call.
+	OnRepairedBlock(id ident.ID, blockStart time.Time, segment ts.Segment)
+
 	// CleanupFileset cleans up fileset files
 	CleanupFileset(earliestToRetain time.Time) error
 
@@ -392,12 +508,14 @@ type databaseShard interface {
 
 // databaseIndex indexes database writes.
 type databaseIndex interface {
-	// Write writes a timeseries ID and Tags.
+	// Write indexes a timeseries ID and Tags for the block containing
+	// the given timestamp.
 	Write(
 		ctx context.Context,
-		namespace ident.ID,
+		namespace namespace.Metadata,
 		id ident.ID,
 		tags ident.TagIterator,
+		timestamp time.Time,
 	) error
 
 	// Query resolves the given query into known IDs.
@@ -406,6 +524,25 @@ type databaseIndex interface {
 		query index.Query,
 		opts index.QueryOptions,
 	) (index.QueryResults, error)
+
+	// Bootstrap adds the reverse index blocks loaded during bootstrap.
+	Bootstrap(
+		namespace namespace.Metadata,
+		blocks result.IndexResults,
+	) error
+
+	// Flush persists the reverse index block starting at the given
+	// block start time to a new index fileset volume, if it exists and
+	// has changed since it was last flushed.
+	Flush(
+		namespace namespace.Metadata,
+		blockStart time.Time,
+		flush persist.Flush,
+	) error
+
+	// BlockStarts returns the start times of the reverse index blocks
+	// held in memory.
+	BlockStarts(namespace namespace.Metadata) []time.Time
 }
 
 // databaseBootstrapManager manages the bootstrap process.
@@ -438,6 +575,15 @@ type databaseCleanupManager interface {
 	Report()
 }
 
+// databaseCompactionManager manages compacting fileset volumes in persistent storage.
+type databaseCompactionManager interface {
+	// Compact removes fileset volumes superseded by a newer complete volume.
+	Compact(t time.Time) error
+
+	// Report reports runtime information
+	Report()
+}
+
 // databaseFileSystemManager manages the database related filesystem activities.
 type databaseFileSystemManager interface {
 	// Cleanup cleans up data not needed in the persistent storage.
@@ -446,6 +592,9 @@ type databaseFileSystemManager interface {
 	// Flush flushes in-memory data to persistent storage.
 	Flush(t time.Time) error
 
+	// Compact removes fileset volumes superseded by a newer complete volume.
+	Compact(t time.Time) error
+
 	// Disable disables the filesystem manager and prevents it from
 	// performing file operations, returns the current file operation status
 	Disable() fileOpStatus
@@ -472,7 +621,7 @@ type databaseShardRepairer interface {
 	// Repair repairs the data for a given namespace and shard
 	Repair(
 		ctx context.Context,
-		namespace ident.ID,
+		namespace namespace.Metadata,
 		tr xtime.Range,
 		shard databaseShard,
 	) (repair.MetadataComparisonResult, error)
@@ -641,6 +790,14 @@ type Options interface {
 	// MaxFlushRetries returns the maximum number of retries when data flushing fails
 	MaxFlushRetries() int
 
+	// SetMinimumSnapshotInterval sets the minimum time between snapshots of
+	// the unflushed data of namespaces with snapshots enabled
+	SetMinimumSnapshotInterval(value time.Duration) Options
+
+	// MinimumSnapshotInterval returns the minimum time between snapshots of
+	// the unflushed data of namespaces with snapshots enabled
+	MinimumSnapshotInterval() time.Duration
+
 	// SetDatabaseBlockRetrieverManager sets the block retriever manager to
 	// use when bootstrapping retrievable blocks instead of blocks
 	// containing data.
@@ -0,0 +1,86 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/m3db/m3db/persist/fs"
+	"github.com/m3db/m3db/persist/fs/backup"
+	"github.com/m3db/m3x/ident"
+	xlog "github.com/m3db/m3x/log"
+)
+
+var (
+	optPathPrefix = flag.String("path-prefix", "/var/lib/m3db", "Path prefix [e.g. /var/lib/m3db]")
+	optNamespaces = flag.String("namespaces", "", "Comma separated namespaces to back up [e.g. metrics,rollups]")
+	optBackupDir  = flag.String("backup-dir", "", "Directory backups are kept in")
+	optBackupID   = flag.String("backup-id", "", "Backup ID, defaults to the current time in nsec")
+	optList       = flag.Bool("list", false, "List the backups in the backup directory")
+)
+
+func main() {
+	flag.Parse()
+	if *optBackupDir == "" || (!*optList && *optNamespaces == "") {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	log := xlog.NewLogger(os.Stderr)
+	fsOpts := fs.NewOptions().SetFilePathPrefix(*optPathPrefix)
+	manager, err := backup.NewManager(backup.NewOptions().
+		SetFilesystemOptions(fsOpts).
+		SetBlobStore(backup.NewLocalBlobStore(*optBackupDir)))
+	if err != nil {
+		log.Fatalf("unable to create backup manager: %v", err)
+	}
+
+	if *optList {
+		backupIDs, err := manager.Backups()
+		if err != nil {
+			log.Fatalf("unable to list backups: %v", err)
+		}
+		for _, backupID := range backupIDs {
+			fmt.Println(backupID)
+		}
+		return
+	}
+
+	backupID := *optBackupID
+	if backupID == "" {
+		backupID = fmt.Sprintf("%d", fsOpts.ClockOptions().NowFn()().UnixNano())
+	}
+	var namespaces []ident.ID
+	for _, namespace := range strings.Split(*optNamespaces, ",") {
+		namespaces = append(namespaces, ident.StringID(strings.TrimSpace(namespace)))
+	}
+
+	manifest, err := manager.Backup(backupID, namespaces)
+	if err != nil {
+		log.Fatalf("unable to back up: %v", err)
+	}
+
+	log.Infof("successfully backed up %d filesets to backup %s",
+		len(manifest.Entries), manifest.ID)
+}
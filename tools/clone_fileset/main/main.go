@@ -4,6 +4,7 @@ import (
 	"flag"
 	"os"
 
+	"github.com/m3db/m3db/persist/fs"
 	"github.com/m3db/m3db/persist/fs/clone"
 	xlog "github.com/m3db/m3x/log"
 	xtime "github.com/m3db/m3x/time"
@@ -19,6 +20,9 @@ var (
 	optDestShard      = flag.Uint("dest-shard-id", 0, "Destination Shard ID")
 	optDestBlockstart = flag.Int64("dest-block-start", 0, "Destination Block Start Time [in nsec]")
 	optDestBlockSize  = flag.Duration("dest-block-size", 0, "Destination Block Size")
+	optCompression    = flag.String("compression", "none", "Destination Compression [none, snappy, zstd]")
+	optEncrypt        = flag.Bool("encrypt", false, "Encrypt Destination with AES-GCM")
+	optKeyFile        = flag.String("encryption-key-file", "", "Encryption Key File [hex encoded]")
 )
 
 func main() {
@@ -50,7 +54,27 @@ func main() {
 	log.Infof("source: %+v", src)
 	log.Infof("destination: %+v", dest)
 
-	opts := clone.NewOptions()
+	compression, err := fs.ParseCompressionType(*optCompression)
+	if err != nil {
+		log.Fatalf("invalid compression: %v", err)
+	}
+	encryption := fs.EncryptionNone
+	if *optEncrypt {
+		encryption = fs.EncryptionAESGCM
+	}
+	var key []byte
+	if *optKeyFile != "" {
+		key, err = fs.ReadEncryptionKeyFile(*optKeyFile)
+		if err != nil {
+			log.Fatalf("unable to read encryption key: %v", err)
+		}
+	}
+	codec, err := fs.NewBlockCodec(compression, encryption, key)
+	if err != nil {
+		log.Fatalf("unable to create block codec: %v", err)
+	}
+
+	opts := clone.NewOptions().SetBlockCodec(codec)
 	cloner := clone.New(opts)
 	if err := cloner.Clone(src, dest, *optDestBlockSize); err != nil {
 		log.Fatalf("unable to clone: %v", err)
@@ -54,7 +54,7 @@ func main() {
 	}
 
 	for {
-		id, _, _, err := reader.ReadMetadata()
+		id, _, _, _, err := reader.ReadMetadata()
 		if err == io.EOF {
 			break
 		}
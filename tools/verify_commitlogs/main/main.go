@@ -69,6 +69,7 @@ var (
 	readConcurrency        = flagParser.Int("read-concurrency", 4, "Commitlog read concurrency")
 	encodingConcurrency    = flagParser.Int("encoding-concurrency", 4, "Encoding concurrency")
 	mergeShardsConcurrency = flagParser.Int("merge-shards-concurrency", 4, "Merge shards concurrency")
+	encryptionKeyFileArg   = flagParser.String("encryption-key-file", "", "Encryption key file [hex encoded] - required to read encrypted commitlogs")
 )
 
 func main() {
@@ -85,6 +86,7 @@ func main() {
 		debugListenAddress = *debugListenAddressArg
 		startUnixTimestamp = *startUnixTimestampArg
 		endUnixTimestamp   = *endUnixTimestampArg
+		encryptionKeyFile  = *encryptionKeyFileArg
 	)
 
 	log := xlog.NewLogger(os.Stderr)
@@ -220,13 +222,45 @@ func main() {
 		SetInstrumentOptions(instrumentOpts).
 		SetFilePathPrefix(pathPrefix)
 
+	// The codec of each commitlog is declared in its info so only the key
+	// is required to decode the chunks of encrypted commitlogs
+	var encryptionKey []byte
+	if encryptionKeyFile != "" {
+		var err error
+		encryptionKey, err = fs.ReadEncryptionKeyFile(encryptionKeyFile)
+		if err != nil {
+			log.Fatalf("could not read encryption key: %v", err)
+		}
+	}
+	chunkCodec, err := fs.NewBlockCodec(fs.CompressionNone, fs.EncryptionNone, encryptionKey)
+	if err != nil {
+		log.Fatalf("could not create chunk codec: %v", err)
+	}
+
 	commitLogOpts := commitlog.NewOptions().
 		SetInstrumentOptions(instrumentOpts).
 		SetFilesystemOptions(fsOpts).
 		SetFlushSize(flushSize).
 		SetBlockSize(blockSize).
 		SetReadConcurrency(*readConcurrency).
-		SetBytesPool(bytesPool)
+		SetBytesPool(bytesPool).
+		SetChunkCodec(chunkCodec)
+
+	commitLogFiles, err := fs.CommitLogFiles(fs.CommitLogsDirPath(pathPrefix))
+	if err != nil {
+		log.Fatalf("could not list commitlogs: %v", err)
+	}
+	for _, file := range commitLogFiles {
+		info, err := commitlog.ReadLogCodecInfo(file, commitLogOpts)
+		if err != nil {
+			log.Fatalf("could not read commitlog info of '%s': %v", file, err)
+		}
+		log.WithFields(
+			xlog.NewField("file", file),
+			xlog.NewField("compression", fs.CompressionType(info.Compression).String()),
+			xlog.NewField("encryption", fs.EncryptionType(info.Encryption).String()),
+		).Infof("commitlog codec")
+	}
 
 	opts := commitlogsrc.NewOptions().
 		SetResultOptions(resultOpts).
@@ -134,7 +134,7 @@ func seriesChecksumsFromReader(reader fs.FileSetReader, host string, shard uint3
 		block:  block,
 	}
 	for {
-		id, _, checksumVal, err := reader.ReadMetadata()
+		id, _, _, checksumVal, err := reader.ReadMetadata()
 		if err == io.EOF {
 			return seriesChecksums
 		}
@@ -0,0 +1,143 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+// Package serialize encodes series tags to and decodes them from a compact
+// binary representation, used to persist the tags of a series alongside its ID.
+package serialize
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/m3db/m3x/ident"
+)
+
+// The encoded format is a magic marker and the number of tags followed by the
+// length prefixed name and value of each tag, all lengths are little endian uint16s:
+// | magic | num tags | name length | name | value length | value | ...
+const (
+	headerMagicNumber uint16 = 10101
+
+	maxTagLiteralLength = math.MaxUint16
+	maxNumTags          = math.MaxUint16
+)
+
+var (
+	endianness = binary.LittleEndian
+
+	errIncorrectHeader = errors.New("encoded tags have an incorrect header")
+	errTooManyTags     = fmt.Errorf("number of tags exceeds limit of %d", maxNumTags)
+	errTagTooLong      = fmt.Errorf("tag name or value exceeds limit of %d bytes", maxTagLiteralLength)
+	errTruncated       = errors.New("encoded tags are truncated")
+)
+
+// EncodeTags encodes tags, returning nil for no tags.
+func EncodeTags(tags ident.Tags) ([]byte, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	if len(tags) > maxNumTags {
+		return nil, errTooManyTags
+	}
+
+	size := 4
+	for _, tag := range tags {
+		name, value := tag.Name.Data().Get(), tag.Value.Data().Get()
+		if len(name) > maxTagLiteralLength || len(value) > maxTagLiteralLength {
+			return nil, errTagTooLong
+		}
+		size += 4 + len(name) + len(value)
+	}
+
+	data := make([]byte, 0, size)
+	data = appendUint16(data, headerMagicNumber)
+	data = appendUint16(data, uint16(len(tags)))
+	for _, tag := range tags {
+		data = appendBytes(data, tag.Name.Data().Get())
+		data = appendBytes(data, tag.Value.Data().Get())
+	}
+	return data, nil
+}
+
+// DecodeTags decodes tags encoded with EncodeTags, returning nil for no data.
+// The returned tags do not reference the encoded data.
+func DecodeTags(data []byte) (ident.Tags, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	magic, data, err := readUint16(data)
+	if err != nil {
+		return nil, err
+	}
+	if magic != headerMagicNumber {
+		return nil, errIncorrectHeader
+	}
+	numTags, data, err := readUint16(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(ident.Tags, 0, int(numTags))
+	for i := 0; i < int(numTags); i++ {
+		var name, value []byte
+		if name, data, err = readBytes(data); err != nil {
+			return nil, err
+		}
+		if value, data, err = readBytes(data); err != nil {
+			return nil, err
+		}
+		tags = append(tags, ident.Tag{
+			Name:  ident.StringID(string(name)),
+			Value: ident.StringID(string(value)),
+		})
+	}
+	return tags, nil
+}
+
+func appendUint16(data []byte, value uint16) []byte {
+	var buf [2]byte
+	endianness.PutUint16(buf[:], value)
+	return append(data, buf[:]...)
+}
+
+func appendBytes(data []byte, value []byte) []byte {
+	data = appendUint16(data, uint16(len(value)))
+	return append(data, value...)
+}
+
+func readUint16(data []byte) (uint16, []byte, error) {
+	if len(data) < 2 {
+		return 0, nil, errTruncated
+	}
+	return endianness.Uint16(data), data[2:], nil
+}
+
+func readBytes(data []byte) ([]byte, []byte, error) {
+	length, data, err := readUint16(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(data) < int(length) {
+		return nil, nil, errTruncated
+	}
+	return data[:length], data[length:], nil
+}
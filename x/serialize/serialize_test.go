@@ -0,0 +1,81 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package serialize
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/m3db/m3x/ident"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTags(nameValues ...string) ident.Tags {
+	var tags ident.Tags
+	for i := 0; i < len(nameValues); i += 2 {
+		tags = append(tags, ident.Tag{
+			Name:  ident.StringID(nameValues[i]),
+			Value: ident.StringID(nameValues[i+1]),
+		})
+	}
+	return tags
+}
+
+func TestEncodeDecodeTags(t *testing.T) {
+	tags := testTags("__name__", "up", "job", "node", "empty", "")
+
+	data, err := EncodeTags(tags)
+	require.NoError(t, err)
+
+	decoded, err := DecodeTags(data)
+	require.NoError(t, err)
+	require.Equal(t, len(tags), len(decoded))
+	for i := range tags {
+		assert.Equal(t, tags[i].Name.String(), decoded[i].Name.String())
+		assert.Equal(t, tags[i].Value.String(), decoded[i].Value.String())
+	}
+}
+
+func TestEncodeDecodeNoTags(t *testing.T) {
+	data, err := EncodeTags(nil)
+	require.NoError(t, err)
+	assert.Nil(t, data)
+
+	decoded, err := DecodeTags(nil)
+	require.NoError(t, err)
+	assert.Nil(t, decoded)
+}
+
+func TestEncodeTagTooLong(t *testing.T) {
+	_, err := EncodeTags(testTags("name", strings.Repeat("a", maxTagLiteralLength+1)))
+	assert.Equal(t, errTagTooLong, err)
+}
+
+func TestDecodeInvalidTags(t *testing.T) {
+	_, err := DecodeTags([]byte{0x1, 0x2, 0x1, 0x0})
+	assert.Equal(t, errIncorrectHeader, err)
+
+	data, err := EncodeTags(testTags("name", "value"))
+	require.NoError(t, err)
+	_, err = DecodeTags(data[:len(data)-1])
+	assert.Equal(t, errTruncated, err)
+}